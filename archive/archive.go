@@ -0,0 +1,435 @@
+// Package archive browses zip and tar files as virtual directories and edits the files inside
+// them.
+//
+// A file inside an archive is named by a virtual path made of the archive path, "::" and the
+// slash separated name of the entry, such as "logs.zip::2024/app.log". Entries are edited by
+// loading them into a buffer bound to their virtual path and written back by rewriting the
+// archive with the entry replaced.
+package archive
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/avalonbits/goted/text"
+)
+
+// Separator separates the archive path from the entry name in a virtual path.
+const Separator = "::"
+
+var (
+	// ErrNotArchive is returned when opening a file that is not a zip or tar archive.
+	ErrNotArchive = errors.New("archive: not a zip or tar file")
+
+	// ErrNotFound is returned when an archive has no entry with the given name.
+	ErrNotFound = errors.New("archive: no such entry")
+)
+
+type kind int
+
+const (
+	zipKind kind = iota
+	tarKind
+	tgzKind
+)
+
+// Entry is a file or directory inside an archive.
+type Entry struct {
+	Name    string
+	Size    int64
+	Dir     bool
+	Mode    fs.FileMode
+	ModTime time.Time
+}
+
+// Archive is an opened zip or tar file.
+type Archive struct {
+	path    string
+	kind    kind
+	entries []Entry
+}
+
+// IsArchive reports whether path names an archive by its extension.
+func IsArchive(path string) bool {
+	name := strings.ToLower(path)
+	for _, ext := range []string{".zip", ".jar", ".tar", ".tar.gz", ".tgz"} {
+		if strings.HasSuffix(name, ext) {
+			return true
+		}
+	}
+	return false
+}
+
+// Split splits a virtual path into the archive path and the entry name. Returns false if path
+// does not name an entry of an archive.
+func Split(path string) (string, string, bool) {
+	archive, name, ok := strings.Cut(path, Separator)
+	return archive, name, ok && archive != ""
+}
+
+// Join returns the virtual path of the entry name in the archive at path.
+func Join(path, name string) string {
+	return path + Separator + name
+}
+
+// Open reads the table of contents of the archive at path. Gzip compressed tar files are
+// supported.
+func Open(path string) (*Archive, error) {
+	k, err := detect(path)
+	if err != nil {
+		return nil, err
+	}
+
+	a := &Archive{path: path, kind: k}
+	err = a.walk(func(e Entry, _ io.Reader) error {
+		a.entries = append(a.entries, e)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
+// Path returns the path of the archive file.
+func (a *Archive) Path() string {
+	return a.path
+}
+
+// Entries returns every entry of the archive in the order they are stored.
+func (a *Archive) Entries() []Entry {
+	return a.entries
+}
+
+// List returns the entries directly inside the directory dir of the archive, "" being the top,
+// directories first and then by name. Directories implied by the names of nested entries are
+// listed even if the archive has no entry for them.
+func (a *Archive) List(dir string) []Entry {
+	prefix := strings.Trim(dir, "/")
+	if prefix != "" {
+		prefix += "/"
+	}
+
+	var list []Entry
+	seen := map[string]bool{}
+	for _, e := range a.entries {
+		rest, ok := strings.CutPrefix(e.Name, prefix)
+		if !ok || rest == "" {
+			continue
+		}
+
+		child := e
+		if i := strings.Index(rest, "/"); i >= 0 {
+			child = Entry{Name: prefix + rest[:i], Dir: true, Mode: fs.ModeDir | 0o755}
+		}
+		if !seen[child.Name] {
+			seen[child.Name] = true
+			list = append(list, child)
+		}
+	}
+
+	slices.SortFunc(list, func(x, y Entry) int {
+		if x.Dir != y.Dir {
+			if x.Dir {
+				return -1
+			}
+			return 1
+		}
+		return strings.Compare(x.Name, y.Name)
+	})
+	return list
+}
+
+// Read returns the contents of the entry name.
+func (a *Archive) Read(name string) ([]byte, error) {
+	var data []byte
+	found := false
+	err := a.walk(func(e Entry, r io.Reader) error {
+		if found || e.Dir || e.Name != name {
+			return nil
+		}
+
+		var err error
+		data, err = io.ReadAll(r)
+		found = true
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, fmt.Errorf("%w: %s", ErrNotFound, name)
+	}
+	return data, nil
+}
+
+// Write replaces the contents of the entry name with data, rewriting the archive. The other
+// entries are copied unchanged.
+func (a *Archive) Write(name string, data []byte) error {
+	i := slices.IndexFunc(a.entries, func(e Entry) bool { return !e.Dir && e.Name == name })
+	if i < 0 {
+		return fmt.Errorf("%w: %s", ErrNotFound, name)
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(a.path), "."+filepath.Base(a.path)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if a.kind == zipKind {
+		err = a.rewriteZip(tmp, name, data)
+	} else {
+		err = a.rewriteTar(tmp, name, data)
+	}
+	if err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+
+	if info, err := os.Stat(a.path); err == nil {
+		os.Chmod(tmp.Name(), info.Mode().Perm())
+	}
+	if err := os.Rename(tmp.Name(), a.path); err != nil {
+		return err
+	}
+	a.entries[i].Size = int64(len(data))
+	a.entries[i].ModTime = time.Now()
+	return nil
+}
+
+// Load loads the entry named by the virtual path into b and binds b to it.
+func Load(b *text.Buffer, path string) error {
+	file, name, ok := Split(path)
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrNotFound, path)
+	}
+
+	a, err := Open(file)
+	if err != nil {
+		return err
+	}
+	data, err := a.Read(name)
+	if err != nil {
+		return err
+	}
+	if err := b.Load(bytes.NewReader(data)); err != nil {
+		return err
+	}
+
+	b.SetPath(path)
+	o := b.Options()
+	o.FileType = text.DetectFileType(name)
+	b.SetOptions(o)
+	return nil
+}
+
+// Save writes b back into the archive entry named by its virtual path.
+func Save(b *text.Buffer) error {
+	file, name, ok := Split(b.Path())
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrNotFound, b.Path())
+	}
+
+	a, err := Open(file)
+	if err != nil {
+		return err
+	}
+	var data bytes.Buffer
+	if err := b.Save(&data); err != nil {
+		return err
+	}
+	return a.Write(name, data.Bytes())
+}
+
+// detect returns the kind of archive of the file at path from its first bytes.
+func detect(path string) (kind, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return 0, err
+	}
+	defer f.Close()
+
+	head := make([]byte, 512)
+	n, _ := io.ReadFull(f, head)
+	head = head[:n]
+
+	switch {
+	case bytes.HasPrefix(head, []byte("PK\x03\x04")), bytes.HasPrefix(head, []byte("PK\x05\x06")):
+		return zipKind, nil
+	case bytes.HasPrefix(head, []byte{0x1f, 0x8b}):
+		return tgzKind, nil
+	case len(head) >= 262 && string(head[257:262]) == "ustar":
+		return tarKind, nil
+	}
+	return 0, fmt.Errorf("%w: %s", ErrNotArchive, path)
+}
+
+// walk calls fn with every entry of the archive and a reader for its contents.
+func (a *Archive) walk(fn func(Entry, io.Reader) error) error {
+	if a.kind == zipKind {
+		zr, err := zip.OpenReader(a.path)
+		if err != nil {
+			return err
+		}
+		defer zr.Close()
+
+		for _, f := range zr.File {
+			rc, err := f.Open()
+			if err != nil {
+				return err
+			}
+			err = fn(zipEntry(&f.FileHeader), rc)
+			rc.Close()
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	return a.readTar(func(tr *tar.Reader) error {
+		for {
+			hdr, err := tr.Next()
+			if err == io.EOF {
+				return nil
+			}
+			if err != nil {
+				return err
+			}
+			if err := fn(tarEntry(hdr), tr); err != nil {
+				return err
+			}
+		}
+	})
+}
+
+// readTar calls fn with a reader over the tar archive, decompressing it if needed.
+func (a *Archive) readTar(fn func(*tar.Reader) error) error {
+	f, err := os.Open(a.path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	var r io.Reader = f
+	if a.kind == tgzKind {
+		zr, err := gzip.NewReader(f)
+		if err != nil {
+			return err
+		}
+		defer zr.Close()
+		r = zr
+	}
+	return fn(tar.NewReader(r))
+}
+
+// rewriteZip writes the archive to w with the contents of the entry name replaced by data.
+func (a *Archive) rewriteZip(w io.Writer, name string, data []byte) error {
+	zr, err := zip.OpenReader(a.path)
+	if err != nil {
+		return err
+	}
+	defer zr.Close()
+
+	zw := zip.NewWriter(w)
+	for _, f := range zr.File {
+		if f.Name != name {
+			if err := zw.Copy(f); err != nil {
+				return err
+			}
+			continue
+		}
+
+		fh := f.FileHeader
+		fh.Modified = time.Now()
+		out, err := zw.CreateHeader(&fh)
+		if err != nil {
+			return err
+		}
+		if _, err := out.Write(data); err != nil {
+			return err
+		}
+	}
+	return zw.Close()
+}
+
+// rewriteTar writes the archive to w with the contents of the entry name replaced by data.
+func (a *Archive) rewriteTar(w io.Writer, name string, data []byte) error {
+	var zw *gzip.Writer
+	if a.kind == tgzKind {
+		zw = gzip.NewWriter(w)
+		w = zw
+	}
+
+	tw := tar.NewWriter(w)
+	err := a.readTar(func(tr *tar.Reader) error {
+		for {
+			hdr, err := tr.Next()
+			if err == io.EOF {
+				return nil
+			}
+			if err != nil {
+				return err
+			}
+
+			var body io.Reader = tr
+			if hdr.Name == name && hdr.Typeflag == tar.TypeReg {
+				hdr.Size, hdr.ModTime = int64(len(data)), time.Now()
+				body = bytes.NewReader(data)
+			}
+			if err := tw.WriteHeader(hdr); err != nil {
+				return err
+			}
+			if _, err := io.Copy(tw, body); err != nil {
+				return err
+			}
+		}
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := tw.Close(); err != nil {
+		return err
+	}
+	if zw != nil {
+		return zw.Close()
+	}
+	return nil
+}
+
+func zipEntry(fh *zip.FileHeader) Entry {
+	info := fh.FileInfo()
+	return Entry{
+		Name:    strings.TrimSuffix(fh.Name, "/"),
+		Size:    int64(fh.UncompressedSize64),
+		Dir:     info.IsDir(),
+		Mode:    info.Mode(),
+		ModTime: fh.Modified,
+	}
+}
+
+func tarEntry(hdr *tar.Header) Entry {
+	return Entry{
+		Name:    strings.TrimSuffix(hdr.Name, "/"),
+		Size:    hdr.Size,
+		Dir:     hdr.Typeflag == tar.TypeDir,
+		Mode:    hdr.FileInfo().Mode(),
+		ModTime: hdr.ModTime,
+	}
+}
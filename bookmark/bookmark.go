@@ -0,0 +1,228 @@
+// Package bookmark keeps notes attached to lines of the files of a project, such as review
+// notes, in a store of their own so the files are left alone.
+//
+// Each project has its store in the cache directory. Bookmarks name their file relative to the
+// project root with forward slashes, and keep the text of their line so they can be found again
+// after the file changed, which also lets a reviewer's notes be exported and imported on another
+// checkout. The list of bookmarks has one line per bookmark, which can be edited and read back:
+//
+//	# bookmarks in /home/me/project
+//	cmd/main.go:12: check the error here
+//	util.go:40: rename to parseAll
+package bookmark
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// ErrList is returned for lines of a list of bookmarks that do not name one of its bookmarks.
+var ErrList = errors.New("bookmark: bad list line")
+
+// ErrVersion is returned when reading bookmarks written by a newer version.
+var ErrVersion = errors.New("bookmark: unknown version")
+
+// version is the version of the format bookmarks are written in.
+const version = 1
+
+// Bookmark is a note on a line of a file. Line is 0-based and Text is what the line read when
+// the bookmark was last placed.
+type Bookmark struct {
+	Path string `json:"path"`
+	Line int    `json:"line"`
+	Text string `json:"text"`
+	Note string `json:"note"`
+}
+
+// file is the format of stores and exports.
+type file struct {
+	Version   int        `json:"version"`
+	Root      string     `json:"root,omitempty"`
+	Bookmarks []Bookmark `json:"bookmarks"`
+}
+
+// StorePath returns the file the bookmarks of the project at root are kept in.
+func StorePath(root string) (string, error) {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256([]byte(root))
+	name := filepath.Base(root) + "-" + hex.EncodeToString(sum[:8]) + ".json"
+	return filepath.Join(dir, "goted", "bookmarks", name), nil
+}
+
+// Load returns the bookmarks kept for the project at root, none if it has none.
+func Load(root string) ([]Bookmark, error) {
+	path, err := StorePath(root)
+	if err != nil {
+		return nil, err
+	}
+	f, err := os.Open(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return Read(f)
+}
+
+// Save keeps bms as the bookmarks of the project at root, removing its store if there are none.
+func Save(root string, bms []Bookmark) error {
+	path, err := StorePath(root)
+	if err != nil {
+		return err
+	}
+	if len(bms) == 0 {
+		if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return err
+		}
+		return nil
+	}
+	data, err := json.MarshalIndent(file{Version: version, Root: root, Bookmarks: Sort(bms)}, "", "\t")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return err
+	}
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, append(data, '\n'), 0o600); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+// Read reads bookmarks written by Write.
+func Read(r io.Reader) ([]Bookmark, error) {
+	var f file
+	if err := json.NewDecoder(r).Decode(&f); err != nil {
+		return nil, fmt.Errorf("bookmark: %w", err)
+	}
+	if f.Version > version {
+		return nil, fmt.Errorf("%w %d", ErrVersion, f.Version)
+	}
+	for i := range f.Bookmarks {
+		f.Bookmarks[i].Path = filepath.ToSlash(f.Bookmarks[i].Path)
+	}
+	return f.Bookmarks, nil
+}
+
+// Write writes bms as JSON, in file and line order, to be shared and read back by Read.
+func Write(w io.Writer, bms []Bookmark) error {
+	data, err := json.MarshalIndent(file{Version: version, Bookmarks: Sort(bms)}, "", "\t")
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(append(data, '\n'))
+	return err
+}
+
+// Merge returns bms with the bookmarks of add, which replace those of bms on the same line.
+func Merge(bms, add []Bookmark) []Bookmark {
+	out := slices.Clone(bms)
+	for _, a := range add {
+		i := slices.IndexFunc(out, func(b Bookmark) bool { return b.Path == a.Path && b.Line == a.Line })
+		if i >= 0 {
+			out[i] = a
+		} else {
+			out = append(out, a)
+		}
+	}
+	return Sort(out)
+}
+
+// Sort sorts bms by file and line, and returns them.
+func Sort(bms []Bookmark) []Bookmark {
+	slices.SortStableFunc(bms, func(a, b Bookmark) int {
+		if c := strings.Compare(a.Path, b.Path); c != 0 {
+			return c
+		}
+		return a.Line - b.Line
+	})
+	return bms
+}
+
+// Relocate returns the line of lines the bookmark is on now: its own line if it still reads
+// Text, or else the nearest line that does. Bookmarks whose text is gone stay on their line,
+// within lines.
+func Relocate(bm Bookmark, lines []string) int {
+	if len(lines) == 0 {
+		return 0
+	}
+	line := min(max(bm.Line, 0), len(lines)-1)
+	if lines[line] == bm.Text || bm.Text == "" {
+		return line
+	}
+	for d := 1; d < len(lines); d++ {
+		if i := line - d; i >= 0 && lines[i] == bm.Text {
+			return i
+		}
+		if i := line + d; i < len(lines) && lines[i] == bm.Text {
+			return i
+		}
+		if line-d < 0 && line+d >= len(lines) {
+			break
+		}
+	}
+	return line
+}
+
+// Format returns the list of bms, whose files are relative to root, with a header naming it.
+// Bookmarks with no note show the text of their line.
+func Format(root string, bms []Bookmark) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "# bookmarks in %s\n", root)
+	for _, bm := range bms {
+		note := bm.Note
+		if note == "" {
+			note = "(" + strings.TrimSpace(bm.Text) + ")"
+		}
+		fmt.Fprintf(&b, "%s:%d: %s\n", bm.Path, bm.Line+1, note)
+	}
+	return b.String()
+}
+
+// Parse returns the bookmarks an edited list of bms stands for: the bookmarks left in it, with
+// their notes as edited. Lines that are empty or start with # are ignored, and notes left as
+// the text of the line in parentheses stay empty.
+func Parse(list string, bms []Bookmark) ([]Bookmark, error) {
+	var out []Bookmark
+	seen := map[int]bool{}
+	for n, line := range strings.Split(list, "\n") {
+		if strings.TrimSpace(line) == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		loc, note, _ := strings.Cut(line, ": ")
+		i := slices.IndexFunc(bms, func(b Bookmark) bool { return key(b) == loc })
+		if i < 0 {
+			return nil, fmt.Errorf("%w: line %d: %q is not a bookmark", ErrList, n+1, loc)
+		}
+		if seen[i] {
+			return nil, fmt.Errorf("%w: line %d: %s is listed twice", ErrList, n+1, loc)
+		}
+		seen[i] = true
+		bm := bms[i]
+		if note == "("+strings.TrimSpace(bm.Text)+")" {
+			note = ""
+		}
+		bm.Note = strings.TrimSpace(note)
+		out = append(out, bm)
+	}
+	return out, nil
+}
+
+// key returns how a bookmark is named in a list.
+func key(bm Bookmark) string {
+	return bm.Path + ":" + strconv.Itoa(bm.Line+1)
+}
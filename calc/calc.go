@@ -0,0 +1,180 @@
+// Package calc evaluates the arithmetic typed in scratch buffers, such as "2 * (3.5 + 1) =".
+//
+// Expressions use numbers, + - * / %, ^ for powers, which binds tighter than the others and
+// associates to the right, unary minus and parentheses. Numbers may have a fraction, an
+// exponent, underscores between digits and 0x, 0o and 0b prefixes.
+package calc
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+var (
+	// ErrSyntax is returned for text that is not an expression.
+	ErrSyntax = errors.New("calc: syntax error")
+
+	// ErrDivision is returned for divisions by zero.
+	ErrDivision = errors.New("calc: division by zero")
+)
+
+// Eval returns the value of the expression expr. A trailing "=" is ignored.
+func Eval(expr string) (float64, error) {
+	p := &parser{s: strings.TrimSuffix(strings.TrimSpace(expr), "=")}
+	v, err := p.sum()
+	if err != nil {
+		return 0, err
+	}
+	if p.skip(); p.i < len(p.s) {
+		return 0, fmt.Errorf("%w: unexpected %q", ErrSyntax, p.s[p.i:])
+	}
+	return v, nil
+}
+
+// Format returns v as it reads best: an integer without a fraction, otherwise the shortest
+// decimal that gives v back.
+func Format(v float64) string {
+	if v == math.Trunc(v) && math.Abs(v) < 1e15 {
+		return strconv.FormatFloat(v, 'f', 0, 64)
+	}
+	return strconv.FormatFloat(v, 'g', -1, 64)
+}
+
+type parser struct {
+	s string
+	i int
+}
+
+func (p *parser) skip() {
+	for p.i < len(p.s) && unicode.IsSpace(rune(p.s[p.i])) {
+		p.i++
+	}
+}
+
+// peek returns the next byte, after white space, or 0 at the end.
+func (p *parser) peek() byte {
+	if p.skip(); p.i < len(p.s) {
+		return p.s[p.i]
+	}
+	return 0
+}
+
+// sum parses terms separated by + and -.
+func (p *parser) sum() (float64, error) {
+	v, err := p.product()
+	for err == nil {
+		op := p.peek()
+		if op != '+' && op != '-' {
+			break
+		}
+		p.i++
+		var w float64
+		if w, err = p.product(); op == '+' {
+			v += w
+		} else {
+			v -= w
+		}
+	}
+	return v, err
+}
+
+// product parses factors separated by *, / and %.
+func (p *parser) product() (float64, error) {
+	v, err := p.unary()
+	for err == nil {
+		op := p.peek()
+		if op != '*' && op != '/' && op != '%' {
+			break
+		}
+		p.i++
+		var w float64
+		if w, err = p.unary(); err != nil {
+			break
+		}
+		switch {
+		case op == '*':
+			v *= w
+		case w == 0:
+			return 0, ErrDivision
+		case op == '/':
+			v /= w
+		default:
+			v = math.Mod(v, w)
+		}
+	}
+	return v, err
+}
+
+// unary parses a power with any leading signs, which bind looser than ^ so -2^2 is -4.
+func (p *parser) unary() (float64, error) {
+	switch p.peek() {
+	case '-':
+		p.i++
+		v, err := p.unary()
+		return -v, err
+	case '+':
+		p.i++
+		return p.unary()
+	}
+	return p.power()
+}
+
+// power parses an operand, raised to a power if followed by ^.
+func (p *parser) power() (float64, error) {
+	v, err := p.operand()
+	if err != nil || p.peek() != '^' {
+		return v, err
+	}
+	p.i++
+	w, err := p.unary()
+	return math.Pow(v, w), err
+}
+
+// operand parses a number or a parenthesized expression.
+func (p *parser) operand() (float64, error) {
+	if p.peek() != '(' {
+		return p.number()
+	}
+	p.i++
+	v, err := p.sum()
+	if err != nil {
+		return 0, err
+	}
+	if p.peek() != ')' {
+		return 0, fmt.Errorf("%w: missing )", ErrSyntax)
+	}
+	p.i++
+	return v, nil
+}
+
+func (p *parser) number() (float64, error) {
+	start := p.i
+	for p.i < len(p.s) {
+		c := p.s[p.i]
+		exp := (c == '+' || c == '-') && p.i > start && strings.ContainsRune("eE", rune(p.s[p.i-1])) && !strings.HasPrefix(p.s[start:], "0x")
+		if !exp && c != '.' && c != '_' && !unicode.IsLetter(rune(c)) && !unicode.IsDigit(rune(c)) {
+			break
+		}
+		p.i++
+	}
+	lit := p.s[start:p.i]
+	if lit == "" {
+		if p.i == len(p.s) {
+			return 0, fmt.Errorf("%w: unexpected end", ErrSyntax)
+		}
+		return 0, fmt.Errorf("%w: unexpected %q", ErrSyntax, p.s[p.i:p.i+1])
+	}
+
+	if n, err := strconv.ParseInt(lit, 0, 64); err == nil {
+		return float64(n), nil
+	}
+	v, err := strconv.ParseFloat(lit, 64)
+	if err != nil {
+		return 0, fmt.Errorf("%w: bad number %q", ErrSyntax, lit)
+	}
+	return v, nil
+}
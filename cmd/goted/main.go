@@ -1,7 +1,414 @@
+// Command goted is a text editor.
+//
+// Usage:
+//
+//	goted [flags] [file ...]
+//
+// Files open in the buffer list, the first one being current. A file may be given as
+// file:line or file:line:col, or preceded by +line, to start with the cursor there; "+" alone
+// goes to the last line. With --readonly the buffers cannot be edited.
+//
+// With --replay recording, the keys typed in a recording of terminal input are played back on
+// the buffers without a user interface, which together with --stdout tests the editor end to
+// end. --screen WxH then prints the current buffer as it would be drawn on a terminal of that
+// size.
+//
+// With --describe file, what changes on the screen is written to file as lines of text, for a
+// screen reader to read out; file may be a named pipe, and "-" is standard error.
+//
+// With --debug addr, profiling data is served over HTTP on addr, such as localhost:6060: the
+// pprof handlers under /debug/pprof/, and under /debug/vars the time spent rendering,
+// highlighting and handling input and a debug log, which times the save hooks.
+//
+// With --startup-profile file, how long each phase of startup took, up to the first frame and
+// the work left for after it, is written to file on exit; "-" is standard error.
+//
+// If an editor is already running for the user, the files are sent to it instead, unless --new
+// is given. With --attach, the terminal shows the session of the running editor instead, as
+// tmux attach does, with the files open: buffers are shared but the window has its own
+// current buffer and cursors, and quitting it only detaches the terminal.
+//
+// A file named "-" reads standard input into a buffer, so goted can sit in a pipeline. With
+// --stdout the final contents of the first buffer, the one read from standard input if any,
+// are written to standard output on exit, so quitting does not ask about the changes to the
+// text read from standard input. The editor still runs on the terminal, which it opens for
+// keys and the screen when standard input or output go through pipes, so
+// "cmd | goted - --stdout | cmd2" edits the text on its way.
+//
+// With --batch script, goted runs without a user interface: the files are opened and the editor
+// commands in script, one per line, are run on them, as in:
+//
+//	open main.go
+//	replace-all "fmt\.Println" log.Println
+//	goto 12:4
+//	write
 package main
 
-import "fmt"
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/avalonbits/goted/config"
+	"github.com/avalonbits/goted/editor"
+	"github.com/avalonbits/goted/instance"
+	"github.com/avalonbits/goted/profile"
+	"github.com/avalonbits/goted/render"
+	"github.com/avalonbits/goted/screen"
+	"github.com/avalonbits/goted/syntax"
+	"github.com/avalonbits/goted/term"
+	"github.com/avalonbits/goted/text"
+	"github.com/avalonbits/goted/view"
+)
+
+// errUsage is returned for bad command lines, after the flag package printed the usage.
+var errUsage = errors.New("usage")
 
 func main() {
-	fmt.Println("goted")
+	err := run(os.Args[1:], os.Stdin, os.Stdout)
+	switch {
+	case err == nil, errors.Is(err, flag.ErrHelp):
+	case errors.Is(err, errUsage):
+		os.Exit(2)
+	default:
+		fmt.Fprintln(os.Stderr, "goted:", err)
+		os.Exit(1)
+	}
+}
+
+// options are the command line flags.
+type options struct {
+	stdout      bool
+	batch       string
+	replay      string
+	screen      string
+	readOnly    bool
+	newInstance bool
+	attach      bool
+	describe    string
+	debug       string
+	startup     string
+
+	// files are the files to open. Line -1 stands for the last line.
+	files []instance.File
+}
+
+// parseArgs parses the command line. Flags may come after the files.
+func parseArgs(args []string) (options, error) {
+	var o options
+	fs := flag.NewFlagSet("goted", flag.ContinueOnError)
+	fs.BoolVar(&o.stdout, "stdout", false, "write the first buffer to standard output on exit")
+	fs.StringVar(&o.batch, "batch", "", "run the editor commands in `script` without a user interface")
+	fs.StringVar(&o.replay, "replay", "", "play back the keys typed in the terminal `recording`")
+	fs.StringVar(&o.screen, "screen", "", "print the screen of `size`, such as 80x24, on exit")
+	fs.BoolVar(&o.readOnly, "readonly", false, "open the files read-only")
+	fs.BoolVar(&o.newInstance, "new", false, "start a new editor even if one is running")
+	fs.BoolVar(&o.attach, "attach", false, "show the session of the running editor on this terminal")
+	fs.StringVar(&o.describe, "describe", "", "write what changes on the screen to `file`, for screen readers")
+	fs.StringVar(&o.debug, "debug", "", "serve profiling data on `addr`, such as localhost:6060")
+	fs.StringVar(&o.startup, "startup-profile", "", "write how long each phase of startup took to `file` on exit")
+
+	line := 0
+	for {
+		if err := fs.Parse(args); errors.Is(err, flag.ErrHelp) {
+			return o, err
+		} else if err != nil {
+			return o, errUsage
+		}
+		if fs.NArg() == 0 {
+			return o, nil
+		}
+		arg := fs.Arg(0)
+		args = fs.Args()[1:]
+
+		if n, ok := strings.CutPrefix(arg, "+"); ok {
+			if line = -1; n != "" {
+				var err error
+				if line, err = strconv.Atoi(n); err != nil {
+					fmt.Fprintf(fs.Output(), "bad line number %q\n", arg)
+					return o, errUsage
+				}
+			}
+			continue
+		}
+
+		f := instance.File{Path: arg}
+		if arg != "-" {
+			f.Path, f.Line, f.Col = editor.SplitLocation(arg)
+		}
+		if line != 0 {
+			f.Line, line = line, 0
+		}
+		o.files = append(o.files, f)
+	}
+}
+
+func run(args []string, stdin io.Reader, stdout io.Writer) (err error) {
+	o, err := parseArgs(args)
+	if err != nil {
+		return err
+	}
+	if o.startup != "" {
+		profile.RecordStartup()
+		defer func() {
+			if werr := writeStartupProfile(o.startup); err == nil {
+				err = werr
+			}
+		}()
+	}
+
+	interactive := o.batch == "" && o.replay == "" && o.screen == ""
+	// In a pipeline, the text read and written is local to this editor, so it runs on its own.
+	piped := o.stdout || slices.ContainsFunc(o.files, func(f instance.File) bool { return f.Path == "-" })
+	if o.debug != "" {
+		if _, err := profile.Serve(o.debug); err != nil {
+			return err
+		}
+	}
+
+	if o.attach {
+		if !interactive || piped || o.newInstance {
+			fmt.Fprintln(os.Stderr, "--attach only goes with files to open")
+			return errUsage
+		}
+		return attach(o.files)
+	}
+
+	var requests <-chan instance.Request
+	if interactive && !piped && !o.newInstance {
+		if len(o.files) > 0 && instance.Send(instance.SocketPath(), instance.Request{Files: o.files}) == nil {
+			return nil
+		}
+		if srv, err := instance.Listen(instance.SocketPath()); err == nil {
+			defer srv.Close()
+			requests = srv.C
+		}
+	}
+
+	done := profile.StartPhase(profile.Init)
+	e := editor.New()
+	done()
+	defer e.Recover(&err)
+	done = profile.StartPhase(profile.Config)
+	s, err := config.Load(".")
+	done()
+	if err != nil {
+		return err
+	}
+	if err := e.Configure(s); err != nil {
+		return err
+	}
+	// Files are opened once the editor is up, where it can ask about them, but standard input,
+	// which is read at once.
+	done = profile.StartPhase(profile.Open)
+	for _, f := range o.files {
+		if f.Path != "-" && interactive {
+			e.Files = append(e.Files, f)
+			continue
+		}
+		var b *text.Buffer
+		if f.Path == "-" {
+			b, err = e.OpenReader(stdin)
+		} else {
+			b, err = e.OpenAt(f.Path, f.Line, f.Col)
+		}
+		if err != nil {
+			return err
+		}
+		b.SetReadOnly(o.readOnly)
+	}
+	e.ReadOnly = o.readOnly
+	done()
+	if bufs := e.Buffers(); len(bufs) > 0 {
+		e.SetCurrent(bufs[0])
+	}
+
+	if o.batch != "" {
+		if err := runScript(e, o.batch, stdin); err != nil {
+			return err
+		}
+	}
+
+	if o.replay != "" {
+		if err := replay(e, o.replay); err != nil {
+			return err
+		}
+	}
+
+	if o.screen != "" {
+		if err := dumpScreen(e, o.screen, stdout); err != nil {
+			return err
+		}
+	}
+
+	if o.stdout && len(e.Buffers()) > 0 {
+		e.Output = e.Buffers()[0]
+	}
+	if interactive {
+		if o.describe != "" {
+			w, err := describeTo(o.describe)
+			if err != nil {
+				return err
+			}
+			defer w.Close()
+			e.Descriptions = w
+		}
+		if err := e.Run(requests); err != nil {
+			return err
+		}
+		if o.stdout && e.Output == nil && len(e.Buffers()) > 0 {
+			e.Output = e.Buffers()[0]
+		}
+	}
+	if e.Output != nil {
+		return e.Output.Save(stdout)
+	}
+	return nil
+}
+
+// attach shows the session of the running editor on the terminal, with files open in its
+// window, until the window quits.
+func attach(files []instance.File) error {
+	t, err := term.Open()
+	if err != nil {
+		return err
+	}
+	width, height, err := term.Size(t.Out)
+	if err != nil || width <= 0 || height <= 0 {
+		width, height = 80, 24
+	}
+	a, err := instance.Attach(instance.SocketPath(), instance.Request{Files: files}, width, height)
+	if err != nil {
+		return fmt.Errorf("no editor to attach to: %w", err)
+	}
+	defer a.Close()
+	if err := t.Start(); err != nil {
+		return err
+	}
+	defer t.Stop()
+
+	resized := make(chan os.Signal, 1)
+	term.NotifyResize(resized)
+	defer signal.Stop(resized)
+	go func() {
+		for range resized {
+			if w, h, err := term.Size(t.Out); err == nil {
+				a.Resize(w, h)
+			}
+		}
+	}()
+	go func() {
+		buf := make([]byte, 4096)
+		for {
+			n, err := t.In.Read(buf)
+			if n > 0 && a.Input(buf[:n]) != nil || err != nil {
+				return
+			}
+		}
+	}()
+
+	if _, err := io.Copy(t.Out, a); !errors.Is(err, instance.ErrDetached) {
+		return err
+	}
+	return nil
+}
+
+// describeTo opens the file at path, standard error for "-", to write descriptions of the
+// screen to.
+func describeTo(path string) (io.WriteCloser, error) {
+	if path == "-" {
+		return nopCloser{os.Stderr}, nil
+	}
+	return os.OpenFile(path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0o600)
+}
+
+// writeStartupProfile writes the startup profile to the file at path, standard error for "-".
+func writeStartupProfile(path string) error {
+	if path == "-" {
+		return profile.StartupReport(os.Stderr)
+	}
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if err := profile.StartupReport(f); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// nopCloser is a writer whose Close does nothing.
+type nopCloser struct {
+	io.Writer
+}
+
+// Close implements io.Closer.
+func (nopCloser) Close() error {
+	return nil
+}
+
+// runScript runs the batch script at path, "-" reading it from stdin.
+func runScript(e *editor.Editor, path string, stdin io.Reader) error {
+	if path == "-" {
+		return e.RunScript("stdin", stdin)
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	return e.RunScript(path, f)
+}
+
+// replay plays back the keys of the terminal recording at path.
+func replay(e *editor.Editor, path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	chunks, err := term.ReadRecording(f)
+	if err != nil {
+		return err
+	}
+	for _, key := range term.ReplayKeys(chunks) {
+		if err := e.Key(key); err != nil {
+			return fmt.Errorf("%s: key %s: %w", path, key, err)
+		}
+	}
+	return nil
+}
+
+// dumpScreen prints the current buffer as drawn on a screen of size, such as "80x24", with the
+// cursor line in view.
+func dumpScreen(e *editor.Editor, size string, out io.Writer) error {
+	w, h, ok := strings.Cut(size, "x")
+	width, err1 := strconv.Atoi(w)
+	height, err2 := strconv.Atoi(h)
+	if !ok || err1 != nil || err2 != nil || width <= 0 || height <= 0 {
+		return fmt.Errorf("bad screen size %q", size)
+	}
+
+	b := e.Current()
+	if b == nil {
+		return nil
+	}
+	v := view.Viewport{Height: height}
+	v.Follow(b.Line(), b.Lines())
+
+	g := screen.NewGrid(width, height)
+	hl := syntax.ForFileType(b.Options().FileType)
+	opts := render.Options{TabWidth: b.Options().TabWidth, States: syntax.NewStates(hl)}
+	render.Draw(g, g.Bounds(), b, v.Top, hl, e.Commands.Theme, opts)
+	_, err := fmt.Fprintln(out, g.String())
+	return err
 }
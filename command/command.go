@@ -0,0 +1,662 @@
+// Package command implements the named editor commands invoked by key bindings and scripts.
+package command
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/avalonbits/goted/archive"
+	"github.com/avalonbits/goted/calc"
+	"github.com/avalonbits/goted/export"
+	"github.com/avalonbits/goted/format"
+	"github.com/avalonbits/goted/guard"
+	"github.com/avalonbits/goted/syntax"
+	"github.com/avalonbits/goted/text"
+	"github.com/avalonbits/goted/theme"
+	"github.com/avalonbits/goted/unichar"
+)
+
+var (
+	// ErrUnknown is returned when running a command that was not registered.
+	ErrUnknown = errors.New("command: unknown command")
+
+	// ErrUsage is returned when a command is given the wrong arguments.
+	ErrUsage = errors.New("command: bad arguments")
+)
+
+// Func is the implementation of a command. args are the command arguments, if any.
+type Func func(b *text.Buffer, args []string) error
+
+// Registry maps command names to their implementation.
+type Registry struct {
+	cmds map[string]Func
+
+	// Guard confirms destructive commands. Headless sessions set its Confirm function to
+	// guard.Always(true).
+	Guard guard.Guard
+
+	// Theme is the active theme, used by commands that render text.
+	Theme theme.Theme
+
+	// Notify shows a message to the user. Messages are dropped if it is nil.
+	Notify func(msg string)
+
+	last      change
+	depth     int
+	repeating bool
+}
+
+// New returns a Registry holding the built-in commands.
+func New() *Registry {
+	r := &Registry{
+		cmds:  map[string]Func{},
+		Guard: guard.Guard{Limits: guard.DefaultLimits()},
+		Theme: theme.Default(),
+	}
+	for name, fn := range builtins {
+		r.Register(name, fn)
+	}
+	r.Register("replace-all", r.replaceAll)
+	r.Register("export-html", r.exporter(export.HTML))
+	r.Register("export-ansi", r.exporter(export.ANSI))
+	r.Register("describe-char", r.describeChar)
+	r.Register("stats", r.stats)
+	r.Register("set-line-ending", r.setLineEnding)
+	r.Register("set-encoding", r.setEncoding)
+	r.Register("repeat", r.repeat)
+	r.Register("reindent", r.reindent)
+	r.Register("retab", r.retab)
+	return r
+}
+
+// Register adds fn as the command name, replacing any previous command with that name.
+func (r *Registry) Register(name string, fn Func) {
+	r.cmds[name] = fn
+}
+
+// Lookup returns the command name, so a command replacing it can fall back to it.
+func (r *Registry) Lookup(name string) (Func, bool) {
+	fn, ok := r.cmds[name]
+	return fn, ok
+}
+
+// Run executes the command name on b. A command that changes the text of b is recorded as the
+// last change, for repeat, unless it moves through the history.
+func (r *Registry) Run(b *text.Buffer, name string, args ...string) error {
+	fn, ok := r.cmds[name]
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrUnknown, name)
+	}
+	cursor, version := b.Cursor(), b.Version()
+	r.depth++
+	err := fn(b, args)
+	r.depth--
+	if err == nil && b.Version() != version && !unrepeatable[name] {
+		r.record(b, cursor, version, step{name: name, args: slices.Clone(args)})
+	}
+	return err
+}
+
+// Names returns the registered command names, sorted.
+func (r *Registry) Names() []string {
+	names := make([]string, 0, len(r.cmds))
+	for name := range r.cmds {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
+
+var builtins = map[string]Func{
+	"undo": func(b *text.Buffer, _ []string) error {
+		b.Undo()
+		return nil
+	},
+	"redo": func(b *text.Buffer, _ []string) error {
+		b.Redo()
+		return nil
+	},
+	"undo-earlier": func(b *text.Buffer, args []string) error {
+		return timeTravel(args, b.Earlier, b.EarlierBy)
+	},
+	"undo-later": func(b *text.Buffer, args []string) error {
+		return timeTravel(args, b.Later, b.LaterBy)
+	},
+	"move-left": func(b *text.Buffer, _ []string) error {
+		b.Seek(b.Cursor() - 1)
+		return nil
+	},
+	"move-right": func(b *text.Buffer, _ []string) error {
+		b.Seek(b.Cursor() + 1)
+		return nil
+	},
+	"move-up": func(b *text.Buffer, _ []string) error {
+		b.MoveUp(1)
+		return nil
+	},
+	"move-down": func(b *text.Buffer, _ []string) error {
+		b.MoveDown(1)
+		return nil
+	},
+	"line-start": func(b *text.Buffer, _ []string) error {
+		b.GotoLine(b.Line(), 0)
+		return nil
+	},
+	"line-end": func(b *text.Buffer, _ []string) error {
+		if b.Options().SmartEnd {
+			b.SmartEnd()
+			return nil
+		}
+		b.GotoLine(b.Line(), math.MaxInt)
+		return nil
+	},
+	"paragraph-next": func(b *text.Buffer, _ []string) error {
+		b.ParagraphForward(1)
+		return nil
+	},
+	"paragraph-previous": func(b *text.Buffer, _ []string) error {
+		b.ParagraphBackward(1)
+		return nil
+	},
+	"sentence-next": func(b *text.Buffer, _ []string) error {
+		b.SentenceForward()
+		return nil
+	},
+	"sentence-previous": func(b *text.Buffer, _ []string) error {
+		b.SentenceBackward()
+		return nil
+	},
+	"reflow": func(b *text.Buffer, args []string) error {
+		width := 0
+		if len(args) > 0 {
+			n, err := strconv.Atoi(args[0])
+			if err != nil || n < 1 {
+				return fmt.Errorf("%w: bad width %q", ErrUsage, args[0])
+			}
+			width = n
+		}
+		return b.Reflow(width)
+	},
+	"conflict-next": func(b *text.Buffer, _ []string) error {
+		if !b.NextConflict(1) {
+			return fmt.Errorf("%w: no conflict below", ErrUsage)
+		}
+		return nil
+	},
+	"conflict-previous": func(b *text.Buffer, _ []string) error {
+		if !b.NextConflict(-1) {
+			return fmt.Errorf("%w: no conflict above", ErrUsage)
+		}
+		return nil
+	},
+	"take-ours": func(b *text.Buffer, _ []string) error {
+		return b.ResolveConflict(text.Ours)
+	},
+	"take-theirs": func(b *text.Buffer, _ []string) error {
+		return b.ResolveConflict(text.Theirs)
+	},
+	"take-both": func(b *text.Buffer, _ []string) error {
+		return b.ResolveConflict(text.Both)
+	},
+	"take-base": func(b *text.Buffer, _ []string) error {
+		return b.ResolveConflict(text.Base)
+	},
+	"surround": func(b *text.Buffer, args []string) error {
+		switch len(args) {
+		case 1:
+			return b.Surround(args[0])
+		case 2:
+			if err := b.SelectObject(args[1], false); err != nil {
+				return err
+			}
+			return b.Surround(args[0])
+		}
+		return fmt.Errorf("%w: surround needs a delimiter or tag and maybe a text object", ErrUsage)
+	},
+	"mark": func(b *text.Buffer, _ []string) error {
+		if _, _, ok := b.Selection(); ok {
+			b.Deselect()
+		} else {
+			b.Select(b.Cursor())
+		}
+		return nil
+	},
+	"expand-selection": func(b *text.Buffer, _ []string) error {
+		b.ExpandSelection()
+		return nil
+	},
+	"shrink-selection": func(b *text.Buffer, _ []string) error {
+		b.ShrinkSelection()
+		return nil
+	},
+	"select-inner":  objectCommand((*text.Buffer).SelectObject, false),
+	"select-around": objectCommand((*text.Buffer).SelectObject, true),
+	"delete-inner":  objectCommand((*text.Buffer).DeleteObject, false),
+	"delete-around": objectCommand((*text.Buffer).DeleteObject, true),
+	"surround-delete": func(b *text.Buffer, args []string) error {
+		if len(args) != 1 {
+			return fmt.Errorf("%w: surround-delete needs a delimiter, or t for a tag", ErrUsage)
+		}
+		return b.DeleteSurround(args[0])
+	},
+	"surround-change": func(b *text.Buffer, args []string) error {
+		if len(args) != 2 {
+			return fmt.Errorf("%w: surround-change needs the old and new delimiters", ErrUsage)
+		}
+		return b.ChangeSurround(args[0], args[1])
+	},
+	"cycle-quotes": func(b *text.Buffer, _ []string) error {
+		return b.CycleQuotes()
+	},
+	"smart-home": func(b *text.Buffer, _ []string) error {
+		b.SmartHome()
+		return nil
+	},
+	"block-start": func(b *text.Buffer, _ []string) error {
+		b.BlockStart()
+		return nil
+	},
+	"block-end": func(b *text.Buffer, _ []string) error {
+		b.BlockEnd()
+		return nil
+	},
+	"newline":            newline,
+	"reindent-selection": reindentSelection,
+	"backspace": func(b *text.Buffer, _ []string) error {
+		if b.Cursor() == 0 {
+			return nil
+		}
+		b.Seek(b.Cursor() - 1)
+		return b.Delete(1)
+	},
+	"delete-char": func(b *text.Buffer, _ []string) error {
+		return b.Delete(1)
+	},
+	"insert-tab": func(b *text.Buffer, _ []string) error {
+		o := b.Options()
+		if !o.ExpandTab || o.TabWidth <= 0 {
+			return b.Insert([]rune{'\t'})
+		}
+		return b.Insert([]rune(strings.Repeat(" ", o.TabWidth-b.Column()%o.TabWidth)))
+	},
+	"goto": func(b *text.Buffer, args []string) error {
+		if len(args) != 1 {
+			return fmt.Errorf("%w: goto needs a line number", ErrUsage)
+		}
+		line, col, ok := strings.Cut(args[0], ":")
+		n, err := strconv.Atoi(line)
+		if err != nil {
+			return fmt.Errorf("%w: bad line %q", ErrUsage, line)
+		}
+		c := 1
+		if ok {
+			if c, err = strconv.Atoi(col); err != nil {
+				return fmt.Errorf("%w: bad column %q", ErrUsage, col)
+			}
+		}
+		b.GotoLine(n-1, c-1)
+		return nil
+	},
+	"write": func(b *text.Buffer, args []string) error {
+		switch len(args) {
+		case 0:
+			if _, _, ok := archive.Split(b.Path()); ok {
+				return archive.Save(b)
+			}
+			return b.SaveFile()
+		case 1:
+			return b.SaveAs(args[0])
+		}
+		return fmt.Errorf("%w: write takes at most a file name", ErrUsage)
+	},
+	"duplicate-lines": func(b *text.Buffer, _ []string) error {
+		return b.DuplicateLines()
+	},
+	"move-lines-up": func(b *text.Buffer, _ []string) error {
+		_, err := b.MoveLines(-1)
+		return err
+	},
+	"move-lines-down": func(b *text.Buffer, _ []string) error {
+		_, err := b.MoveLines(1)
+		return err
+	},
+	"sort-lines": func(b *text.Buffer, args []string) error {
+		desc := slices.Contains(args, "desc")
+		if slices.Contains(args, "numeric") {
+			return b.SortLinesNumeric(desc)
+		}
+		return b.SortLines(desc)
+	},
+	"unique-lines": func(b *text.Buffer, _ []string) error {
+		return b.UniqueLines()
+	},
+	"reverse-lines": func(b *text.Buffer, _ []string) error {
+		return b.ReverseLines()
+	},
+	"shuffle-lines": func(b *text.Buffer, _ []string) error {
+		return b.ShuffleLines(nil)
+	},
+	"align": func(b *text.Buffer, args []string) error {
+		if len(args) == 0 {
+			return fmt.Errorf("%w: align needs a delimiter", ErrUsage)
+		}
+		return b.AlignLines(args[0], slices.Contains(args[1:], "all"))
+	},
+	"increment": func(b *text.Buffer, args []string) error {
+		return increment(b, args, 1, false)
+	},
+	"decrement": func(b *text.Buffer, args []string) error {
+		return increment(b, args, -1, false)
+	},
+	"increment-sequence": func(b *text.Buffer, args []string) error {
+		return increment(b, args, 1, true)
+	},
+	"save-as": func(b *text.Buffer, args []string) error {
+		if len(args) != 1 {
+			return fmt.Errorf("%w: save-as needs a file name", ErrUsage)
+		}
+		return b.SaveAs(args[0])
+	},
+	"rename-file": func(b *text.Buffer, args []string) error {
+		if len(args) != 1 {
+			return fmt.Errorf("%w: rename-file needs a file name", ErrUsage)
+		}
+		return b.Rename(args[0])
+	},
+	"json-pretty": func(b *text.Buffer, args []string) error {
+		indent := "  "
+		if len(args) > 0 {
+			indent = args[0]
+		}
+		return format.ReformatJSON(b, func(src []rune) ([]rune, error) {
+			return format.JSON(src, indent)
+		})
+	},
+	"json-minify": func(b *text.Buffer, _ []string) error {
+		return format.ReformatJSON(b, format.CompactJSON)
+	},
+	"calc": func(b *text.Buffer, _ []string) error {
+		return evalLine(b)
+	},
+	"insert-char": func(b *text.Buffer, args []string) error {
+		query := strings.Join(args, " ")
+		found := unichar.Search(query, 1)
+		if len(found) == 0 {
+			return fmt.Errorf("%w: no character matches %q", ErrUsage, query)
+		}
+		return b.Insert([]rune{found[0].Rune})
+	},
+	"upper-case":       transform(text.ToUpper),
+	"lower-case":       transform(text.ToLower),
+	"title-case":       transform(text.ToTitle),
+	"snake-case":       transform(text.ToSnake),
+	"camel-case":       transform(text.ToCamel),
+	"rot13":            transform(text.ROT13),
+	"url-encode":       transform(text.URLEncode),
+	"url-decode":       transform(text.URLDecode),
+	"base64-encode":    transform(text.Base64Encode),
+	"base64-decode":    transform(text.Base64Decode),
+	"escape-unicode":   inString(text.EscapeUnicode),
+	"unescape-unicode": inString(text.UnescapeUnicode),
+}
+
+// replaceAll replaces every match of the regular expression args[0] with args[1], asking for
+// confirmation when there are too many matches.
+func (r *Registry) replaceAll(b *text.Buffer, args []string) error {
+	if len(args) != 2 {
+		return fmt.Errorf("%w: replace-all needs a pattern and a replacement", ErrUsage)
+	}
+
+	re, err := regexp.Compile(args[0])
+	if err != nil {
+		return err
+	}
+	if !r.Guard.ReplaceAll(len(b.FindAll(re))) {
+		return guard.ErrCanceled
+	}
+
+	_, err = b.ReplaceAll(re, args[1])
+	return err
+}
+
+// describeChar shows the code point, name, category and bytes of the character under the cursor.
+func (r *Registry) describeChar(b *text.Buffer, _ []string) error {
+	line, _ := b.LineRunes(b.Line())
+	if b.Column() >= len(line) {
+		r.notify("End of line")
+		return nil
+	}
+	r.notify(unichar.DescribeCluster(line, b.Column()))
+	return nil
+}
+
+// evalLine evaluates the arithmetic on the cursor line and writes the result after an "=" at its
+// end. On a line already ending in "= result", the part before the last "=" is evaluated again
+// and the result replaced.
+func evalLine(b *text.Buffer) error {
+	line, _ := b.LineRunes(b.Line())
+	expr := string(line)
+	if i := strings.LastIndex(expr, "="); i >= 0 {
+		expr = expr[:i]
+	}
+	v, err := calc.Eval(expr)
+	if err != nil {
+		return err
+	}
+
+	start := b.Offset(b.Line(), 0)
+	keep := []rune(strings.TrimRight(expr, " \t"))
+	return b.Replace(start+len(keep), start+len(line), []rune(" = "+calc.Format(v)))
+}
+
+// stats shows the statistics of the selection, or of the buffer if there is none.
+func (r *Registry) stats(b *text.Buffer, _ []string) error {
+	if s, ok := b.SelectionStats(); ok {
+		r.notify("Selection: " + s.String())
+		return nil
+	}
+	r.notify(b.Stats().String())
+	return nil
+}
+
+// setLineEnding changes the line endings the buffer is saved with to args[0], "lf" or "crlf".
+func (r *Registry) setLineEnding(b *text.Buffer, args []string) error {
+	if len(args) != 1 {
+		return fmt.Errorf("%w: set-line-ending needs lf or crlf", ErrUsage)
+	}
+
+	f := b.FileFormat()
+	switch strings.ToLower(args[0]) {
+	case "lf", "unix":
+		f.LineEnding = text.LF
+	case "crlf", "dos":
+		f.LineEnding = text.CRLF
+	default:
+		return fmt.Errorf("%w: unknown line ending %q", ErrUsage, args[0])
+	}
+	if f == b.FileFormat() {
+		r.notify("Line endings are already " + strings.ToUpper(f.LineEnding.String()))
+		return nil
+	}
+
+	if err := b.SetFileFormat(f); err != nil {
+		return err
+	}
+	r.notify(fmt.Sprintf("%d lines will be saved with %s endings", b.Lines()-1, strings.ToUpper(f.LineEnding.String())))
+	return nil
+}
+
+// setEncoding changes the encoding the buffer is saved with to args[0].
+func (r *Registry) setEncoding(b *text.Buffer, args []string) error {
+	if len(args) != 1 {
+		return fmt.Errorf("%w: set-encoding needs an encoding", ErrUsage)
+	}
+
+	old := b.FileFormat()
+	f := old
+	f.Encoding = text.Encoding(strings.ToLower(args[0]))
+	if !slices.Contains(text.Encodings, f.Encoding) {
+		return fmt.Errorf("%w: unknown encoding %q", ErrUsage, args[0])
+	}
+	if f == old {
+		r.notify("Encoding is already " + string(f.Encoding))
+		return nil
+	}
+
+	if err := b.SetFileFormat(f); err != nil {
+		return err
+	}
+	r.notify(fmt.Sprintf("Will be saved as %s instead of %s", f.Encoding, old.Encoding))
+	return nil
+}
+
+func (r *Registry) notify(msg string) {
+	if r.Notify != nil {
+		r.Notify(msg)
+	}
+}
+
+// exporter returns a command writing the highlighted buffer to the file args[0] with render.
+func (r *Registry) exporter(render func(io.Writer, *text.Buffer, syntax.Highlighter, theme.Theme) error) Func {
+	return func(b *text.Buffer, args []string) error {
+		if len(args) != 1 {
+			return fmt.Errorf("%w: export needs an output file", ErrUsage)
+		}
+
+		f, err := os.Create(args[0])
+		if err != nil {
+			return err
+		}
+		if err := render(f, b, syntax.ForFileType(b.Options().FileType), r.Theme); err != nil {
+			f.Close()
+			return err
+		}
+		return f.Close()
+	}
+}
+
+// increment changes numbers by sign times the count given in args, 1 by default. Over a
+// selection every line is changed.
+func increment(b *text.Buffer, args []string, sign int, sequential bool) error {
+	count, err := countArg(args)
+	if err != nil {
+		return err
+	}
+
+	if _, _, ok := b.Selection(); ok || sequential {
+		_, err = b.IncrementLines(sign*count, sequential)
+		return err
+	}
+	_, err = b.Increment(sign * count)
+	return err
+}
+
+// timeTravel moves through the undo history by the count of states given in args, or by a
+// duration such as "5m" or "30s".
+func timeTravel(args []string, steps func(int) bool, by func(time.Duration) bool) error {
+	if len(args) > 0 {
+		if d, err := time.ParseDuration(args[0]); err == nil {
+			by(d)
+			return nil
+		}
+	}
+
+	count, err := countArg(args)
+	if err == nil {
+		steps(count)
+	}
+	return err
+}
+
+// countArg returns the count given as the first argument, 1 if there is none.
+func countArg(args []string) (int, error) {
+	if len(args) == 0 {
+		return 1, nil
+	}
+
+	n, err := strconv.Atoi(args[0])
+	if err != nil {
+		return 0, fmt.Errorf("%w: bad count %q", ErrUsage, args[0])
+	}
+	return n, nil
+}
+
+// objectCommand returns a command running fn on the text object named by its argument.
+func objectCommand(fn func(b *text.Buffer, name string, around bool) error, around bool) Func {
+	return func(b *text.Buffer, args []string) error {
+		if len(args) != 1 {
+			return fmt.Errorf("%w: needs a text object, such as w, \" or (", ErrUsage)
+		}
+		return fn(b, args[0], around)
+	}
+}
+
+// transform adapts fn into a command over the selection or the word under the cursor.
+func transform(fn text.TextTransform) Func {
+	return func(b *text.Buffer, _ []string) error {
+		return b.TransformText(fn)
+	}
+}
+
+// inString returns a command replacing the selection, or else the contents of the string
+// literal at the cursor, with the result of the transform fn returns for the file type of the
+// buffer. The literal is found by the highlighter of the file type, or as the quoted string
+// on the line for file types that have none.
+func inString(fn func(fileType string) text.TextTransform) Func {
+	return func(b *text.Buffer, _ []string) error {
+		transform := fn(b.Options().FileType)
+		if _, _, ok := b.Selection(); ok {
+			return b.TransformText(transform)
+		}
+		r, ok := stringAt(b)
+		if !ok {
+			return fmt.Errorf("%w: no selection or string at the cursor", ErrUsage)
+		}
+		cursor := b.Cursor()
+		b.Select(r.Start)
+		b.Seek(r.End)
+		err := b.TransformText(transform)
+		end := b.Cursor()
+		b.Deselect()
+		b.Seek(min(cursor, end))
+		return err
+	}
+}
+
+// stringAt returns the contents of the string literal around the cursor of b, without its
+// quotes.
+func stringAt(b *text.Buffer) (text.Range, bool) {
+	hl := syntax.ForFileType(b.Options().FileType)
+	if hl == nil {
+		var best text.Range
+		found := false
+		for _, q := range []string{`"`, `'`, "`"} {
+			if inner, _, ok := b.Object(q, b.Cursor()); ok && (!found || inner.Start > best.Start) {
+				best, found = inner, true
+			}
+		}
+		return best, found
+	}
+
+	line, _ := b.LineRunes(b.Line())
+	col, start := b.Column(), b.Offset(b.Line(), 0)
+	for _, s := range hl.Highlight(line) {
+		if s.Scope != "string" && !strings.HasPrefix(s.Scope, "string.") || col < s.Start || col >= s.End {
+			continue
+		}
+		end := s.End - 1
+		if s.End-s.Start < 2 || line[end] != line[s.Start] {
+			end = s.End
+		}
+		return text.Range{Start: start + s.Start + 1, End: start + end}, true
+	}
+	return text.Range{}, false
+}
@@ -0,0 +1,134 @@
+package command
+
+import (
+	"fmt"
+
+	"github.com/avalonbits/goted/indent"
+	"github.com/avalonbits/goted/text"
+)
+
+// newline breaks the line at the cursor of b. With rules for the file type of b, the line is
+// first reindented by them, as the closing bracket or the else typed on it may take it out,
+// and the new line is indented by them. Otherwise the new line keeps the indentation of the
+// line it was broken from.
+func newline(b *text.Buffer, _ []string) error {
+	e := indent.For(b.Options().FileType)
+	if e == nil {
+		return b.SplitLine(true)
+	}
+	return b.Transaction(func() error {
+		if line, _ := b.LineRunes(b.Line()); len(leading(line)) < len(line) {
+			if err := reindentLine(b, e, b.Line()); err != nil {
+				return err
+			}
+		}
+		if err := b.SplitLine(true); err != nil {
+			return err
+		}
+		return reindentLine(b, e, b.Line())
+	})
+}
+
+// reindentLine indents line n of b by e. A cursor on the line keeps its place in the text.
+func reindentLine(b *text.Buffer, e indent.Engine, n int) error {
+	line, _ := b.LineRunes(n)
+	lead := len(leading(line))
+	want := []rune(indent.Line(e, indentContext(b), n))
+	if string(line[:lead]) == string(want) {
+		return nil
+	}
+	col := -1
+	if b.Line() == n {
+		col = max(b.Column()-lead, 0)
+	}
+	start := b.Offset(n, 0)
+	if err := b.Replace(start, start+lead, want); err != nil {
+		return err
+	}
+	if col >= 0 {
+		b.Seek(start + len(want) + col)
+	}
+	return nil
+}
+
+// leading returns the indentation of line.
+func leading(line []rune) []rune {
+	i := 0
+	for i < len(line) && (line[i] == ' ' || line[i] == '\t') {
+		i++
+	}
+	return line[:i]
+}
+
+// reindentSelection indents the selected lines of b, or the cursor line, by the rules of the
+// file type of b.
+func reindentSelection(b *text.Buffer, _ []string) error {
+	e := indent.For(b.Options().FileType)
+	if e == nil {
+		return fmt.Errorf("%w: no indentation rules for file type %q", ErrUsage, b.Options().FileType)
+	}
+	c := indentContext(b)
+	_, err := b.Reindent(func(n int) string {
+		return indent.Line(e, c, n)
+	})
+	return err
+}
+
+// reindent indents the selected lines of b, or every line, by the rules of the file type of b,
+// and tells how many lines changed.
+func (r *Registry) reindent(b *text.Buffer, _ []string) error {
+	e := indent.For(b.Options().FileType)
+	if e == nil {
+		return fmt.Errorf("%w: no indentation rules for file type %q", ErrUsage, b.Options().FileType)
+	}
+	c := indentContext(b)
+	changed, err := b.ReindentAll(func(n int) string {
+		return indent.Line(e, c, n)
+	})
+	if err != nil {
+		return err
+	}
+	r.notify(fmt.Sprintf("Reindented %d lines", changed))
+	return nil
+}
+
+// retab rewrites the indentation of the selected lines of b, or of every line, as tabs or as
+// spaces, as args[0] says or else as expand_tab does, at the tab width of b, and tells how many
+// lines changed.
+func (r *Registry) retab(b *text.Buffer, args []string) error {
+	tabs := !b.Options().ExpandTab
+	switch {
+	case len(args) > 1:
+		return fmt.Errorf("%w: retab takes at most tabs or spaces", ErrUsage)
+	case len(args) == 0:
+	case args[0] == "tabs":
+		tabs = true
+	case args[0] == "spaces":
+		tabs = false
+	default:
+		return fmt.Errorf("%w: retab to tabs or spaces, not %q", ErrUsage, args[0])
+	}
+	changed, err := b.Retab(tabs, b.Options().TabWidth)
+	if err != nil {
+		return err
+	}
+	with := "spaces"
+	if tabs {
+		with = "tabs"
+	}
+	r.notify(fmt.Sprintf("Indented %d lines with %s", changed, with))
+	return nil
+}
+
+// indentContext returns the lines of b and how b is indented, for an indentation engine.
+func indentContext(b *text.Buffer) indent.Context {
+	o := b.Options()
+	return indent.Context{
+		Line: func(n int) string {
+			line, _ := b.LineRunes(n)
+			return string(line)
+		},
+		TabWidth: o.TabWidth,
+		Tabs:     !o.ExpandTab,
+	}
+}
@@ -0,0 +1,148 @@
+package command
+
+import (
+	"slices"
+	"strings"
+)
+
+// Keymap maps key chords, such as "Alt+Up", to command names, which may be followed by arguments
+// as on a command line, such as "select-inner w". A binding may also be a sequence of chords
+// separated by spaces, such as "Ctrl+K u", in which case its first chords form a prefix that
+// waits for the rest.
+type Keymap map[string]string
+
+// Continuation is a key that may follow a prefix: it either runs Command or, if Prefix is set,
+// starts Bindings longer bindings.
+type Continuation struct {
+	Key      string
+	Command  string
+	Prefix   bool
+	Bindings int
+}
+
+// DefaultKeymap returns the built-in key bindings.
+func DefaultKeymap() Keymap {
+	return Keymap{
+		"Left":         "move-left",
+		"Right":        "move-right",
+		"Up":           "move-up",
+		"Down":         "move-down",
+		"Home":         "smart-home",
+		"End":          "line-end",
+		"Enter":        "newline",
+		"Backspace":    "backspace",
+		"Delete":       "delete-char",
+		"Tab":          "insert-tab",
+		"Ctrl+Q":       "quit",
+		"Ctrl+S":       "write",
+		"Alt+x":        "command-prompt",
+		"Ctrl+Tab":     "switch",
+		"Ctrl+Z":       "suspend",
+		"Ctrl+_":       "undo",
+		"Ctrl+Y":       "redo",
+		"Ctrl+Shift+D": "duplicate-lines",
+		"Alt+Up":       "move-lines-up",
+		"Alt+Down":     "move-lines-down",
+		"Ctrl+A":       "increment",
+		"Ctrl+X":       "decrement",
+		"Ctrl+D":       "scroll-half-down",
+		"Ctrl+U":       "scroll-half-up",
+		"PageDown":     "scroll-page-down",
+		"PageUp":       "scroll-page-up",
+		"Ctrl+L":       "cursor-center",
+		"Ctrl+K u":     "upper-case",
+		"Ctrl+K l":     "lower-case",
+		"Ctrl+K s":     "sort-lines",
+		"Ctrl+K =":     "calc",
+		"Ctrl+K j":     "json-pretty",
+		"Ctrl+K f":     "open-at-point",
+		"Ctrl+Down":    "paragraph-next",
+		"Ctrl+Up":      "paragraph-previous",
+		"Alt+e":        "sentence-next",
+		"Alt+a":        "sentence-previous",
+		"Ctrl+K q":     "reflow",
+		"Ctrl+K m n":   "conflict-next",
+		"Ctrl+K m p":   "conflict-previous",
+		"Ctrl+K m o":   "take-ours",
+		"Ctrl+K m t":   "take-theirs",
+		"Ctrl+K m b":   "take-both",
+		"Ctrl+K '":     "cycle-quotes",
+		"Alt+=":        "expand-selection",
+		"Alt+-":        "shrink-selection",
+		"Ctrl+K Space": "mark",
+		"Ctrl+K i w":   "select-inner w",
+		"Ctrl+K i b":   "select-inner b",
+		"Ctrl+K i a":   "select-inner a",
+		"Ctrl+K a w":   "select-around w",
+		"Ctrl+K a b":   "select-around b",
+		"Ctrl+K a a":   "select-around a",
+		"Ctrl+K [":     "block-start",
+		"Ctrl+K ]":     "block-end",
+		"Ctrl+K d":     "go-doc",
+		"Ctrl+K (":     "signature-help",
+		"Ctrl+Space":   "complete",
+		"Ctrl+K o":     "outline",
+		"Ctrl+K O":     "symbols",
+		"Ctrl+K b":     "bookmark",
+		"Ctrl+K B":     "bookmarks",
+		"Ctrl+K .":     "bookmark-next",
+		"Ctrl+K ,":     "bookmark-previous",
+		"Ctrl+K w":     "announce",
+		"Ctrl+K k":     "compose-char",
+		"Ctrl+K K":     "pick-char",
+		"Alt+.":        "repeat",
+	}
+}
+
+// Lookup returns the command bound to the chord sequence keys and whether keys is also the
+// prefix of longer bindings, in which case the caller should wait for more keys.
+func (k Keymap) Lookup(keys []string) (string, bool) {
+	seq := strings.Join(keys, " ")
+	prefix := false
+	for binding := range k {
+		if strings.HasPrefix(binding, seq+" ") {
+			prefix = true
+			break
+		}
+	}
+	return k[seq], prefix
+}
+
+// Continuations returns the keys that may follow the chord sequence prefix, sorted, so they can
+// be listed after a prefix key is pressed.
+func (k Keymap) Continuations(prefix []string) []Continuation {
+	start := strings.Join(prefix, " ")
+	if start != "" {
+		start += " "
+	}
+
+	byKey := map[string]*Continuation{}
+	for binding, cmd := range k {
+		rest, ok := strings.CutPrefix(binding, start)
+		if !ok || rest == "" {
+			continue
+		}
+
+		key, more, _ := strings.Cut(rest, " ")
+		c := byKey[key]
+		if c == nil {
+			c = &Continuation{Key: key}
+			byKey[key] = c
+		}
+		if more == "" {
+			c.Command = cmd
+		} else {
+			c.Prefix = true
+			c.Bindings++
+		}
+	}
+
+	conts := make([]Continuation, 0, len(byKey))
+	for _, c := range byKey {
+		conts = append(conts, *c)
+	}
+	slices.SortFunc(conts, func(x, y Continuation) int {
+		return strings.Compare(x.Key, y.Key)
+	})
+	return conts
+}
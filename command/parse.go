@@ -0,0 +1,56 @@
+package command
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Parse splits a command line into the command name and its arguments. Arguments are separated
+// by spaces and may be quoted with single quotes, taken literally, or double quotes, in which a
+// backslash escapes the next character. Outside quotes a backslash also escapes the next
+// character. Returns an empty name for blank lines and comments, which start with '#'.
+func Parse(line string) (string, []string, error) {
+	line = strings.TrimSpace(line)
+	if line == "" || line[0] == '#' {
+		return "", nil, nil
+	}
+
+	var fields []string
+	var field strings.Builder
+	inField := false
+	var quote rune
+	escaped := false
+
+	for _, r := range line {
+		switch {
+		case escaped:
+			field.WriteRune(r)
+			escaped = false
+		case r == '\\' && quote != '\'':
+			escaped, inField = true, true
+		case quote != 0 && r == quote:
+			quote = 0
+		case quote != 0:
+			field.WriteRune(r)
+		case r == '"' || r == '\'':
+			quote, inField = r, true
+		case r == ' ' || r == '\t':
+			if inField {
+				fields = append(fields, field.String())
+				field.Reset()
+				inField = false
+			}
+		default:
+			field.WriteRune(r)
+			inField = true
+		}
+	}
+
+	if quote != 0 || escaped {
+		return "", nil, fmt.Errorf("%w: unterminated quote or escape", ErrUsage)
+	}
+	if inField {
+		fields = append(fields, field.String())
+	}
+	return fields[0], fields[1:], nil
+}
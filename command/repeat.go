@@ -0,0 +1,130 @@
+package command
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/avalonbits/goted/text"
+)
+
+// unrepeatable are the commands that change the text without being edits to repeat: they move
+// through the history, save the file, formatting it on the way, or repeat the last change
+// themselves.
+var unrepeatable = map[string]bool{
+	"write":        true,
+	"save-as":      true,
+	"undo":         true,
+	"redo":         true,
+	"undo-earlier": true,
+	"undo-later":   true,
+	"repeat":       true,
+}
+
+// typing are the commands that are part of the text being typed, so they extend a run of
+// typed text rather than starting a change of their own.
+var typing = map[string]bool{
+	"newline":    true,
+	"backspace":  true,
+	"insert-tab": true,
+}
+
+// step is one part of a change: text typed, wrapped as typing wraps it if wrap is set, or a
+// command run with its arguments.
+type step struct {
+	text []rune
+	wrap bool
+	name string
+	args []string
+}
+
+// change is the last edit, as the steps to repeat it. buffer, cursor and version are where the
+// last step left off, so the next one extends a run of typing only if it starts there.
+type change struct {
+	steps   []step
+	buffer  *text.Buffer
+	cursor  int
+	version int
+	typing  bool
+}
+
+// Type inserts text at the cursor of b as it is typed or pasted, wrapping the line after it if
+// wrap is set, and records it as the last change: a run of text typed in one place, with any
+// newline, backspace or insert-tab on the way, is repeated as a whole.
+func (r *Registry) Type(b *text.Buffer, text []rune, wrap bool) error {
+	cursor, version := b.Cursor(), b.Version()
+	if err := b.Insert(text); err != nil {
+		return err
+	}
+	if wrap {
+		if _, err := b.WrapLine(); err != nil {
+			return err
+		}
+	}
+	r.record(b, cursor, version, step{text: text, wrap: wrap})
+	return nil
+}
+
+// record adds s, which took b from cursor and version to where it is now, to the last change,
+// or makes it a new one. Steps recorded while a change is repeated, or by commands run by
+// another command, are not recorded.
+func (r *Registry) record(b *text.Buffer, cursor, version int, s step) {
+	if r.repeating || r.depth > 0 {
+		return
+	}
+	last := &r.last
+	typed := s.name == "" || typing[s.name]
+	continues := last.typing && typed && last.buffer == b && last.cursor == cursor && last.version == version
+	if !continues {
+		*last = change{typing: typed}
+	}
+	last.steps = append(last.steps, s)
+	last.buffer, last.cursor, last.version = b, b.Cursor(), b.Version()
+}
+
+// repeat applies the last change again at the cursor of b, args[0] times if given, undone in
+// one step. Text typed is typed again and commands are run again with the same arguments, so a
+// surround or a replace applies to what is under the cursor or selected now.
+func (r *Registry) repeat(b *text.Buffer, args []string) error {
+	count := 1
+	if len(args) > 1 {
+		return fmt.Errorf("%w: repeat takes at most a count", ErrUsage)
+	} else if len(args) == 1 {
+		n, err := strconv.Atoi(args[0])
+		if err != nil || n < 1 {
+			return fmt.Errorf("%w: repeat count %q", ErrUsage, args[0])
+		}
+		count = n
+	}
+	if len(r.last.steps) == 0 {
+		return fmt.Errorf("%w: no change to repeat", ErrUsage)
+	}
+
+	r.repeating = true
+	defer func() { r.repeating = false }()
+	steps := r.last.steps
+	return b.Transaction(func() error {
+		for range count {
+			for _, s := range steps {
+				if err := r.replay(b, s); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
+}
+
+// replay applies step s to b.
+func (r *Registry) replay(b *text.Buffer, s step) error {
+	if s.name != "" {
+		return r.Run(b, s.name, s.args...)
+	}
+	if err := b.Insert(s.text); err != nil {
+		return err
+	}
+	if s.wrap {
+		_, err := b.WrapLine()
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,18 @@
+// Package complete provides completion candidates for text being typed in a buffer.
+package complete
+
+import "github.com/avalonbits/goted/text"
+
+// Item is a completion candidate: accepting it replaces the runes between Start and End with
+// Text.
+type Item struct {
+	Label string
+	Text  []rune
+	Start int
+	End   int
+}
+
+// Provider computes completion candidates for the cursor position of a buffer.
+type Provider interface {
+	Complete(b *text.Buffer) []Item
+}
@@ -0,0 +1,117 @@
+package complete
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/avalonbits/goted/text"
+)
+
+// Paths completes file system paths inside string literals. Relative paths are resolved against
+// the directory of the buffer's file (or the working directory for buffers with no file), then
+// against Root if it is set.
+type Paths struct {
+	Root string
+}
+
+// Complete returns the entries matching the path being typed, if the cursor is inside a quoted
+// string that looks like a path. Directories are suffixed with a slash.
+func (p Paths) Complete(b *text.Buffer) []Item {
+	line, _ := b.LineRunes(b.Line())
+	token, ok := stringPrefix(line[:b.Column()])
+	if !ok || !strings.ContainsFunc(token, isSeparator) && !strings.HasPrefix(token, ".") && !strings.HasPrefix(token, "~") {
+		return nil
+	}
+
+	dir, prefix := "", token
+	if i := strings.LastIndexFunc(token, isSeparator); i >= 0 {
+		dir, prefix = token[:i+1], token[i+1:]
+	}
+
+	seen := map[string]bool{}
+	var items []Item
+	for _, d := range p.dirs(b, dir) {
+		entries, err := os.ReadDir(d)
+		if err != nil {
+			continue
+		}
+
+		for _, e := range entries {
+			name := e.Name()
+			if !strings.HasPrefix(name, prefix) || seen[name] || strings.HasPrefix(name, ".") && !strings.HasPrefix(prefix, ".") {
+				continue
+			}
+			seen[name] = true
+
+			if e.IsDir() {
+				name += "/"
+			}
+			items = append(items, Item{
+				Label: dir + name,
+				Text:  []rune(name),
+				Start: b.Cursor() - len([]rune(prefix)),
+				End:   b.Cursor(),
+			})
+		}
+	}
+
+	slices.SortFunc(items, func(a, b Item) int {
+		return strings.Compare(a.Label, b.Label)
+	})
+	return items
+}
+
+// dirs returns the directories the path dir may refer to, in order of preference.
+func (p Paths) dirs(b *text.Buffer, dir string) []string {
+	switch {
+	case filepath.IsAbs(dir):
+		return []string{dir}
+	case len(dir) >= 2 && dir[0] == '~' && isSeparator(rune(dir[1])):
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return nil
+		}
+		return []string{filepath.Join(home, dir[2:])}
+	}
+
+	var dirs []string
+	if b.Path() != "" {
+		dirs = append(dirs, filepath.Join(filepath.Dir(b.Path()), dir))
+	} else if wd, err := os.Getwd(); err == nil {
+		dirs = append(dirs, filepath.Join(wd, dir))
+	}
+	if p.Root != "" && !strings.HasPrefix(dir, "./") && !strings.HasPrefix(dir, "../") {
+		dirs = append(dirs, filepath.Join(p.Root, dir))
+	}
+	return dirs
+}
+
+// isSeparator reports whether r separates the elements of a path: a slash, or also a backslash
+// on Windows.
+func isSeparator(r rune) bool {
+	return r < 0x80 && os.IsPathSeparator(uint8(r))
+}
+
+// stringPrefix returns the contents of the string literal that is still open at the end of
+// before. Returns false if before does not end inside a string.
+func stringPrefix(before []rune) (string, bool) {
+	var quote rune
+	start := 0
+	for i := 0; i < len(before); i++ {
+		switch r := before[i]; {
+		case quote != 0 && r == '\\' && quote != '`':
+			i++
+		case quote != 0 && r == quote:
+			quote = 0
+		case quote == 0 && (r == '"' || r == '\'' || r == '`'):
+			quote, start = r, i+1
+		}
+	}
+
+	if quote == 0 {
+		return "", false
+	}
+	return string(before[start:]), true
+}
@@ -0,0 +1,306 @@
+// Package config loads editor settings.
+//
+// Settings are layered: the built-in defaults are overridden by the user configuration file,
+// which is in turn overridden by the project file named .goted at the project root. Each layer is
+// a JSON object and only the keys present in it override, so a project file can change the tab
+// width without repeating the rest of the user configuration. Maps are merged key by key while
+// lists are replaced as a whole. Settings that run commands or name files outside the project
+// are only read from the user configuration, since project files come with the code.
+package config
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+
+	"github.com/avalonbits/goted/guard"
+	"github.com/avalonbits/goted/history"
+)
+
+// ProjectFile is the name of the project settings file.
+const ProjectFile = ".goted"
+
+// Settings holds the editor configuration.
+type Settings struct {
+	TabWidth  int  `json:"tab_width"`
+	ExpandTab bool `json:"expand_tab"`
+
+	// TextWidth is the width reflow wraps prose to.
+	TextWidth int `json:"text_width"`
+
+	// AutoWrap lists the file types, such as markdown, in which lines are broken while typing
+	// past the text width.
+	AutoWrap []string `json:"auto_wrap"`
+
+	// ElasticTabs lists the file types, such as tsv, whose tab separated columns line up on
+	// screen, as elastic tabstops.
+	ElasticTabs []string `json:"elastic_tabs"`
+
+	// DetectIndent sets tabs or spaces, and the width of spaces, from how the lines of files
+	// are indented when they are opened, unless a modeline says.
+	DetectIndent bool `json:"detect_indent"`
+
+	// SmartEnd makes End stop past the last non-blank character of the line before going to its
+	// end.
+	SmartEnd bool `json:"smart_end"`
+
+	// ScrollOff is how many lines of context are kept above and below the cursor.
+	ScrollOff int `json:"scroll_off"`
+
+	// Formatters maps a file type to the command that formats it.
+	Formatters map[string]string `json:"formatters"`
+
+	// Exclude lists glob patterns of directories skipped by project wide operations.
+	Exclude []string `json:"exclude"`
+
+	// OnSave lists shell commands run, in the project root, after a buffer is saved. They are
+	// run before PostSave, failures shown as warnings.
+	OnSave []string `json:"on_save"`
+
+	// PreSave and PostSave are the hooks run, in order, before a buffer is written and after.
+	PreSave  []SaveHook `json:"pre_save"`
+	PostSave []SaveHook `json:"post_save"`
+
+	// Modelines enables applying vim and emacs modelines found in opened files. It is off by
+	// default since files can then change editor settings, and only the user configuration
+	// turns it on.
+	Modelines bool `json:"modelines"`
+
+	// Templates enables filling the buffers of new files from the template for their file type.
+	Templates bool `json:"templates"`
+
+	// Header is the license header put at the top of new files by templates.
+	Header string `json:"header"`
+
+	// RestorePosition enables reopening files where the cursor was when they were last edited.
+	RestorePosition bool `json:"restore_position"`
+
+	// RestoreExclude lists glob patterns, matched against the base name and the full path, of
+	// files opened at their start instead, such as commit messages and temporary files.
+	RestoreExclude []string `json:"restore_exclude"`
+
+	// IncludePath lists the directories searched for the file under the cursor after the
+	// directory of the buffer, the project root and the working directory. Relative directories
+	// are taken from the project root.
+	IncludePath []string `json:"include_path"`
+
+	// GoImports enables formatting Go files and fixing their imports on save, as goimports
+	// does for the standard library.
+	GoImports bool `json:"go_imports"`
+
+	// Theme is the name of the theme, or "auto" for ThemeDark or ThemeLight as the desktop
+	// prefers, dark if it cannot tell.
+	Theme      string `json:"theme"`
+	ThemeDark  string `json:"theme_dark"`
+	ThemeLight string `json:"theme_light"`
+
+	// Transparent draws the text with no background color, so the background of the terminal,
+	// which may be transparent, shows through.
+	Transparent bool `json:"transparent"`
+
+	// Scrollbar shows a scrollbar on the right of the buffer, marking the matches being
+	// searched for and merge conflicts, and turns mouse reporting on so that clicking it jumps.
+	Scrollbar bool `json:"scrollbar"`
+
+	// Images is the protocol images are drawn inline with: kitty, iterm, sixel, off, or auto to
+	// detect it from the environment.
+	Images string `json:"images"`
+
+	// AgeIdentity is the file of age identities encrypted files are decrypted with, and
+	// AgeRecipients that of the recipients they are encrypted to on save, by default those of
+	// the identities.
+	AgeIdentity   string `json:"age_identity"`
+	AgeRecipients string `json:"age_recipients"`
+
+	// Redact masks likely secrets on screen from the start, see the redact command, and
+	// RedactPatterns changes the patterns they are found with: names map to regular
+	// expressions to add or replace, or to "" to leave a built-in pattern out.
+	Redact         bool              `json:"redact"`
+	RedactPatterns map[string]string `json:"redact_patterns"`
+
+	// UndoFiles keeps the undo history of each saved file in a hidden file next to it, to be
+	// restored when the file is opened again unchanged.
+	UndoFiles bool `json:"undo_files"`
+
+	// History keeps a copy of each save of a file, within HistoryLimits, for the history
+	// command to look at, compare and restore.
+	History       bool           `json:"history"`
+	HistoryLimits history.Limits `json:"history_limits"`
+
+	// Limits are the thresholds above which destructive operations ask for confirmation.
+	Limits guard.Limits `json:"limits"`
+
+	// Root is the project root the settings were loaded for, if any.
+	Root string `json:"-"`
+}
+
+// SaveHook is a step of saving a buffer: one of the built-in steps, or the shell command Run.
+// The built-in steps edit the buffer and only run before it is written: trim_whitespace drops
+// the blanks ending lines, final_newline ends the text with a newline and format formats the
+// buffer with the command Formatters has for its file type, if any. Commands run before the
+// buffer is written get its text on their standard input.
+type SaveHook struct {
+	Builtin string `json:"builtin"`
+	Run     string `json:"run"`
+
+	// FileTypes limits the hook to buffers of these file types, all of them if empty.
+	FileTypes []string `json:"file_types"`
+
+	// OnError is what a failure of the hook does: HookWarn, the default, shows it and goes on
+	// with the next hook, while HookAbort stops the chain, and the save if it is not written
+	// yet.
+	OnError string `json:"on_error"`
+}
+
+// Failure policies of save hooks.
+const (
+	HookWarn  = "warn"
+	HookAbort = "abort"
+)
+
+// Default returns the built-in settings.
+func Default() Settings {
+	return Settings{
+		TabWidth:   4,
+		TextWidth:  80,
+		AutoWrap:   []string{"gitcommit", "markdown", "text"},
+		ScrollOff:  3,
+		Formatters: map[string]string{},
+		Exclude:    []string{".git", "node_modules", "vendor"},
+		Templates:  true,
+		GoImports:  true,
+		Theme:      "auto",
+		ThemeDark:  "dark",
+		ThemeLight: "light",
+		Images:     "auto",
+		Limits:     guard.DefaultLimits(),
+
+		IncludePath:  []string{"/usr/local/include", "/usr/include"},
+		DetectIndent: true,
+
+		History:       true,
+		HistoryLimits: history.DefaultLimits(),
+
+		RestorePosition: true,
+		RestoreExclude: []string{
+			"COMMIT_EDITMSG", "MERGE_MSG", "TAG_EDITMSG", "git-rebase-todo", "*.orig", "*.rej",
+			filepath.Join(os.TempDir(), "*"),
+		},
+	}
+}
+
+// UserPath returns the location of the user configuration file.
+func UserPath() (string, error) {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "goted", "config.json"), nil
+}
+
+// ProjectRoot returns the closest directory at or above dir that holds a version control
+// checkout or a project settings file. Returns false if there is none.
+func ProjectRoot(dir string) (string, bool) {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return "", false
+	}
+
+	for {
+		for _, name := range []string{".git", ProjectFile} {
+			if _, err := os.Stat(filepath.Join(dir, name)); err == nil {
+				return dir, true
+			}
+		}
+
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return "", false
+		}
+		dir = parent
+	}
+}
+
+// Load returns the settings for files in dir: the defaults, merged with the user configuration
+// and then with the project file, if any, but for the settings only the user may set, see
+// keepUser. Missing files are skipped.
+func Load(dir string) (Settings, error) {
+	s := Default()
+
+	if path, err := UserPath(); err == nil {
+		if err := s.merge(path); err != nil {
+			return s, err
+		}
+	}
+
+	if root, ok := ProjectRoot(dir); ok {
+		s.Root = root
+		user := s.clone()
+		if err := s.merge(filepath.Join(root, ProjectFile)); err != nil {
+			return s, err
+		}
+		s.keepUser(user)
+	}
+	return s, nil
+}
+
+// clone returns a copy of s that merging other settings over s leaves alone.
+func (s Settings) clone() Settings {
+	s.Formatters = maps.Clone(s.Formatters)
+	s.OnSave = slices.Clone(s.OnSave)
+	s.PreSave = slices.Clone(s.PreSave)
+	s.PostSave = slices.Clone(s.PostSave)
+	s.IncludePath = slices.Clone(s.IncludePath)
+	return s
+}
+
+// keepUser puts back the settings of user a project file may not change: those that run
+// commands or reach files outside the project, which a cloned repository could otherwise use to
+// run its own commands as files are saved or to encrypt them to its own key, and modelines,
+// which would let its files change settings. The save hooks of the project stay, but for those
+// running commands the user did not configure.
+func (s *Settings) keepUser(user Settings) {
+	s.Formatters, s.OnSave, s.IncludePath = user.Formatters, user.OnSave, user.IncludePath
+	s.AgeIdentity, s.AgeRecipients = user.AgeIdentity, user.AgeRecipients
+	s.Modelines = user.Modelines
+	s.PreSave = trustedHooks(s.PreSave, user.PreSave)
+	s.PostSave = trustedHooks(s.PostSave, user.PostSave)
+}
+
+// trustedHooks returns the hooks that are built-in steps or run a command of the user hooks.
+func trustedHooks(hooks, user []SaveHook) []SaveHook {
+	return slices.DeleteFunc(hooks, func(h SaveHook) bool {
+		return h.Run != "" && !slices.ContainsFunc(user, func(u SaveHook) bool { return u.Run == h.Run })
+	})
+}
+
+// merge overrides s with the keys set in the settings file at path.
+func (s *Settings) merge(path string) error {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("config: %w", err)
+	}
+
+	// Decoding into the hooks already there would fill in the fields a hook of the file
+	// leaves out from those of the hook it replaces.
+	pre, post := s.PreSave, s.PostSave
+	s.PreSave, s.PostSave = nil, nil
+	if err := json.Unmarshal(data, s); err != nil {
+		return fmt.Errorf("config: %s: %w", path, err)
+	}
+	if s.PreSave == nil {
+		s.PreSave = pre
+	}
+	if s.PostSave == nil {
+		s.PostSave = post
+	}
+	return nil
+}
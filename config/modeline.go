@@ -0,0 +1,165 @@
+package config
+
+import (
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/avalonbits/goted/text"
+)
+
+// modelineLines is how many lines at the start and end of a file are searched for modelines.
+const modelineLines = 5
+
+var (
+	vimRE   = regexp.MustCompile(`(?:^|\s)(?:vi|vim|Vim|ex):\s*(.*)$`)
+	emacsRE = regexp.MustCompile(`-\*-\s*(.*?)\s*-\*-`)
+)
+
+// Modeline holds the options set by a modeline. Nil fields were not set.
+type Modeline struct {
+	TabWidth  *int
+	TextWidth *int
+	ExpandTab *bool
+	FileType  *string
+}
+
+// ParseModeline extracts the recognized options from a vim ("vim: ts=4 et") or emacs
+// ("-*- mode: go; tab-width: 4 -*-") modeline. Returns false if line is not a modeline.
+func ParseModeline(line string) (Modeline, bool) {
+	var m Modeline
+	if match := emacsRE.FindStringSubmatch(line); match != nil {
+		return m, m.emacs(match[1])
+	}
+	if match := vimRE.FindStringSubmatch(line); match != nil {
+		return m, m.vim(match[1])
+	}
+	return m, false
+}
+
+func (m *Modeline) vim(opts string) bool {
+	// The "set" form ends at the first colon after the options, the other separates them with
+	// colons.
+	if rest, ok := strings.CutPrefix(opts, "set "); ok {
+		opts, _, _ = strings.Cut(rest, ":")
+	} else if rest, ok := strings.CutPrefix(opts, "se "); ok {
+		opts, _, _ = strings.Cut(rest, ":")
+	}
+
+	found := false
+	for _, opt := range strings.FieldsFunc(opts, func(r rune) bool { return r == ' ' || r == '\t' || r == ':' }) {
+		name, value, _ := strings.Cut(opt, "=")
+		switch name {
+		case "ts", "tabstop":
+			found = m.setTabWidth(value) || found
+		case "tw", "textwidth":
+			found = m.setTextWidth(value) || found
+		case "et", "expandtab":
+			m.ExpandTab, found = ptr(true), true
+		case "noet", "noexpandtab":
+			m.ExpandTab, found = ptr(false), true
+		case "ft", "filetype":
+			if value != "" {
+				m.FileType, found = ptr(value), true
+			}
+		}
+	}
+	return found
+}
+
+func (m *Modeline) emacs(opts string) bool {
+	if !strings.Contains(opts, ":") {
+		m.FileType = ptr(strings.ToLower(strings.TrimSpace(opts)))
+		return *m.FileType != ""
+	}
+
+	found := false
+	for _, opt := range strings.Split(opts, ";") {
+		name, value, ok := strings.Cut(opt, ":")
+		if !ok {
+			continue
+		}
+
+		value = strings.TrimSpace(value)
+		switch strings.ToLower(strings.TrimSpace(name)) {
+		case "mode":
+			m.FileType, found = ptr(strings.ToLower(value)), true
+		case "tab-width":
+			found = m.setTabWidth(value) || found
+		case "fill-column":
+			found = m.setTextWidth(value) || found
+		case "indent-tabs-mode":
+			m.ExpandTab, found = ptr(value == "nil"), true
+		}
+	}
+	return found
+}
+
+func (m *Modeline) setTabWidth(value string) bool {
+	n, err := strconv.Atoi(value)
+	if err != nil || n <= 0 {
+		return false
+	}
+	m.TabWidth = ptr(n)
+	return true
+}
+
+// Apply sets the options of b from the settings and, if modelines are enabled, from the
+// modelines found in the first and last lines of b. Returns false if no modeline was applied.
+func (s Settings) Apply(b *text.Buffer) bool {
+	o := b.Options()
+	o.TabWidth, o.ExpandTab, o.SmartEnd = s.TabWidth, s.ExpandTab, s.SmartEnd
+	o.AutoWrap = slices.Contains(s.AutoWrap, o.FileType)
+	o.ElasticTabs = slices.Contains(s.ElasticTabs, o.FileType)
+	if s.TextWidth > 0 {
+		o.TextWidth = s.TextWidth
+	}
+	defer func() { b.SetOptions(o) }()
+
+	if !s.Modelines {
+		return false
+	}
+
+	count := b.Lines()
+	applied := false
+	for n := range count {
+		if n >= modelineLines && n < count-modelineLines {
+			continue
+		}
+
+		line, _ := b.LineRunes(n)
+		m, ok := ParseModeline(string(line))
+		if !ok {
+			continue
+		}
+
+		applied = true
+		if m.TabWidth != nil {
+			o.TabWidth = *m.TabWidth
+		}
+		if m.TextWidth != nil {
+			o.TextWidth = *m.TextWidth
+		}
+		if m.ExpandTab != nil {
+			o.ExpandTab = *m.ExpandTab
+		}
+		if m.FileType != nil {
+			o.FileType = *m.FileType
+		}
+	}
+	return applied
+}
+
+func (m *Modeline) setTextWidth(value string) bool {
+	n, err := strconv.Atoi(value)
+	if err != nil || n <= 0 {
+		return false
+	}
+	m.TextWidth = ptr(n)
+	return true
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
@@ -0,0 +1,304 @@
+// Package crypt decrypts files encrypted with GPG or age into memory and encrypts them again,
+// through the gpg and age commands. Plain text only ever goes through pipes to and from them,
+// never to a file.
+//
+// A Key is what Decrypt learns about how a file was encrypted, which Encrypt uses to encrypt
+// it the same way: to the same GPG recipients, with the same passphrase, or to the age
+// recipients of the identity it was decrypted with, armored if it was.
+package crypt
+
+import (
+	"bytes"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/avalonbits/goted/storage"
+)
+
+var (
+	// ErrPassphrase is returned by Decrypt when the file needs a passphrase and the one given,
+	// if any, is wrong.
+	ErrPassphrase = errors.New("crypt: passphrase needed")
+
+	// ErrAgePassphrase is returned for files encrypted with an age passphrase, which the age
+	// command only reads from a terminal, the one the editor is using.
+	ErrAgePassphrase = errors.New("crypt: age passphrase files are not supported")
+)
+
+// Kind is the tool a file is encrypted with.
+type Kind int
+
+const (
+	None Kind = iota
+	GPG
+	Age
+)
+
+func (k Kind) String() string {
+	switch k {
+	case GPG:
+		return "gpg"
+	case Age:
+		return "age"
+	}
+	return ""
+}
+
+var (
+	ageMagic   = []byte("age-encryption.org/v1\n")
+	ageArmor   = []byte("-----BEGIN AGE ENCRYPTED FILE-----")
+	pgpArmor   = []byte("-----BEGIN PGP MESSAGE-----")
+	ageScrypt  = []byte("\n-> scrypt ")
+	gpgStatus  = "[GNUPG:] "
+	gpgPackets = map[byte]bool{
+		// Old format public key and symmetric key packets, of each length type.
+		0x84: true, 0x85: true, 0x86: true, 0x87: true,
+		0x8c: true, 0x8d: true, 0x8e: true, 0x8f: true,
+		// New format packets.
+		0xc1: true, 0xc3: true,
+	}
+)
+
+// Detect returns the kind of encryption of data, read from the file at path. GPG files are
+// told by their armor or, for binary ones named .gpg or .pgp, their first packet; age files by
+// their header.
+func Detect(path string, data []byte) Kind {
+	switch {
+	case bytes.HasPrefix(data, ageMagic), bytes.HasPrefix(data, ageArmor):
+		return Age
+	case bytes.HasPrefix(data, pgpArmor):
+		return GPG
+	}
+	ext := strings.ToLower(filepath.Ext(path))
+	if (ext == ".gpg" || ext == ".pgp") && len(data) > 0 && gpgPackets[data[0]] {
+		return GPG
+	}
+	return None
+}
+
+// Options are the files age decrypts and encrypts with. Identity is the file of age identities
+// tried on decryption, by default age/keys.txt in the user configuration directory.
+// Recipients, if set, is a file of the recipients files are encrypted to, instead of those of
+// Identity.
+type Options struct {
+	Identity   string
+	Recipients string
+}
+
+// identity returns the identity file of o.
+func (o Options) identity() (string, error) {
+	if o.Identity != "" {
+		return o.Identity, nil
+	}
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "age", "keys.txt"), nil
+}
+
+// Key is how a file was encrypted, for Encrypt.
+type Key struct {
+	Kind  Kind
+	Armor bool
+
+	// Recipients are the GPG key IDs the file was encrypted to, none for a passphrase.
+	Recipients []string
+
+	// Passphrase is the GPG passphrase, for files encrypted with one.
+	Passphrase string
+
+	// Options are the age files.
+	Options Options
+}
+
+// Decrypt decrypts data, encrypted as Detect says. GPG is given passphrase, if it is not empty,
+// for a passphrase encrypted file or a secret key protected by one; without it, keys the GPG
+// agent has unlocked still work. Returns ErrPassphrase if one is needed and wrong or missing.
+func Decrypt(kind Kind, data []byte, passphrase string, o Options) ([]byte, Key, error) {
+	switch kind {
+	case GPG:
+		return decryptGPG(data, passphrase)
+	case Age:
+		return decryptAge(data, o)
+	}
+	return data, Key{}, nil
+}
+
+// Encrypt encrypts plain with k.
+func Encrypt(k Key, plain []byte) ([]byte, error) {
+	switch k.Kind {
+	case GPG:
+		args := []string{"--batch", "--yes", "--quiet"}
+		if k.Armor {
+			args = append(args, "--armor")
+		}
+		if len(k.Recipients) == 0 {
+			args = append(args, "--pinentry-mode", "loopback", "--passphrase-fd", "3", "--symmetric")
+			out, _, err := run("gpg", args, plain, k.Passphrase)
+			return out, err
+		}
+		args = append(args, "--trust-model", "always", "--encrypt")
+		for _, r := range k.Recipients {
+			args = append(args, "--recipient", r)
+		}
+		out, _, err := run("gpg", args, plain, "")
+		return out, err
+	case Age:
+		args := []string{"--encrypt"}
+		if k.Armor {
+			args = append(args, "--armor")
+		}
+		if k.Options.Recipients != "" {
+			args = append(args, "--recipients-file", k.Options.Recipients)
+		} else {
+			id, err := k.Options.identity()
+			if err != nil {
+				return nil, err
+			}
+			args = append(args, "--identity", id)
+		}
+		out, _, err := run("age", args, plain, "")
+		return out, err
+	}
+	return plain, nil
+}
+
+func decryptGPG(data []byte, passphrase string) ([]byte, Key, error) {
+	args := []string{"--batch", "--yes", "--quiet", "--status-fd", "2", "--pinentry-mode", "loopback"}
+	if passphrase != "" {
+		args = append(args, "--passphrase-fd", "3")
+	}
+	out, stderr, err := run("gpg", append(args, "--decrypt"), data, passphrase)
+
+	k := Key{Kind: GPG, Armor: bytes.HasPrefix(data, pgpArmor), Passphrase: passphrase}
+	var needed bool
+	for _, line := range strings.Split(stderr, "\n") {
+		status, ok := strings.CutPrefix(line, gpgStatus)
+		if !ok {
+			continue
+		}
+		fields := strings.Fields(status)
+		if len(fields) == 0 {
+			continue
+		}
+		switch fields[0] {
+		case "ENC_TO":
+			if len(fields) > 1 {
+				k.Recipients = append(k.Recipients, fields[1])
+			}
+		case "NEED_PASSPHRASE", "NEED_PASSPHRASE_SYM", "BAD_PASSPHRASE", "MISSING_PASSPHRASE":
+			needed = true
+		}
+	}
+	if err != nil && needed {
+		return nil, Key{}, ErrPassphrase
+	}
+	if err != nil {
+		return nil, Key{}, err
+	}
+	if len(k.Recipients) > 0 {
+		k.Passphrase = ""
+	}
+	return out, k, nil
+}
+
+func decryptAge(data []byte, o Options) ([]byte, Key, error) {
+	if scrypt(data) {
+		return nil, Key{}, ErrAgePassphrase
+	}
+	id, err := o.identity()
+	if err != nil {
+		return nil, Key{}, err
+	}
+	out, _, err := run("age", []string{"--decrypt", "--identity", id}, data, "")
+	if err != nil {
+		return nil, Key{}, err
+	}
+	return out, Key{Kind: Age, Armor: bytes.HasPrefix(data, ageArmor), Options: o}, nil
+}
+
+// scrypt reports whether the age file data is encrypted with a passphrase.
+func scrypt(data []byte) bool {
+	if bytes.HasPrefix(data, ageArmor) {
+		body := bytes.TrimPrefix(data, ageArmor)
+		if end := bytes.Index(body, []byte("-----END")); end >= 0 {
+			body = body[:end]
+		}
+		dec, err := base64.StdEncoding.DecodeString(strings.Join(strings.Fields(string(body)), ""))
+		if err != nil {
+			return false
+		}
+		data = dec
+	}
+	header, _, _ := bytes.Cut(data, []byte("\n---"))
+	return bytes.Contains(header, ageScrypt)
+}
+
+// run runs name with args, plain on its standard input and secret, if any, on file descriptor
+// 3, and returns its output and what it printed on standard error.
+func run(name string, args []string, in []byte, secret string) ([]byte, string, error) {
+	cmd := exec.Command(name, args...)
+	var out, stderr bytes.Buffer
+	cmd.Stdin, cmd.Stdout, cmd.Stderr = bytes.NewReader(in), &out, &stderr
+	if secret != "" {
+		r, w, err := os.Pipe()
+		if err != nil {
+			return nil, "", err
+		}
+		defer r.Close()
+		cmd.ExtraFiles = []*os.File{r}
+		go func() {
+			w.WriteString(secret + "\n")
+			w.Close()
+		}()
+	}
+	if err := cmd.Run(); err != nil {
+		if msg := lastLine(stderr.String()); msg != "" {
+			err = fmt.Errorf("%s: %w: %s", name, err, msg)
+		}
+		return nil, stderr.String(), err
+	}
+	return out.Bytes(), stderr.String(), nil
+}
+
+// lastLine returns the last line of what a command printed that is not a GPG status line.
+func lastLine(s string) string {
+	lines := strings.Split(strings.TrimSpace(s), "\n")
+	for i := len(lines) - 1; i >= 0; i-- {
+		if line := strings.TrimSpace(lines[i]); line != "" && !strings.HasPrefix(line, gpgStatus) {
+			return line
+		}
+	}
+	return ""
+}
+
+// Store is a storage backend for encrypted files: it encrypts what is written with Key before
+// storing it through the backend of the path, and decrypts what it reads with it.
+type Store struct {
+	Key Key
+}
+
+// Read returns the decrypted contents stored at path.
+func (s Store) Read(path string) ([]byte, error) {
+	data, err := storage.For(path).Read(path)
+	if err != nil {
+		return nil, err
+	}
+	plain, _, err := Decrypt(s.Key.Kind, data, s.Key.Passphrase, s.Key.Options)
+	return plain, err
+}
+
+// Write stores plain at path encrypted with Key.
+func (s Store) Write(path string, plain []byte) error {
+	data, err := Encrypt(s.Key, plain)
+	if err != nil {
+		return err
+	}
+	return storage.For(path).Write(path, data)
+}
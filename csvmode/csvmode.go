@@ -0,0 +1,172 @@
+// Package csvmode provides column aware helpers for CSV and TSV buffers. None of them change the
+// text: alignment is computed for display only, so files are saved exactly as they were read.
+package csvmode
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/avalonbits/goted/syntax"
+	"github.com/avalonbits/goted/text"
+)
+
+// columnScopes is how many distinct scopes the highlighter cycles through.
+const columnScopes = 6
+
+func init() {
+	syntax.Register("csv", Highlighter{Sep: ','})
+	syntax.Register("tsv", Highlighter{Sep: '\t'})
+}
+
+// Detect returns the field separator of b if it holds CSV or TSV data, based on its file type or
+// file extension.
+func Detect(b *text.Buffer) (rune, bool) {
+	kind := b.Options().FileType
+	if kind == "" {
+		kind = strings.TrimPrefix(strings.ToLower(filepath.Ext(b.Path())), ".")
+	}
+
+	switch kind {
+	case "csv":
+		return ',', true
+	case "tsv", "tab":
+		return '\t', true
+	}
+	return 0, false
+}
+
+// Fields returns the rune ranges of the fields of line. Fields may be quoted with double quotes,
+// in which case separators inside them do not count. The ranges include the quotes.
+func Fields(line []rune, sep rune) [][2]int {
+	var fields [][2]int
+	start, quoted := 0, false
+	for i, r := range line {
+		switch {
+		case r == '"':
+			quoted = !quoted
+		case r == sep && !quoted:
+			fields = append(fields, [2]int{start, i})
+			start = i + 1
+		}
+	}
+	return append(fields, [2]int{start, len(line)})
+}
+
+// Column returns the index of the field holding rune column col of line.
+func Column(line []rune, sep rune, col int) int {
+	fields := Fields(line, sep)
+	for i, f := range fields {
+		if col <= f[1] {
+			return i
+		}
+	}
+	return len(fields) - 1
+}
+
+// Highlighter gives every column of a line its own scope, "csv.column0" to "csv.column5",
+// cycling for wider tables.
+type Highlighter struct {
+	Sep rune
+}
+
+// Highlight implements syntax.Highlighter.
+func (h Highlighter) Highlight(line []rune) []syntax.Span {
+	var spans []syntax.Span
+	for i, f := range Fields(line, h.Sep) {
+		if f[1] > f[0] {
+			spans = append(spans, syntax.Span{Start: f[0], End: f[1], Scope: fmt.Sprintf("csv.column%d", i%columnScopes)})
+		}
+	}
+	return spans
+}
+
+// JumpToColumn moves the cursor to the start of field n of the current line. Returns false if the
+// line has fewer fields.
+func JumpToColumn(b *text.Buffer, sep rune, n int) bool {
+	line, _ := b.LineRunes(b.Line())
+	fields := Fields(line, sep)
+	if n < 0 || n >= len(fields) {
+		return false
+	}
+
+	b.Seek(b.Cursor() - b.Column() + fields[n][0])
+	return true
+}
+
+// ColumnRanges returns the buffer ranges of field n on every line from first to last, for use as
+// a column selection. Lines with fewer fields are skipped.
+func ColumnRanges(b *text.Buffer, sep rune, n, first, last int) [][2]int {
+	var ranges [][2]int
+	start := 0
+	for i := range b.Lines() {
+		line, _ := b.LineRunes(i)
+		if i >= first && i <= last {
+			if fields := Fields(line, sep); n < len(fields) {
+				ranges = append(ranges, [2]int{start + fields[n][0], start + fields[n][1]})
+			}
+		}
+		start += len(line) + 1
+	}
+	return ranges
+}
+
+// Widths returns the width of the widest field of every column across lines.
+func Widths(lines [][]rune, sep rune) []int {
+	var widths []int
+	for _, line := range lines {
+		for i, f := range Fields(line, sep) {
+			if i == len(widths) {
+				widths = append(widths, 0)
+			}
+			widths[i] = max(widths[i], f[1]-f[0])
+		}
+	}
+	return widths
+}
+
+// Align returns line as displayed with virtual alignment: every field but the last is padded to
+// the width of its column. Tab separators are shown as a single space.
+func Align(line []rune, sep rune, widths []int) []rune {
+	fields := Fields(line, sep)
+	var out []rune
+	for i, f := range fields {
+		out = append(out, line[f[0]:f[1]]...)
+		if i == len(fields)-1 {
+			break
+		}
+
+		pad := 0
+		if i < len(widths) {
+			pad = widths[i] - (f[1] - f[0])
+		}
+		out = append(out, []rune(strings.Repeat(" ", pad))...)
+		if sep == '\t' {
+			out = append(out, ' ')
+		} else {
+			out = append(out, sep, ' ')
+		}
+	}
+	return out
+}
+
+// DisplayColumn maps rune column col of line to its column in the output of Align.
+func DisplayColumn(line []rune, sep rune, widths []int, col int) int {
+	fields := Fields(line, sep)
+	display := 0
+	for i, f := range fields {
+		if col <= f[1] || i == len(fields)-1 {
+			return display + col - f[0]
+		}
+
+		width := f[1] - f[0]
+		if i < len(widths) {
+			width = max(width, widths[i])
+		}
+		display += width + 1
+		if sep != '\t' {
+			display++
+		}
+	}
+	return display
+}
@@ -0,0 +1,269 @@
+// Package dired edits directories as text, as Emacs's dired and wdired do.
+//
+// A listing has a header naming the directory and a "move to:" line, followed by one line per
+// entry:
+//
+//	# /home/me/project
+//	move to: ../archive
+//	  1 cmd/
+//	D 2 notes.txt
+//	M 3 old.go
+//	  4 README.md
+//
+// Each entry line starts with a mark column and the id of the entry. Editing the name after
+// the id renames the entry, D deletes it and M moves it into the directory on the "move to:"
+// line, relative to the listed directory. Lines removed from the listing are left alone, so
+// only a D deletes anything, and directories are only deleted when empty. Plan computes the
+// operations without touching the file system, for a dry run, and Apply carries them out.
+package dired
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// ErrListing is returned for listings that cannot be turned into operations.
+var ErrListing = errors.New("dired: bad listing")
+
+// Listing is a directory as it was listed: Names are its entries, directories ending in a
+// slash, numbered from 1 in the listing.
+type Listing struct {
+	Dir   string
+	Names []string
+}
+
+// Kind is the kind of an operation.
+type Kind int
+
+const (
+	Rename Kind = iota
+	Delete
+	Move
+)
+
+// Op is an operation on a file: From is renamed or moved to To, or deleted.
+type Op struct {
+	Kind Kind
+	From string
+	To   string
+}
+
+func (o Op) String() string {
+	switch o.Kind {
+	case Delete:
+		return "delete " + o.From
+	case Move:
+		return "move " + o.From + " -> " + o.To
+	}
+	return "rename " + o.From + " -> " + o.To
+}
+
+var (
+	entryLine = regexp.MustCompile(`^([ DM]) *(\d+) (.+)$`)
+	moveLine  = "move to:"
+)
+
+// Read lists the directory dir, subdirectories first, each group sorted by name.
+func Read(dir string) (*Listing, error) {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return nil, err
+	}
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	l := &Listing{Dir: dir}
+	for _, e := range entries {
+		name := e.Name()
+		if e.IsDir() {
+			name += "/"
+		}
+		l.Names = append(l.Names, name)
+	}
+	slices.SortFunc(l.Names, func(a, b string) int {
+		if da, db := strings.HasSuffix(a, "/"), strings.HasSuffix(b, "/"); da != db {
+			if da {
+				return -1
+			}
+			return 1
+		}
+		return strings.Compare(a, b)
+	})
+	return l, nil
+}
+
+// Format returns the text of the listing, with no marks and an empty "move to:" line.
+func (l *Listing) Format() string {
+	var s strings.Builder
+	fmt.Fprintf(&s, "# %s\n%s \n", l.Dir, moveLine)
+	width := len(strconv.Itoa(len(l.Names)))
+	for i, name := range l.Names {
+		fmt.Fprintf(&s, "  %*d %s\n", width, i+1, name)
+	}
+	return s.String()
+}
+
+// Plan returns the operations the edited listing text asks for, in the order Apply carries them
+// out: renames, then moves, then deletions. It fails on lines it cannot read, unknown or
+// repeated ids and names that would collide, before anything is done.
+func (l *Listing) Plan(text string) ([]Op, error) {
+	var renames, moves, deletes []Op
+	var dest string
+	seen := map[int]bool{}
+	targets := map[string]bool{}
+
+	for n, line := range strings.Split(text, "\n") {
+		if rest, ok := strings.CutPrefix(line, moveLine); ok {
+			dest = strings.TrimSpace(rest)
+			continue
+		}
+		if strings.TrimSpace(line) == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		m := entryLine.FindStringSubmatch(line)
+		if m == nil {
+			return nil, fmt.Errorf("%w: line %d: not an entry", ErrListing, n+1)
+		}
+		id, _ := strconv.Atoi(m[2])
+		if id < 1 || id > len(l.Names) {
+			return nil, fmt.Errorf("%w: line %d: no entry %d", ErrListing, n+1, id)
+		}
+		if seen[id] {
+			return nil, fmt.Errorf("%w: line %d: entry %d is listed twice", ErrListing, n+1, id)
+		}
+		seen[id] = true
+
+		old := l.Names[id-1]
+		from := filepath.Join(l.Dir, old)
+		name := strings.TrimSuffix(m[3], "/")
+		if name == "" || strings.Contains(name, "/") && m[1] != " " {
+			return nil, fmt.Errorf("%w: line %d: bad name %q", ErrListing, n+1, m[3])
+		}
+
+		switch m[1] {
+		case "D":
+			deletes = append(deletes, Op{Kind: Delete, From: from})
+		case "M":
+			if dest == "" {
+				return nil, fmt.Errorf("%w: line %d: marked to move but the move to: line is empty", ErrListing, n+1)
+			}
+			to := filepath.Join(l.Dir, dest, name)
+			if targets[to] {
+				return nil, fmt.Errorf("%w: line %d: %s is the target twice", ErrListing, n+1, to)
+			}
+			targets[to] = true
+			moves = append(moves, Op{Kind: Move, From: from, To: to})
+		default:
+			if name == strings.TrimSuffix(old, "/") {
+				continue
+			}
+			to := filepath.Join(l.Dir, name)
+			if targets[to] {
+				return nil, fmt.Errorf("%w: line %d: %s is the target twice", ErrListing, n+1, to)
+			}
+			targets[to] = true
+			renames = append(renames, Op{Kind: Rename, From: from, To: to})
+		}
+	}
+	return append(append(renames, moves...), deletes...), nil
+}
+
+// Apply carries out ops in order, refusing to overwrite existing files. Renames go through
+// temporary names first, so entries can swap names, and are checked before any is made: if one
+// still fails, those made are undone. It stops at the first failure and returns the operations
+// done.
+func Apply(ops []Op) ([]Op, error) {
+	renamed := map[string]bool{}
+	for _, op := range ops {
+		if op.Kind == Rename {
+			renamed[op.From] = true
+		}
+	}
+	for _, op := range ops {
+		if op.Kind == Delete {
+			continue
+		}
+		if _, err := os.Lstat(op.To); err == nil && !renamed[op.To] {
+			return nil, fmt.Errorf("dired: %s already exists", op.To)
+		}
+		if _, err := os.Lstat(staging(op)); op.Kind == Rename && err == nil {
+			return nil, fmt.Errorf("dired: %s is in the way", staging(op))
+		}
+	}
+
+	var done, staged []Op
+	for _, op := range ops {
+		if op.Kind != Rename {
+			continue
+		}
+		if err := os.Rename(op.From, staging(op)); err != nil {
+			return nil, unrename(nil, staged, err)
+		}
+		staged = append(staged, op)
+	}
+	for _, op := range staged {
+		if err := os.Rename(staging(op), op.To); err != nil {
+			return nil, unrename(done, staged, err)
+		}
+		done = append(done, op)
+	}
+
+	for _, op := range ops {
+		switch op.Kind {
+		case Move:
+			if _, err := os.Lstat(op.To); err == nil {
+				return done, fmt.Errorf("dired: %s already exists", op.To)
+			}
+			if err := os.MkdirAll(filepath.Dir(op.To), 0o755); err != nil {
+				return done, err
+			}
+			if err := os.Rename(op.From, op.To); err != nil {
+				return done, err
+			}
+		case Delete:
+			if err := os.Remove(op.From); err != nil {
+				return done, err
+			}
+		default:
+			continue
+		}
+		done = append(done, op)
+	}
+	return done, nil
+}
+
+// staging returns the temporary name the entry renamed by op goes through.
+func staging(op Op) string {
+	return op.From + ".dired~"
+}
+
+// unrename puts the entries renamed by done and staged back under their names, after err, and
+// returns err with whatever could not be put back.
+func unrename(done, staged []Op, err error) error {
+	errs := []error{err}
+	left := map[string]bool{}
+	for _, op := range done {
+		if err := os.Rename(op.To, staging(op)); err != nil {
+			errs = append(errs, fmt.Errorf("dired: %s is left as %s: %w", op.From, op.To, err))
+			left[op.From] = true
+		}
+	}
+	for _, op := range staged {
+		if left[op.From] {
+			continue
+		}
+		if err := os.Rename(staging(op), op.From); err != nil {
+			errs = append(errs, fmt.Errorf("dired: %s is left as %s: %w", op.From, staging(op), err))
+		}
+	}
+	return errors.Join(errs...)
+}
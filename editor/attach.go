@@ -0,0 +1,214 @@
+package editor
+
+import (
+	"errors"
+	"slices"
+
+	"github.com/avalonbits/goted/instance"
+	"github.com/avalonbits/goted/screen"
+	"github.com/avalonbits/goted/term"
+	"github.com/avalonbits/goted/text"
+	"github.com/avalonbits/goted/view"
+)
+
+// window is a view of the session: the screen it is drawn on, its current buffer, where its
+// cursor and selection are in each buffer, how far each buffer is scrolled and its message. The
+// buffers themselves are shared by every window. Attached terminals each have one, while the
+// editor holds the state of the window being handled, the one of its own terminal otherwise.
+type window struct {
+	client    *instance.Client
+	terminal  *term.Terminal
+	screen    *screen.Screen
+	current   *text.Buffer
+	cursors   map[*text.Buffer]*text.Mark
+	anchors   map[*text.Buffer]*text.Mark
+	viewports map[*text.Buffer]*view.Viewport
+	message   string
+
+	// rest is input from the client that is not keys yet, as a paste that is still arriving.
+	rest []byte
+}
+
+// windowEvent is what the terminal of w sent, or ok false once it detached.
+type windowEvent struct {
+	w  *window
+	ev instance.Event
+	ok bool
+}
+
+// newWindow returns a window with nothing stashed in it.
+func newWindow() *window {
+	return &window{
+		cursors:   map[*text.Buffer]*text.Mark{},
+		anchors:   map[*text.Buffer]*text.Mark{},
+		viewports: map[*text.Buffer]*view.Viewport{},
+	}
+}
+
+// attach opens a window on the terminal of the client of r, with the files of r open in it.
+// What the terminal sends is forwarded on events.
+func (e *Editor) attach(r instance.Request, events chan<- windowEvent) {
+	w := newWindow()
+	w.client = r.Client
+	w.screen = screen.New(r.Client, r.Width, r.Height)
+	w.current = e.Current()
+	w.message = "attached: quit detaches this terminal"
+	e.windows = append(e.windows, w)
+	go func() {
+		for ev := range r.Client.Events {
+			events <- windowEvent{w: w, ev: ev, ok: true}
+		}
+		events <- windowEvent{w: w}
+	}()
+
+	e.focus(w)
+	defer e.focus(nil)
+	e.openFiles(r.Files, false)
+}
+
+// handleWindow handles what the terminal of an attached window sent. A quit in the window
+// detaches its terminal, leaving the session running.
+func (e *Editor) handleWindow(we windowEvent) {
+	w := we.w
+	if !slices.Contains(e.windows, w) {
+		return
+	}
+	if !we.ok {
+		e.detach(w)
+		return
+	}
+	if we.ev.Input == nil {
+		w.screen.Resize(max(we.ev.Width, 1), max(we.ev.Height, 1))
+		return
+	}
+
+	w.rest = append(w.rest, we.ev.Input...)
+	if term.Pasting(w.rest, len(we.ev.Input)) {
+		return
+	}
+	// A terminal sends each key whole, so an escape is not waited on to start a sequence.
+	var keys []string
+	keys, w.rest = term.Keys(w.rest, true)
+	e.focus(w)
+	err := e.keys(keys)
+	e.focus(nil)
+	if errors.Is(err, ErrQuit) {
+		e.detach(w)
+	}
+}
+
+// drawWindows draws the windows of the attached terminals, detaching those that cannot be
+// written to.
+func (e *Editor) drawWindows() {
+	for _, w := range slices.Clone(e.windows) {
+		e.focus(w)
+		err := e.draw()
+		e.focus(nil)
+		if err != nil {
+			e.detach(w)
+		}
+	}
+}
+
+// detach closes the window w and the connection of its terminal.
+func (e *Editor) detach(w *window) {
+	if e.focused == w {
+		e.focus(nil)
+	}
+	w.client.Close()
+	for b, m := range w.cursors {
+		b.DeleteMark(m)
+	}
+	for b, m := range w.anchors {
+		b.DeleteMark(m)
+	}
+	for _, v := range w.viewports {
+		e.links.Unlink(v)
+	}
+	e.windows = slices.DeleteFunc(e.windows, func(o *window) bool { return o == w })
+}
+
+// detachAll detaches every attached terminal, as the editor exits.
+func (e *Editor) detachAll() {
+	for len(e.windows) > 0 {
+		e.detach(e.windows[0])
+	}
+}
+
+// focus makes w, or the window of the terminal of the editor if w is nil, the one the editor
+// handles: the state of the window handled so far is stashed in it and that of w put in its
+// place.
+func (e *Editor) focus(w *window) {
+	if w == e.focused {
+		return
+	}
+	from := e.focused
+	if from == nil {
+		if e.home == nil {
+			e.home = newWindow()
+		}
+		from = e.home
+	}
+	e.stash(from)
+	if w == nil {
+		e.unstash(e.home)
+	} else {
+		e.unstash(w)
+	}
+	e.focused = w
+}
+
+// stash saves the state of the window the editor handles into w.
+func (e *Editor) stash(w *window) {
+	w.terminal, w.screen = e.Terminal, e.Screen
+	w.current, w.viewports, w.message = e.Current(), e.viewports, e.message
+	for _, b := range e.buffers {
+		setMark(w.cursors, b, b.Cursor())
+		start, end, ok := b.Selection()
+		if !ok {
+			if m, ok := w.anchors[b]; ok {
+				b.DeleteMark(m)
+				delete(w.anchors, b)
+			}
+			continue
+		}
+		anchor := start
+		if b.Cursor() == start {
+			anchor = end
+		}
+		setMark(w.anchors, b, anchor)
+	}
+}
+
+// unstash makes the state saved in w that of the window the editor handles. Buffers w has not
+// seen yet keep the cursor they have, and marks in buffers closed since are dropped.
+func (e *Editor) unstash(w *window) {
+	e.Terminal, e.Screen = w.terminal, w.screen
+	e.viewports, e.message = w.viewports, w.message
+	if i := slices.Index(e.buffers, w.current); i >= 0 {
+		e.current = i
+	}
+	for b, m := range w.cursors {
+		if !slices.Contains(e.buffers, b) {
+			delete(w.cursors, b)
+			delete(w.anchors, b)
+			continue
+		}
+		b.Deselect()
+		if a, ok := w.anchors[b]; ok {
+			b.Select(a.Offset())
+		}
+		b.Seek(m.Offset())
+	}
+}
+
+// setMark points the mark of b in marks at offset, adding one if there is none.
+func setMark(marks map[*text.Buffer]*text.Mark, b *text.Buffer, offset int) {
+	if m, ok := marks[b]; ok {
+		if m.Offset() == offset {
+			return
+		}
+		b.DeleteMark(m)
+	}
+	marks[b] = b.NewMark(offset)
+}
@@ -0,0 +1,31 @@
+package editor
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+
+	"github.com/avalonbits/goted/command"
+	"github.com/avalonbits/goted/guard"
+)
+
+// RunScript runs the commands read from script, one per line as parsed by command.Parse, on the
+// current buffer. Blank lines and lines starting with '#' are skipped. Every confirmation is
+// answered with yes since nobody is there to answer. If no buffer is open, the script starts on
+// an empty buffer bound to no file. Stops at the first failing command.
+func (e *Editor) RunScript(name string, script io.Reader) error {
+	e.Commands.Guard.Confirm = guard.Always(true)
+	e.ensure()
+
+	sc := bufio.NewScanner(script)
+	for n := 1; sc.Scan(); n++ {
+		cmd, args, err := command.Parse(sc.Text())
+		if err == nil && cmd != "" {
+			err = e.Commands.Run(e.Current(), cmd, args...)
+		}
+		if err != nil {
+			return fmt.Errorf("%s:%d: %w", name, n, err)
+		}
+	}
+	return sc.Err()
+}
@@ -0,0 +1,312 @@
+package editor
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/avalonbits/goted/bookmark"
+	"github.com/avalonbits/goted/command"
+	"github.com/avalonbits/goted/text"
+)
+
+// bookmarksPath is the path of the list of the bookmarks of a project.
+const bookmarksPath = "bookmarks:"
+
+// lineNote is a bookmark in an open buffer, whose mark follows the line as the buffer is edited.
+type lineNote struct {
+	mark *text.Mark
+	note string
+}
+
+// Bookmark bookmarks the line of the cursor of b with note, replacing the note of a bookmark
+// already there. With no note, it adds a bookmark with none, or removes the one on the line.
+// Bookmarks are kept per project, see Bookmarks.
+func (e *Editor) Bookmark(b *text.Buffer, note string) error {
+	if !filepath.IsAbs(b.Path()) {
+		return fmt.Errorf("%w: only lines of files can be bookmarked", command.ErrUsage)
+	}
+	if !inClear(b) {
+		return fmt.Errorf("%w: bookmarks would keep lines of the encrypted file in clear", command.ErrUsage)
+	}
+	notes := e.notes[b]
+	i := slices.IndexFunc(notes, func(n *lineNote) bool { return b.LineOf(n.mark.Offset()) == b.Line() })
+	switch {
+	case i >= 0 && note == "":
+		b.DeleteMark(notes[i].mark)
+		e.notes[b] = slices.Delete(notes, i, i+1)
+		e.message = "bookmark removed"
+	case i >= 0:
+		notes[i].note = note
+		e.message = "bookmark noted"
+	default:
+		e.notes[b] = append(notes, &lineNote{mark: b.NewMark(b.Offset(b.Line(), 0)), note: note})
+		e.message = "bookmark added"
+	}
+	return e.saveBookmarks(b)
+}
+
+// BookmarkNext moves the cursor to the next bookmarked line of b, or the previous one if dir
+// is negative, wrapping around.
+func (e *Editor) BookmarkNext(b *text.Buffer, dir int) error {
+	lines := e.bookmarkedLines(b)
+	if len(lines) == 0 {
+		return fmt.Errorf("%w: the buffer has no bookmarks", command.ErrUsage)
+	}
+	sorted := make([]int, 0, len(lines))
+	for n := range lines {
+		sorted = append(sorted, n)
+	}
+	slices.Sort(sorted)
+	if dir < 0 {
+		slices.Reverse(sorted)
+	}
+	next := sorted[0]
+	for _, n := range sorted {
+		if (dir >= 0 && n > b.Line()) || (dir < 0 && n < b.Line()) {
+			next = n
+			break
+		}
+	}
+	b.GotoLine(next, 0)
+	return nil
+}
+
+// Bookmarks lists the bookmarks of the project of b, one "file:line: note" line each. Enter
+// opens the bookmark under the cursor, and editing the notes or removing lines and then writing
+// the list changes the bookmarks to match.
+func (e *Editor) Bookmarks(b *text.Buffer) error {
+	root, _, err := project(b)
+	if err != nil {
+		return err
+	}
+	return e.listBookmarks(root)
+}
+
+// listBookmarks shows the list of the bookmarks of the project at root.
+func (e *Editor) listBookmarks(root string) error {
+	bms, err := e.projectBookmarks(root)
+	if err != nil {
+		return err
+	}
+	if err := e.results(bookmarksPath, root, bookmark.Format(root, bms), nil); err != nil {
+		return err
+	}
+	e.bookmarkLists[e.Current()] = bms
+	return nil
+}
+
+// bookmarksApply changes the bookmarks of the project to match the edited list b.
+func (e *Editor) bookmarksApply(b *text.Buffer) error {
+	root := e.resultRoots[b]
+	bms, err := bookmark.Parse(b.String(), e.bookmarkLists[b])
+	if err != nil {
+		return err
+	}
+	if err := e.setBookmarks(root, bms); err != nil {
+		return err
+	}
+	line := b.Line()
+	if err := e.listBookmarks(root); err != nil {
+		return err
+	}
+	b.GotoLine(line, 0)
+	e.message = fmt.Sprintf("%d bookmarks", len(bms))
+	return nil
+}
+
+// ExportBookmarks writes the bookmarks of the project of b to the file at path, as JSON.
+func (e *Editor) ExportBookmarks(b *text.Buffer, path string) error {
+	root, _, err := project(b)
+	if err != nil {
+		return err
+	}
+	bms, err := e.projectBookmarks(root)
+	if err != nil {
+		return err
+	}
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if err := bookmark.Write(f, bms); err != nil {
+		f.Close()
+		return err
+	}
+	e.message = fmt.Sprintf("%d bookmarks exported", len(bms))
+	return f.Close()
+}
+
+// ImportBookmarks adds the bookmarks exported to the file at path to those of the project of
+// b. Imported bookmarks replace those on the same line, and are moved to the nearest line that
+// reads as the bookmarked one did if the file changed since.
+func (e *Editor) ImportBookmarks(b *text.Buffer, path string) error {
+	root, _, err := project(b)
+	if err != nil {
+		return err
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	add, err := bookmark.Read(f)
+	if err != nil {
+		return err
+	}
+	bms, err := e.projectBookmarks(root)
+	if err != nil {
+		return err
+	}
+	if err := e.setBookmarks(root, bookmark.Merge(bms, add)); err != nil {
+		return err
+	}
+	e.message = fmt.Sprintf("%d bookmarks imported", len(add))
+	return nil
+}
+
+// SaveBookmarks records the bookmarks of every buffer on the lines they moved to.
+func (e *Editor) SaveBookmarks() error {
+	for b := range e.notes {
+		if err := e.saveBookmarks(b); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// restoreBookmarks places the bookmarks kept for the file of b, just opened.
+func (e *Editor) restoreBookmarks(b *text.Buffer) {
+	root, _, err := project(b)
+	if err != nil {
+		return
+	}
+	bms, err := bookmark.Load(root)
+	if err != nil {
+		return
+	}
+	e.placeBookmarks(b, root, bms)
+}
+
+// placeBookmarks replaces the bookmarks of b with those of bms naming its file.
+func (e *Editor) placeBookmarks(b *text.Buffer, root string, bms []bookmark.Bookmark) {
+	for _, n := range e.notes[b] {
+		b.DeleteMark(n.mark)
+	}
+	delete(e.notes, b)
+
+	path, ok := bookmarkPath(root, b)
+	if !ok {
+		return
+	}
+	var lines []string
+	for _, bm := range bms {
+		if bm.Path != path {
+			continue
+		}
+		if lines == nil {
+			lines = make([]string, b.Lines())
+			for n := range lines {
+				line, _ := b.LineRunes(n)
+				lines[n] = string(line)
+			}
+		}
+		mark := b.NewMark(b.Offset(bookmark.Relocate(bm, lines), 0))
+		e.notes[b] = append(e.notes[b], &lineNote{mark: mark, note: bm.Note})
+	}
+}
+
+// bookmarksOf returns the bookmarks of b, which is in the project at root, on the lines they
+// are on now.
+func (e *Editor) bookmarksOf(b *text.Buffer, root string) []bookmark.Bookmark {
+	path, ok := bookmarkPath(root, b)
+	if !ok {
+		return nil
+	}
+	var bms []bookmark.Bookmark
+	for _, n := range e.notes[b] {
+		line := b.LineOf(n.mark.Offset())
+		runes, _ := b.LineRunes(line)
+		bms = append(bms, bookmark.Bookmark{Path: path, Line: line, Text: string(runes), Note: n.note})
+	}
+	return bms
+}
+
+// projectBookmarks returns the bookmarks kept for the project at root, with those of its open
+// buffers as they are now.
+func (e *Editor) projectBookmarks(root string) ([]bookmark.Bookmark, error) {
+	bms, err := bookmark.Load(root)
+	if err != nil {
+		return nil, err
+	}
+	for _, b := range e.buffers {
+		path, ok := bookmarkPath(root, b)
+		if !ok {
+			continue
+		}
+		bms = slices.DeleteFunc(bms, func(bm bookmark.Bookmark) bool { return bm.Path == path })
+		bms = append(bms, e.bookmarksOf(b, root)...)
+	}
+	return bookmark.Sort(bms), nil
+}
+
+// setBookmarks makes bms the bookmarks of the project at root, in its open buffers and its
+// store.
+func (e *Editor) setBookmarks(root string, bms []bookmark.Bookmark) error {
+	for _, b := range e.buffers {
+		if _, ok := bookmarkPath(root, b); ok {
+			e.placeBookmarks(b, root, bms)
+		}
+	}
+	return bookmark.Save(root, bms)
+}
+
+// saveBookmarks records the bookmarks of b in the store of its project.
+func (e *Editor) saveBookmarks(b *text.Buffer) error {
+	root, _, err := project(b)
+	if err != nil {
+		return err
+	}
+	bms, err := e.projectBookmarks(root)
+	if err != nil {
+		return err
+	}
+	return bookmark.Save(root, bms)
+}
+
+// bookmarkedLines returns the lines of b that have a bookmark.
+func (e *Editor) bookmarkedLines(b *text.Buffer) map[int]bool {
+	if len(e.notes[b]) == 0 {
+		return nil
+	}
+	lines := map[int]bool{}
+	for _, n := range e.notes[b] {
+		lines[b.LineOf(n.mark.Offset())] = true
+	}
+	return lines
+}
+
+// lineNoteAt returns the note of the bookmark on line n of b.
+func (e *Editor) lineNoteAt(b *text.Buffer, n int) (string, bool) {
+	for _, note := range e.notes[b] {
+		if b.LineOf(note.mark.Offset()) == n {
+			return note.note, true
+		}
+	}
+	return "", false
+}
+
+// bookmarkPath returns how bookmarks name the file of b: relative to root, with forward
+// slashes. Buffers bound to no file or to one outside root, and encrypted files, have none.
+func bookmarkPath(root string, b *text.Buffer) (string, bool) {
+	if !filepath.IsAbs(b.Path()) || !inClear(b) {
+		return "", false
+	}
+	rel, err := filepath.Rel(root, b.Path())
+	if err != nil || strings.HasPrefix(rel, "..") {
+		return "", false
+	}
+	return filepath.ToSlash(rel), true
+}
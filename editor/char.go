@@ -0,0 +1,94 @@
+package editor
+
+import (
+	"fmt"
+	"unicode/utf8"
+
+	"github.com/avalonbits/goted/command"
+	"github.com/avalonbits/goted/text"
+	"github.com/avalonbits/goted/unichar"
+)
+
+// charsPath is the path of the character picker.
+const charsPath = "chars:"
+
+// charsKeys are the bindings of the character picker, over the global ones.
+var charsKeys = command.Keymap{
+	"Enter": "char-pick",
+	"Esc":   "switch-previous",
+}
+
+// PickChar shows a picker of characters filtered by name, code point or digraph as they are
+// typed, every character with a digraph when the filter is empty, in which Enter inserts the
+// character under the cursor, or the best match, in the buffer it was opened from.
+func (e *Editor) PickChar(filter string) {
+	p := unichar.NewPicker()
+	e.chars = p
+	search := func(filter string) []string {
+		p.SetQuery(filter)
+		items := make([]string, len(p.Items()))
+		for i, it := range p.Items() {
+			items[i] = fmt.Sprintf("%c  U+%04X  %s", it.Rune, it.Rune, it.Name)
+			if it.Digraph != "" {
+				items[i] += "  " + it.Digraph
+			}
+		}
+		return items
+	}
+	e.showPicker(charsPath, charsKeys, &picker{search: search}, filter)
+}
+
+// charPick inserts the character under the cursor of the character picker b, or the best
+// match when the cursor is on the filter line, in the buffer it was opened from.
+func (e *Editor) charPick(b *text.Buffer) error {
+	p := e.chars
+	if p == nil || b.Path() != charsPath {
+		return fmt.Errorf("%w: not the character picker", command.ErrUsage)
+	}
+	p.Move(max(b.Line(), 1) - 1 - p.Selected())
+	r, ok := p.Choice()
+	if !ok {
+		return fmt.Errorf("%w: no character matches", command.ErrUsage)
+	}
+	if err := e.switchPrevious(); err != nil {
+		return err
+	}
+	return e.Current().Insert([]rune{r})
+}
+
+// ComposeChar makes the next keys typed compose a character to insert in b: a two key digraph,
+// such as e' for é, or U+ followed by its code point in hex, ended by Enter or any key that is
+// not a hex digit.
+func (e *Editor) ComposeChar(b *text.Buffer) {
+	e.composer, e.composing = &unichar.Composer{}, b
+	e.message = "compose: a digraph, or U+ and a code point"
+}
+
+// composeKey feeds key to the character being composed, inserting it once it is complete.
+// Keys other than characters, but Enter, cancel it.
+func (e *Editor) composeKey(key string) error {
+	var r rune
+	switch {
+	case key == "Space":
+		r = ' '
+	case key == "Enter":
+		r = '\n'
+	case utf8.RuneCountInString(key) == 1:
+		r, _ = utf8.DecodeRuneInString(key)
+	default:
+		e.composer, e.composing = nil, nil
+		e.message = "canceled"
+		return nil
+	}
+	c, done, ok := e.composer.Feed(r)
+	switch {
+	case !done:
+		return nil
+	case !ok:
+		e.composer, e.composing = nil, nil
+		return fmt.Errorf("%w: no character for what was typed", command.ErrUsage)
+	}
+	b := e.composing
+	e.composer, e.composing = nil, nil
+	return b.Insert([]rune{c})
+}
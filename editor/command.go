@@ -0,0 +1,325 @@
+package editor
+
+import (
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/avalonbits/goted/command"
+	"github.com/avalonbits/goted/help"
+	"github.com/avalonbits/goted/text"
+)
+
+// register adds the commands that act on the session rather than on a single buffer.
+func (e *Editor) register() {
+	e.Commands.Register("open", func(_ *text.Buffer, args []string) error {
+		if len(args) != 1 {
+			return fmt.Errorf("%w: open needs a file name", command.ErrUsage)
+		}
+		_, err := e.Open(args[0])
+		return err
+	})
+	e.Commands.Register("open-at-point", func(b *text.Buffer, _ []string) error {
+		return e.OpenAtPoint(b)
+	})
+	e.Commands.Register("command-prompt", func(_ *text.Buffer, _ []string) error {
+		e.CommandPrompt()
+		return nil
+	})
+	e.Commands.Register("close", func(b *text.Buffer, _ []string) error {
+		return e.Close(b)
+	})
+	e.Commands.Register("quit", func(_ *text.Buffer, _ []string) error {
+		return e.quit(false)
+	})
+	e.Commands.Register("quit-discard", func(_ *text.Buffer, _ []string) error {
+		return e.quit(true)
+	})
+	e.Commands.Register("suspend", func(_ *text.Buffer, _ []string) error {
+		return e.Suspend()
+	})
+	e.Commands.Register("link-scroll", e.linkScroll)
+	e.Commands.Register("switch", func(_ *text.Buffer, args []string) error {
+		return e.quickSwitch(strings.Join(args, " "))
+	})
+	e.Commands.Register("switch-previous", func(_ *text.Buffer, _ []string) error {
+		return e.switchPrevious()
+	})
+	e.Commands.Register("switch-pick", func(b *text.Buffer, _ []string) error {
+		if b.Path() != switcherPath {
+			return fmt.Errorf("%w: not the switcher buffer", command.ErrUsage)
+		}
+		return e.switchPick(b)
+	})
+	e.Commands.Register("outline", func(_ *text.Buffer, _ []string) error {
+		return e.Outline()
+	})
+	e.Commands.Register("pick-char", func(_ *text.Buffer, args []string) error {
+		e.PickChar(strings.Join(args, " "))
+		return nil
+	})
+	e.Commands.Register("char-pick", func(b *text.Buffer, _ []string) error {
+		return e.charPick(b)
+	})
+	e.Commands.Register("compose-char", func(b *text.Buffer, _ []string) error {
+		e.ComposeChar(b)
+		return nil
+	})
+	e.Commands.Register("csv-column", e.csvColumn)
+	e.Commands.Register("csv-view", func(b *text.Buffer, _ []string) error {
+		return e.csvView(b)
+	})
+	e.Commands.Register("markdown-preview", func(b *text.Buffer, _ []string) error {
+		return e.MarkdownPreview(b)
+	})
+	e.Commands.Register("undo-tree", func(b *text.Buffer, _ []string) error {
+		return e.UndoTree(b)
+	})
+	e.Commands.Register("undo-tree-pick", func(b *text.Buffer, _ []string) error {
+		return e.undoTreePick(b)
+	})
+	e.Commands.Register("outline-jump", func(b *text.Buffer, _ []string) error {
+		return e.outlineJump(b)
+	})
+	e.Commands.Register("symbols", func(b *text.Buffer, args []string) error {
+		return e.Symbols(b, strings.Join(args, " "))
+	})
+	e.Commands.Register("symbols-pick", func(b *text.Buffer, _ []string) error {
+		if b.Path() != symbolsPath {
+			return fmt.Errorf("%w: not the symbol picker", command.ErrUsage)
+		}
+		return e.symbolsPick(b)
+	})
+	e.Commands.Register("grep", func(b *text.Buffer, args []string) error {
+		if len(args) != 1 {
+			return fmt.Errorf("%w: grep needs a pattern", command.ErrUsage)
+		}
+		return e.Grep(b, args[0])
+	})
+	e.Commands.Register("grep-buffers", func(b *text.Buffer, args []string) error {
+		if len(args) != 1 {
+			return fmt.Errorf("%w: grep-buffers needs a pattern", command.ErrUsage)
+		}
+		return e.GrepBuffers(b, args[0])
+	})
+	e.Commands.Register("grep-open", func(b *text.Buffer, _ []string) error {
+		return e.grepOpen(b)
+	})
+	e.Commands.Register("replace-project", func(b *text.Buffer, args []string) error {
+		if len(args) != 2 {
+			return fmt.Errorf("%w: replace-project needs a pattern and a replacement", command.ErrUsage)
+		}
+		return e.ReplaceProject(b, args[0], args[1])
+	})
+	e.Commands.Register("bookmark", func(b *text.Buffer, args []string) error {
+		return e.Bookmark(b, strings.Join(args, " "))
+	})
+	e.Commands.Register("bookmark-next", func(b *text.Buffer, _ []string) error {
+		return e.BookmarkNext(b, 1)
+	})
+	e.Commands.Register("bookmark-previous", func(b *text.Buffer, _ []string) error {
+		return e.BookmarkNext(b, -1)
+	})
+	e.Commands.Register("bookmarks", func(b *text.Buffer, _ []string) error {
+		return e.Bookmarks(b)
+	})
+	e.Commands.Register("bookmarks-export", func(b *text.Buffer, args []string) error {
+		if len(args) != 1 {
+			return fmt.Errorf("%w: bookmarks-export needs a file name", command.ErrUsage)
+		}
+		return e.ExportBookmarks(b, args[0])
+	})
+	e.Commands.Register("bookmarks-import", func(b *text.Buffer, args []string) error {
+		if len(args) != 1 {
+			return fmt.Errorf("%w: bookmarks-import needs a file name", command.ErrUsage)
+		}
+		return e.ImportBookmarks(b, args[0])
+	})
+	e.Commands.Register("plugin-load", e.loadPlugin)
+	e.Commands.Register("plugin-revoke", e.revokePlugin)
+	e.Commands.Register("set-theme", func(_ *text.Buffer, args []string) error {
+		if len(args) != 1 {
+			return fmt.Errorf("%w: set-theme needs a theme name, dark, light or auto", command.ErrUsage)
+		}
+		return e.SetTheme(args[0])
+	})
+	e.Commands.Register("toggle-theme", func(_ *text.Buffer, _ []string) error {
+		return e.ToggleTheme()
+	})
+	e.Commands.Register("preview-image", e.previewImage)
+	e.Commands.Register("follow", func(b *text.Buffer, _ []string) error {
+		return e.Follow(b)
+	})
+	e.Commands.Register("regex-test", func(b *text.Buffer, args []string) error {
+		if len(args) > 1 {
+			return fmt.Errorf("%w: regex-test takes at most a pattern", command.ErrUsage)
+		}
+		e.TestRegex(b, strings.Join(args, ""))
+		return nil
+	})
+	e.Commands.Register("history", func(b *text.Buffer, _ []string) error {
+		return e.ShowHistory(b)
+	})
+	e.Commands.Register("history-open", func(b *text.Buffer, _ []string) error {
+		return e.historyOpen(b)
+	})
+	e.Commands.Register("history-diff", func(b *text.Buffer, _ []string) error {
+		return e.historyDiff(b)
+	})
+	e.Commands.Register("history-restore", func(b *text.Buffer, _ []string) error {
+		return e.historyRestore(b)
+	})
+	e.Commands.Register("mode", e.modeCommand)
+	e.Commands.Register("set-indent", e.setIndent)
+	e.Commands.Register("dump-state", e.dumpState)
+	e.Commands.Register("load-state", e.loadState)
+	e.Commands.Register("redact", func(_ *text.Buffer, _ []string) error {
+		e.ToggleRedact()
+		return nil
+	})
+	e.Commands.Register("announce", func(_ *text.Buffer, _ []string) error {
+		e.announce()
+		return nil
+	})
+	e.Commands.Register("narrow", func(b *text.Buffer, _ []string) error {
+		return e.Narrow(b)
+	})
+	e.Commands.Register("widen", func(b *text.Buffer, _ []string) error {
+		return e.Widen(b)
+	})
+	e.Commands.Register("scratch", func(_ *text.Buffer, _ []string) error {
+		e.scratch()
+		return nil
+	})
+	write, _ := e.Commands.Lookup("write")
+	e.Commands.Register("write", func(b *text.Buffer, args []string) error {
+		if _, ok := e.listings[b]; ok && len(args) == 0 {
+			return e.diredApply(b)
+		}
+		if _, ok := e.replacements[b]; ok && len(args) == 0 {
+			return e.replaceApply(b)
+		}
+		if _, ok := e.bookmarkLists[b]; ok && len(args) == 0 {
+			return e.bookmarksApply(b)
+		}
+		if _, ok := e.narrowings[b]; ok && len(args) == 0 {
+			return e.narrowWrite(b)
+		}
+		if b.Options().FileType == "go" {
+			e.goImports(b)
+		}
+		return e.saved(b, func() error { return write(b, args) })
+	})
+	saveAs, _ := e.Commands.Lookup("save-as")
+	e.Commands.Register("save-as", func(b *text.Buffer, args []string) error {
+		return e.saved(b, func() error { return saveAs(b, args) })
+	})
+	e.Commands.Register("go-doc", func(b *text.Buffer, _ []string) error {
+		return e.GoDoc(b)
+	})
+	e.Commands.Register("signature-help", func(b *text.Buffer, _ []string) error {
+		return e.SignatureHelp(b)
+	})
+	e.Commands.Register("complete", func(b *text.Buffer, _ []string) error {
+		return e.Complete(b)
+	})
+	e.Commands.Register("rename-symbol", func(b *text.Buffer, args []string) error {
+		if len(args) != 1 {
+			return fmt.Errorf("%w: rename-symbol needs the new name", command.ErrUsage)
+		}
+		return e.RenameSymbol(b, args[0])
+	})
+	e.Commands.Register("rename-preview", func(b *text.Buffer, args []string) error {
+		if len(args) != 1 {
+			return fmt.Errorf("%w: rename-preview needs the new name", command.ErrUsage)
+		}
+		return e.renamePreview(b, args[0])
+	})
+	e.Commands.Register("dired-preview", func(b *text.Buffer, _ []string) error {
+		return e.diredPreview(b)
+	})
+	e.Commands.Register("dired-open", func(b *text.Buffer, _ []string) error {
+		return e.diredOpen(b)
+	})
+	e.Commands.Register("help", func(_ *text.Buffer, args []string) error {
+		return e.help(strings.Join(args, " "))
+	})
+	e.Commands.Register("help-follow", func(b *text.Buffer, _ []string) error {
+		if !help.IsHelp(b) {
+			return fmt.Errorf("%w: not a help buffer", command.ErrUsage)
+		}
+		return help.Follow(b, e.Keymap)
+	})
+}
+
+// scratchPrefix starts the paths of scratch buffers, which are never saved and so never ask to
+// be.
+const scratchPrefix = "scratch:"
+
+// scratch opens a new scratch buffer, for notes and arithmetic run through calc, and makes it
+// current.
+func (e *Editor) scratch() *text.Buffer {
+	n := 1
+	for slices.ContainsFunc(e.buffers, func(b *text.Buffer) bool { return b.Path() == fmt.Sprint(scratchPrefix, n) }) {
+		n++
+	}
+	b := text.New(minSize)
+	b.SetPath(fmt.Sprint(scratchPrefix, n))
+	return e.add(b)
+}
+
+// help shows topic in the help buffer, creating it if needed, and makes it current.
+func (e *Editor) help(topic string) error {
+	i := slices.IndexFunc(e.buffers, help.IsHelp)
+	b := text.New(minSize)
+	if i >= 0 {
+		b = e.buffers[i]
+	}
+
+	if err := help.Show(b, topic, e.Keymap); err != nil {
+		return err
+	}
+	if i < 0 {
+		e.add(b)
+	}
+	e.SetCurrent(b)
+	return nil
+}
+
+// Suspend stops the editor and gives the terminal back to the shell until it is continued, then
+// redraws the screen.
+func (e *Editor) Suspend() error {
+	if e.Terminal == nil {
+		return fmt.Errorf("%w: no terminal to suspend", command.ErrUsage)
+	}
+	if err := e.Terminal.Suspend(); err != nil {
+		return err
+	}
+	if e.Screen != nil {
+		e.Screen.Invalidate()
+	}
+	return nil
+}
+
+// Signal handles a signal delivered through term.NotifySuspend or term.NotifyTerminate: it
+// suspends the editor for SIGTSTP and, for any other, saves the modified buffers to recovery
+// files and restores the terminal. Returns true if the editor must exit.
+func (e *Editor) Signal(sig os.Signal) bool {
+	if sig == suspendSignal {
+		e.Suspend()
+		return false
+	}
+
+	saved, err := e.EmergencySave()
+	if e.Terminal != nil {
+		e.Terminal.Stop()
+	}
+	for _, path := range saved {
+		fmt.Fprintln(os.Stderr, "goted: saved unsaved changes to", path)
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "goted:", err)
+	}
+	return true
+}
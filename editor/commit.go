@@ -0,0 +1,43 @@
+package editor
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/avalonbits/goted/text"
+)
+
+// Commit messages keep their subject to commitSubject columns and wrap their body at
+// commitBody, as git's own tools expect.
+const (
+	commitSubject = 50
+	commitBody    = 72
+)
+
+// commitMode sets up b, a git commit message, for editing: guides after the subject and body
+// widths, with the body wrapped at the latter.
+func commitMode(b *text.Buffer) {
+	o := b.Options()
+	o.Guides = []int{commitSubject, commitBody}
+	o.TextWidth = commitBody
+	b.SetOptions(o)
+}
+
+// commitWarning returns what is wrong with the subject of the commit message b, for the
+// status line after a save, or "" if nothing is. Comment lines are skipped, as git does.
+func commitWarning(b *text.Buffer) string {
+	for n := range b.Lines() {
+		line, _ := b.LineRunes(n)
+		if strings.HasPrefix(string(line), "#") {
+			continue
+		}
+		if strings.TrimSpace(string(line)) == "" {
+			return "the commit subject is empty"
+		}
+		if len(line) > commitSubject {
+			return fmt.Sprintf("the commit subject is %d characters, over %d", len(line), commitSubject)
+		}
+		return ""
+	}
+	return "the commit message is empty"
+}
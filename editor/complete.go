@@ -0,0 +1,139 @@
+package editor
+
+import (
+	"errors"
+	"path/filepath"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/avalonbits/goted/complete"
+	"github.com/avalonbits/goted/config"
+	"github.com/avalonbits/goted/syntax"
+	"github.com/avalonbits/goted/text"
+)
+
+// ErrNoCompletion is returned when nothing completes the text before the cursor.
+var ErrNoCompletion = errors.New("editor: nothing to complete at the cursor")
+
+// maxCandidates is how many candidates the completion popup shows at a time.
+const maxCandidates = 10
+
+// completion is the list of candidates shown for buffer as of version, with the highlighted
+// one at index.
+type completion struct {
+	buffer  *text.Buffer
+	version int
+	items   []complete.Item
+	index   int
+}
+
+// Complete completes the text before the cursor of b: paths inside string literals, and
+// whatever CompletionProviders offer. A single candidate is put in at once; the text the
+// candidates share is, and the rest are listed in a popup in which Tab and Down, or Shift+Tab
+// and Up, highlight the next or previous one and Enter puts it in.
+func (e *Editor) Complete(b *text.Buffer) error {
+	dir := "."
+	if path := b.Path(); filepath.IsAbs(path) {
+		dir = filepath.Dir(path)
+	}
+	root, _ := config.ProjectRoot(dir)
+
+	items := complete.Paths{Root: root}.Complete(b)
+	for _, p := range e.CompletionProviders {
+		items = append(items, p.Complete(b)...)
+	}
+	switch len(items) {
+	case 0:
+		return ErrNoCompletion
+	case 1:
+		return accept(b, items[0])
+	}
+
+	if common := sharedPrefix(items); len(common) > 0 {
+		return accept(b, complete.Item{Text: common, Start: items[0].End, End: items[0].End})
+	}
+	labels := make([]string, len(items))
+	for i, it := range items {
+		labels[i] = it.Label
+	}
+	e.ShowPopup(strings.Join(labels, "\n"))
+	e.popup.Anchor = items[0].Start
+	e.completion = &completion{buffer: b, version: b.Version(), items: items}
+	e.highlightCandidate()
+	return nil
+}
+
+// sharedPrefix returns what every item would put in past the cursor, if they all replace the
+// same text.
+func sharedPrefix(items []complete.Item) []rune {
+	first := items[0]
+	typed := first.End - first.Start
+	common := first.Text
+	for _, it := range items[1:] {
+		if it.Start != first.Start || it.End != first.End {
+			return nil
+		}
+		n := 0
+		for n < len(common) && n < len(it.Text) && common[n] == it.Text[n] {
+			n++
+		}
+		common = common[:n]
+	}
+	if len(common) <= typed {
+		return nil
+	}
+	// The candidates start with what was typed, which stays.
+	return slices.Clone(common[typed:])
+}
+
+// accept puts it in b, leaving the cursor after it.
+func accept(b *text.Buffer, it complete.Item) error {
+	return b.Replace(it.Start, it.End, it.Text)
+}
+
+// completionKey handles key while candidates are listed. Returns false if the key closed the
+// list and should still be handled as usual.
+func (e *Editor) completionKey(key string) bool {
+	c := e.completion
+	switch key {
+	case "Tab", "Down":
+		c.index = (c.index + 1) % len(c.items)
+	case "Shift+Tab", "Up":
+		c.index = (c.index + len(c.items) - 1) % len(c.items)
+	case "Enter":
+		e.closeCompletion()
+		if c.buffer.Version() != c.version {
+			return true
+		}
+		if err := accept(c.buffer, c.items[c.index]); err != nil {
+			e.message = err.Error()
+		}
+		return true
+	case "PageDown", "PageUp":
+		return false
+	default:
+		e.closeCompletion()
+		return key == "Esc"
+	}
+	e.highlightCandidate()
+	return true
+}
+
+// highlightCandidate highlights the current candidate in the popup and scrolls it into view.
+func (e *Editor) highlightCandidate() {
+	c := e.completion
+	start := 0
+	for _, it := range c.items[:c.index] {
+		start += utf8.RuneCountInString(it.Label) + 1
+	}
+	end := start + utf8.RuneCountInString(c.items[c.index].Label)
+	e.popup.Spans = []syntax.Span{{Start: start, End: end, Scope: "ui.popup.active"}}
+	e.popup.MaxHeight = maxCandidates
+	e.popup.Top = max(min(e.popup.Top, c.index), c.index-maxCandidates+1)
+}
+
+// closeCompletion closes the list of candidates and its popup.
+func (e *Editor) closeCompletion() {
+	e.completion, e.popup = nil, nil
+}
@@ -0,0 +1,22 @@
+package editor
+
+import "github.com/avalonbits/goted/text"
+
+// conflictScope returns the scope line n is drawn with when it is part of one of the merge
+// conflicts cs, or "".
+func conflictScope(cs []text.Conflict, n int) string {
+	for _, c := range cs {
+		switch {
+		case n < c.Start || n > c.End:
+			continue
+		case n == c.Start || n == c.Base || n == c.Middle || n == c.End:
+			return "conflict.marker"
+		case n > c.Middle:
+			return "conflict.theirs"
+		case c.Base >= 0 && n > c.Base:
+			return "conflict.base"
+		}
+		return "conflict.ours"
+	}
+	return ""
+}
@@ -0,0 +1,107 @@
+package editor
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"slices"
+
+	"github.com/avalonbits/goted/config"
+	"github.com/avalonbits/goted/crypt"
+	"github.com/avalonbits/goted/text"
+)
+
+// ErrLocked is returned when saving an encrypted file that was not decrypted.
+var ErrLocked = errors.New("editor: the file was not decrypted")
+
+// locked is the storage of an encrypted file not decrypted yet, which must not be saved over.
+type locked struct{}
+
+func (locked) Read(path string) ([]byte, error)     { return nil, ErrLocked }
+func (locked) Write(path string, data []byte) error { return ErrLocked }
+
+// openEncrypted opens path, whose contents data are encrypted as kind, in a buffer that only
+// ever holds them decrypted in memory: it saves by encrypting them again the same way, and has
+// no undo file, bookmarks or recovery file in clear. If a passphrase is needed, it is asked
+// for, and the buffer stays empty and read-only until the right one is given.
+func (e *Editor) openEncrypted(path string, kind crypt.Kind, data []byte, s config.Settings) (*text.Buffer, error) {
+	o := crypt.Options{Identity: s.AgeIdentity, Recipients: s.AgeRecipients}
+	plain, key, err := crypt.Decrypt(kind, data, "", o)
+	if err == nil {
+		b, err := e.decrypted(path, plain, key, s)
+		if err != nil {
+			return nil, err
+		}
+		return e.add(b), nil
+	}
+	if !errors.Is(err, crypt.ErrPassphrase) {
+		return nil, err
+	}
+
+	b := text.New(minSize)
+	b.SetPath(path)
+	e.detect(b, s)
+	b.SetReadOnly(true)
+	b.SetStorage(locked{})
+	e.add(b)
+
+	name := filepath.Base(path)
+	var unlock func(string) error
+	unlock = func(passphrase string) error {
+		plain, key, err := crypt.Decrypt(kind, data, passphrase, o)
+		if errors.Is(err, crypt.ErrPassphrase) {
+			e.Prompt(fmt.Sprintf("Wrong passphrase, try again for %s: ", name), true, unlock)
+			return nil
+		} else if err != nil {
+			return err
+		}
+		d, err := e.decrypted(path, plain, key, s)
+		if err != nil {
+			return err
+		}
+		e.replace(b, d)
+		return nil
+	}
+	e.Prompt(fmt.Sprintf("Passphrase for %s: ", name), true, unlock)
+	return b, nil
+}
+
+// decrypted returns a buffer bound to path holding plain, which is saved encrypted with key.
+// Fails if plain cannot be loaded, rather than leaving an empty buffer to save over the file.
+func (e *Editor) decrypted(path string, plain []byte, key crypt.Key, s config.Settings) (*text.Buffer, error) {
+	b, err := load(plain)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	b.SetPath(path)
+	b.SetStorage(crypt.Store{Key: key})
+	e.detect(b, s)
+	e.restorePosition(b, s)
+	return b, nil
+}
+
+// replace puts b in the place of the open buffer old, as current if old was.
+func (e *Editor) replace(old, b *text.Buffer) {
+	i := slices.Index(e.buffers, old)
+	if i < 0 {
+		return
+	}
+	cur := e.Current()
+	e.remove(old)
+	e.add(b)
+	e.buffers = slices.Insert(e.buffers[:len(e.buffers)-1], i, b)
+	if cur == old {
+		cur = b
+	}
+	e.current = slices.Index(e.buffers, cur)
+}
+
+// inClear reports whether b may be written out in clear, to undo, bookmark and recovery files:
+// it is not an encrypted file.
+func inClear(b *text.Buffer) bool {
+	switch b.Storage().(type) {
+	case crypt.Store, locked:
+		return false
+	}
+	return true
+}
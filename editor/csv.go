@@ -0,0 +1,97 @@
+package editor
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/avalonbits/goted/command"
+	"github.com/avalonbits/goted/csvmode"
+	"github.com/avalonbits/goted/text"
+)
+
+// csvPrefix starts the paths of the buffers showing a table aligned, followed by the path of
+// its file.
+const csvPrefix = "csv:"
+
+// csvSeparator returns the field separator of b, which must be in csv mode: that of its file
+// type, or a comma for buffers put in the mode by hand.
+func (e *Editor) csvSeparator(b *text.Buffer) (rune, error) {
+	if !e.InMode(b, "csv") {
+		return 0, fmt.Errorf("%w: not in csv mode", command.ErrUsage)
+	}
+	if sep, ok := csvmode.Detect(b); ok {
+		return sep, nil
+	}
+	return ',', nil
+}
+
+// csvColumn moves the cursor of b to the start of field n, counted from 1, of its line, or
+// to the next or previous field if the argument is next or previous.
+func (e *Editor) csvColumn(b *text.Buffer, args []string) error {
+	if len(args) != 1 {
+		return fmt.Errorf("%w: csv-column needs a column number, next or previous", command.ErrUsage)
+	}
+	sep, err := e.csvSeparator(b)
+	if err != nil {
+		return err
+	}
+	line, _ := b.LineRunes(b.Line())
+	n := csvmode.Column(line, sep, b.Column())
+	switch args[0] {
+	case "next":
+		n++
+	case "previous":
+		n--
+	default:
+		if n, err = strconv.Atoi(args[0]); err != nil || n < 1 {
+			return fmt.Errorf("%w: bad column %q", command.ErrUsage, args[0])
+		}
+		n--
+	}
+	if !csvmode.JumpToColumn(b, sep, n) {
+		return fmt.Errorf("%w: the line has no column %d", command.ErrUsage, n+1)
+	}
+	return nil
+}
+
+// csvView shows the table in b with its columns aligned, in a read-only buffer, with the cursor
+// in the same field. The file is left as it is.
+func (e *Editor) csvView(b *text.Buffer) error {
+	sep, err := e.csvSeparator(b)
+	if err != nil {
+		return err
+	}
+	src := strings.Split(strings.TrimSuffix(b.String(), "\n"), "\n")
+	lines := make([][]rune, len(src))
+	for i, s := range src {
+		lines[i] = []rune(s)
+	}
+	widths := csvmode.Widths(lines, sep)
+
+	var table strings.Builder
+	for _, line := range lines {
+		table.WriteString(string(csvmode.Align(line, sep, widths)))
+		table.WriteByte('\n')
+	}
+	n, col := b.Line(), b.Column()
+	if err := e.preview(csvPrefix+b.Path(), table.String()); err != nil {
+		return err
+	}
+	vb := e.Current()
+	vb.SetReadOnly(true)
+	if n < len(lines) {
+		vb.GotoLine(n, csvmode.DisplayColumn(lines[n], sep, widths, col))
+	}
+	return nil
+}
+
+// csvStatus returns what the status line shows of b in csv mode: the column of the cursor.
+func (e *Editor) csvStatus(b *text.Buffer) string {
+	sep, err := e.csvSeparator(b)
+	if err != nil {
+		return ""
+	}
+	line, _ := b.LineRunes(b.Line())
+	return e.tr(fmt.Sprintf("column %d", csvmode.Column(line, sep, b.Column())+1))
+}
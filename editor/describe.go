@@ -0,0 +1,177 @@
+package editor
+
+import (
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/avalonbits/goted/text"
+)
+
+// shownState is what a screen reader is told about: the buffer shown, where the cursor is and
+// the text of its line, the size of the selection, -1 without one, whether the buffer has
+// unsaved changes, and the message, question and popup shown.
+type shownState struct {
+	buffer    *text.Buffer
+	name      string
+	line, col int
+	text      string
+	selection int
+	modified  bool
+	message   string
+	question  string
+	popup     string
+}
+
+// shownNow returns the state of what is shown.
+func (e *Editor) shownNow() shownState {
+	b := e.Current()
+	line, _ := b.LineRunes(b.Line())
+	s := shownState{
+		buffer:    b,
+		name:      e.tr("no file"),
+		line:      b.Line(),
+		col:       b.Column(),
+		text:      string(line),
+		selection: -1,
+		modified:  unsaved(b),
+		message:   e.message,
+	}
+	if b.Path() != "" {
+		s.name = filepath.Base(b.Path())
+	}
+	if start, end, ok := b.Selection(); ok {
+		s.selection = end - start
+	}
+	if len(e.prompts) > 0 {
+		s.question, _ = e.prompts[0].line()
+		s.question = strings.TrimSpace(s.question)
+	}
+	if e.popup != nil {
+		s.popup, _, _ = strings.Cut(strings.TrimSpace(e.popup.Text), "\n")
+	}
+	return s
+}
+
+// describe writes to Descriptions what changed on the screen since it was last described, a
+// line for each change, in few words: the buffer switched to, the line moved to and its text,
+// the character moved onto or typed, the selection, the buffer being modified or saved, and
+// the message, question and popup shown. Descriptions are dropped once writing them fails, as
+// when the reader of a pipe is gone.
+func (e *Editor) describe() {
+	if e.Descriptions == nil || e.Current() == nil {
+		return
+	}
+	now, was := e.shownNow(), e.described
+	e.described = now
+	var out []string
+	// Messages are translated, but not the text of the buffer they read out.
+	say := func(format string, args ...any) {
+		out = append(out, e.tr(fmt.Sprintf(format, args...)))
+	}
+	read := func(text string, format string, args ...any) {
+		if format == "" {
+			out = append(out, text)
+			return
+		}
+		out = append(out, e.tr(fmt.Sprintf(format, args...))+" "+text)
+	}
+
+	switch {
+	case now.buffer != was.buffer:
+		say("%s, line %d of %d", now.name, now.line+1, now.buffer.Lines())
+		read(e.lineText(now.text), "")
+	case now.line != was.line:
+		read(e.lineText(now.text), "line %d:", now.line+1)
+	case now.text != was.text && now.col == was.col+1:
+		read(e.charAt(now.text, now.col-1), "")
+	case now.text != was.text:
+		read(e.lineText(now.text), "line %d:", now.line+1)
+	case now.col != was.col:
+		read(e.charAt(now.text, now.col), "column %d:", now.col+1)
+	}
+	if now.selection != was.selection && now.buffer == was.buffer || now.buffer != was.buffer && now.selection >= 0 {
+		if now.selection < 0 {
+			say("selection cleared")
+		} else {
+			say("%d characters selected", now.selection)
+		}
+	}
+	if now.buffer == was.buffer && now.modified != was.modified {
+		if now.modified {
+			say("modified")
+		} else {
+			say("saved")
+		}
+	}
+	if now.message != was.message && now.message != "" {
+		say("%s", now.message)
+	}
+	if now.question != was.question && now.question != "" {
+		read(now.question, "question:")
+	}
+	if now.popup != was.popup && now.popup != "" {
+		read(now.popup, "popup:")
+	}
+
+	for _, s := range out {
+		if _, err := io.WriteString(e.Descriptions, s+"\n"); err != nil {
+			e.Descriptions = nil
+			return
+		}
+	}
+}
+
+// announce describes all of what is shown, not only what changed: to Descriptions if set, or
+// as the message otherwise.
+func (e *Editor) announce() {
+	b := e.Current()
+	s := e.shownNow()
+	parts := []string{e.tr(fmt.Sprintf("%s, line %d of %d, column %d", s.name, s.line+1, b.Lines(), s.col+1))}
+	if s.modified {
+		parts = append(parts, e.tr("modified"))
+	}
+	if s.selection >= 0 {
+		parts = append(parts, e.tr(fmt.Sprintf("%d characters selected", s.selection)))
+	}
+	if s.question != "" {
+		parts = append(parts, e.tr("question:")+" "+s.question)
+	}
+	if e.Descriptions == nil {
+		e.message = strings.Join(parts, ", ")
+		return
+	}
+	parts = append(parts, e.lineText(s.text))
+	e.described = s
+	for _, p := range parts {
+		if _, err := io.WriteString(e.Descriptions, p+"\n"); err != nil {
+			e.Descriptions = nil
+			return
+		}
+	}
+}
+
+// lineText returns the text of a line to read out, "blank" if there is none.
+func (e *Editor) lineText(line string) string {
+	if strings.TrimSpace(line) == "" {
+		return e.tr("blank")
+	}
+	return strings.TrimSpace(line)
+}
+
+// charAt returns the name of the character at rune column col of line, as it is read out.
+func (e *Editor) charAt(line string, col int) string {
+	if col < 0 || col >= utf8.RuneCountInString(line) {
+		return e.tr("end of line")
+	}
+	switch r := []rune(line)[col]; r {
+	case ' ':
+		return e.tr("space")
+	case '\t':
+		return e.tr("tab")
+	default:
+		return string(r)
+	}
+}
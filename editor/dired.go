@@ -0,0 +1,129 @@
+package editor
+
+import (
+	"fmt"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/avalonbits/goted/command"
+	"github.com/avalonbits/goted/dired"
+	"github.com/avalonbits/goted/text"
+)
+
+// Directory listings are bound to dired: followed by the directory, and their previews to
+// dired-preview: followed by the directory. Neither is ever saved as a file.
+const (
+	diredPrefix   = "dired:"
+	previewPrefix = "dired-preview:"
+)
+
+// diredKeys are the bindings of directory listings, over the global ones.
+var diredKeys = command.Keymap{
+	"Enter":    "dired-open",
+	"Ctrl+K p": "dired-preview",
+	"Ctrl+K w": "write",
+}
+
+// openDir lists the directory dir in a buffer and makes it current, or switches to its listing
+// if it is already open. Editing the listing and writing it renames, moves and deletes the
+// entries as the dired package describes.
+func (e *Editor) openDir(dir string) (*text.Buffer, error) {
+	path := diredPrefix + dir
+	if i := slices.IndexFunc(e.buffers, func(b *text.Buffer) bool { return b.Path() == path }); i >= 0 {
+		e.SetCurrent(e.buffers[i])
+		return e.buffers[i], nil
+	}
+
+	b := text.New(minSize)
+	b.SetPath(path)
+	if err := e.relist(b); err != nil {
+		return nil, err
+	}
+	e.keymaps[b] = diredKeys
+	return e.add(b), nil
+}
+
+// relist reads the directory of the listing b again and replaces its text, keeping the cursor
+// on the same line.
+func (e *Editor) relist(b *text.Buffer) error {
+	l, err := dired.Read(strings.TrimPrefix(b.Path(), diredPrefix))
+	if err != nil {
+		return err
+	}
+	line := b.Line()
+	if err := b.Load(strings.NewReader(l.Format())); err != nil {
+		return err
+	}
+	b.GotoLine(max(line, 2), 0)
+	e.listings[b] = l
+	return nil
+}
+
+// listing returns the directory listing shown in b.
+func (e *Editor) listing(b *text.Buffer) (*dired.Listing, error) {
+	l, ok := e.listings[b]
+	if !ok {
+		return nil, fmt.Errorf("%w: not a directory listing", command.ErrUsage)
+	}
+	return l, nil
+}
+
+// diredApply carries out the operations the edited listing b asks for and lists the directory
+// again. Operations done before a failure stay done and are listed as such.
+func (e *Editor) diredApply(b *text.Buffer) error {
+	l, err := e.listing(b)
+	if err != nil {
+		return err
+	}
+	ops, err := l.Plan(b.String())
+	if err != nil {
+		return err
+	}
+	done, err := dired.Apply(ops)
+	if rerr := e.relist(b); err == nil {
+		err = rerr
+	}
+	if err != nil {
+		return fmt.Errorf("after %d of %d operations: %w", len(done), len(ops), err)
+	}
+	e.message = fmt.Sprintf("%d operations done", len(done))
+	return nil
+}
+
+// diredPreview shows the operations the edited listing b asks for in a preview buffer, without
+// doing any of them.
+func (e *Editor) diredPreview(b *text.Buffer) error {
+	l, err := e.listing(b)
+	if err != nil {
+		return err
+	}
+	ops, err := l.Plan(b.String())
+	if err != nil {
+		return err
+	}
+
+	var s strings.Builder
+	fmt.Fprintf(&s, "# %d operations, done when the listing is written\n", len(ops))
+	for _, op := range ops {
+		s.WriteString(op.String() + "\n")
+	}
+
+	return e.preview(previewPrefix+l.Dir, s.String())
+}
+
+// diredOpen opens the entry on the cursor line of the listing b: files in a buffer and
+// directories as another listing.
+func (e *Editor) diredOpen(b *text.Buffer) error {
+	l, err := e.listing(b)
+	if err != nil {
+		return err
+	}
+	line, _ := b.LineRunes(b.Line())
+	var id int
+	if _, err := fmt.Sscanf(strings.TrimLeft(string(line), " DM"), "%d", &id); err != nil || id < 1 || id > len(l.Names) {
+		return fmt.Errorf("%w: no entry on this line", command.ErrUsage)
+	}
+	_, err = e.Open(filepath.Join(l.Dir, l.Names[id-1]))
+	return err
+}
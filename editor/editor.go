@@ -0,0 +1,624 @@
+// Package editor holds an editing session: the open buffers and the commands run on them.
+package editor
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"time"
+
+	"github.com/avalonbits/goted/archive"
+	"github.com/avalonbits/goted/bookmark"
+	"github.com/avalonbits/goted/command"
+	"github.com/avalonbits/goted/complete"
+	"github.com/avalonbits/goted/config"
+	"github.com/avalonbits/goted/crypt"
+	"github.com/avalonbits/goted/dired"
+	"github.com/avalonbits/goted/event"
+	"github.com/avalonbits/goted/graphics"
+	"github.com/avalonbits/goted/grep"
+	"github.com/avalonbits/goted/guard"
+	"github.com/avalonbits/goted/idle"
+	"github.com/avalonbits/goted/instance"
+	"github.com/avalonbits/goted/locale"
+	"github.com/avalonbits/goted/plugin"
+	"github.com/avalonbits/goted/profile"
+	"github.com/avalonbits/goted/redact"
+	"github.com/avalonbits/goted/scaffold"
+	"github.com/avalonbits/goted/screen"
+	"github.com/avalonbits/goted/storage"
+	"github.com/avalonbits/goted/term"
+	"github.com/avalonbits/goted/text"
+	"github.com/avalonbits/goted/unichar"
+	"github.com/avalonbits/goted/view"
+)
+
+// ErrCanceled is returned when the user declines an operation the guard asked about, such as
+// opening a very large file.
+var ErrCanceled = guard.ErrCanceled
+
+// minSize is the capacity, in runes, of the smallest buffer.
+const minSize = 1 << 20
+
+// Editor is an editing session.
+type Editor struct {
+	// Commands are the commands available in the session.
+	Commands *command.Registry
+
+	// Keymap binds keys to the commands.
+	Keymap command.Keymap
+
+	// Terminal and Screen are where the session is shown. Both are nil in headless sessions.
+	Terminal *term.Terminal
+	Screen   *screen.Screen
+
+	// Files are opened by Run once the terminal is up, so that questions about them, such as
+	// whether to open a large file, can be asked on the status line. ReadOnly opens them
+	// read-only.
+	Files    []instance.File
+	ReadOnly bool
+
+	// Output, if set, is the buffer written to standard output on exit, as with --stdout, whose
+	// changes quitting does not ask about since they are not lost.
+	Output *text.Buffer
+
+	// RenameProvider, if set, computes the edits renaming the symbol under the cursor of a
+	// buffer across files, as a language server does. Without it, renames stay in the buffer.
+	RenameProvider func(b *text.Buffer, name string) (WorkspaceEdit, error)
+
+	// SignatureProvider, if set, returns the signature of the function called by call in a
+	// buffer, as a language server does. Without it, signatures come from declarations in the
+	// buffer and from go doc.
+	SignatureProvider func(b *text.Buffer, call text.Call) (Signature, error)
+
+	// CompletionProviders offer candidates for the text before the cursor, as a language
+	// server does, next to the paths complete offers inside strings.
+	CompletionProviders []complete.Provider
+
+	// Idle runs maintenance work, such as writing undo files, while the user is not typing.
+	Idle *idle.Scheduler
+
+	// Descriptions, if set, is told in lines of text what changes on the screen as keys are
+	// handled, such as the line the cursor moved to and the messages shown, for a screen reader
+	// to read out.
+	Descriptions io.Writer
+
+	// Events announces what happens in the session, such as buffers being opened and saved,
+	// to the features and extensions subscribed.
+	Events *event.Bus
+
+	buffers   []*text.Buffer
+	current   int
+	pending   []string
+	unsynced  map[*text.Buffer]bool
+	viewports map[*text.Buffer]*view.Viewport
+	churned   map[*text.Buffer]bool
+	keymaps   map[*text.Buffer]command.Keymap
+	listings  map[*text.Buffer]*dired.Listing
+	message   string
+
+	popup       *view.Popup
+	popupBuffer *text.Buffer
+
+	call          *text.Call
+	callSignature Signature
+	signatures    map[string]Signature
+	completion    *completion
+
+	mru     []*text.Buffer
+	recent  []string
+	pickers map[*text.Buffer]*picker
+	chars   *unichar.Picker
+
+	composer  *unichar.Composer
+	composing *text.Buffer
+
+	outline       *outlinePane
+	undoTree      *undoTreeView
+	symbolsRoot   string
+	markdownPanes map[*text.Buffer]*markdownPane
+
+	resultRoots  map[*text.Buffer]string
+	replacements map[*text.Buffer]*grep.Preview
+	timelines    map[*text.Buffer]*timeline
+
+	prompts []*prompt
+	tester  *regexTester
+
+	followers map[*text.Buffer]*follower
+	async     map[*text.Buffer]bool
+	drawnAt   map[*text.Buffer]time.Time
+
+	notes         map[*text.Buffer][]*lineNote
+	bookmarkLists map[*text.Buffer][]bookmark.Bookmark
+
+	themeDark, themeLight  string
+	autoTheme, transparent bool
+	terminalBackground     string
+
+	showScrollbar  bool
+	shownScrollbar *scrollbar
+
+	protocol graphics.Protocol
+	images   map[*text.Buffer]*shownImage
+
+	redacting      bool
+	redactPatterns []redact.Pattern
+	redactions     map[*text.Buffer]*redaction
+
+	narrowings map[*text.Buffer]*narrowing
+	highlights map[*text.Buffer]*highlightStates
+
+	plugins map[string]*plugin.Host
+
+	windows       []*window
+	home, focused *window
+	links         view.Links
+
+	described shownState
+	catalog   *locale.Catalog
+
+	vars  map[*text.Buffer]map[any]any
+	modes []*Mode
+}
+
+// New returns an Editor with no buffers, the built-in and session commands and the default key
+// bindings.
+func New() *Editor {
+	e := &Editor{
+		Commands:  command.New(),
+		Keymap:    command.DefaultKeymap(),
+		Idle:      idle.New(),
+		Events:    &event.Bus{},
+		unsynced:  map[*text.Buffer]bool{},
+		viewports: map[*text.Buffer]*view.Viewport{},
+		churned:   map[*text.Buffer]bool{},
+		keymaps:   map[*text.Buffer]command.Keymap{},
+		listings:  map[*text.Buffer]*dired.Listing{},
+
+		pickers:       map[*text.Buffer]*picker{},
+		markdownPanes: map[*text.Buffer]*markdownPane{},
+
+		resultRoots:  map[*text.Buffer]string{},
+		replacements: map[*text.Buffer]*grep.Preview{},
+		timelines:    map[*text.Buffer]*timeline{},
+		followers:    map[*text.Buffer]*follower{},
+		async:        map[*text.Buffer]bool{},
+		drawnAt:      map[*text.Buffer]time.Time{},
+
+		notes:         map[*text.Buffer][]*lineNote{},
+		bookmarkLists: map[*text.Buffer][]bookmark.Bookmark{},
+
+		protocol: graphics.None,
+		images:   map[*text.Buffer]*shownImage{},
+
+		redactions: map[*text.Buffer]*redaction{},
+		narrowings: map[*text.Buffer]*narrowing{},
+		highlights: map[*text.Buffer]*highlightStates{},
+
+		signatures: map[string]Signature{},
+		vars:       map[*text.Buffer]map[any]any{},
+		plugins:    map[string]*plugin.Host{},
+	}
+	e.Commands.Notify = func(msg string) { e.message = msg }
+	e.register()
+	e.registerModes()
+	e.subscribe()
+	view.Register(e.Commands, e.viewport)
+	return e
+}
+
+// Configure applies the settings s that hold for the whole session rather than per file: the
+// limits of the guard, the theme, see SetTheme, the scrollbar, the protocol images are drawn
+// with and redact mode, see ToggleRedact, with the patterns it finds secrets with. The catalog
+// translating messages into the language of the environment is loaded with them.
+func (e *Editor) Configure(s config.Settings) error {
+	e.Commands.Guard.Limits = s.Limits
+	e.themeDark, e.themeLight = s.ThemeDark, s.ThemeLight
+	e.transparent = s.Transparent
+	e.showScrollbar = s.Scrollbar
+	p, err := graphics.ParseProtocol(s.Images, os.Getenv)
+	if err != nil {
+		return err
+	}
+	e.protocol = p
+	patterns, err := redact.Patterns(s.RedactPatterns)
+	if err != nil {
+		return err
+	}
+	e.redacting, e.redactPatterns = s.Redact, patterns
+	clear(e.redactions)
+	done := profile.StartPhase(profile.Locale)
+	if dir, err := locale.Dir(); err == nil {
+		if e.catalog, err = locale.Load(dir, locale.Language()); err != nil {
+			return err
+		}
+	}
+	done()
+	defer profile.StartPhase(profile.Theme)()
+	return e.SetTheme(s.Theme)
+}
+
+// tr returns msg in the language of the user, as their catalog translates it.
+func (e *Editor) tr(msg string) string {
+	return e.catalog.Translate(msg)
+}
+
+// Buffers returns the open buffers, in the order they were opened.
+func (e *Editor) Buffers() []*text.Buffer {
+	return e.buffers
+}
+
+// Current returns the buffer being edited, or nil if there is none.
+func (e *Editor) Current() *text.Buffer {
+	if len(e.buffers) == 0 {
+		return nil
+	}
+	return e.buffers[e.current]
+}
+
+// SetCurrent makes b, which must be open, the buffer being edited.
+func (e *Editor) SetCurrent(b *text.Buffer) {
+	if i := slices.Index(e.buffers, b); i >= 0 {
+		e.current = i
+		e.visit(b)
+	}
+}
+
+// Find returns the open buffer bound to the file at path.
+func (e *Editor) Find(path string) (*text.Buffer, bool) {
+	abs, err := filepath.Abs(path)
+	if storage.Remote(path) {
+		abs = path
+	} else if err != nil {
+		return nil, false
+	}
+	for _, b := range e.buffers {
+		if b.Path() == abs {
+			return b, true
+		}
+	}
+	return nil, false
+}
+
+// Open opens the file at path in a new buffer and makes it current, or switches to its buffer if
+// it is already open. Files that do not exist give an empty buffer bound to path, filled from the
+// template for its file type when templates are enabled. Paths naming an archive entry, such as
+// "logs.zip::app.log", open the entry and directories open as a listing to edit, see openDir.
+// Images open as a preview, see PreviewImage.
+// Files open where the cursor was when they were last
+// edited, if restore_position is set, with their bookmarks. Encrypted files are decrypted, see
+// openEncrypted. Paths of other storage backends,
+// such as "sftp://host/etc/hosts", open through the backend, see openRemote.
+func (e *Editor) Open(path string) (*text.Buffer, error) {
+	if b, ok := e.Find(path); ok {
+		e.SetCurrent(b)
+		return b, nil
+	}
+	if storage.Remote(path) {
+		return e.openRemote(path)
+	}
+
+	abs, err := filepath.Abs(path)
+	if err != nil {
+		return nil, err
+	}
+	if info, err := os.Stat(abs); err == nil && info.IsDir() {
+		return e.openDir(abs)
+	}
+	if isImage(abs) {
+		return e.PreviewImage(abs)
+	}
+	s, err := config.Load(filepath.Dir(abs))
+	if err != nil {
+		return nil, err
+	}
+
+	var b *text.Buffer
+	if _, _, ok := archive.Split(abs); ok {
+		b = text.New(minSize)
+		if err := archive.Load(b, abs); err != nil {
+			return nil, err
+		}
+		s.Apply(b)
+		return e.add(b), nil
+	}
+
+	if ok, err := e.Commands.Guard.Open(abs); err != nil {
+		return nil, err
+	} else if !ok {
+		return nil, ErrCanceled
+	}
+
+	data, err := os.ReadFile(abs)
+	switch {
+	case errors.Is(err, os.ErrNotExist):
+		b = text.New(minSize)
+		b.SetPath(abs)
+		e.detect(b, s)
+		if s.Templates {
+			dir, _ := scaffold.Dir()
+			if _, err := scaffold.Fill(b, scaffold.Options{Dir: dir, Header: s.Header}); err != nil {
+				return nil, err
+			}
+		}
+	case err != nil:
+		return nil, err
+	default:
+		if kind := crypt.Detect(abs, data); kind != crypt.None {
+			return e.openEncrypted(abs, kind, data, s)
+		}
+		if b, err = load(data); err != nil {
+			return nil, err
+		}
+		b.SetPath(abs)
+		e.detect(b, s)
+		if s.UndoFiles {
+			undoFiles.Set(e, b, true)
+			if err := b.LoadUndoFile(); err != nil && !errors.Is(err, text.ErrUndoMismatch) {
+				os.Remove(text.UndoPath(abs))
+				e.message = "undo file dropped: " + err.Error()
+			}
+		}
+		e.restorePosition(b, s)
+		e.restoreBookmarks(b)
+	}
+	return e.add(b), nil
+}
+
+// openRemote opens path from the storage backend it belongs to, as Open does local files. The
+// settings are those for the working directory, and there are no undo files or bookmarks.
+func (e *Editor) openRemote(path string) (*text.Buffer, error) {
+	s, err := config.Load(".")
+	if err != nil {
+		return nil, err
+	}
+	b := text.New(minSize)
+	data, err := storage.For(path).Read(path)
+	switch {
+	case errors.Is(err, os.ErrNotExist):
+	case err != nil:
+		return nil, err
+	default:
+		if kind := crypt.Detect(path, data); kind != crypt.None {
+			return e.openEncrypted(path, kind, data, s)
+		}
+		if b, err = load(data); err != nil {
+			return nil, err
+		}
+	}
+	b.SetPath(path)
+	e.detect(b, s)
+	e.restorePosition(b, s)
+	return e.add(b), nil
+}
+
+// OpenAt opens the file at path, as Open does, and moves the cursor to the 1-based line and
+// column. A line of 0 leaves the cursor alone and -1 goes to the last line.
+func (e *Editor) OpenAt(path string, line, col int) (*text.Buffer, error) {
+	b, err := e.Open(path)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case line < 0:
+		b.GotoLine(b.Lines()-1, 0)
+	case line > 0:
+		b.GotoLine(line-1, col-1)
+	}
+	return b, nil
+}
+
+// openFiles opens files, with the cursor at their line and column, read-only if readOnly is
+// set. Those the guard asks about are opened once the question is answered, and errors are
+// shown on the status line. The first file opened at once is left current.
+func (e *Editor) openFiles(files []instance.File, readOnly bool) {
+	var first *text.Buffer
+	for _, f := range files {
+		err := e.guarded(func() error {
+			b, err := e.OpenAt(f.Path, f.Line, f.Col)
+			if err != nil {
+				return err
+			}
+			b.SetReadOnly(readOnly || b.ReadOnly())
+			if first == nil {
+				first = b
+			}
+			return nil
+		})
+		if err != nil {
+			e.message = err.Error()
+		}
+	}
+	if first != nil {
+		e.SetCurrent(first)
+	}
+}
+
+// OpenReader reads r into a new buffer bound to no file, such as standard input, and makes it
+// current.
+func (e *Editor) OpenReader(r io.Reader) (*text.Buffer, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	b, err := load(data)
+	if err != nil {
+		return nil, err
+	}
+
+	s, err := config.Load(".")
+	if err != nil {
+		return nil, err
+	}
+	s.Apply(b)
+	return e.add(b), nil
+}
+
+// detect sets the file type of b from its path and then applies the settings s, so modelines
+// can override it, and the indentation its text shows if there is none, and publishes the file
+// type it ends up with.
+func (e *Editor) detect(b *text.Buffer, s config.Settings) {
+	o := b.Options()
+	o.FileType = text.DetectFileType(b.Path())
+	b.SetOptions(o)
+	if !s.Apply(b) && s.DetectIndent {
+		e.detectIndent(b)
+	}
+	event.Publish(e.Events, event.FileTypeSet{Buffer: b, FileType: b.Options().FileType})
+}
+
+// unsaved reports whether b has changes that would be lost on exit. Buffers made by the editor
+// itself, such as help and the quick switcher, whose paths are neither file paths nor those of
+// a storage backend, have none.
+func unsaved(b *text.Buffer) bool {
+	if path := b.Path(); path != "" && !filepath.IsAbs(path) && !storage.Remote(path) {
+		return false
+	}
+	return b.Modified()
+}
+
+func (e *Editor) add(b *text.Buffer) *text.Buffer {
+	b.OnChange(func(c text.Change) {
+		e.unsynced[b] = true
+		e.invalidateStates(b, c)
+	})
+	e.buffers = append(e.buffers, b)
+	e.current = len(e.buffers) - 1
+	e.visit(b)
+	event.Publish(e.Events, event.BufferOpened{Buffer: b})
+	return b
+}
+
+// remove closes the open buffer b, dropping what is kept for it in every window, once its
+// bookmarks are recorded. If b was current, the buffer visited before it becomes current.
+func (e *Editor) remove(b *text.Buffer) {
+	i := slices.Index(e.buffers, b)
+	if i < 0 {
+		return
+	}
+	cur := e.Current()
+	e.buffers = slices.Delete(e.buffers, i, i+1)
+	if j := slices.Index(e.mru, b); j >= 0 {
+		e.mru = slices.Delete(e.mru, j, j+1)
+	}
+	for _, vs := range e.viewportMaps() {
+		if v, ok := vs[b]; ok {
+			e.links.Unlink(v)
+			delete(vs, b)
+		}
+	}
+	delete(e.unsynced, b)
+	delete(e.churned, b)
+	delete(e.keymaps, b)
+	delete(e.listings, b)
+	delete(e.pickers, b)
+	delete(e.markdownPanes, b)
+	delete(e.resultRoots, b)
+	delete(e.replacements, b)
+	delete(e.timelines, b)
+	delete(e.followers, b)
+	delete(e.async, b)
+	delete(e.drawnAt, b)
+	if _, ok := e.notes[b]; ok {
+		if err := e.saveBookmarks(b); err != nil {
+			e.message = err.Error()
+		}
+	}
+	delete(e.notes, b)
+	delete(e.bookmarkLists, b)
+	delete(e.images, b)
+	delete(e.redactions, b)
+	delete(e.narrowings, b)
+	delete(e.highlights, b)
+	delete(e.vars, b)
+	e.forget(b)
+	if !slices.Contains(e.buffers, cur) && len(e.mru) > 0 {
+		cur = e.mru[0]
+	}
+	e.current = max(slices.Index(e.buffers, cur), 0)
+}
+
+// viewportMaps returns the viewports of the buffers in every window.
+func (e *Editor) viewportMaps() []map[*text.Buffer]*view.Viewport {
+	maps := []map[*text.Buffer]*view.Viewport{e.viewports}
+	for _, w := range append([]*window{e.home}, e.windows...) {
+		if w != nil {
+			maps = append(maps, w.viewports)
+		}
+	}
+	return maps
+}
+
+// forget drops the popup, completion, character being composed, panes and pickers that belong
+// to b, as it is closed. The outline and the undo tree of b are closed with it.
+func (e *Editor) forget(b *text.Buffer) {
+	if e.popupBuffer == b {
+		e.popup, e.popupBuffer, e.call = nil, nil, nil
+	}
+	if c := e.completion; c != nil && c.buffer == b {
+		e.closeCompletion()
+	}
+	if e.composing == b {
+		e.composer, e.composing = nil, nil
+	}
+	if t := e.tester; t != nil && t.buffer == b {
+		e.tester = nil
+	}
+	if s := e.shownScrollbar; s != nil && s.buffer == b {
+		e.shownScrollbar = nil
+	}
+	if b.Path() == charsPath {
+		e.chars = nil
+	}
+	if o := e.outline; o != nil && (o.buffer == b || o.source == b) {
+		e.outline = nil
+		if o.source == b {
+			e.remove(o.buffer)
+		}
+	}
+	if t := e.undoTree; t != nil && (t.buffer == b || t.source == b) {
+		e.undoTree = nil
+		if t.source == b {
+			e.remove(t.buffer)
+		}
+	}
+}
+
+// undoFiles is whether the undo history of a buffer is kept in an undo file, as the undo_files
+// setting had it when its file was opened.
+var undoFiles = NewVar("undo-files", false)
+
+// syncUndoFiles writes the undo files of the buffers edited since they were last written, for
+// those that keep one. Only saved buffers are written, since an undo file is only restored onto
+// the contents it was written for; the others are kept until a later key schedules another run.
+// Failures are shown on the status line.
+func (e *Editor) syncUndoFiles() {
+	for b := range e.unsynced {
+		if b.Modified() || b.Path() == "" {
+			continue
+		}
+		_, _, inArchive := archive.Split(b.Path())
+		if undoFiles.Get(e, b) && !inArchive && !storage.Remote(b.Path()) && inClear(b) {
+			if err := b.SaveUndoFile(); err != nil {
+				e.message = "undo file not written: " + err.Error()
+			}
+		}
+		delete(e.unsynced, b)
+	}
+}
+
+// load returns a buffer holding data, growing it until the decoded text fits.
+func load(data []byte) (*text.Buffer, error) {
+	for size := max(2*len(data), minSize); ; size *= 4 {
+		b := text.New(size)
+		err := b.Load(bytes.NewReader(data))
+		if !errors.Is(err, text.ErrFull) {
+			return b, err
+		}
+	}
+}
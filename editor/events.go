@@ -0,0 +1,61 @@
+package editor
+
+import (
+	"path/filepath"
+
+	"github.com/avalonbits/goted/config"
+	"github.com/avalonbits/goted/event"
+	"github.com/avalonbits/goted/text"
+)
+
+// subscribe hooks the features that follow what happens to buffers, rather than being asked
+// for by a command, up to the events of the session.
+func (e *Editor) subscribe() {
+	event.Subscribe(e.Events, func(ev event.FileTypeSet) {
+		switch ev.FileType {
+		case "gitcommit":
+			commitMode(ev.Buffer)
+		case "csv", "tsv":
+			e.SetMode(ev.Buffer, "csv", true)
+		}
+	})
+	event.Subscribe(e.Events, func(ev event.BufferSaved) {
+		if ev.Buffer.Options().FileType != "gitcommit" {
+			return
+		}
+		if w := commitWarning(ev.Buffer); w != "" {
+			e.message = "warning: " + w
+		}
+	})
+}
+
+// saved runs save, which writes b, between the save hooks of the settings of b, and publishes
+// that b was saved if it succeeds, and that its file type changed if saving under a new name
+// changed it.
+func (e *Editor) saved(b *text.Buffer, save func() error) error {
+	fileType := b.Options().FileType
+	s, _ := config.Load(filepath.Dir(b.Path()))
+	if err := e.preSave(b, s); err != nil {
+		return err
+	}
+	if err := save(); err != nil {
+		return err
+	}
+	if ft := b.Options().FileType; ft != fileType {
+		event.Publish(e.Events, event.FileTypeSet{Buffer: b, FileType: ft})
+	}
+	event.Publish(e.Events, event.BufferSaved{Buffer: b, Path: b.Path()})
+	s, _ = config.Load(filepath.Dir(b.Path()))
+	e.keepHistory(b, s)
+	return e.postSave(b, s)
+}
+
+// cursorMoved publishes that the cursor moved if the current buffer is no longer b, or its
+// cursor is no longer at line and col.
+func (e *Editor) cursorMoved(b *text.Buffer, line, col int) {
+	cur := e.Current()
+	if cur == nil || cur == b && cur.Line() == line && cur.Column() == col {
+		return
+	}
+	event.Publish(e.Events, event.CursorMoved{Buffer: cur, Line: cur.Line(), Col: cur.Column()})
+}
@@ -0,0 +1,109 @@
+package editor
+
+import (
+	"fmt"
+	"go/parser"
+	"go/token"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"unicode"
+
+	"github.com/avalonbits/goted/command"
+	"github.com/avalonbits/goted/config"
+	"github.com/avalonbits/goted/format"
+	"github.com/avalonbits/goted/text"
+)
+
+// goImports formats the Go buffer b and fixes its imports before it is saved, if go_imports is
+// set for it. Files that do not parse are saved as they are, with the error shown.
+func (e *Editor) goImports(b *text.Buffer) {
+	s, err := config.Load(filepath.Dir(b.Path()))
+	if err != nil || !s.GoImports {
+		return
+	}
+	out, err := format.GoImports(filepath.Base(b.Path()), []byte(b.String()))
+	if err != nil {
+		e.message = "go_imports: " + err.Error()
+		return
+	}
+	if _, err := format.Reformat(b, []rune(string(out))); err != nil {
+		e.message = "go_imports: " + err.Error()
+	}
+}
+
+// GoDoc shows the documentation of the Go identifier under the cursor of b, as go doc prints
+// it, in a popup. A selector such as strings.Cut is looked up in the package imported
+// under that name, a package name in the imports shows the package and any other identifier
+// is looked up in the package of the file.
+func (e *Editor) GoDoc(b *text.Buffer) error {
+	query := goDocQuery(b)
+	if query == "" {
+		return fmt.Errorf("%w: no identifier under the cursor", command.ErrUsage)
+	}
+
+	cmd := exec.Command("go", "doc", query)
+	if path := b.Path(); filepath.IsAbs(path) {
+		cmd.Dir = filepath.Dir(path)
+	}
+	out, err := cmd.CombinedOutput()
+	if err != nil && len(out) == 0 {
+		return err
+	}
+	e.ShowPopup(string(out))
+	return nil
+}
+
+// goDocQuery returns the argument to go doc for the identifier under the cursor of b.
+func goDocQuery(b *text.Buffer) string {
+	word := b.WordAt()
+	if word == "" {
+		return ""
+	}
+
+	imports := importPaths(b)
+	if p, ok := imports[word]; ok {
+		return p
+	}
+
+	// Look for a package name and a dot right before the word.
+	line, _ := b.LineRunes(b.Line())
+	col := min(b.Column(), len(line))
+	start := col
+	for start > 0 && isIdent(line[start-1]) {
+		start--
+	}
+	if start > 0 && line[start-1] == '.' {
+		end := start - 1
+		pkg := end
+		for pkg > 0 && isIdent(line[pkg-1]) {
+			pkg--
+		}
+		if p, ok := imports[string(line[pkg:end])]; ok {
+			return p + "." + word
+		}
+	}
+	return word
+}
+
+// importPaths maps the names the Go file of b imports packages under to their paths.
+func importPaths(b *text.Buffer) map[string]string {
+	imports := map[string]string{}
+	f, err := parser.ParseFile(token.NewFileSet(), "", b.String(), parser.ImportsOnly)
+	if err != nil {
+		return imports
+	}
+	for _, spec := range f.Imports {
+		p, _ := strconv.Unquote(spec.Path.Value)
+		name := format.ImportName(p)
+		if spec.Name != nil {
+			name = spec.Name.Name
+		}
+		imports[name] = p
+	}
+	return imports
+}
+
+func isIdent(r rune) bool {
+	return r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r)
+}
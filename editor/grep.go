@@ -0,0 +1,241 @@
+package editor
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/avalonbits/goted/command"
+	"github.com/avalonbits/goted/config"
+	"github.com/avalonbits/goted/grep"
+	"github.com/avalonbits/goted/text"
+)
+
+// Search results are bound to grep: followed by the pattern, grep-buffers: for those of open
+// buffers, and replacement previews to replace: followed by the pattern. None is ever saved as
+// a file.
+const (
+	grepPrefix        = "grep:"
+	grepBuffersPrefix = "grep-buffers:"
+	replacePrefix     = "replace:"
+)
+
+// grepKeys are the bindings of search results and replacement previews, over the global ones.
+var grepKeys = command.Keymap{
+	"Enter":    "grep-open",
+	"Ctrl+K w": "write",
+}
+
+// project returns the root of the project of b, or the directory of its file if it is in no
+// project, and the settings there.
+func project(b *text.Buffer) (string, config.Settings, error) {
+	dir := "."
+	if path := b.Path(); filepath.IsAbs(path) {
+		dir = filepath.Dir(path)
+	}
+	s, err := config.Load(dir)
+	if err != nil {
+		return "", s, err
+	}
+	root, ok := config.ProjectRoot(dir)
+	if !ok {
+		root, err = filepath.Abs(dir)
+	}
+	return root, s, err
+}
+
+// Grep lists the lines of the files in the project of b that match the regular expression
+// pattern in a buffer, one "file:line: text" line each. Enter opens the match under the cursor.
+func (e *Editor) Grep(b *text.Buffer, pattern string) error {
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return err
+	}
+	root, s, err := project(b)
+	if err != nil {
+		return err
+	}
+	matches, err := grep.Search(root, re, s.Exclude)
+	if err != nil {
+		return err
+	}
+
+	var list strings.Builder
+	fmt.Fprintf(&list, "# %d lines match %q in %s\n", len(matches), pattern, root)
+	for _, m := range matches {
+		fmt.Fprintf(&list, "%s:%d: %s\n", relative(root, m.Path), m.Line, m.Text)
+	}
+	return e.results(grepPrefix+pattern, root, list.String(), nil)
+}
+
+// GrepBuffers lists the lines of the open buffers bound to files that match the regular
+// expression pattern, as Grep does for the files of the project of b. The text searched is
+// that of the buffers, unsaved changes included, rather than that of their files.
+func (e *Editor) GrepBuffers(b *text.Buffer, pattern string) error {
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return err
+	}
+	root, _, err := project(b)
+	if err != nil {
+		return err
+	}
+
+	var matches []grep.Match
+	searched := 0
+	for _, ob := range e.buffers {
+		if !filepath.IsAbs(ob.Path()) {
+			continue
+		}
+		searched++
+		for n := range ob.Lines() {
+			line, _ := ob.LineRunes(n)
+			if re.MatchString(string(line)) {
+				matches = append(matches, grep.Match{Path: ob.Path(), Line: n + 1, Text: string(line)})
+			}
+		}
+	}
+
+	var list strings.Builder
+	fmt.Fprintf(&list, "# %d lines match %q in %d open buffers\n", len(matches), pattern, searched)
+	for _, m := range matches {
+		fmt.Fprintf(&list, "%s:%d: %s\n", relative(root, m.Path), m.Line, m.Text)
+	}
+	return e.results(grepBuffersPrefix+pattern, root, list.String(), nil)
+}
+
+// ReplaceProject shows what replacing the matches of the regular expression pattern with repl
+// in the files of the project of b would leave, one line per changed line, in which $1 stands
+// for the first group. Lines removed from the preview are skipped and edited ones written as
+// edited when the preview is written. See the grep package.
+func (e *Editor) ReplaceProject(b *text.Buffer, pattern, repl string) error {
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return err
+	}
+	root, s, err := project(b)
+	if err != nil {
+		return err
+	}
+	matches, err := grep.Search(root, re, s.Exclude)
+	if err != nil {
+		return err
+	}
+	p, changes := grep.NewPreview(root, re, repl, matches)
+	if !e.Commands.Guard.ReplaceAll(len(changes)) {
+		return ErrCanceled
+	}
+	return e.results(replacePrefix+pattern, root, p.Format(changes), p)
+}
+
+// results shows text in the buffer bound to path, listing matches under root, with p the
+// replacement it previews, if any.
+func (e *Editor) results(path, root, text string, p *grep.Preview) error {
+	if err := e.preview(path, text); err != nil {
+		return err
+	}
+	b := e.Current()
+	b.GotoLine(1, 0)
+	e.keymaps[b] = grepKeys
+	e.resultRoots[b] = root
+	if p != nil {
+		e.replacements[b] = p
+	}
+	return nil
+}
+
+// replaceApply writes the replacements left in the preview b. Files with unsaved changes in a
+// buffer are refused, and buffers of the files changed are loaded again. The files are backed
+// up first in a directory of their own, named in the message.
+func (e *Editor) replaceApply(b *text.Buffer) error {
+	p := e.replacements[b]
+	if p == nil {
+		return fmt.Errorf("%w: the replacement was already done", command.ErrUsage)
+	}
+	changes, err := p.Plan(b.String())
+	if err != nil {
+		return err
+	}
+	for _, c := range changes {
+		if ob, ok := e.Find(c.Path); ok && ob.Modified() {
+			return fmt.Errorf("%s has unsaved changes", c.Path)
+		}
+	}
+
+	dir, err := backupDir()
+	if err != nil {
+		return err
+	}
+	files, err := grep.Apply(p.Root, changes, dir)
+	if err != nil {
+		return err
+	}
+	for _, path := range files {
+		if ob, ok := e.Find(path); ok {
+			reload(ob)
+		}
+	}
+	e.replacements[b] = nil
+	e.message = fmt.Sprintf("replaced %d lines in %d files, backups in %s", len(changes), len(files), dir)
+	return nil
+}
+
+// grepOpen opens the match on the cursor line of the search results or replacement preview b.
+func (e *Editor) grepOpen(b *text.Buffer) error {
+	root, ok := e.resultRoots[b]
+	if !ok {
+		return fmt.Errorf("%w: not a list of matches", command.ErrUsage)
+	}
+	line, _ := b.LineRunes(b.Line())
+	return e.openLocation(root, string(line))
+}
+
+// openLocation opens the file:line or file:line:col reference at the start of line, followed
+// by ": ", relative to root unless it is absolute.
+func (e *Editor) openLocation(root, line string) error {
+	loc, _, ok := strings.Cut(line, ": ")
+	if !ok || strings.HasPrefix(line, "#") {
+		return fmt.Errorf("%w: no location on this line", command.ErrUsage)
+	}
+	if !filepath.IsAbs(loc) {
+		loc = filepath.Join(root, loc)
+	}
+	path, n, col := SplitLocation(loc)
+	_, err := e.OpenAt(path, n, col)
+	return err
+}
+
+// backupDir returns a new directory for the backups of a replacement, named after the time.
+func backupDir() (string, error) {
+	cache, err := os.UserCacheDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(cache, "goted", "backups", time.Now().Format("20060102-150405.000")), nil
+}
+
+// reload reads the file of b again, keeping the cursor on the same line and column.
+func reload(b *text.Buffer) error {
+	f, err := os.Open(b.Path())
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	line, col := b.Line(), b.Column()
+	if err := b.Load(f); err != nil {
+		return err
+	}
+	b.GotoLine(line, col)
+	return nil
+}
+
+// relative returns path relative to root if it is under it.
+func relative(root, path string) string {
+	if rel, err := filepath.Rel(root, path); err == nil && !strings.HasPrefix(rel, "..") {
+		return rel
+	}
+	return path
+}
@@ -0,0 +1,64 @@
+package editor
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/avalonbits/goted/text"
+)
+
+// guarded runs op, which the guard of the commands refuses with ErrCanceled when it needs an
+// answer, since none can be waited for while op runs. The question is then asked on the status
+// line and op run again, with it answered, if the answer is y. Sessions whose questions are
+// answered without the user, see RunScript, just run op.
+func (e *Editor) guarded(op func() error) error {
+	g := &e.Commands.Guard
+	if g.Confirm != nil {
+		return op()
+	}
+	var asked string
+	g.Confirm = func(question string) bool {
+		if asked == "" {
+			asked = question
+		}
+		return false
+	}
+	err := op()
+	g.Confirm = nil
+	if !errors.Is(err, ErrCanceled) || asked == "" {
+		return err
+	}
+
+	e.Prompt(asked+" (y/n) ", false, func(answer string) error {
+		if !yes(answer) {
+			e.message = "canceled"
+			return nil
+		}
+		g.Confirm = func(question string) bool { return question == asked }
+		defer func() { g.Confirm = nil }()
+		return op()
+	})
+	return nil
+}
+
+// yes reports whether answer is yes to a y/n question.
+func yes(answer string) bool {
+	switch strings.ToLower(strings.TrimSpace(answer)) {
+	case "y", "yes":
+		return true
+	}
+	return false
+}
+
+// Close closes b, asking first if it has unsaved changes. A narrowed buffer is widened back
+// into its parent instead, see Widen.
+func (e *Editor) Close(b *text.Buffer) error {
+	if _, ok := e.narrowings[b]; ok {
+		return e.Widen(b)
+	}
+	if !e.Commands.Guard.Close(b) {
+		return ErrCanceled
+	}
+	e.remove(b)
+	return nil
+}
@@ -0,0 +1,52 @@
+package editor
+
+import (
+	"github.com/avalonbits/goted/profile"
+	"github.com/avalonbits/goted/syntax"
+	"github.com/avalonbits/goted/text"
+)
+
+// highlightStates are the states the lines of a buffer start in for the stateful highlighter of
+// its file type, as of a version of the buffer.
+type highlightStates struct {
+	fileType string
+	version  int
+	states   *syntax.States
+}
+
+// highlightStates returns the states of the lines of b for hl, the highlighter of its file type,
+// or nil if hl is not stateful. Edits drop the states from the line they change on, see
+// invalidateStates, and the states start over when b changes otherwise, as when it is loaded
+// again, or when its file type changes.
+func (e *Editor) highlightStates(b *text.Buffer, hl syntax.Highlighter) *syntax.States {
+	fileType := b.Options().FileType
+	h, ok := e.highlights[b]
+	if !ok || h.fileType != fileType || h.version != b.Version() {
+		states := syntax.NewStates(hl)
+		if states == nil {
+			delete(e.highlights, b)
+			return nil
+		}
+		h = &highlightStates{fileType: fileType, version: b.Version(), states: states}
+		e.highlights[b] = h
+	}
+	return h.states
+}
+
+// invalidateStates drops the states of the lines of b after the one c changed.
+func (e *Editor) invalidateStates(b *text.Buffer, c text.Change) {
+	if h, ok := e.highlights[b]; ok {
+		h.states.Invalidate(b.LineOf(c.Offset))
+		h.version = b.Version()
+	}
+}
+
+// preload builds the highlighters of the file types of the open buffers, so switching to one
+// does not wait for its grammar. It runs once the first frame is drawn, which only builds the
+// highlighter of the buffer shown.
+func (e *Editor) preload() {
+	defer profile.StartPhase(profile.Preload)()
+	for _, b := range e.buffers {
+		syntax.ForFileType(b.Options().FileType)
+	}
+}
@@ -0,0 +1,219 @@
+package editor
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/avalonbits/goted/command"
+	"github.com/avalonbits/goted/config"
+	"github.com/avalonbits/goted/format"
+	"github.com/avalonbits/goted/history"
+	"github.com/avalonbits/goted/profile"
+	"github.com/avalonbits/goted/text"
+)
+
+// historyPrefix starts the paths of the buffers listing the saves of a file, followed by its
+// path, and of those showing one of them, followed by "@" and its time.
+const historyPrefix = "history:"
+
+// historyKeys are the bindings of history listings, over the global ones.
+var historyKeys = command.Keymap{
+	"Enter":    "history-open",
+	"Ctrl+K d": "history-diff",
+	"Ctrl+K r": "history-restore",
+}
+
+// timeline is a history listing: the file it lists the saves of and those saves, one per line
+// after the heading, newest first.
+type timeline struct {
+	path     string
+	versions []history.Version
+}
+
+// keepHistory keeps what b was saved as in the history, if s enables it, warning when it
+// cannot. Files not on the local disk are left out, and so are encrypted ones, which would be
+// kept in the clear.
+func (e *Editor) keepHistory(b *text.Buffer, s config.Settings) {
+	if !s.History || !filepath.IsAbs(b.Path()) || !inClear(b) {
+		return
+	}
+	var data bytes.Buffer
+	_, err := b.WriteTo(&data)
+	if err == nil {
+		err = history.Save(b.Path(), data.Bytes(), time.Now(), s.HistoryLimits)
+	}
+	if err != nil {
+		profile.Logf("history of %s: %v", b.Path(), err)
+		e.message = "warning: history: " + err.Error()
+	}
+}
+
+// ShowHistory lists the saves kept of the file of b, newest first, in a buffer in which Enter
+// shows the save under the cursor, Ctrl+K d compares it with the file as it is now and Ctrl+K r
+// restores it. In a listing, it lists the saves of its file again.
+func (e *Editor) ShowHistory(b *text.Buffer) error {
+	path := b.Path()
+	if t, ok := e.timelines[b]; ok {
+		path = t.path
+	}
+	if !filepath.IsAbs(path) {
+		return fmt.Errorf("%w: history needs a file", command.ErrUsage)
+	}
+	versions, err := history.List(path)
+	if err != nil {
+		return err
+	}
+	if len(versions) == 0 {
+		return fmt.Errorf("%w: no saves of %s are kept", command.ErrUsage, path)
+	}
+
+	var list strings.Builder
+	fmt.Fprintf(&list, "# %d saves of %s\n", len(versions), path)
+	for _, v := range versions {
+		fmt.Fprintf(&list, "%s  %d bytes\n", versionTime(v), v.Size)
+	}
+	if err := e.preview(historyPrefix+path, list.String()); err != nil {
+		return err
+	}
+	lb := e.Current()
+	lb.GotoLine(1, 0)
+	e.keymaps[lb] = historyKeys
+	e.timelines[lb] = &timeline{path: path, versions: versions}
+	return nil
+}
+
+// versionTime returns the time of v as listings show it.
+func versionTime(v history.Version) string {
+	return v.Time.Local().Format("2006-01-02 15:04:05")
+}
+
+// pickedVersion returns the listing b and the save on the line of its cursor.
+func (e *Editor) pickedVersion(b *text.Buffer) (*timeline, history.Version, error) {
+	t, ok := e.timelines[b]
+	if !ok {
+		return nil, history.Version{}, fmt.Errorf("%w: not a history listing", command.ErrUsage)
+	}
+	n := b.Line() - 1
+	if n < 0 || n >= len(t.versions) {
+		return nil, history.Version{}, fmt.Errorf("%w: no save on this line", command.ErrUsage)
+	}
+	return t, t.versions[n], nil
+}
+
+// versionText returns the text of v, decoded as the file would be when opened.
+func versionText(v history.Version) ([]rune, error) {
+	data, err := v.Read()
+	if err != nil {
+		return nil, err
+	}
+	return decode(data)
+}
+
+// decode returns the text of the contents of a file, as it would be loaded.
+func decode(data []byte) ([]rune, error) {
+	b := text.New(minSize)
+	if err := b.Load(bytes.NewReader(data)); err != nil {
+		return nil, err
+	}
+	return b.Text(0, b.Len()), nil
+}
+
+// historyOpen shows the save under the cursor of the listing b, read-only and highlighted as
+// its file is.
+func (e *Editor) historyOpen(b *text.Buffer) error {
+	t, v, err := e.pickedVersion(b)
+	if err != nil {
+		return err
+	}
+	content, err := versionText(v)
+	if err != nil {
+		return err
+	}
+	if err := e.preview(historyPrefix+t.path+"@"+versionTime(v), string(content)); err != nil {
+		return err
+	}
+	vb := e.Current()
+	o := vb.Options()
+	o.FileType = text.DetectFileType(t.path)
+	vb.SetOptions(o)
+	vb.SetReadOnly(true)
+	return nil
+}
+
+// historyDiff shows how the file of the listing b changed since the save under the cursor,
+// as a diff, from its buffer if it is open and from the disk otherwise.
+func (e *Editor) historyDiff(b *text.Buffer) error {
+	t, v, err := e.pickedVersion(b)
+	if err != nil {
+		return err
+	}
+	old, err := versionText(v)
+	if err != nil {
+		return err
+	}
+	var now []rune
+	if i := slices.IndexFunc(e.buffers, func(b *text.Buffer) bool { return b.Path() == t.path }); i >= 0 {
+		now = e.buffers[i].Text(0, e.buffers[i].Len())
+	} else {
+		data, err := os.ReadFile(t.path)
+		if err != nil {
+			return err
+		}
+		if now, err = decode(data); err != nil {
+			return err
+		}
+	}
+
+	name := t.path + "@" + versionTime(v)
+	diff := text.UnifiedDiff(name, t.path, diffLines(old), diffLines(now), 3)
+	if diff == "" {
+		e.message = "no changes since " + versionTime(v)
+		return nil
+	}
+	if err := e.preview(historyPrefix+name+".diff", diff); err != nil {
+		return err
+	}
+	db := e.Current()
+	o := db.Options()
+	o.FileType = "diff"
+	db.SetOptions(o)
+	db.SetReadOnly(true)
+	return nil
+}
+
+// diffLines splits text into lines for a diff, without their line breaks.
+func diffLines(text []rune) []string {
+	s := strings.TrimSuffix(string(text), "\n")
+	if s == "" {
+		return nil
+	}
+	return strings.Split(s, "\n")
+}
+
+// historyRestore puts the text of the save under the cursor of the listing b back in the
+// buffer of its file, opening it if needed, as one edit that can be undone. The buffer is not
+// written.
+func (e *Editor) historyRestore(b *text.Buffer) error {
+	t, v, err := e.pickedVersion(b)
+	if err != nil {
+		return err
+	}
+	content, err := versionText(v)
+	if err != nil {
+		return err
+	}
+	fb, err := e.Open(t.path)
+	if err != nil {
+		return err
+	}
+	if _, err := format.Reformat(fb, content); err != nil {
+		return err
+	}
+	e.message = "restored the save of " + versionTime(v)
+	return nil
+}
@@ -0,0 +1,114 @@
+package editor
+
+import (
+	"fmt"
+	"image"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/avalonbits/goted/command"
+	"github.com/avalonbits/goted/graphics"
+	"github.com/avalonbits/goted/screen"
+	"github.com/avalonbits/goted/term"
+	"github.com/avalonbits/goted/text"
+)
+
+// imagePrefix starts the paths of image previews, followed by the path of the image file.
+const imagePrefix = "image:"
+
+// imageExtensions are the extensions of the files opened as image previews.
+var imageExtensions = map[string]bool{".png": true, ".jpg": true, ".jpeg": true, ".gif": true}
+
+// shownImage is the image of an image preview, with the escape sequence drawing it at the size
+// it was last drawn at.
+type shownImage struct {
+	img        image.Image
+	cols, rows int
+	seq        string
+}
+
+// PreviewImage shows the PNG, JPEG or GIF image in the file at path in a read-only buffer, drawn
+// inline with the image protocol of the terminal, see the images setting. The buffer holds a
+// line describing the image, and is all there is on terminals that cannot show images.
+func (e *Editor) PreviewImage(path string) (*text.Buffer, error) {
+	abs, err := filepath.Abs(path)
+	if err != nil {
+		return nil, err
+	}
+	img, format, err := graphics.Decode(abs)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+
+	size := img.Bounds().Size()
+	desc := fmt.Sprintf("%s  %d×%d %s", filepath.Base(abs), size.X, size.Y, format)
+	if e.imageProtocol() == graphics.None {
+		desc += "  (this terminal cannot show images, see the images setting)"
+	}
+	if err := e.preview(imagePrefix+abs, desc); err != nil {
+		return nil, err
+	}
+	b := e.Current()
+	b.SetReadOnly(true)
+	e.images[b] = &shownImage{img: img}
+	return b, nil
+}
+
+// previewImage is the preview-image command, showing the file named or the one of b.
+func (e *Editor) previewImage(b *text.Buffer, args []string) error {
+	path := b.Path()
+	switch {
+	case len(args) == 1:
+		path = args[0]
+	case len(args) > 1:
+		return fmt.Errorf("%w: preview-image takes at most a file name", command.ErrUsage)
+	case !filepath.IsAbs(path):
+		return fmt.Errorf("%w: preview-image needs a file name", command.ErrUsage)
+	}
+	_, err := e.PreviewImage(path)
+	return err
+}
+
+// isImage reports whether the file at path is opened as an image preview.
+func isImage(path string) bool {
+	if !imageExtensions[strings.ToLower(filepath.Ext(path))] {
+		return false
+	}
+	info, err := os.Stat(path)
+	return err == nil && info.Mode().IsRegular()
+}
+
+// imageProtocol returns the protocol images are drawn with on the terminal of the session,
+// None in headless sessions.
+func (e *Editor) imageProtocol() graphics.Protocol {
+	if e.Terminal == nil {
+		return graphics.None
+	}
+	return e.protocol
+}
+
+// drawImage draws the image of the preview b, if it is one, in body below its first line and
+// returns the graphics for the screen to show.
+func (e *Editor) drawImage(b *text.Buffer, body screen.Rect) []screen.Graphic {
+	si, ok := e.images[b]
+	p := e.imageProtocol()
+	if !ok || p == graphics.None || body.Height < 2 {
+		return nil
+	}
+	cw, ch, _ := term.CellSize(e.Terminal.Out)
+	size := si.img.Bounds().Size()
+	cols, rows := graphics.Fit(size.X, size.Y, body.Width, body.Height-1, cw, ch)
+	if cols == 0 {
+		return nil
+	}
+	if si.seq == "" || cols != si.cols || rows != si.rows {
+		seq, err := graphics.Encode(p, si.img, cols, rows, cw, ch)
+		if err != nil {
+			e.message = err.Error()
+			return nil
+		}
+		si.seq, si.cols, si.rows = seq, cols, rows
+	}
+	return []screen.Graphic{{X: body.X, Y: body.Y + 1, Seq: si.seq, Clear: graphics.Clear(p)}}
+}
@@ -0,0 +1,80 @@
+package editor
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/avalonbits/goted/command"
+	"github.com/avalonbits/goted/indent"
+	"github.com/avalonbits/goted/text"
+)
+
+// errNoIndent is returned when the indentation of a buffer cannot be told from its text.
+var errNoIndent = fmt.Errorf("%w: too few lines are indented to tell how", command.ErrUsage)
+
+// indentStyle is the indentation of a buffer as it was detected or set, such as "spaces:4", for
+// the status line. Buffers whose indentation was neither hold "".
+var indentStyle = NewVar("indent", "")
+
+// detectIndent sets tabs or spaces, and the width of spaces, from how the lines of b are
+// indented. Returns false if too few of them are to tell.
+func (e *Editor) detectIndent(b *text.Buffer) bool {
+	style, ok := indent.Detect(func(n int) string {
+		line, _ := b.LineRunes(n)
+		return string(line)
+	}, b.Lines())
+	if !ok {
+		return false
+	}
+	e.useIndent(b, style)
+	return true
+}
+
+// useIndent makes b indent in style.
+func (e *Editor) useIndent(b *text.Buffer, style indent.Style) {
+	o := b.Options()
+	o.ExpandTab = !style.Tabs
+	if !style.Tabs {
+		o.TabWidth = style.Width
+	}
+	b.SetOptions(o)
+	indentStyle.Set(e, b, style.String())
+}
+
+// setIndent makes b indent with tabs, or with spaces, as many as the second argument says or
+// the tab width, as the first argument says, or as detected from its text for "detect". It
+// only changes how new lines are indented; retab rewrites those there are. With no
+// arguments, it tells how b indents.
+func (e *Editor) setIndent(b *text.Buffer, args []string) error {
+	o := b.Options()
+	current := indent.Style{Tabs: !o.ExpandTab, Width: o.TabWidth}
+	if len(args) == 0 {
+		e.message = "indenting with " + current.String()
+		return nil
+	}
+
+	style := indent.Style{Width: o.TabWidth}
+	switch {
+	case args[0] == "detect" && len(args) == 1:
+		if !e.detectIndent(b) {
+			return errNoIndent
+		}
+		e.message = "detected " + indentStyle.Get(e, b)
+		return nil
+	case args[0] == "tabs" && len(args) == 1:
+		style.Tabs = true
+	case args[0] == "spaces" && len(args) <= 2:
+		if len(args) == 2 {
+			n, err := strconv.Atoi(args[1])
+			if err != nil || n <= 0 {
+				return fmt.Errorf("%w: bad width %q", command.ErrUsage, args[1])
+			}
+			style.Width = n
+		}
+	default:
+		return fmt.Errorf("%w: set-indent takes tabs, spaces [width] or detect", command.ErrUsage)
+	}
+	e.useIndent(b, style)
+	e.message = "indenting with " + style.String()
+	return nil
+}
@@ -0,0 +1,111 @@
+package editor
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	"github.com/avalonbits/goted/command"
+	"github.com/avalonbits/goted/term"
+	"github.com/avalonbits/goted/text"
+)
+
+// Key handles a key chord typed by the user, named as in command.Keymap. While a question is
+// asked, see Prompt, keys answer it, while a popup is shown, its keys go to it, and while a
+// character is composed, see ComposeChar, they compose it. Clicks go to the scrollbar. A key
+// bound to a command, by the keymap of the current buffer if it has one or by the global one,
+// runs it, a key starting longer bindings waits for the next keys and a character with no
+// binding is inserted. Other keys are ignored. Pasted text, which term.Keys returns as one key,
+// is inserted as it is. Text typed and pasted is recorded for repeat, as commands are. Commands
+// and pastes the guard asks about run once the question is answered y, see guarded.
+func (e *Editor) Key(key string) error {
+	e.Idle.Touch()
+	defer func() {
+		if len(e.unsynced) > 0 {
+			e.Idle.Schedule("undo-files", e.syncUndoFiles)
+		}
+	}()
+	if ok, err := e.promptKey(key); ok {
+		return err
+	}
+
+	if e.popup != nil && e.popupKey(key) {
+		return nil
+	}
+	if e.composer != nil {
+		return e.composeKey(key)
+	}
+	if x, y, ok := term.Clicked(key); ok {
+		e.pending = nil
+		e.click(x, y)
+		return nil
+	}
+	defer e.updateSignature(key)
+
+	b := e.ensure()
+	defer e.cursorMoved(b, b.Line(), b.Column())
+	if _, ok := e.pickers[b]; ok {
+		defer e.refreshPicker(b)
+	}
+	if text, ok := term.Pasted(key); ok {
+		e.pending = nil
+		return e.guarded(func() error { return e.paste(b, text) })
+	}
+
+	keys := append(e.pending, key)
+	cmd, prefix := e.Keymap.Lookup(keys)
+	if local, ok := e.keymaps[b]; ok {
+		if c, p := local.Lookup(keys); c != "" || p {
+			cmd, prefix = c, p
+		}
+	}
+	if prefix {
+		e.pending = keys
+		return nil
+	}
+
+	e.pending = nil
+	switch {
+	case cmd != "":
+		name, args, err := command.Parse(cmd)
+		if err != nil {
+			return err
+		}
+		return e.guarded(func() error { return e.Commands.Run(b, name, args...) })
+	case len(keys) > 1:
+		return nil
+	case key == "Space":
+		return e.Commands.Type(b, []rune{' '}, false)
+	case utf8.RuneCountInString(key) == 1:
+		return e.Commands.Type(b, []rune(key), b.Options().AutoWrap)
+	}
+	return nil
+}
+
+// Pending returns the prefix keys waiting for the rest of a binding, so the keys that may follow
+// can be shown.
+func (e *Editor) Pending() []string {
+	return e.pending
+}
+
+// ensure returns the current buffer, first opening an empty buffer bound to no file if there is
+// none.
+func (e *Editor) ensure() *text.Buffer {
+	if len(e.buffers) == 0 {
+		e.add(text.New(minSize))
+	}
+	return e.Current()
+}
+
+// paste inserts pasted text at the cursor of b in one edit, undone in one step. The text goes
+// in as it came, without the indentation or wrapping typing it would get, and the buffer is
+// highlighted again once, when it is next drawn. Pastes of more lines than the guard allows
+// are asked about first.
+func (e *Editor) paste(b *text.Buffer, text string) error {
+	if text == "" {
+		return nil
+	}
+	if !e.Commands.Guard.Paste(strings.Count(text, "\n") + 1) {
+		return ErrCanceled
+	}
+	return e.Commands.Type(b, []rune(text), false)
+}
@@ -0,0 +1,28 @@
+package editor
+
+import (
+	"github.com/avalonbits/goted/format"
+	"github.com/avalonbits/goted/text"
+)
+
+// jsonPathAt is the JSONPath of the value under the cursor of a JSON buffer at offset cursor,
+// as of version.
+type jsonPathAt struct {
+	version, cursor int
+	path            string
+}
+
+// jsonPath keeps the JSONPath shown on the status line of a JSON buffer, so that it is only
+// worked out again when the buffer is edited or the cursor moves.
+var jsonPath = NewVar("json-path", jsonPathAt{version: -1})
+
+// bufferJSONPath returns the JSONPath of the value under the cursor of the JSON buffer b, such
+// as $.items[2].name.
+func (e *Editor) bufferJSONPath(b *text.Buffer) string {
+	at := jsonPath.Get(e, b)
+	if at.version != b.Version() || at.cursor != b.Cursor() {
+		at = jsonPathAt{version: b.Version(), cursor: b.Cursor(), path: format.BufferJSONPath(b)}
+		jsonPath.Set(e, b, at)
+	}
+	return at.path
+}
@@ -0,0 +1,89 @@
+package editor
+
+import (
+	"fmt"
+
+	"github.com/avalonbits/goted/command"
+	"github.com/avalonbits/goted/text"
+	"github.com/avalonbits/goted/view"
+)
+
+// followModes are the arguments of link-scroll, by the way they keep two windows together.
+var followModes = map[string]view.FollowMode{
+	"line":    view.FollowLine,
+	"percent": view.FollowPercent,
+}
+
+// linkScroll links the scrolling of b in this window to that of the buffer current in the other
+// window, by line unless args is percent, or unlinks them if they already are.
+func (e *Editor) linkScroll(b *text.Buffer, args []string) error {
+	mode := view.FollowLine
+	if len(args) > 0 {
+		m, ok := followModes[args[0]]
+		if len(args) > 1 || !ok {
+			return fmt.Errorf("%w: link-scroll takes line or percent", command.ErrUsage)
+		}
+		mode = m
+	}
+	w := e.otherWindow()
+	if w == nil {
+		return fmt.Errorf("%w: no other window to link to", command.ErrUsage)
+	}
+	other, ok := w.viewports[w.current]
+	if !ok {
+		return fmt.Errorf("%w: the other window shows nothing yet", command.ErrUsage)
+	}
+	v := e.viewport(b)
+	if v == other {
+		return fmt.Errorf("%w: both windows show the same view", command.ErrUsage)
+	}
+
+	if e.links.Toggle(v, other, mode) {
+		e.message = e.tr("scrolling linked to the other window")
+	} else {
+		e.message = e.tr("scrolling unlinked")
+	}
+	return nil
+}
+
+// otherWindow returns the window linked scrolling pairs this one with: the terminal attached
+// last from the terminal of the editor, and the terminal of the editor from an attached one.
+// Returns nil if no terminal is attached.
+func (e *Editor) otherWindow() *window {
+	switch {
+	case len(e.windows) == 0:
+		return nil
+	case e.focused == nil:
+		return e.windows[len(e.windows)-1]
+	default:
+		return e.home
+	}
+}
+
+// scrolled moves the viewports linked to that of the current buffer after keys in this window
+// moved its cursor, which it follows first.
+func (e *Editor) scrolled() {
+	b := e.Current()
+	v := e.viewport(b)
+	if !e.links.Linked(v) {
+		return
+	}
+	v.Follow(b.Line(), b.Lines())
+	e.links.Scrolled(v, e.viewportLines)
+}
+
+// viewportLines returns the number of lines of the buffer v shows, in any window.
+func (e *Editor) viewportLines(v *view.Viewport) int {
+	windows := append([]*window{{viewports: e.viewports}}, e.windows...)
+	if e.home != nil {
+		windows = append(windows, e.home)
+	}
+	for _, w := range windows {
+		for b, wv := range w.viewports {
+			if wv == v {
+				return b.Lines()
+			}
+		}
+	}
+	return 1
+}
@@ -0,0 +1,39 @@
+package editor
+
+import (
+	"os"
+	"strconv"
+	"strings"
+)
+
+// SplitLocation splits a file argument of the form file:line or file:line:col, as printed by
+// compilers and grep, into the path and the 1-based line and column. line and col are 0 when
+// not given. Arguments naming an existing file are never split.
+func SplitLocation(arg string) (path string, line, col int) {
+	if _, err := os.Stat(arg); err == nil {
+		return arg, 0, 0
+	}
+
+	path = arg
+	var nums []int
+	for range 2 {
+		i := strings.LastIndexByte(path, ':')
+		if i <= 0 {
+			break
+		}
+		n, err := strconv.Atoi(path[i+1:])
+		if err != nil || n < 1 {
+			break
+		}
+		nums = append([]int{n}, nums...)
+		path = path[:i]
+	}
+
+	switch len(nums) {
+	case 1:
+		return path, nums[0], 0
+	case 2:
+		return path, nums[0], nums[1]
+	}
+	return arg, 0, 0
+}
@@ -0,0 +1,377 @@
+package editor
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"time"
+
+	"github.com/avalonbits/goted/config"
+	"github.com/avalonbits/goted/instance"
+	"github.com/avalonbits/goted/profile"
+	"github.com/avalonbits/goted/render"
+	"github.com/avalonbits/goted/screen"
+	"github.com/avalonbits/goted/syntax"
+	"github.com/avalonbits/goted/term"
+	"github.com/avalonbits/goted/text"
+	"github.com/avalonbits/goted/view"
+)
+
+// ErrQuit is returned by the quit commands to end Run.
+var ErrQuit = errors.New("editor: quit")
+
+// FrameBudget is the shortest time between two frames. Keys arriving faster, as when a key is
+// held or text is pasted, are all handled before the next frame is drawn, so a slow terminal
+// makes the editor skip frames instead of falling behind the keyboard.
+const FrameBudget = time.Second / 60
+
+// Run shows the session on the terminal, with Files opened, and handles input until a quit
+// command runs or the editor is killed. Files sent by other invocations of goted arrive on
+// requests, which may be nil, and so do the terminals they attach, each shown its own window of
+// the session until it quits. Attached terminals are detached once the editor exits.
+//
+// The terminal is first asked for its background color, to choose the theme when it is chosen
+// automatically. Input is then read in the background and everything that arrived is decoded
+// and handled at once, or once a paste that started has all arrived.
+// The screen is then drawn at most once per FrameBudget, and idle tasks run when nothing else
+// is happening. Followed files are checked every followInterval, and what they add is drawn
+// at most once per AsyncBudget. Work the first frame does not need, such as building the
+// highlighters of the buffers not shown, is left to an idle task after it.
+func (e *Editor) Run(requests <-chan instance.Request) error {
+	done := profile.StartPhase(profile.Terminal)
+	t, err := term.Open()
+	if err != nil {
+		return err
+	}
+	t.Mouse = e.showScrollbar
+	if err := t.Start(); err != nil {
+		return err
+	}
+	defer t.Stop()
+
+	width, height, err := term.Size(t.Out)
+	if err != nil || width <= 0 || height <= 0 {
+		width, height = 80, 24
+	}
+	e.Terminal, e.Screen = t, screen.New(t.Out, width, height)
+	defer func() { e.Terminal, e.Screen = nil, nil }()
+	defer e.SavePositions()
+	defer e.SaveRecent()
+	defer e.SaveBookmarks()
+	defer e.detachAll()
+	e.openFiles(e.Files, e.ReadOnly)
+	e.ensure()
+	rest := e.detectBackground(t)
+	done()
+	firstFrame := profile.StartPhase(profile.FirstRender)
+
+	input := make(chan []byte, 64)
+	go read(t.In, input)
+
+	signals := make(chan os.Signal, 4)
+	term.NotifySuspend(signals)
+	term.NotifyTerminate(signals)
+	defer signal.Stop(signals)
+	resized := make(chan os.Signal, 1)
+	term.NotifyResize(resized)
+	defer signal.Stop(resized)
+
+	ticker := time.NewTicker(followInterval)
+	defer ticker.Stop()
+
+	windows := make(chan windowEvent, 64)
+
+	var last time.Time
+	dirty := true
+	for {
+		var async <-chan time.Time
+		if wait, ok := e.asyncRedraw(time.Now()); ok && wait <= 0 {
+			dirty = true
+		} else if ok {
+			async = time.After(wait)
+		}
+		if dirty && time.Since(last) >= FrameBudget {
+			if err := e.draw(); err != nil {
+				return err
+			}
+			if firstFrame != nil {
+				firstFrame()
+				firstFrame = nil
+				e.Idle.Schedule("preload", e.preload)
+			}
+			e.describe()
+			e.drawWindows()
+			last, dirty = time.Now(), false
+		}
+
+		var frame, escape <-chan time.Time
+		if dirty {
+			frame = time.After(FrameBudget - time.Since(last))
+		}
+		if term.Escaping(rest) {
+			escape = time.After(term.EscTimeout)
+		}
+		var idle <-chan time.Time
+		if wait := e.Idle.Wait(); wait >= 0 && !dirty {
+			idle = time.After(wait)
+		}
+		var poll <-chan time.Time
+		if len(e.followers) > 0 {
+			poll = ticker.C
+		}
+
+		select {
+		case data, ok := <-input:
+			if !ok {
+				return io.ErrUnexpectedEOF
+			}
+			rest = append(rest, data...)
+			added := len(data)
+			for more := true; more; {
+				select {
+				case data, ok := <-input:
+					rest, more = append(rest, data...), ok
+					added += len(data)
+				default:
+					more = false
+				}
+			}
+			if term.Pasting(rest, added) {
+				continue
+			}
+			var keys []string
+			keys, rest = term.Keys(rest, false)
+			if err := e.keys(keys); err != nil {
+				return nil
+			}
+			dirty = true
+		case <-escape:
+			var keys []string
+			keys, rest = term.Keys(rest, true)
+			if err := e.keys(keys); err != nil {
+				return nil
+			}
+			dirty = true
+		case sig := <-signals:
+			if e.Signal(sig) {
+				return nil
+			}
+			dirty = true
+		case <-resized:
+			if w, h, err := term.Size(t.Out); err == nil {
+				e.Screen.Resize(w, h)
+			}
+			dirty = true
+		case r := <-requests:
+			if r.Client != nil {
+				e.attach(r, windows)
+				dirty = true
+				continue
+			}
+			e.openFiles(r.Files, false)
+			dirty = true
+		case we := <-windows:
+			e.handleWindow(we)
+			dirty = true
+		case <-poll:
+			if e.pollFollowers() {
+				dirty = true
+			}
+		case <-async:
+		case <-frame:
+		case <-idle:
+			e.Idle.Run(FrameBudget / 2)
+		}
+	}
+}
+
+// read sends what is read from r on c until it fails, then closes c.
+func read(r io.Reader, c chan<- []byte) {
+	buf := make([]byte, 4096)
+	for {
+		n, err := r.Read(buf)
+		if n > 0 {
+			c <- append([]byte(nil), buf[:n]...)
+		}
+		if err != nil {
+			close(c)
+			return
+		}
+	}
+}
+
+// keys handles keys in order. Errors are shown on the status line, except ErrQuit, which is
+// returned. The windows linked to this one are scrolled along after the keys. With profiling
+// on, buffers whose edits move a lot of text across the gaps of their blocks are reported, once
+// each.
+func (e *Editor) keys(keys []string) error {
+	for _, key := range keys {
+		e.message = ""
+		done := profile.Start(profile.Input)
+		err := e.Key(key)
+		done()
+		if errors.Is(err, ErrQuit) {
+			return err
+		} else if err != nil {
+			e.message = err.Error()
+		}
+	}
+	e.scrolled()
+
+	b := e.Current()
+	if !profile.Enabled() {
+		return nil
+	}
+	if !e.churned[b] && b.Churn().High() {
+		e.churned[b] = true
+		e.message = "gap buffer churn is high: " + b.Churn().String()
+	}
+	return nil
+}
+
+// quit returns ErrQuit, unless some buffer has unsaved changes and force is not set. In the
+// window of an attached terminal, which quitting only detaches, it always does.
+func (e *Editor) quit(force bool) error {
+	if force || e.focused != nil {
+		return ErrQuit
+	}
+	n := 0
+	for _, b := range e.buffers {
+		if b != e.Output && unsaved(b) || e.narrowChanged(b) {
+			n++
+		}
+	}
+	if n > 0 {
+		return fmt.Errorf("%d buffers have unsaved changes: run quit-discard to quit anyway", n)
+	}
+	return ErrQuit
+}
+
+// viewport returns the viewport showing b, creating it if needed.
+func (e *Editor) viewport(b *text.Buffer) *view.Viewport {
+	v, ok := e.viewports[b]
+	if !ok {
+		v = &view.Viewport{ScrollOff: config.Default().ScrollOff}
+		e.viewports[b] = v
+	}
+	if e.Screen != nil {
+		_, height := e.Screen.Size()
+		v.Height = max(height-1, 1)
+	}
+	return v
+}
+
+// draw draws the current buffer above a status line and flushes the screen, with the outline
+// on its left and its Markdown preview on its right if they are shown for it. The status line
+// of prose buffers shows their word count, or that of the selection, that of JSON buffers the
+// path of the value under the cursor, that of tables the column of the cursor, and that of a
+// bookmarked line its note. Secrets are masked in redact mode. A question being asked takes the
+// status line over, with the cursor. A buffer whose scrolling is linked to another window stays
+// where that window scrolled it, even if its cursor is out of view.
+func (e *Editor) draw() error {
+	defer profile.Start(profile.Render)()
+
+	g := e.Screen.Grid()
+	width, height := g.Size()
+	th := e.Commands.Theme
+	body := screen.Rect{Width: width, Height: max(height-1, 0)}
+
+	b := e.Current()
+	shown := b
+	var x, y int
+	var ok bool
+	if o := e.outline; o != nil && (b == o.buffer || b == o.source) && width >= 3*minOutline {
+		shown = o.source
+		body, x, y, ok = e.drawOutline(g, body)
+	}
+
+	v := e.viewport(shown)
+	if !e.links.Linked(v) {
+		v.Follow(shown.Line(), shown.Lines())
+	}
+	body = e.drawMarkdownPreview(g, body, shown, v.Top)
+	body = e.drawScrollbar(g, body, shown, v)
+	opts := render.Options{TabWidth: shown.Options().TabWidth, Guides: shown.Options().Guides, Wrap: e.InMode(shown, "wrap"), Redact: e.redactSpans(shown)}
+	opts.ElasticTabs = shown.Options().ElasticTabs
+	opts.Emphasis = emphasis(e.wordDiffSpans(shown), e.testerSpans(shown))
+	if cs := shown.Conflicts(); len(cs) > 0 {
+		opts.LineScope = func(n int) string { return conflictScope(cs, n) }
+	} else if marked := e.bookmarkedLines(shown); marked != nil {
+		opts.LineScope = func(n int) string {
+			if marked[n] {
+				return "ui.bookmark"
+			}
+			return ""
+		}
+	}
+	hl := syntax.ForFileType(shown.Options().FileType)
+	opts.States = e.highlightStates(shown, hl)
+	sx, sy, sok := render.Draw(g, body, shown, v.Top, hl, th, opts)
+	e.drawPopup(g, body, shown, v.Top, opts)
+	e.Screen.SetGraphics(e.drawImage(shown, body))
+	if shown == b {
+		x, y, ok = sx, sy, sok
+	}
+	e.drawn(time.Now(), b, shown)
+
+	status := screen.Rect{Y: height - 1, Width: width, Height: 1}
+	style := th.Style("ui.status")
+	if style.FG == "" {
+		style.FG = th.Background
+	}
+	if style.BG == "" {
+		style.BG = th.Foreground
+	}
+	g.Fill(status, style)
+	name := e.tr("[no file]")
+	if b.Path() != "" {
+		name = filepath.Base(b.Path())
+	}
+	if unsaved(b) {
+		name += " +"
+	}
+	for _, m := range e.Modes(b) {
+		name += " " + e.tr("["+m+"]")
+	}
+	if e.redacting {
+		name += " " + e.tr("[redact]")
+	}
+	pos := fmt.Sprintf("%d:%d", b.Line()+1, b.Column()+1)
+	if ft := b.Options().FileType; ft == "text" || ft == "markdown" {
+		words := b.Stats().Words
+		if s, ok := b.SelectionStats(); ok {
+			words = s.Words
+		}
+		pos += "  " + e.tr(fmt.Sprintf("%d words", words))
+	}
+	if style := indentStyle.Get(e, b); style != "" {
+		pos += "  " + style
+	}
+	if b.Options().FileType == "json" {
+		pos += "  " + e.bufferJSONPath(b)
+	}
+	if column := e.csvStatus(b); column != "" {
+		pos += "  " + column
+	}
+	msg := e.tr(e.message)
+	if note, ok := e.lineNoteAt(b, b.Line()); ok && msg == "" && note != "" {
+		msg = e.tr("note: " + note)
+	}
+	g.Print(0, status.Y, fmt.Sprintf(" %s  %s  %s", name, pos, msg), style)
+	if len(e.prompts) > 0 {
+		text, col := e.prompts[0].line()
+		g.Fill(status, style)
+		g.Print(0, status.Y, text, style)
+		x, y, ok = min(col, width-1), status.Y, true
+	}
+
+	if ok {
+		e.Screen.ShowCursor(x, y)
+	} else {
+		e.Screen.HideCursor()
+	}
+	return e.Screen.Flush()
+}
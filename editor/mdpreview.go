@@ -0,0 +1,73 @@
+package editor
+
+import (
+	"fmt"
+
+	"github.com/avalonbits/goted/command"
+	"github.com/avalonbits/goted/markdown"
+	"github.com/avalonbits/goted/render"
+	"github.com/avalonbits/goted/screen"
+	"github.com/avalonbits/goted/text"
+)
+
+// minPreview is the narrowest the Markdown preview pane gets, in columns. It takes half of the
+// screen beside the buffer, and is not shown on screens too narrow for both.
+const minPreview = 20
+
+// markdownPane is the rendering of a Markdown buffer shown beside it, and whether it is shown:
+// the rendering is kept when it is hidden, since it follows every edit of the buffer.
+type markdownPane struct {
+	preview *markdown.Preview
+	shown   bool
+}
+
+// MarkdownPreview shows the rendering of the Markdown buffer b in a pane on its right, kept up
+// to date as b is edited and scrolled along with it, or hides it if it is shown.
+func (e *Editor) MarkdownPreview(b *text.Buffer) error {
+	if b.Options().FileType != "markdown" {
+		return fmt.Errorf("%w: markdown-preview needs a Markdown buffer", command.ErrUsage)
+	}
+	p, ok := e.markdownPanes[b]
+	if !ok {
+		p = &markdownPane{preview: markdown.NewPreview(b, minPreview)}
+		e.markdownPanes[b] = p
+	}
+	p.shown = !p.shown
+	return nil
+}
+
+// drawMarkdownPreview draws the preview of b on the right of body, from the line rendered from
+// top, the first line of b drawn, if it is shown, and returns the rest of body.
+func (e *Editor) drawMarkdownPreview(g *screen.Grid, body screen.Rect, b *text.Buffer, top int) screen.Rect {
+	p, ok := e.markdownPanes[b]
+	if !ok || !p.shown || body.Width < 2*minPreview+1 {
+		return body
+	}
+	th := e.Commands.Theme
+
+	side := body
+	side.Width = (body.Width - 1) / 2
+	side.X = body.X + body.Width - side.Width
+	p.preview.Resize(side.Width)
+	lines := p.preview.Lines()
+	rows := make([]render.PopupRow, len(lines))
+	for i, l := range lines {
+		rows[i] = render.PopupRow{Text: string(l.Text), Spans: l.Spans}
+	}
+	render.DrawRows(g, side, rows, p.preview.PreviewLine(top), th)
+
+	border := th.Style("ui.border")
+	if border.FG == "" {
+		border.FG = th.Foreground
+	}
+	if border.BG == "" {
+		border.BG = th.Background
+	}
+	for row := range side.Height {
+		g.Put(side.X-1, side.Y+row, "│", 1, border)
+	}
+
+	rest := body
+	rest.Width -= side.Width + 1
+	return rest
+}
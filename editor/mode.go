@@ -0,0 +1,202 @@
+package editor
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/avalonbits/goted/command"
+	"github.com/avalonbits/goted/event"
+	"github.com/avalonbits/goted/text"
+)
+
+// ErrNoMode is returned for the name of a mode that was not registered.
+var ErrNoMode = errors.New("editor: no such mode")
+
+// Var is a variable each buffer holds a value of its own of, for features and extensions to
+// keep what they know about a buffer without a map of their own. Buffers it was not set in
+// hold its default. Vars are told apart by identity rather than by name, so those of two
+// extensions never clash.
+type Var[T any] struct {
+	name string
+	def  T
+}
+
+// NewVar returns a buffer-local variable named name, which is only shown in messages, holding
+// def in every buffer until it is set.
+func NewVar[T any](name string, def T) *Var[T] {
+	return &Var[T]{name: name, def: def}
+}
+
+// Name returns the name of v.
+func (v *Var[T]) Name() string {
+	return v.name
+}
+
+// Get returns the value of v in b.
+func (v *Var[T]) Get(e *Editor, b *text.Buffer) T {
+	if val, ok := e.vars[b][v]; ok {
+		return val.(T)
+	}
+	return v.def
+}
+
+// Set sets the value of v in b.
+func (v *Var[T]) Set(e *Editor, b *text.Buffer, val T) {
+	vars, ok := e.vars[b]
+	if !ok {
+		vars = map[any]any{}
+		e.vars[b] = vars
+	}
+	vars[v] = val
+}
+
+// Unset makes b hold the default of v again.
+func (v *Var[T]) Unset(e *Editor, b *text.Buffer) {
+	delete(e.vars[b], v)
+}
+
+// Mode is a minor mode, such as wrap, that each buffer is in or not apart from the others.
+// Buffers in a mode show its name in the status line.
+type Mode struct {
+	// Name names the mode in commands and the status line.
+	Name string
+
+	// Persist remembers which files are in the mode, with their positions, to put them back in
+	// it when they are opened again.
+	Persist bool
+
+	// On reports whether b is in the mode, and Set turns it on or off, for modes whose state
+	// lives in the feature they belong to. Without them, the editor keeps the state and Set
+	// announces changes with an event.ModeChanged.
+	On  func(b *text.Buffer) bool
+	Set func(b *text.Buffer, on bool) error
+
+	state *Var[bool]
+}
+
+// RegisterMode adds the mode m, replacing the one of the same name if there is one.
+func (e *Editor) RegisterMode(m Mode) {
+	if m.On == nil || m.Set == nil {
+		m.state = NewVar(m.Name, false)
+	}
+	if i := slices.IndexFunc(e.modes, func(o *Mode) bool { return o.Name == m.Name }); i >= 0 {
+		e.modes[i] = &m
+		return
+	}
+	e.modes = append(e.modes, &m)
+}
+
+// mode returns the mode named name, or nil if there is none.
+func (e *Editor) mode(name string) *Mode {
+	if i := slices.IndexFunc(e.modes, func(m *Mode) bool { return m.Name == name }); i >= 0 {
+		return e.modes[i]
+	}
+	return nil
+}
+
+// InMode reports whether b is in the mode named name, false if there is no such mode.
+func (e *Editor) InMode(b *text.Buffer, name string) bool {
+	m := e.mode(name)
+	switch {
+	case m == nil:
+		return false
+	case m.state != nil:
+		return m.state.Get(e, b)
+	}
+	return m.On(b)
+}
+
+// SetMode turns the mode named name on or off for b.
+func (e *Editor) SetMode(b *text.Buffer, name string, on bool) error {
+	m := e.mode(name)
+	if m == nil {
+		return fmt.Errorf("%w: %s", ErrNoMode, name)
+	}
+	if e.InMode(b, name) == on {
+		return nil
+	}
+	if m.state == nil {
+		return m.Set(b, on)
+	}
+	m.state.Set(e, b, on)
+	event.Publish(e.Events, event.ModeChanged{Buffer: b, Mode: name, On: on})
+	return nil
+}
+
+// Modes returns the names of the modes b is in, in the order they were registered.
+func (e *Editor) Modes(b *text.Buffer) []string {
+	var names []string
+	for _, m := range e.modes {
+		if e.InMode(b, m.Name) {
+			names = append(names, m.Name)
+		}
+	}
+	return names
+}
+
+// registerModes adds the built-in modes: readonly and follow, kept by the buffer and follow
+// mode, and wrap, which breaks long lines into rows, and spell, which marks buffers for the
+// spell checkers of extensions, both remembered from one session to the next.
+func (e *Editor) registerModes() {
+	e.RegisterMode(Mode{
+		Name: "readonly",
+		On:   (*text.Buffer).ReadOnly,
+		Set: func(b *text.Buffer, on bool) error {
+			b.SetReadOnly(on)
+			event.Publish(e.Events, event.ModeChanged{Buffer: b, Mode: "readonly", On: on})
+			return nil
+		},
+	})
+	e.RegisterMode(Mode{
+		Name: "follow",
+		On: func(b *text.Buffer) bool {
+			_, ok := e.followers[b]
+			return ok
+		},
+		Set: func(b *text.Buffer, _ bool) error { return e.Follow(b) },
+	})
+	e.RegisterMode(Mode{Name: "wrap", Persist: true})
+	e.RegisterMode(Mode{Name: "spell", Persist: true})
+	e.RegisterMode(Mode{Name: "csv"})
+}
+
+// modeCommand lists the modes of b with no arguments, and otherwise toggles the mode named by
+// the first, or turns it on or off as the second says.
+func (e *Editor) modeCommand(b *text.Buffer, args []string) error {
+	if len(args) == 0 {
+		if modes := e.Modes(b); len(modes) > 0 {
+			e.message = "modes: " + strings.Join(modes, ", ")
+		} else {
+			e.message = "no modes on"
+		}
+		return nil
+	}
+	if len(args) > 2 {
+		return fmt.Errorf("%w: mode takes a mode name and on or off", command.ErrUsage)
+	}
+	if e.mode(args[0]) == nil {
+		return fmt.Errorf("%w: %s", ErrNoMode, args[0])
+	}
+	on := !e.InMode(b, args[0])
+	if len(args) == 2 {
+		switch args[1] {
+		case "on":
+			on = true
+		case "off":
+			on = false
+		default:
+			return fmt.Errorf("%w: mode %s takes on or off", command.ErrUsage, args[0])
+		}
+	}
+	if err := e.SetMode(b, args[0], on); err != nil {
+		return err
+	}
+	state := "off"
+	if on {
+		state = "on"
+	}
+	e.message = args[0] + " " + state
+	return nil
+}
@@ -0,0 +1,187 @@
+package editor
+
+import (
+	"fmt"
+	"math"
+	"path/filepath"
+	"strings"
+
+	"github.com/avalonbits/goted/command"
+	"github.com/avalonbits/goted/config"
+	"github.com/avalonbits/goted/event"
+	"github.com/avalonbits/goted/text"
+)
+
+// narrowPrefix starts the paths of narrowed buffers, which go on with the name of the file of
+// the parent buffer and the lines narrowed to, as in "narrow:README.md:12-20".
+const narrowPrefix = "narrow:"
+
+// narrowing is a region of parent being edited on its own in a narrowed buffer. start and end
+// follow the region as parent changes, its text as of version, and readOnly is whether parent
+// was read-only before.
+type narrowing struct {
+	parent     *text.Buffer
+	start, end *text.Mark
+	version    int
+	readOnly   bool
+}
+
+// Narrow opens the selection of b in a buffer of its own, to edit it in isolation, or in
+// Markdown with no selection the fenced code block at the cursor, as a buffer of the type the
+// fence names. Widen writes it back. b is read-only meanwhile.
+func (e *Editor) Narrow(b *text.Buffer) error {
+	if e.narrowed(b) {
+		return fmt.Errorf("%w: the buffer is already narrowed", command.ErrUsage)
+	}
+	start, end, ok := b.Selection()
+	fileType := b.Options().FileType
+	if !ok && fileType == "markdown" {
+		start, end, fileType, ok = fencedBlock(b)
+	}
+	if !ok {
+		return fmt.Errorf("%w: no selection or code block to narrow to", command.ErrUsage)
+	}
+
+	n, err := load([]byte(string(b.Text(start, end))))
+	if err != nil {
+		return err
+	}
+	name := "[no file]"
+	if b.Path() != "" {
+		name = filepath.Base(b.Path())
+	}
+	first, last := b.LineOf(start), b.LineOf(max(end-1, start))
+	n.SetPath(fmt.Sprintf("%s%s:%d-%d", narrowPrefix, name, first+1, last+1))
+	dir := "."
+	if filepath.IsAbs(b.Path()) {
+		dir = filepath.Dir(b.Path())
+	}
+	s, err := config.Load(dir)
+	if err != nil {
+		return err
+	}
+	o := n.Options()
+	o.FileType = fileType
+	n.SetOptions(o)
+	s.Apply(n)
+	event.Publish(e.Events, event.FileTypeSet{Buffer: n, FileType: n.Options().FileType})
+	n.Seek(min(max(b.Cursor()-start, 0), n.Len()))
+
+	e.narrowings[n] = &narrowing{
+		parent:   b,
+		start:    b.NewMark(start),
+		end:      b.NewMark(end),
+		version:  n.Version(),
+		readOnly: b.ReadOnly(),
+	}
+	b.Deselect()
+	b.SetReadOnly(true)
+	e.add(n)
+	return nil
+}
+
+// Widen writes the narrowed buffer b back over the region of its parent it was narrowed to,
+// closes it and goes back to the parent, with the cursor where it was in b.
+func (e *Editor) Widen(b *text.Buffer) error {
+	nw, ok := e.narrowings[b]
+	if !ok {
+		return fmt.Errorf("%w: not a narrowed buffer", command.ErrUsage)
+	}
+	if e.narrowed(b) {
+		return fmt.Errorf("%w: widen the buffer narrowed from this one first", command.ErrUsage)
+	}
+	if err := e.writeBack(b); err != nil {
+		return err
+	}
+	nw.parent.SetReadOnly(nw.readOnly)
+	nw.parent.Seek(nw.start.Offset() + b.Cursor())
+	nw.parent.DeleteMark(nw.start)
+	nw.parent.DeleteMark(nw.end)
+	delete(e.narrowings, b)
+	e.remove(b)
+	e.SetCurrent(nw.parent)
+	return nil
+}
+
+// writeBack replaces the region of the parent of the narrowed buffer b with the text of b, if
+// it changed since it was last written back, in one undo step.
+func (e *Editor) writeBack(b *text.Buffer) error {
+	nw := e.narrowings[b]
+	if b.Version() == nw.version {
+		return nil
+	}
+	p := nw.parent
+	start := nw.start.Offset()
+	p.SetReadOnly(false)
+	err := p.Replace(start, nw.end.Offset(), b.Text(0, b.Len()))
+	p.SetReadOnly(true)
+	if err != nil {
+		return err
+	}
+	// The end of an empty region does not move past text inserted at it.
+	p.DeleteMark(nw.end)
+	nw.end = p.NewMark(start + b.Len())
+	nw.version = b.Version()
+	return nil
+}
+
+// narrowWrite writes the narrowed buffer b back to its parent and saves the parent.
+func (e *Editor) narrowWrite(b *text.Buffer) error {
+	if err := e.writeBack(b); err != nil {
+		return err
+	}
+	return e.Commands.Run(e.narrowings[b].parent, "write")
+}
+
+// narrowed reports whether a region of b is open in a narrowed buffer.
+func (e *Editor) narrowed(b *text.Buffer) bool {
+	for _, nw := range e.narrowings {
+		if nw.parent == b {
+			return true
+		}
+	}
+	return false
+}
+
+// narrowChanged reports whether b is a narrowed buffer with changes not written back.
+func (e *Editor) narrowChanged(b *text.Buffer) bool {
+	nw, ok := e.narrowings[b]
+	return ok && b.Version() != nw.version
+}
+
+// fencedBlock returns the lines inside the fenced code block of the Markdown buffer b the
+// cursor is in, and the file type its fence names, or that of b if it names none.
+func fencedBlock(b *text.Buffer) (start, end int, fileType string, ok bool) {
+	cursor, open, fence := b.Line(), -1, ""
+	for n := range b.Lines() {
+		line, _ := b.LineRunes(n)
+		trimmed := strings.TrimSpace(string(line))
+		switch {
+		case open < 0 && (strings.HasPrefix(trimmed, "```") || strings.HasPrefix(trimmed, "~~~")):
+			open, fence = n, trimmed
+		case open >= 0 && strings.HasPrefix(trimmed, fence[:3]) && strings.Trim(trimmed, fence[:1]) == "":
+			if open < cursor && cursor < n {
+				info, _, _ := strings.Cut(strings.TrimLeft(fence, fence[:1]), " ")
+				return b.Offset(open+1, 0), b.Offset(n-1, math.MaxInt), blockType(info, b.Options().FileType), true
+			}
+			open = -1
+		}
+		if open < 0 && n > cursor {
+			break
+		}
+	}
+	return 0, 0, "", false
+}
+
+// blockType returns the file type the info string of a fence names, such as go, py or python,
+// or def if it names none.
+func blockType(info, def string) string {
+	info = strings.ToLower(strings.Trim(info, "{}."))
+	if info == "" {
+		return def
+	}
+	if ft := text.DetectFileType("block." + info); ft != "" {
+		return ft
+	}
+	return info
+}
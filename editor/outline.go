@@ -0,0 +1,202 @@
+package editor
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/avalonbits/goted/command"
+	"github.com/avalonbits/goted/outline"
+	"github.com/avalonbits/goted/render"
+	"github.com/avalonbits/goted/screen"
+	"github.com/avalonbits/goted/text"
+)
+
+// outlinePath and symbolsPath are the paths of the outline buffer and of the project symbol
+// picker.
+const (
+	outlinePath = "outline:"
+	symbolsPath = "symbols:"
+)
+
+// outlineWidth is the widest the outline pane gets, in columns, and minOutline the narrowest.
+// The pane takes a third of the screen at most, and is not shown on screens too narrow for it.
+const (
+	outlineWidth = 32
+	minOutline   = 12
+)
+
+// outlineKeys are the bindings of the outline buffer, over the global ones.
+var outlineKeys = command.Keymap{
+	"Enter": "outline-jump",
+	"Esc":   "outline",
+}
+
+// symbolsKeys are the bindings of the project symbol picker, over the global ones.
+var symbolsKeys = command.Keymap{
+	"Enter": "symbols-pick",
+	"Esc":   "switch-previous",
+}
+
+// outlinePane is the outline shown beside the buffer it lists the symbols of.
+type outlinePane struct {
+	buffer  *text.Buffer
+	source  *text.Buffer
+	symbols []outline.Symbol
+	version int
+}
+
+// Outline shows the outline of the current buffer in a pane on its left and moves the cursor
+// there, where Enter jumps to the symbol under the cursor. Run from the outline, it goes back
+// to the buffer, and run again from the buffer it closes the outline.
+func (e *Editor) Outline() error {
+	b := e.ensure()
+	o := e.outline
+	switch {
+	case o != nil && b == o.buffer:
+		e.SetCurrent(o.source)
+		return nil
+	case o != nil && b == o.source:
+		e.outline = nil
+		return nil
+	}
+
+	if o == nil {
+		buf := text.New(minSize)
+		buf.SetPath(outlinePath)
+		buf.SetReadOnly(true)
+		e.keymaps[buf] = outlineKeys
+		e.add(buf)
+		o = &outlinePane{buffer: buf}
+		e.outline = o
+	}
+	o.source, o.version = b, -1
+	e.refreshOutline()
+	o.buffer.GotoLine(o.current(), 0)
+	e.SetCurrent(o.buffer)
+	return nil
+}
+
+// refreshOutline lists the symbols of the outlined buffer again if it changed since they were
+// last listed, keeping the cursor of the outline on the same line.
+func (e *Editor) refreshOutline() {
+	o := e.outline
+	if o.version == o.source.Version() {
+		return
+	}
+	o.version = o.source.Version()
+	o.symbols = outline.Symbols(o.source.Options().FileType, o.source.String())
+
+	var list strings.Builder
+	for i, s := range o.symbols {
+		if i > 0 {
+			list.WriteByte('\n')
+		}
+		fmt.Fprintf(&list, "%s%s %s", strings.Repeat("  ", s.Depth), s.Kind, s.Name)
+	}
+	line := o.buffer.Line()
+	o.buffer.Load(strings.NewReader(list.String()))
+	o.buffer.GotoLine(line, 0)
+}
+
+// drawOutline draws the outline on the left of body, with the symbol the cursor of the
+// outlined buffer is in highlighted, and returns the rest of body and the position of the
+// cursor of the outline.
+func (e *Editor) drawOutline(g *screen.Grid, body screen.Rect) (rest screen.Rect, x, y int, ok bool) {
+	o := e.outline
+	e.refreshOutline()
+	th := e.Commands.Theme
+
+	side := body
+	side.Width = min(outlineWidth, body.Width/3)
+	cur := o.current()
+	follow := cur
+	if e.Current() == o.buffer {
+		follow = o.buffer.Line()
+	}
+	v := e.viewport(o.buffer)
+	v.Follow(follow, o.buffer.Lines())
+	opts := render.Options{TabWidth: o.source.Options().TabWidth, LineScope: func(n int) string {
+		if n == cur && len(o.symbols) > 0 {
+			return "ui.outline.current"
+		}
+		return ""
+	}}
+	x, y, ok = render.Draw(g, side, o.buffer, v.Top, nil, th, opts)
+
+	border := th.Style("ui.border")
+	if border.FG == "" {
+		border.FG = th.Foreground
+	}
+	if border.BG == "" {
+		border.BG = th.Background
+	}
+	for row := range side.Height {
+		g.Put(side.X+side.Width, side.Y+row, "│", 1, border)
+	}
+
+	rest = body
+	rest.X += side.Width + 1
+	rest.Width -= side.Width + 1
+	return rest, x, y, ok
+}
+
+// outlineJump moves the cursor to the symbol under the cursor of the outline, in the buffer it
+// outlines.
+func (e *Editor) outlineJump(b *text.Buffer) error {
+	o := e.outline
+	if o == nil || b != o.buffer {
+		return fmt.Errorf("%w: not the outline buffer", command.ErrUsage)
+	}
+	if b.Line() >= len(o.symbols) {
+		return fmt.Errorf("%w: no symbol under the cursor", command.ErrUsage)
+	}
+	s := o.symbols[b.Line()]
+	e.SetCurrent(o.source)
+	o.source.GotoLine(s.Line, s.Col)
+	return nil
+}
+
+// current returns the index of the symbol the cursor of the outlined buffer is in: the last
+// one declared at or before its line.
+func (o *outlinePane) current() int {
+	line, cur := o.source.Line(), 0
+	for i, s := range o.symbols {
+		if s.Line > line {
+			break
+		}
+		cur = i
+	}
+	return cur
+}
+
+// Symbols shows a picker of the symbols declared in the files of the project of b, or of its
+// directory, filtered by what is typed, in which Enter jumps to the symbol under the cursor or
+// the best match.
+func (e *Editor) Symbols(b *text.Buffer, filter string) error {
+	root, s, err := project(b)
+	if err != nil {
+		return err
+	}
+	syms, err := outline.Project(root, s.Exclude)
+	if err != nil {
+		return err
+	}
+
+	items := make([]string, len(syms))
+	for i, sym := range syms {
+		items[i] = fmt.Sprintf("%s:%d:%d: %s %s", relative(root, sym.Path), sym.Line+1, sym.Col+1, sym.Kind, sym.Name)
+	}
+	e.symbolsRoot = root
+	e.pick(symbolsPath, symbolsKeys, items, filter)
+	return nil
+}
+
+// symbolsPick jumps to the symbol under the cursor of the symbol picker b, or to the best match
+// when the cursor is on the filter line.
+func (e *Editor) symbolsPick(b *text.Buffer) error {
+	item, ok := picked(b)
+	if !ok {
+		return fmt.Errorf("%w: no symbol matches", command.ErrUsage)
+	}
+	return e.openLocation(e.symbolsRoot, item)
+}
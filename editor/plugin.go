@@ -0,0 +1,109 @@
+package editor
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/avalonbits/goted/command"
+	"github.com/avalonbits/goted/plugin"
+	"github.com/avalonbits/goted/text"
+)
+
+// LoadPlugin loads the plugin whose manifest is at path, once the user granted what it
+// declares, and returns its host. Loading it again replaces its host.
+func (e *Editor) LoadPlugin(path string) (*plugin.Host, error) {
+	m, err := plugin.ReadManifest(path)
+	if err != nil {
+		return nil, err
+	}
+	h, err := e.AuthorizePlugin(m)
+	if err != nil {
+		return nil, err
+	}
+	e.plugins[m.Name] = h
+	return h, nil
+}
+
+// Plugin returns the host of the loaded plugin name.
+func (e *Editor) Plugin(name string) (*plugin.Host, bool) {
+	h, ok := e.plugins[name]
+	return h, ok
+}
+
+// AuthorizePlugin returns the host the plugin m reaches outside the editor through, asking the
+// user to grant what its manifest declares and was not granted before. The guard of the
+// commands asks, so the question is refused with ErrCanceled at first, for guarded to ask it
+// on the status line and authorize again. Headless sessions, whose questions are answered
+// without the user, grant nothing new.
+func (e *Editor) AuthorizePlugin(m plugin.Manifest) (*plugin.Host, error) {
+	path, err := plugin.GrantsPath()
+	if err != nil {
+		return nil, err
+	}
+	grants, err := plugin.LoadGrants(path)
+	if err != nil {
+		return nil, err
+	}
+	var confirm func(string) bool
+	refused := false
+	if c := e.Commands.Guard.Confirm; c != nil && e.Terminal != nil {
+		confirm = func(question string) bool {
+			refused = !c(question)
+			return !refused
+		}
+	}
+	h, changed := plugin.Authorize(m, grants, confirm)
+	if changed {
+		if err := grants.Save(path); err != nil {
+			return nil, err
+		}
+	}
+	if refused {
+		return nil, ErrCanceled
+	}
+	return h, nil
+}
+
+// revokePlugin forgets what was granted to the plugin name, which has to be loaded again.
+func (e *Editor) revokePlugin(_ *text.Buffer, args []string) error {
+	if len(args) != 1 {
+		return fmt.Errorf("%w: plugin-revoke needs a plugin name", command.ErrUsage)
+	}
+	path, err := plugin.GrantsPath()
+	if err != nil {
+		return err
+	}
+	grants, err := plugin.LoadGrants(path)
+	if err != nil {
+		return err
+	}
+	if !grants.Revoke(args[0]) {
+		return fmt.Errorf("%w: nothing is granted to %s", command.ErrUsage, args[0])
+	}
+	delete(e.plugins, args[0])
+	e.message = "permissions of " + args[0] + " revoked"
+	return grants.Save(path)
+}
+
+// loadPlugin is the plugin-load command: it loads the plugin whose manifest is at the path
+// given and tells what it may do.
+func (e *Editor) loadPlugin(_ *text.Buffer, args []string) error {
+	if len(args) != 1 {
+		return fmt.Errorf("%w: plugin-load needs the path of a manifest", command.ErrUsage)
+	}
+	h, err := e.LoadPlugin(args[0])
+	if err != nil {
+		return err
+	}
+	var may []string
+	for _, c := range h.Manifest.Permissions {
+		if h.Allowed(c) {
+			may = append(may, string(c))
+		}
+	}
+	if len(may) == 0 {
+		may = []string{"nothing outside the editor"}
+	}
+	e.message = fmt.Sprintf("plugin %s loaded: may use %s", h.Manifest.Name, strings.Join(may, ", "))
+	return nil
+}
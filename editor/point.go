@@ -0,0 +1,126 @@
+package editor
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+
+	"github.com/avalonbits/goted/config"
+	"github.com/avalonbits/goted/text"
+)
+
+// ErrNoFile is returned when the text under the cursor names no file that exists.
+var ErrNoFile = errors.New("editor: no file under the cursor")
+
+// pathDelims end the file name or URL under the cursor, besides spaces: quotes and the brackets
+// around include file names and links.
+const pathDelims = "\"'`<>()[]{},;|"
+
+// OpenAtPoint opens the file named under the cursor of b, as vim's gF does, or the http(s) URL
+// there in the system browser. Relative file names are looked up next to the file of b, in the
+// project root, in the working directory and in the include_path directories, in that order. A
+// file:line or file:line:col reference opens the file at that line.
+func (e *Editor) OpenAtPoint(b *text.Buffer) error {
+	name := pointName(b)
+	if name == "" {
+		return ErrNoFile
+	}
+	if strings.HasPrefix(name, "http://") || strings.HasPrefix(name, "https://") {
+		return browse(name)
+	}
+
+	name = strings.TrimPrefix(name, "file://")
+	path, line, col := SplitLocation(name)
+	found, ok := lookPath(path, b)
+	if !ok {
+		if found, ok = lookPath(name, b); !ok {
+			return fmt.Errorf("%w: %s", ErrNoFile, path)
+		}
+		line, col = 0, 0
+	}
+	_, err := e.OpenAt(found, line, col)
+	return err
+}
+
+// pointName returns the file name or URL under the cursor of b, without the punctuation that
+// usually follows one in prose, such as a final period.
+func pointName(b *text.Buffer) string {
+	line, _ := b.LineRunes(b.Line())
+	col := min(b.Column(), len(line))
+	delim := func(r rune) bool {
+		return r == ' ' || r == '\t' || strings.ContainsRune(pathDelims, r)
+	}
+
+	start, end := col, col
+	for start > 0 && !delim(line[start-1]) {
+		start--
+	}
+	for end < len(line) && !delim(line[end]) {
+		end++
+	}
+	return strings.TrimRight(string(line[start:end]), ".:!?")
+}
+
+// lookPath finds the file name, relative to the places OpenAtPoint searches.
+func lookPath(name string, b *text.Buffer) (string, bool) {
+	if name == "~" || strings.HasPrefix(name, "~/") {
+		if home, err := os.UserHomeDir(); err == nil {
+			name = filepath.Join(home, name[1:])
+		}
+	}
+	if filepath.IsAbs(name) {
+		_, err := os.Stat(name)
+		return name, err == nil
+	}
+
+	var dirs []string
+	dir := "."
+	if path := b.Path(); filepath.IsAbs(path) {
+		dir = filepath.Dir(path)
+		dirs = append(dirs, dir)
+	}
+	s, _ := config.Load(dir)
+	if s.Root != "" {
+		dirs = append(dirs, s.Root)
+	}
+	dirs = append(dirs, ".")
+	for _, inc := range s.IncludePath {
+		if !filepath.IsAbs(inc) && s.Root != "" {
+			inc = filepath.Join(s.Root, inc)
+		}
+		dirs = append(dirs, inc)
+	}
+
+	for _, dir := range dirs {
+		path := filepath.Join(dir, name)
+		if _, err := os.Stat(path); err == nil {
+			return path, true
+		}
+	}
+	return "", false
+}
+
+// browse opens url in the browser named by $BROWSER or, failing that, the one the system
+// opens links with.
+func browse(url string) error {
+	var cmd *exec.Cmd
+	switch browser := os.Getenv("BROWSER"); {
+	case browser != "":
+		cmd = exec.Command(browser, url)
+	case runtime.GOOS == "darwin":
+		cmd = exec.Command("open", url)
+	case runtime.GOOS == "windows":
+		cmd = exec.Command("rundll32", "url.dll,FileProtocolHandler", url)
+	default:
+		cmd = exec.Command("xdg-open", url)
+	}
+	if err := cmd.Start(); err != nil {
+		return err
+	}
+	go cmd.Wait()
+	return nil
+}
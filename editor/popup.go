@@ -0,0 +1,66 @@
+package editor
+
+import (
+	"github.com/avalonbits/goted/render"
+	"github.com/avalonbits/goted/screen"
+	"github.com/avalonbits/goted/text"
+	"github.com/avalonbits/goted/view"
+)
+
+// popupWidth is the widest a popup's text gets, so that documentation stays readable on wide
+// screens.
+const popupWidth = 80
+
+// ShowPopup shows text in a popup anchored at the cursor of the current buffer, replacing any
+// popup already shown. PageDown and PageUp scroll it, Esc closes it and any other key closes it
+// before doing what it usually does, except for signature help, which stays open while typing,
+// and completion candidates, which take Tab, Up, Down and Enter.
+func (e *Editor) ShowPopup(text string) {
+	b := e.ensure()
+	e.popup = &view.Popup{Anchor: b.Cursor(), Text: text, MaxWidth: popupWidth}
+	e.popupBuffer, e.call, e.completion = b, nil, nil
+}
+
+// popupKey handles key while a popup is shown. Returns false if the key closed the popup and
+// should still be handled as usual.
+func (e *Editor) popupKey(key string) bool {
+	if e.completion != nil {
+		return e.completionKey(key)
+	}
+	switch key {
+	case "Esc":
+		e.popup, e.call = nil, nil
+	case "PageDown":
+		e.popup.ScrollPage(1)
+	case "PageUp":
+		e.popup.ScrollPage(-1)
+	default:
+		if e.call == nil {
+			e.popup = nil
+		}
+		return false
+	}
+	return true
+}
+
+// drawPopup draws the popup, if one is shown for b, within body, where b is drawn from line top
+// with opts. Popups whose anchor is scrolled out of view are not drawn.
+func (e *Editor) drawPopup(g *screen.Grid, body screen.Rect, b *text.Buffer, top int, opts render.Options) {
+	p := e.popup
+	if p == nil || e.popupBuffer != b {
+		return
+	}
+	anchor := min(p.Anchor, b.Len())
+	n := b.LineOf(anchor)
+	if n < top || n >= top+body.Height {
+		return
+	}
+	line, _ := b.LineRunes(n)
+	x := render.ScreenColumn(line, anchor-b.Offset(n, 0), opts)
+	if x >= body.Width {
+		return
+	}
+
+	r, rows := p.Layout(body.X+x, body.Y+n-top, body)
+	render.DrawPopup(g, r, rows, p.Top, e.Commands.Theme)
+}
@@ -0,0 +1,145 @@
+package editor
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"slices"
+	"time"
+
+	"github.com/avalonbits/goted/config"
+	"github.com/avalonbits/goted/text"
+)
+
+// maxPositions is how many files positions are remembered for. The least recently edited are
+// forgotten first.
+const maxPositions = 1000
+
+// Position is where the cursor and the viewport of a file were when it was last edited, and
+// the modes it was in that persist. Line and Col are 0-based.
+type Position struct {
+	Line  int       `json:"line"`
+	Col   int       `json:"col"`
+	Top   int       `json:"top"`
+	Modes []string  `json:"modes,omitempty"`
+	Time  time.Time `json:"time"`
+}
+
+// PositionsPath returns the file the positions of edited files are kept in.
+func PositionsPath() (string, error) {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "goted", "positions.json"), nil
+}
+
+// readPositions returns the positions kept in the file at path, none if it is missing or
+// unreadable.
+func readPositions(path string) map[string]Position {
+	positions := map[string]Position{}
+	if data, err := os.ReadFile(path); err == nil {
+		json.Unmarshal(data, &positions)
+	}
+	return positions
+}
+
+// restorePosition moves the cursor and viewport of b back to where they were when its file was
+// last edited, and puts it back in the modes it was in, unless s disables it for the file. The
+// cursor of a file with merge conflicts stays at the start, where they are better looked at
+// from.
+func (e *Editor) restorePosition(b *text.Buffer, s config.Settings) {
+	if !s.RestorePosition || excluded(b.Path(), s.RestoreExclude) {
+		return
+	}
+	path, err := PositionsPath()
+	if err != nil {
+		return
+	}
+
+	p, ok := readPositions(path)[b.Path()]
+	if !ok {
+		return
+	}
+	for _, name := range p.Modes {
+		if m := e.mode(name); m != nil && m.Persist {
+			e.SetMode(b, name, true)
+		}
+	}
+	if hasConflicts(b) {
+		return
+	}
+	b.GotoLine(p.Line, p.Col)
+	e.viewport(b).Top = min(p.Top, b.Line())
+}
+
+// SavePositions records where the cursor and viewport of every buffer bound to a file are, for
+// restorePosition. Positions written by other sessions in the meantime are kept.
+func (e *Editor) SavePositions() error {
+	path, err := PositionsPath()
+	if err != nil {
+		return err
+	}
+
+	positions := readPositions(path)
+	now := time.Now()
+	for _, b := range e.buffers {
+		if b.Path() == "" {
+			continue
+		}
+		p := Position{Line: b.Line(), Col: b.Column(), Time: now}
+		for _, m := range e.modes {
+			if m.Persist && e.InMode(b, m.Name) {
+				p.Modes = append(p.Modes, m.Name)
+			}
+		}
+		if v, ok := e.viewports[b]; ok {
+			p.Top = v.Top
+		}
+		positions[b.Path()] = p
+	}
+
+	if len(positions) > maxPositions {
+		paths := make([]string, 0, len(positions))
+		for path := range positions {
+			paths = append(paths, path)
+		}
+		slices.SortFunc(paths, func(a, b string) int {
+			return positions[b].Time.Compare(positions[a].Time)
+		})
+		for _, path := range paths[maxPositions:] {
+			delete(positions, path)
+		}
+	}
+
+	data, err := json.Marshal(positions)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return err
+	}
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o600); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+// excluded reports whether the base name or the full path matches one of the glob patterns.
+func excluded(path string, patterns []string) bool {
+	for _, pattern := range patterns {
+		if ok, _ := filepath.Match(pattern, filepath.Base(path)); ok {
+			return true
+		}
+		if ok, _ := filepath.Match(pattern, path); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// hasConflicts reports whether b holds merge conflicts.
+func hasConflicts(b *text.Buffer) bool {
+	return len(b.Conflicts()) > 0
+}
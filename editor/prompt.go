@@ -0,0 +1,114 @@
+package editor
+
+import (
+	"unicode/utf8"
+
+	"github.com/avalonbits/goted/command"
+	"github.com/avalonbits/goted/term"
+)
+
+// prompt is a question asked on the status line. done is called with the answer once Enter is
+// pressed; Esc cancels it, calling canceled if it is set. changed, if set, is called with the
+// answer each time it is edited. Secret answers, such as passphrases, show as asterisks.
+type prompt struct {
+	label    string
+	answer   []rune
+	secret   bool
+	done     func(answer string) error
+	changed  func(answer string)
+	canceled func()
+}
+
+// Prompt asks label on the status line and calls done with what is typed once Enter is pressed,
+// showing the error it returns, if any. Until then, keys edit the answer instead of the buffer.
+// Questions asked while another is shown wait for it to be answered. A secret answer is shown
+// as asterisks and cleared from the prompt once done. The label is shown translated.
+func (e *Editor) Prompt(label string, secret bool, done func(answer string) error) {
+	e.prompts = append(e.prompts, &prompt{label: e.tr(label), secret: secret, done: done})
+}
+
+// promptLive asks label like Prompt, starting from answer, and calls changed with the answer
+// each time it is edited, so the editor can show what it would do, and canceled if it is
+// canceled.
+func (e *Editor) promptLive(label, answer string, changed func(answer string), done func(answer string) error, canceled func()) {
+	e.prompts = append(e.prompts, &prompt{label: e.tr(label), answer: []rune(answer), done: done, changed: changed, canceled: canceled})
+}
+
+// CommandPrompt asks for a command line on the status line and runs it, as a key binding would,
+// on the current buffer.
+func (e *Editor) CommandPrompt() {
+	e.Prompt("command: ", false, func(answer string) error {
+		name, args, err := command.Parse(answer)
+		if err != nil || name == "" {
+			return err
+		}
+		return e.Commands.Run(e.ensure(), name, args...)
+	})
+}
+
+// promptKey handles key for the prompt shown, if any, and reports whether there was one.
+func (e *Editor) promptKey(key string) (bool, error) {
+	if len(e.prompts) == 0 {
+		return false, nil
+	}
+	p := e.prompts[0]
+	if text, ok := term.Pasted(key); ok {
+		p.answer = append(p.answer, []rune(text)...)
+		p.edited()
+		return true, nil
+	}
+	switch {
+	case key == "Enter":
+		e.prompts = e.prompts[1:]
+		answer := string(p.answer)
+		p.clear()
+		return true, e.guarded(func() error { return p.done(answer) })
+	case key == "Esc" || key == "Ctrl+G":
+		e.prompts = e.prompts[1:]
+		p.clear()
+		e.message = "canceled"
+		if p.canceled != nil {
+			p.canceled()
+		}
+	case key == "Backspace":
+		if len(p.answer) > 0 {
+			p.answer = p.answer[:len(p.answer)-1]
+			p.edited()
+		}
+	case key == "Space":
+		p.answer = append(p.answer, ' ')
+		p.edited()
+	case utf8.RuneCountInString(key) == 1:
+		p.answer = append(p.answer, []rune(key)...)
+		p.edited()
+	}
+	return true, nil
+}
+
+// edited tells changed, if it is set, that the answer was edited.
+func (p *prompt) edited() {
+	if p.changed != nil {
+		p.changed(string(p.answer))
+	}
+}
+
+// clear overwrites the answer, so a secret does not linger in memory.
+func (p *prompt) clear() {
+	for i := range p.answer {
+		p.answer[i] = 0
+	}
+	p.answer = nil
+}
+
+// line returns the status line text of the prompt and the column of its cursor in it.
+func (p *prompt) line() (string, int) {
+	answer := string(p.answer)
+	if p.secret {
+		answer = ""
+		for range p.answer {
+			answer += "*"
+		}
+	}
+	text := " " + p.label + answer
+	return text, utf8.RuneCountInString(text)
+}
@@ -0,0 +1,270 @@
+package editor
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"unicode"
+
+	"github.com/avalonbits/goted/command"
+	"github.com/avalonbits/goted/storage"
+	"github.com/avalonbits/goted/text"
+)
+
+// maxRecent is how many recently opened files are remembered across sessions.
+const maxRecent = 100
+
+// switcherPath is the path of the quick switcher buffer.
+const switcherPath = "switch:"
+
+// switcherKeys are the bindings of the quick switcher buffer, over the global ones.
+var switcherKeys = command.Keymap{
+	"Enter": "switch-pick",
+	"Esc":   "switch-previous",
+}
+
+// RecentPath returns the file the recently opened files are kept in.
+func RecentPath() (string, error) {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "goted", "recent.json"), nil
+}
+
+// visit records that b became the current buffer, for switch-previous and the quick switcher.
+func (e *Editor) visit(b *text.Buffer) {
+	if i := slices.Index(e.mru, b); i >= 0 {
+		e.mru = slices.Delete(e.mru, i, i+1)
+	}
+	e.mru = slices.Insert(e.mru, 0, b)
+
+	if path := b.Path(); filepath.IsAbs(path) || storage.Remote(path) {
+		recent := e.Recent()
+		if i := slices.Index(recent, path); i >= 0 {
+			recent = slices.Delete(recent, i, i+1)
+		}
+		e.recent = slices.Insert(recent, 0, path)[:min(len(recent)+1, maxRecent)]
+	}
+}
+
+// Recent returns the files opened recently, in this session and earlier ones, most recent first.
+func (e *Editor) Recent() []string {
+	if e.recent == nil {
+		e.recent = []string{}
+		if path, err := RecentPath(); err == nil {
+			if data, err := os.ReadFile(path); err == nil {
+				json.Unmarshal(data, &e.recent)
+			}
+		}
+	}
+	return e.recent
+}
+
+// SaveRecent writes the recently opened files for later sessions. Files opened by other
+// sessions in the meantime are kept after the ones of this session.
+func (e *Editor) SaveRecent() error {
+	path, err := RecentPath()
+	if err != nil {
+		return err
+	}
+
+	recent := slices.Clone(e.Recent())
+	var others []string
+	if data, err := os.ReadFile(path); err == nil {
+		json.Unmarshal(data, &others)
+	}
+	for _, p := range others {
+		if !slices.Contains(recent, p) {
+			recent = append(recent, p)
+		}
+	}
+
+	data, err := json.Marshal(recent[:min(len(recent), maxRecent)])
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return err
+	}
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o600); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+// switchPrevious makes the buffer used before the current one current again.
+func (e *Editor) switchPrevious() error {
+	cur := e.Current()
+	for _, b := range e.mru {
+		if b != cur && b.Path() != switcherPath {
+			e.SetCurrent(b)
+			return nil
+		}
+	}
+	return fmt.Errorf("%w: no other buffer", command.ErrUsage)
+}
+
+// candidates returns what the quick switcher offers, most recent first: the open buffers bound
+// to a file and then the recent files that are not open.
+func (e *Editor) candidates() []string {
+	var paths []string
+	for _, b := range e.mru {
+		if path := b.Path(); path != "" && path != switcherPath && path != e.Current().Path() {
+			paths = append(paths, path)
+		}
+	}
+	for _, path := range e.Recent() {
+		if !slices.Contains(paths, path) && path != e.Current().Path() {
+			paths = append(paths, path)
+		}
+	}
+	return paths
+}
+
+// quickSwitch shows the quick switcher: a picker of the open buffers and recent files, in
+// which Enter opens the candidate under the cursor, or the best one from the filter line, and
+// Esc goes back.
+func (e *Editor) quickSwitch(filter string) error {
+	e.pick(switcherPath, switcherKeys, e.candidates(), filter)
+	return nil
+}
+
+// picker is the state of a picker buffer: the items it offers and the filter they were last
+// listed for. Pickers with a search list what it finds for the filter instead, in its order.
+type picker struct {
+	items  []string
+	search func(filter string) []string
+	filter string
+}
+
+// pick shows the picker buffer bound to path: its first line is a filter, followed by the items
+// matching it, best first, refreshed as the filter is typed. keys are its bindings, over the
+// global ones.
+func (e *Editor) pick(path string, keys command.Keymap, items []string, filter string) {
+	e.showPicker(path, keys, &picker{items: items}, filter)
+}
+
+// showPicker shows the picker buffer bound to path, as pick does, with the state p.
+func (e *Editor) showPicker(path string, keys command.Keymap, p *picker, filter string) {
+	i := slices.IndexFunc(e.buffers, func(b *text.Buffer) bool { return b.Path() == path })
+	var b *text.Buffer
+	if i >= 0 {
+		b = e.buffers[i]
+	} else {
+		b = text.New(minSize)
+		b.SetPath(path)
+		e.keymaps[b] = keys
+	}
+
+	p.filter = "\x00"
+	e.pickers[b] = p
+	b.Load(strings.NewReader(filter))
+	e.refreshPicker(b)
+	b.GotoLine(0, len(filter))
+	if i < 0 {
+		e.add(b)
+	}
+	e.SetCurrent(b)
+}
+
+// refreshPicker lists the items matching the filter on the first line of the picker b, if it
+// changed since the last refresh.
+func (e *Editor) refreshPicker(b *text.Buffer) {
+	p := e.pickers[b]
+	filter, _ := b.LineRunes(0)
+	if string(filter) == p.filter {
+		return
+	}
+	p.filter = string(filter)
+
+	var list strings.Builder
+	for _, item := range p.matches() {
+		list.WriteString("\n" + item)
+	}
+	cursor := b.Cursor()
+	b.Replace(len(filter), b.Len(), []rune(list.String()))
+	b.Seek(cursor)
+}
+
+// matches returns the items matching the filter, best first.
+func (p *picker) matches() []string {
+	if p.search != nil {
+		return p.search(p.filter)
+	}
+	type match struct {
+		item  string
+		score int
+	}
+	var matches []match
+	for _, item := range p.items {
+		if score, ok := fuzzy(p.filter, item); ok {
+			matches = append(matches, match{item, score})
+		}
+	}
+	slices.SortStableFunc(matches, func(a, b match) int { return b.score - a.score })
+	items := make([]string, len(matches))
+	for i, m := range matches {
+		items[i] = m.item
+	}
+	return items
+}
+
+// picked returns the item under the cursor of the picker b, or the best match when the cursor
+// is on the filter line.
+func picked(b *text.Buffer) (string, bool) {
+	line, ok := b.LineRunes(max(b.Line(), 1))
+	return string(line), ok && len(line) > 0
+}
+
+// switchPick opens the candidate under the cursor of the quick switcher b, or the best match
+// when the cursor is on the filter line.
+func (e *Editor) switchPick(b *text.Buffer) error {
+	path, ok := picked(b)
+	if !ok {
+		return fmt.Errorf("%w: no file matches", command.ErrUsage)
+	}
+	_, err := e.Open(path)
+	return err
+}
+
+// fuzzy reports whether the runes of pattern appear in order in s, ignoring case, and scores
+// the match: runes following each other, or starting a path element or word, score higher, as
+// do matches in the base name.
+func fuzzy(pattern, s string) (int, bool) {
+	p := []rune(strings.ToLower(pattern))
+	r := []rune(strings.ToLower(s))
+	base := len(r) - len([]rune(filepath.Base(s)))
+
+	score, j, last := 0, 0, -2
+	for i := 0; i < len(r) && j < len(p); i++ {
+		if unicode.IsSpace(p[j]) {
+			j++
+			i--
+			continue
+		}
+		if r[i] != p[j] {
+			continue
+		}
+		score++
+		if i == last+1 {
+			score += 4
+		}
+		if i == 0 || strings.ContainsRune("/\\_-. ", r[i-1]) {
+			score += 3
+		}
+		if i >= base {
+			score += 2
+		}
+		last = i
+		j++
+	}
+	for j < len(p) && unicode.IsSpace(p[j]) {
+		j++
+	}
+	return score, j == len(p)
+}
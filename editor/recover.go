@@ -0,0 +1,153 @@
+package editor
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime/debug"
+	"strings"
+	"time"
+
+	"github.com/avalonbits/goted/text"
+)
+
+// ErrCrashed is returned by Recover when the editor panicked.
+var ErrCrashed = errors.New("editor: crashed")
+
+// RecoveryDir returns the directory recovery files are written to.
+func RecoveryDir() (string, error) {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "goted", "recover"), nil
+}
+
+// recoveryName returns the name of the recovery file of b, which is the n-th open buffer: its
+// path with separators replaced by '%', as vim names swap files, or "unnamed-<pid>-<n>" for a
+// buffer with no file.
+func recoveryName(b *text.Buffer, n int) string {
+	if b.Path() == "" {
+		return fmt.Sprintf("unnamed-%d-%d", os.Getpid(), n)
+	}
+	return strings.ReplaceAll(filepath.ToSlash(b.Path()), "/", "%")
+}
+
+// EmergencySave writes every modified buffer to a file in RecoveryDir, without touching the
+// files being edited, as the editor is killed or crashes. It keeps going after failures and
+// returns the files written along with the first error.
+func (e *Editor) EmergencySave() ([]string, error) {
+	dir, err := RecoveryDir()
+	if err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, err
+	}
+
+	var saved []string
+	var first error
+	for n, b := range e.buffers {
+		if !unsaved(b) {
+			continue
+		}
+
+		path := filepath.Join(dir, recoveryName(b, n))
+		if err := writeRecovery(path, b); err != nil {
+			if first == nil {
+				first = err
+			}
+			continue
+		}
+		saved = append(saved, path)
+	}
+	return saved, first
+}
+
+// writeRecovery writes b to the recovery file at path. Encrypted files are written encrypted,
+// as they are saved.
+func writeRecovery(path string, b *text.Buffer) error {
+	if !inClear(b) {
+		var data bytes.Buffer
+		if _, err := b.WriteTo(&data); err != nil {
+			return err
+		}
+		return b.Storage().Write(path, data.Bytes())
+	}
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0o600)
+	if err != nil {
+		return err
+	}
+	if _, err := b.WriteTo(f); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// Recover must be deferred by the goroutine running the user interface, as in:
+//
+//	defer e.Recover(&err)
+//
+// On a panic it saves the modified buffers to recovery files, restores the terminal and writes
+// the panic value and stack trace to a crash log, then sets *err to an ErrCrashed error naming
+// the log and the recovery files instead of letting the panic print over the editor's screen.
+func (e *Editor) Recover(err *error) {
+	r := recover()
+	if r == nil {
+		return
+	}
+	stack := debug.Stack()
+
+	saved, saveErr := e.EmergencySave()
+	if e.Terminal != nil {
+		e.Terminal.Stop()
+	}
+
+	msg := fmt.Sprint(ErrCrashed, ": ", r)
+	if log, logErr := writeCrashLog(r, stack); logErr == nil {
+		msg += "\ncrash log: " + log
+	} else {
+		msg += fmt.Sprintf("\n%s", stack)
+	}
+	for _, path := range saved {
+		msg += "\nsaved unsaved changes to " + path
+	}
+	if saveErr != nil {
+		msg += "\nsaving unsaved changes: " + saveErr.Error()
+	}
+	*err = &crash{msg: msg}
+}
+
+// crash is the error Recover reports, matching ErrCrashed.
+type crash struct {
+	msg string
+}
+
+func (c *crash) Error() string {
+	return c.msg
+}
+
+func (c *crash) Is(target error) bool {
+	return target == ErrCrashed
+}
+
+// writeCrashLog writes the panic value r and its stack trace to a new crash-<time>.log file next
+// to RecoveryDir and returns its path.
+func writeCrashLog(r any, stack []byte) (string, error) {
+	dir, err := RecoveryDir()
+	if err != nil {
+		return "", err
+	}
+	dir = filepath.Dir(dir)
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return "", err
+	}
+
+	now := time.Now()
+	path := filepath.Join(dir, fmt.Sprintf("crash-%s.log", now.Format("20060102-150405")))
+	data := fmt.Sprintf("goted crashed at %s\n\npanic: %v\n\n%s", now.Format(time.RFC3339), r, stack)
+	return path, os.WriteFile(path, []byte(data), 0o600)
+}
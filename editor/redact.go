@@ -0,0 +1,62 @@
+package editor
+
+import (
+	"math"
+	"sort"
+
+	"github.com/avalonbits/goted/redact"
+	"github.com/avalonbits/goted/syntax"
+	"github.com/avalonbits/goted/text"
+)
+
+// redaction is where the secrets of a buffer are, as of a version of it.
+type redaction struct {
+	version int
+	ranges  []redact.Range
+}
+
+// ToggleRedact turns redact mode on or off for the session. While it is on, text that looks like
+// a secret, such as an access key, a token or a password in a URL or an assignment, is drawn as
+// asterisks in every buffer, so screens can be shared or recorded without leaking it. The text
+// itself is left as it is: it is copied, searched and saved unchanged.
+func (e *Editor) ToggleRedact() {
+	e.redacting = !e.redacting
+	clear(e.redactions)
+	if e.redacting {
+		e.message = "redact on"
+	} else {
+		e.message = "redact off"
+	}
+}
+
+// redactSpans returns the function render.Options.Redact takes for b, or nil if redact mode is
+// off. The secrets of b are found again only when it changed since they were last found, and
+// each line is given the spans of the secrets crossing it.
+func (e *Editor) redactSpans(b *text.Buffer) func(n int) []syntax.Span {
+	if !e.redacting {
+		return nil
+	}
+	r, ok := e.redactions[b]
+	if !ok || r.version != b.Version() {
+		r = &redaction{version: b.Version(), ranges: redact.Find(b.String(), e.redactPatterns)}
+		e.redactions[b] = r
+	}
+	if len(r.ranges) == 0 {
+		return nil
+	}
+
+	return func(n int) []syntax.Span {
+		start := b.Offset(n, 0)
+		end := b.Offset(n, math.MaxInt)
+		i := sort.Search(len(r.ranges), func(i int) bool { return r.ranges[i].End > start })
+		var spans []syntax.Span
+		for ; i < len(r.ranges) && r.ranges[i].Start < end; i++ {
+			spans = append(spans, syntax.Span{
+				Start: max(r.ranges[i].Start, start) - start,
+				End:   min(r.ranges[i].End, end) - start,
+				Scope: "ui.redacted",
+			})
+		}
+		return spans
+	}
+}
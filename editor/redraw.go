@@ -0,0 +1,55 @@
+package editor
+
+import (
+	"time"
+
+	"github.com/avalonbits/goted/text"
+)
+
+// AsyncBudget is the shortest time between two redraws of a buffer for changes that do not come
+// from the keyboard, such as the lines a followed file grows by. Changes arriving faster are
+// drawn together, so a noisy buffer cannot keep the editor drawing it instead of handling keys.
+const AsyncBudget = 100 * time.Millisecond
+
+// changedAsync notes that b changed other than from the keyboard, as when a producer such as a
+// followed file or a job appends to it. The change is drawn within AsyncBudget of the last time
+// b was drawn, if b is still shown by then, together with any others made in the meantime.
+func (e *Editor) changedAsync(b *text.Buffer) {
+	e.async[b] = true
+}
+
+// asyncRedraw returns how long until the screen must be drawn for shown buffers that changed
+// as changedAsync notes, or false if none did. Buffers no longer shown are forgotten: they are
+// drawn as they are once shown again.
+func (e *Editor) asyncRedraw(now time.Time) (wait time.Duration, ok bool) {
+	for b := range e.async {
+		if !e.shown(b) {
+			delete(e.async, b)
+			continue
+		}
+		due := e.drawnAt[b].Add(AsyncBudget).Sub(now)
+		if !ok || due < wait {
+			wait, ok = due, true
+		}
+	}
+	return wait, ok
+}
+
+// drawn notes that buffers were drawn as they are at now.
+func (e *Editor) drawn(now time.Time, buffers ...*text.Buffer) {
+	for _, b := range buffers {
+		delete(e.async, b)
+		e.drawnAt[b] = now
+	}
+}
+
+// shown reports whether b is on the screen: it is the current buffer, or it is beside the
+// outline of it shown.
+func (e *Editor) shown(b *text.Buffer) bool {
+	cur := e.Current()
+	if b == cur {
+		return true
+	}
+	o := e.outline
+	return o != nil && (cur == o.buffer && b == o.source || cur == o.source && b == o.buffer)
+}
@@ -0,0 +1,192 @@
+package editor
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/avalonbits/goted/syntax"
+	"github.com/avalonbits/goted/text"
+)
+
+// maxTested bounds how many matches the regex tester finds, so that a pattern matching
+// everything, as most do while being typed, stays quick on large buffers.
+const maxTested = 1000
+
+// maxGroupText is how many characters of a group the regex tester shows before cutting it.
+const maxGroupText = 60
+
+// regexTester is the pattern being tried on buffer, nil if it does not compile, and its
+// matches as of version.
+type regexTester struct {
+	buffer  *text.Buffer
+	version int
+	re      *regexp.Regexp
+	matches [][]int
+}
+
+// TestRegex asks for a regular expression, starting from pattern, and as it is typed highlights
+// its matches in b and shows in a popup how many there are, with the groups of the match at or
+// after the cursor. Enter then asks for a replacement and replaces every match, as replace-all
+// does; Esc leaves the buffer alone.
+func (e *Editor) TestRegex(b *text.Buffer, pattern string) {
+	changed := func(pattern string) { e.testRegex(b, pattern) }
+	done := func(pattern string) error {
+		if e.tester == nil || e.tester.re == nil {
+			e.closeTester()
+			return nil
+		}
+		replace := func(repl string) error {
+			e.closeTester()
+			return e.Commands.Run(b, "replace-all", pattern, repl)
+		}
+		e.promptLive("replace with: ", "", nil, replace, e.closeTester)
+		return nil
+	}
+	e.promptLive("regex: ", pattern, changed, done, e.closeTester)
+	changed(pattern)
+}
+
+// testRegex tries pattern on b, showing its matches or why it does not compile.
+func (e *Editor) testRegex(b *text.Buffer, pattern string) {
+	t := &regexTester{buffer: b}
+	e.tester = t
+	if pattern == "" {
+		e.popup = nil
+		return
+	}
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		e.ShowPopup(strings.TrimPrefix(err.Error(), "error parsing regexp: "))
+		return
+	}
+	t.re = re
+	t.find()
+	e.ShowPopup(t.describe())
+}
+
+// find finds the matches of the pattern in the buffer as it is now.
+func (t *regexTester) find() {
+	t.version = t.buffer.Version()
+	t.matches = t.buffer.FindSubmatches(t.re, maxTested+1)
+}
+
+// current returns the index of the match at or after the cursor, the first one if there is
+// none after it, and -1 if there are no matches.
+func (t *regexTester) current() int {
+	if len(t.matches) == 0 {
+		return -1
+	}
+	cursor := t.buffer.Cursor()
+	i := sort.Search(len(t.matches), func(i int) bool { return t.matches[i][1] > cursor })
+	if i == len(t.matches) {
+		return 0
+	}
+	return i
+}
+
+// describe returns the text of the popup: how many matches there are and the groups of the
+// current one, named ones with their names.
+func (t *regexTester) describe() string {
+	i := t.current()
+	switch {
+	case i < 0:
+		return "no matches"
+	case len(t.matches) > maxTested:
+		return fmt.Sprintf("match %d of more than %d\n%s", i+1, maxTested, t.groups(i))
+	}
+	return fmt.Sprintf("match %d of %d\n%s", i+1, len(t.matches), t.groups(i))
+}
+
+// groups returns a line for each group of match i, the whole match as $0.
+func (t *regexTester) groups(i int) string {
+	m := t.matches[i]
+	names := t.re.SubexpNames()
+	var lines []string
+	for g := range len(m) / 2 {
+		line := "$" + strconv.Itoa(g)
+		if names[g] != "" {
+			line += " " + names[g]
+		}
+		start, end := m[2*g], m[2*g+1]
+		if start < 0 {
+			lines = append(lines, line+" unmatched")
+			continue
+		}
+		s := t.buffer.Text(start, end)
+		cut := ""
+		if len(s) > maxGroupText {
+			s, cut = s[:maxGroupText], "…"
+		}
+		lines = append(lines, line+" "+strconv.Quote(string(s))+cut)
+	}
+	return strings.Join(lines, "\n")
+}
+
+// closeTester stops highlighting the matches of the regex tester and closes its popup.
+func (e *Editor) closeTester() {
+	if e.tester != nil && e.tester.buffer == e.popupBuffer {
+		e.popup = nil
+	}
+	e.tester = nil
+}
+
+// testerSpans returns the function render.Options.Emphasis takes for b, marking the matches of
+// the regex tester as ui.search and the current one as ui.search.current, or nil if it is not
+// testing a pattern in b.
+func (e *Editor) testerSpans(b *text.Buffer) func(n int) []syntax.Span {
+	t := e.tester
+	if t == nil || t.buffer != b || t.re == nil {
+		return nil
+	}
+	if t.version != b.Version() {
+		t.find()
+	}
+	matches := t.matches[:min(len(t.matches), maxTested)]
+	cur := t.current()
+	return func(n int) []syntax.Span {
+		start := b.Offset(n, 0)
+		end := b.Offset(n, math.MaxInt)
+		i := sort.Search(len(matches), func(i int) bool { return matches[i][1] > start })
+		var spans []syntax.Span
+		for ; i < len(matches) && matches[i][0] < end; i++ {
+			scope := "ui.search"
+			if i == cur {
+				scope = "ui.search.current"
+			}
+			spans = append(spans, syntax.Span{
+				Start: max(matches[i][0], start) - start,
+				End:   min(matches[i][1], end) - start,
+				Scope: scope,
+			})
+		}
+		return spans
+	}
+}
+
+// emphasis returns a function laying the spans of each of fs over those of the ones before,
+// or nil if they are all nil.
+func emphasis(fs ...func(n int) []syntax.Span) func(n int) []syntax.Span {
+	var set []func(n int) []syntax.Span
+	for _, f := range fs {
+		if f != nil {
+			set = append(set, f)
+		}
+	}
+	switch len(set) {
+	case 0:
+		return nil
+	case 1:
+		return set[0]
+	}
+	return func(n int) []syntax.Span {
+		var spans []syntax.Span
+		for _, f := range set {
+			spans = append(spans, f(n)...)
+		}
+		return spans
+	}
+}
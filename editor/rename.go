@@ -0,0 +1,152 @@
+package editor
+
+import (
+	"fmt"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/avalonbits/goted/command"
+	"github.com/avalonbits/goted/text"
+)
+
+// renamePreviewPath is the path of the buffer showing what a rename would change.
+const renamePreviewPath = "rename-preview:"
+
+// WorkspaceEdit holds edits to several files, by path, as language servers answer a rename.
+// Offsets are in runes, as text.TextEdit has them.
+type WorkspaceEdit map[string][]text.TextEdit
+
+// renameEdits returns the edits renaming the symbol under the cursor of b to name: those of
+// RenameProvider if it is set, or else those renaming every whole-word occurrence in b.
+func (e *Editor) renameEdits(b *text.Buffer, name string) (WorkspaceEdit, error) {
+	if e.RenameProvider != nil {
+		return e.RenameProvider(b, name)
+	}
+	old := b.WordAt()
+	if old == "" {
+		return nil, fmt.Errorf("%w: no symbol under the cursor", command.ErrUsage)
+	}
+	return WorkspaceEdit{b.Path(): b.WordEdits(old, name)}, nil
+}
+
+// RenameSymbol renames the symbol under the cursor of b to name, see renameEdits, and reports
+// how many occurrences changed.
+func (e *Editor) RenameSymbol(b *text.Buffer, name string) error {
+	w, err := e.renameEdits(b, name)
+	if err != nil {
+		return err
+	}
+	if err := e.applyRename(b, w); err != nil {
+		return err
+	}
+	n := 0
+	for _, edits := range w {
+		n += len(edits)
+	}
+	e.message = fmt.Sprintf("renamed %d occurrences", n)
+	if len(w) > 1 {
+		e.message += fmt.Sprintf(" in %d files", len(w))
+	}
+	return nil
+}
+
+// applyRename applies w, whose edits for the path of b, or for "" if b has no file, go to b.
+func (e *Editor) applyRename(b *text.Buffer, w WorkspaceEdit) error {
+	if edits, ok := w[b.Path()]; ok && !filepath.IsAbs(b.Path()) {
+		return b.ApplyEdits(edits)
+	}
+	return e.ApplyWorkspaceEdit(w)
+}
+
+// ApplyWorkspaceEdit applies the edits of w to the buffers of their files, opening those that
+// are not open, all or none of them: every edit is checked before any is applied, and if one
+// still fails, the buffers already edited are reverted. The current buffer stays current and
+// the edited buffers are left unsaved.
+func (e *Editor) ApplyWorkspaceEdit(w WorkspaceEdit) error {
+	cur := e.Current()
+	defer func() {
+		if cur != nil {
+			e.SetCurrent(cur)
+		}
+	}()
+
+	paths := make([]string, 0, len(w))
+	for path := range w {
+		paths = append(paths, path)
+	}
+	slices.Sort(paths)
+
+	buffers := make([]*text.Buffer, len(paths))
+	for i, path := range paths {
+		b, err := e.Open(path)
+		if err != nil {
+			return err
+		}
+		if err := b.CheckEdits(w[path]); err != nil {
+			return fmt.Errorf("%s: %w", path, err)
+		}
+		buffers[i] = b
+	}
+
+	for i, b := range buffers {
+		if err := b.ApplyEdits(w[paths[i]]); err != nil {
+			for _, done := range buffers[:i] {
+				done.Undo()
+			}
+			return fmt.Errorf("%s: %w", paths[i], err)
+		}
+	}
+	return nil
+}
+
+// renamePreview shows what renaming the symbol under the cursor of b to name would change, one
+// line per occurrence, without changing anything.
+func (e *Editor) renamePreview(b *text.Buffer, name string) error {
+	w, err := e.renameEdits(b, name)
+	if err != nil {
+		return err
+	}
+
+	paths := make([]string, 0, len(w))
+	for path := range w {
+		paths = append(paths, path)
+	}
+	slices.Sort(paths)
+
+	var s strings.Builder
+	fmt.Fprintf(&s, "# rename to %s\n", name)
+	for _, path := range paths {
+		src := b
+		if path != b.Path() {
+			if src, err = e.Open(path); err != nil {
+				return err
+			}
+		}
+		for _, edit := range w[path] {
+			n := src.LineOf(edit.Start)
+			line, _ := src.LineRunes(n)
+			fmt.Fprintf(&s, "%s:%d:%d: %s\n", path, n+1, edit.Start-src.Offset(n, 0)+1, strings.TrimSpace(string(line)))
+		}
+	}
+	return e.preview(renamePreviewPath, s.String())
+}
+
+// preview shows content in the buffer bound to the virtual path, creating it if needed, and
+// makes it current.
+func (e *Editor) preview(path, content string) error {
+	i := slices.IndexFunc(e.buffers, func(b *text.Buffer) bool { return b.Path() == path })
+	p := text.New(minSize)
+	if i >= 0 {
+		p = e.buffers[i]
+	}
+	if err := p.Load(strings.NewReader(content)); err != nil {
+		return err
+	}
+	p.SetPath(path)
+	if i < 0 {
+		e.add(p)
+	}
+	e.SetCurrent(p)
+	return nil
+}
@@ -0,0 +1,140 @@
+package editor
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/avalonbits/goted/config"
+	"github.com/avalonbits/goted/format"
+	"github.com/avalonbits/goted/profile"
+	"github.com/avalonbits/goted/text"
+)
+
+// ErrSaveHook is returned when a save hook failed and its failure policy is to abort.
+var ErrSaveHook = errors.New("editor: save hook failed")
+
+// hookTimeout bounds how long a save hook command may run.
+const hookTimeout = 30 * time.Second
+
+// preSave runs the hooks of s that come before b is written. A hook failing with the abort
+// policy stops the chain and the save.
+func (e *Editor) preSave(b *text.Buffer, s config.Settings) error {
+	return e.saveHooks(b, s, s.PreSave, "pre_save")
+}
+
+// postSave runs the hooks of s that come after b was written: the on_save commands, then
+// post_save.
+func (e *Editor) postSave(b *text.Buffer, s config.Settings) error {
+	hooks := make([]config.SaveHook, 0, len(s.OnSave)+len(s.PostSave))
+	for _, cmd := range s.OnSave {
+		hooks = append(hooks, config.SaveHook{Run: cmd})
+	}
+	return e.saveHooks(b, s, append(hooks, s.PostSave...), "post_save")
+}
+
+// saveHooks runs the hooks of chain that apply to the file type of b, in order, logging how
+// long each took. Failures are shown as warnings, except for hooks with the abort policy,
+// which stop the chain and return the error.
+func (e *Editor) saveHooks(b *text.Buffer, s config.Settings, hooks []config.SaveHook, chain string) error {
+	ft := b.Options().FileType
+	for _, h := range hooks {
+		if len(h.FileTypes) > 0 && !slices.Contains(h.FileTypes, ft) {
+			continue
+		}
+		start := time.Now()
+		err := e.saveHook(b, s, h, chain == "pre_save")
+		status := "ok"
+		if err != nil {
+			status = err.Error()
+		}
+		profile.Logf("%s %s on %s: %s in %s", chain, hookName(h), b.Path(), status, time.Since(start).Round(time.Microsecond))
+		if err == nil {
+			continue
+		}
+		if h.OnError == config.HookAbort {
+			return fmt.Errorf("%w: %s %s: %v", ErrSaveHook, chain, hookName(h), err)
+		}
+		e.message = fmt.Sprintf("warning: %s %s: %v", chain, hookName(h), err)
+	}
+	return nil
+}
+
+// saveHook runs h on b, before it is written if pre is set.
+func (e *Editor) saveHook(b *text.Buffer, s config.Settings, h config.SaveHook, pre bool) error {
+	switch {
+	case h.Run != "" && h.Builtin != "":
+		return errors.New("both builtin and run are set")
+	case h.Run != "":
+		var stdin []byte
+		if pre {
+			stdin = []byte(b.String())
+		}
+		_, err := hookCommand(b, s, h.Run, stdin)
+		return err
+	case !pre:
+		return errors.New("built-in hooks only run before saving")
+	}
+
+	src := b.Text(0, b.Len())
+	var out []rune
+	switch h.Builtin {
+	case "trim_whitespace":
+		out = format.TrimTrailing(src)
+	case "final_newline":
+		out = format.FinalNewline(src)
+	case "format":
+		cmd, ok := s.Formatters[b.Options().FileType]
+		if !ok {
+			return nil
+		}
+		res, err := hookCommand(b, s, cmd, []byte(string(src)))
+		if err != nil {
+			return err
+		}
+		out = []rune(string(res))
+	default:
+		return fmt.Errorf("unknown built-in hook %q", h.Builtin)
+	}
+	_, err := format.Reformat(b, out)
+	return err
+}
+
+// hookCommand runs the shell command cmd for b in the project root, or the directory of b, with
+// stdin on its standard input, and returns its output. The command finds the file in the
+// environment as GOTED_FILE and its file type as GOTED_FILETYPE.
+func hookCommand(b *text.Buffer, s config.Settings, cmd string, stdin []byte) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), hookTimeout)
+	defer cancel()
+
+	c := exec.CommandContext(ctx, "sh", "-c", cmd)
+	c.Dir = s.Root
+	if c.Dir == "" {
+		c.Dir = filepath.Dir(b.Path())
+	}
+	c.Env = append(os.Environ(), "GOTED_FILE="+b.Path(), "GOTED_FILETYPE="+b.Options().FileType)
+	var out, stderr bytes.Buffer
+	c.Stdin, c.Stdout, c.Stderr = bytes.NewReader(stdin), &out, &stderr
+	if err := c.Run(); err != nil {
+		if lines := strings.Split(strings.TrimSpace(stderr.String()), "\n"); lines[len(lines)-1] != "" {
+			err = fmt.Errorf("%w: %s", err, lines[len(lines)-1])
+		}
+		return nil, err
+	}
+	return out.Bytes(), nil
+}
+
+// hookName returns how h is named in messages and the debug log.
+func hookName(h config.SaveHook) string {
+	if h.Run != "" {
+		return fmt.Sprintf("%q", h.Run)
+	}
+	return h.Builtin
+}
@@ -0,0 +1,85 @@
+package editor
+
+import (
+	"github.com/avalonbits/goted/screen"
+	"github.com/avalonbits/goted/text"
+	"github.com/avalonbits/goted/theme"
+	"github.com/avalonbits/goted/view"
+)
+
+// scrollbar is where the scrollbar was last drawn, the column of box, and the buffer it
+// scrolls, for clicks on it.
+type scrollbar struct {
+	box    screen.Rect
+	buffer *text.Buffer
+}
+
+// markerScopes are the UI scopes of the markers of the scrollbar.
+var markerScopes = map[view.MarkerKind]string{
+	view.SearchMarker:     "ui.scrollbar.search",
+	view.HunkMarker:       "ui.scrollbar.hunk",
+	view.DiagnosticMarker: "ui.scrollbar.diagnostic",
+}
+
+// drawScrollbar draws the scrollbar of b, shown from the top of v, in the last column of body,
+// if the scrollbar setting is on, and returns the rest of body. The matches of the regex tester
+// and the merge conflicts of b are marked on it.
+func (e *Editor) drawScrollbar(g *screen.Grid, body screen.Rect, b *text.Buffer, v *view.Viewport) screen.Rect {
+	e.shownScrollbar = nil
+	if !e.showScrollbar || body.Width < 2 {
+		return body
+	}
+	th := e.Commands.Theme
+
+	var markers []view.Marker
+	if t := e.tester; t != nil && t.buffer == b && t.re != nil {
+		markers = view.SearchMarkers(b, t.re)
+	}
+	for _, c := range b.Conflicts() {
+		markers = append(markers, view.Marker{Line: c.Start, Kind: view.HunkMarker})
+	}
+
+	bar := screen.Rect{X: body.X + body.Width - 1, Y: body.Y, Width: 1, Height: body.Height}
+	base := scrollbarStyle(th, "ui.scrollbar")
+	thumb := scrollbarStyle(th, "ui.scrollbar.thumb")
+	for i, row := range v.Scrollbar(b.Lines(), markers) {
+		if i >= bar.Height {
+			break
+		}
+		style, cell := base, " "
+		if row.Thumb {
+			style = thumb
+		}
+		if scope, ok := markerScopes[row.Marker]; ok {
+			style.FG, cell = th.Style(scope).FG, "▪"
+		}
+		g.Put(bar.X, bar.Y+i, cell, 1, style)
+	}
+	e.shownScrollbar = &scrollbar{box: bar, buffer: b}
+
+	body.Width--
+	return body
+}
+
+// scrollbarStyle returns the style of the UI scope of the scrollbar, drawn on the background of
+// the text where the theme gives it none.
+func scrollbarStyle(th theme.Theme, scope string) theme.Style {
+	s := th.Style(scope)
+	if s.BG == "" {
+		s.BG = th.Background
+	}
+	return s
+}
+
+// click handles a click on the cell x, y of the screen: on the scrollbar, it moves the cursor
+// to the line that row stands for. Other clicks do nothing.
+func (e *Editor) click(x, y int) {
+	bar := e.shownScrollbar
+	if bar == nil || x != bar.box.X || y < bar.box.Y || y >= bar.box.Y+bar.box.Height {
+		return
+	}
+	b := bar.buffer
+	v := e.viewport(b)
+	b.GotoLine(v.ScrollbarLine(y-bar.box.Y, b.Lines()), 0)
+	v.Top = max(b.Line()-v.Height/2, 0)
+}
@@ -0,0 +1,8 @@
+//go:build !(linux || darwin || freebsd || netbsd || openbsd || dragonfly)
+
+package editor
+
+import "syscall"
+
+// suspendSignal asks the editor to stop. This system has no job control, so it is never sent.
+const suspendSignal = syscall.Signal(-1)
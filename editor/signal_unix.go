@@ -0,0 +1,8 @@
+//go:build linux || darwin || freebsd || netbsd || openbsd || dragonfly
+
+package editor
+
+import "syscall"
+
+// suspendSignal asks the editor to stop.
+const suspendSignal = syscall.SIGTSTP
@@ -0,0 +1,223 @@
+package editor
+
+import (
+	"errors"
+	"fmt"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/avalonbits/goted/syntax"
+	"github.com/avalonbits/goted/text"
+)
+
+// ErrNoSignature is returned when the signature of the function called at the cursor is not
+// known.
+var ErrNoSignature = errors.New("editor: no signature for the call at the cursor")
+
+// declaration matches the declaration of a function in Go, Python, JavaScript, Rust and the
+// like, the name taking the place of %s.
+const declaration = `\b(?:func|def|function|fn)\s+(?:\([^)\n]*\)\s*)?(%s)\s*\(`
+
+// Signature is the signature of a function, shown while a call to it is typed. Params are the
+// parameters as they read in Label, in order.
+type Signature struct {
+	Label  string
+	Params []string
+}
+
+// SignatureHelp shows the signature of the function called around the cursor of b in a popup
+// at the open parenthesis, with the argument the cursor is in highlighted. The popup follows
+// the typing and closes when the call is closed or the cursor leaves it. Signatures come from
+// SignatureProvider if it is set, or else from the declarations in b and, for calls to
+// imported Go packages, from go doc.
+func (e *Editor) SignatureHelp(b *text.Buffer) error {
+	call, ok := b.CallAt(b.Cursor())
+	if !ok {
+		return fmt.Errorf("%w: the cursor is not in a call", ErrNoSignature)
+	}
+	sig, err := e.signature(b, call)
+	if err != nil {
+		return err
+	}
+	e.showSignature(call, sig)
+	return nil
+}
+
+// showSignature shows sig for call, replacing any popup.
+func (e *Editor) showSignature(call text.Call, sig Signature) {
+	e.ShowPopup(sig.Label)
+	e.popup.Anchor = call.Open
+	if span, ok := sig.param(call.Arg); ok {
+		e.popup.Spans = []syntax.Span{span}
+	}
+	e.call, e.callSignature = &call, sig
+}
+
+// updateSignature keeps the signature help in step after key was handled: an open parenthesis
+// typed starts it, and it follows the argument the cursor is in until the call is closed, the
+// cursor leaves it or its popup is closed. Nested calls show their own signature if it is
+// known, and back in the enclosing call its signature shows again.
+func (e *Editor) updateSignature(key string) {
+	if e.call == nil && key != "(" {
+		return
+	}
+	open := e.call
+	e.call = nil
+	b := e.Current()
+	if open != nil && (e.popup == nil || e.popupBuffer != b) {
+		e.popup = nil
+		return
+	}
+
+	call, ok := b.CallAt(b.Cursor())
+	switch {
+	case !ok:
+		if open != nil {
+			e.popup = nil
+		}
+	case open == nil:
+		e.SignatureHelp(b)
+	case call.Open == open.Open:
+		e.showSignature(call, e.callSignature)
+	case call.Open > open.Open && key != "(":
+		// Inside a nested call with no signature of its own.
+		e.call = open
+	default:
+		if err := e.SignatureHelp(b); err == nil {
+			break
+		}
+		if call.Open > open.Open {
+			e.call = open
+		} else {
+			e.popup = nil
+		}
+	}
+}
+
+// signature returns the signature of the function call calls in b.
+func (e *Editor) signature(b *text.Buffer, call text.Call) (Signature, error) {
+	if e.SignatureProvider != nil {
+		return e.SignatureProvider(b, call)
+	}
+
+	name := call.Name[strings.LastIndexByte(call.Name, '.')+1:]
+	re := regexp.MustCompile(fmt.Sprintf(declaration, regexp.QuoteMeta(name)))
+	src := b.String()
+	if m := re.FindStringSubmatchIndex(src); m != nil {
+		return parseSignature(src[m[2]:]), nil
+	}
+
+	pkg, _, ok := strings.Cut(call.Name, ".")
+	if b.Options().FileType != "go" || !ok {
+		return Signature{}, fmt.Errorf("%w: %s is not declared in the buffer", ErrNoSignature, name)
+	}
+	path, ok := importPaths(b)[pkg]
+	if !ok {
+		return Signature{}, fmt.Errorf("%w: %s is not an imported package", ErrNoSignature, pkg)
+	}
+	query := path + "." + name
+	sig, ok := e.signatures[query]
+	if !ok {
+		sig = goDocSignature(b, query)
+		e.signatures[query] = sig
+	}
+	if sig.Label == "" {
+		return Signature{}, fmt.Errorf("%w: go doc has no function %s", ErrNoSignature, query)
+	}
+	return sig, nil
+}
+
+// goDocSignature returns the signature of the function query, as pkg.Name, as go doc prints
+// it, or an empty one if it does not print a function.
+func goDocSignature(b *text.Buffer, query string) Signature {
+	cmd := exec.Command("go", "doc", "-short", query)
+	if path := b.Path(); filepath.IsAbs(path) {
+		cmd.Dir = filepath.Dir(path)
+	}
+	out, err := cmd.Output()
+	if err != nil {
+		return Signature{}
+	}
+	for _, line := range strings.Split(string(out), "\n") {
+		if decl, ok := strings.CutPrefix(line, "func "); ok {
+			return parseSignature(decl)
+		}
+	}
+	return Signature{}
+}
+
+// parseSignature reads a signature from decl, which starts with the name of the function and
+// runs to the end of its parameters, the results that follow them on the line, or further.
+// Runs of white space, as in parameters split across lines, are put on one line. Returns an
+// empty signature if the parameters are not closed.
+func parseSignature(decl string) Signature {
+	open := strings.IndexByte(decl, '(')
+	if open < 0 {
+		return Signature{}
+	}
+
+	var sig Signature
+	depth, start, end := 0, open+1, -1
+scan:
+	for i := open; i < len(decl); i++ {
+		switch decl[i] {
+		case '(', '[', '{':
+			depth++
+		case ')', ']', '}':
+			depth--
+			if depth == 0 {
+				end = i + 1
+				break scan
+			}
+		case ',':
+			if depth == 1 {
+				sig.Params = append(sig.Params, decl[start:i])
+				start = i + 1
+			}
+		}
+	}
+	if end < 0 {
+		return Signature{}
+	}
+	if p := decl[start : end-1]; strings.TrimSpace(p) != "" || len(sig.Params) > 0 {
+		sig.Params = append(sig.Params, p)
+	}
+	for i, p := range sig.Params {
+		sig.Params[i] = strings.Join(strings.Fields(p), " ")
+	}
+
+	// Keep the results on the same line, up to the body.
+	rest, _, _ := strings.Cut(decl[end:], "\n")
+	rest = strings.TrimRight(strings.TrimSuffix(strings.TrimSpace(rest), "{"), " :")
+	sig.Label = strings.Join(strings.Fields(decl[:end]+" "+rest), " ")
+	return sig
+}
+
+// param returns the span of parameter n in the label, or of the last one if it is variadic
+// and n is past it.
+func (s Signature) param(n int) (syntax.Span, bool) {
+	if n >= len(s.Params) && len(s.Params) > 0 && strings.Contains(s.Params[len(s.Params)-1], "...") {
+		n = len(s.Params) - 1
+	}
+	if n >= len(s.Params) {
+		return syntax.Span{}, false
+	}
+
+	at := strings.IndexByte(s.Label, '(') + 1
+	for i, p := range s.Params {
+		j := strings.Index(s.Label[at:], p)
+		if j < 0 {
+			return syntax.Span{}, false
+		}
+		at += j
+		if i == n {
+			start := utf8.RuneCountInString(s.Label[:at])
+			return syntax.Span{Start: start, End: start + utf8.RuneCountInString(p), Scope: "ui.popup.active"}, true
+		}
+		at += len(p)
+	}
+	return syntax.Span{}, false
+}
@@ -0,0 +1,211 @@
+package editor
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/avalonbits/goted/command"
+	"github.com/avalonbits/goted/format"
+	"github.com/avalonbits/goted/storage"
+	"github.com/avalonbits/goted/text"
+)
+
+// State is the state of a session as tooling drives it, such as test frameworks and pair
+// programming tools: the buffers open, which is current and where the cursor, the selection
+// and the bookmarks of each are. Lines and columns are 0-based and count runes.
+type State struct {
+	Current int           `json:"current"`
+	Buffers []BufferState `json:"buffers"`
+}
+
+// BufferState is the state of a buffer in a State. Path is empty for a buffer bound to no
+// file. Text holds the contents of those and of buffers with unsaved changes, the others being
+// as their file is. Anchor is the end of the selection the cursor is not at, if there is one.
+type BufferState struct {
+	Path      string          `json:"path,omitempty"`
+	Text      *string         `json:"text,omitempty"`
+	Modified  bool            `json:"modified,omitempty"`
+	Cursor    Point           `json:"cursor"`
+	Anchor    *Point          `json:"anchor,omitempty"`
+	Bookmarks []BookmarkState `json:"bookmarks,omitempty"`
+	Modes     []string        `json:"modes,omitempty"`
+}
+
+// Point is a place in a buffer.
+type Point struct {
+	Line int `json:"line"`
+	Col  int `json:"col"`
+}
+
+// BookmarkState is a bookmarked line and its note.
+type BookmarkState struct {
+	Line int    `json:"line"`
+	Note string `json:"note,omitempty"`
+}
+
+// State returns the state of the session. Buffers the editor made itself, such as help, are
+// left out.
+func (e *Editor) State() State {
+	var s State
+	for _, b := range e.buffers {
+		if b.Path() != "" && !filepath.IsAbs(b.Path()) && !storage.Remote(b.Path()) {
+			continue
+		}
+		if b == e.Current() {
+			s.Current = len(s.Buffers)
+		}
+		bs := BufferState{
+			Path:     b.Path(),
+			Modified: b.Modified(),
+			Cursor:   pointAt(b, b.Cursor()),
+			Modes:    e.Modes(b),
+		}
+		if b.Path() == "" || b.Modified() {
+			t := b.String()
+			bs.Text = &t
+		}
+		if start, end, ok := b.Selection(); ok {
+			anchor := pointAt(b, start)
+			if b.Cursor() == start {
+				anchor = pointAt(b, end)
+			}
+			bs.Anchor = &anchor
+		}
+		for _, n := range e.notes[b] {
+			bs.Bookmarks = append(bs.Bookmarks, BookmarkState{Line: b.LineOf(n.mark.Offset()), Note: n.note})
+		}
+		s.Buffers = append(s.Buffers, bs)
+	}
+	return s
+}
+
+// SetState puts the session in the state s: the files of s are opened if they are not, the
+// buffers bound to no file are added, and each takes the text, cursor, selection, bookmarks
+// and modes s has for it. Buffers left out of s stay open as they are, except for empty ones
+// bound to no file, such as the one batch scripts start on. Bookmarks are only kept in the
+// session, not saved with those of the project, and modes not registered are skipped.
+func (e *Editor) SetState(s State) error {
+	if len(s.Buffers) == 0 {
+		return nil
+	}
+	if s.Current < 0 || s.Current >= len(s.Buffers) {
+		return fmt.Errorf("%w: current buffer %d of %d", command.ErrUsage, s.Current, len(s.Buffers))
+	}
+	empty := slices.DeleteFunc(slices.Clone(e.buffers), func(b *text.Buffer) bool {
+		return b.Path() != "" || b.Len() > 0 || b.Modified()
+	})
+	bufs := make([]*text.Buffer, len(s.Buffers))
+	for i, bs := range s.Buffers {
+		b, err := e.setBufferState(bs)
+		if err != nil {
+			return err
+		}
+		bufs[i] = b
+	}
+	for _, b := range empty {
+		e.remove(b)
+	}
+	e.SetCurrent(bufs[s.Current])
+	return nil
+}
+
+// setBufferState returns the buffer bs is the state of, opening or adding it, in that state.
+func (e *Editor) setBufferState(bs BufferState) (*text.Buffer, error) {
+	var b *text.Buffer
+	var err error
+	if bs.Path == "" {
+		b, err = e.OpenReader(strings.NewReader(deref(bs.Text)))
+	} else if b, err = e.Open(bs.Path); err == nil && bs.Text != nil {
+		_, err = format.Reformat(b, []rune(*bs.Text))
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	b.Deselect()
+	if bs.Anchor != nil {
+		b.Select(b.Offset(bs.Anchor.Line, bs.Anchor.Col))
+	}
+	b.Seek(b.Offset(bs.Cursor.Line, bs.Cursor.Col))
+
+	if filepath.IsAbs(b.Path()) {
+		for _, n := range e.notes[b] {
+			b.DeleteMark(n.mark)
+		}
+		e.notes[b] = nil
+		for _, bm := range bs.Bookmarks {
+			e.notes[b] = append(e.notes[b], &lineNote{mark: b.NewMark(b.Offset(bm.Line, 0)), note: bm.Note})
+		}
+	}
+
+	for _, m := range e.modes {
+		if err := e.SetMode(b, m.Name, slices.Contains(bs.Modes, m.Name)); err != nil {
+			return nil, err
+		}
+	}
+	return b, nil
+}
+
+// pointAt returns the point of offset in b.
+func pointAt(b *text.Buffer, offset int) Point {
+	n := b.LineOf(offset)
+	return Point{Line: n, Col: offset - b.Offset(n, 0)}
+}
+
+// deref returns what s points to, "" if it is nil.
+func deref(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+// dumpState writes the state of the session as JSON to the file named by the first argument,
+// or to standard output for "-".
+func (e *Editor) dumpState(_ *text.Buffer, args []string) error {
+	if len(args) != 1 {
+		return fmt.Errorf("%w: dump-state needs a file, or - for standard output", command.ErrUsage)
+	}
+	data, err := json.MarshalIndent(e.State(), "", "  ")
+	if err != nil {
+		return err
+	}
+	data = append(data, '\n')
+	if args[0] == "-" {
+		_, err = os.Stdout.Write(data)
+		return err
+	}
+	if err := os.WriteFile(args[0], data, 0o644); err != nil {
+		return err
+	}
+	e.message = "state written to " + args[0]
+	return nil
+}
+
+// loadState puts the session in the state read as JSON from the file named by the first
+// argument, or from standard input for "-".
+func (e *Editor) loadState(_ *text.Buffer, args []string) error {
+	if len(args) != 1 {
+		return fmt.Errorf("%w: load-state needs a file, or - for standard input", command.ErrUsage)
+	}
+	var data []byte
+	var err error
+	if args[0] == "-" {
+		data, err = io.ReadAll(os.Stdin)
+	} else {
+		data, err = os.ReadFile(args[0])
+	}
+	if err != nil {
+		return err
+	}
+	var s State
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("editor: %s: %w", args[0], err)
+	}
+	return e.SetState(s)
+}
@@ -0,0 +1,146 @@
+package editor
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/avalonbits/goted/command"
+	"github.com/avalonbits/goted/event"
+	"github.com/avalonbits/goted/text"
+)
+
+// followInterval is how often the files of followed buffers are checked for new content.
+const followInterval = 250 * time.Millisecond
+
+// maxFollowRead is the most read from a followed file at each check, so that a burst of output
+// does not hold up the keyboard.
+const maxFollowRead = 4 << 20
+
+// follower is how far a followed buffer has read its file. rest is the start of a UTF-8
+// sequence cut by the last read, and readOnly whether the buffer was read-only before.
+type follower struct {
+	info     os.FileInfo
+	size     int64
+	rest     []byte
+	readOnly bool
+}
+
+// Follow toggles follow mode for b, as tail -f does: the buffer becomes read-only and what is
+// written to its file is appended as it arrives. While the cursor is on the last line it stays
+// there, scrolling along, and once moved up it stays put until moved back to the end. A file
+// that is truncated or replaced, as when a log rotates, is read again from the start.
+func (e *Editor) Follow(b *text.Buffer) error {
+	if f, ok := e.followers[b]; ok {
+		delete(e.followers, b)
+		b.SetReadOnly(f.readOnly)
+		e.message = "follow off"
+		event.Publish(e.Events, event.ModeChanged{Buffer: b, Mode: "follow"})
+		return nil
+	}
+
+	if !filepath.IsAbs(b.Path()) {
+		return fmt.Errorf("%w: the buffer has no file to follow", command.ErrUsage)
+	}
+	if b.Modified() {
+		return fmt.Errorf("%w: the buffer has unsaved changes", command.ErrUsage)
+	}
+	info, err := os.Stat(b.Path())
+	if err != nil {
+		return err
+	}
+	e.followers[b] = &follower{info: info, size: info.Size(), readOnly: b.ReadOnly()}
+	b.SetReadOnly(true)
+	b.Seek(b.Len())
+	e.message = "follow on"
+	event.Publish(e.Events, event.ModeChanged{Buffer: b, Mode: "follow", On: true})
+	return nil
+}
+
+// pollFollowers appends what was written to the files of followed buffers since the last
+// check, to be drawn as changedAsync says. Buffers whose file can no longer be read stop
+// following, with the error shown. Returns whether any did, so the error is shown at once.
+func (e *Editor) pollFollowers() bool {
+	stopped := false
+	for b, f := range e.followers {
+		grew, err := f.poll(b)
+		if err != nil {
+			delete(e.followers, b)
+			b.SetReadOnly(f.readOnly)
+			e.message = "follow stopped: " + err.Error()
+			event.Publish(e.Events, event.ModeChanged{Buffer: b, Mode: "follow"})
+			stopped = true
+		}
+		if grew {
+			e.changedAsync(b)
+		}
+	}
+	return stopped
+}
+
+// poll appends what was written to the file of b since the last poll. A file that is missing,
+// as during a log rotation, is waited for.
+func (f *follower) poll(b *text.Buffer) (bool, error) {
+	info, err := os.Stat(b.Path())
+	if errors.Is(err, os.ErrNotExist) {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+
+	atEnd := b.Line() == b.Lines()-1
+	if !os.SameFile(f.info, info) || info.Size() < f.size {
+		f.info, f.size, f.rest = info, info.Size(), nil
+		if err := reload(b); err != nil {
+			return false, err
+		}
+		if atEnd {
+			b.Seek(b.Len())
+		}
+		return true, nil
+	}
+	if info.Size() == f.size {
+		return false, nil
+	}
+
+	file, err := os.Open(b.Path())
+	if err != nil {
+		return false, err
+	}
+	defer file.Close()
+	data, err := io.ReadAll(io.NewSectionReader(file, f.size, min(info.Size()-f.size, maxFollowRead)))
+	if err != nil {
+		return false, err
+	}
+	f.info, f.size = info, f.size+int64(len(data))
+
+	data = append(f.rest, data...)
+	end := completeRunes(data)
+	f.rest = append([]byte(nil), data[end:]...)
+	if err := b.Append([]rune(strings.ReplaceAll(string(data[:end]), "\r\n", "\n"))); err != nil {
+		return false, err
+	}
+	if atEnd {
+		b.Seek(b.Len())
+	}
+	return true, nil
+}
+
+// completeRunes returns the length of the part of data that does not end in a cut UTF-8
+// sequence.
+func completeRunes(data []byte) int {
+	for i := len(data) - 1; i >= max(len(data)-utf8.UTFMax, 0); i-- {
+		if utf8.RuneStart(data[i]) {
+			if !utf8.FullRune(data[i:]) {
+				return i
+			}
+			break
+		}
+	}
+	return len(data)
+}
@@ -0,0 +1,74 @@
+package editor
+
+import (
+	"github.com/avalonbits/goted/term"
+	"github.com/avalonbits/goted/theme"
+)
+
+// SetTheme switches to the theme called name. "dark" and "light" use the theme_dark or
+// theme_light setting, and "auto" either one as suits the background of the terminal, or as
+// the desktop prefers if the terminal does not tell, dark if neither does. Other names are
+// built-in or user themes, see theme.Named. With the transparent setting, the theme has no
+// background of its own.
+func (e *Editor) SetTheme(name string) error {
+	e.autoTheme = name == "auto" || name == ""
+	switch name {
+	case "auto", "":
+		name = "dark"
+		dark, ok := theme.IsDark(e.terminalBackground)
+		if !ok {
+			dark, ok = theme.SystemDark()
+		}
+		if ok && !dark {
+			name = "light"
+		}
+		fallthrough
+	case "dark", "light":
+		name = e.variant(name)
+	}
+	t, err := theme.Named(name)
+	if err != nil {
+		return err
+	}
+	if e.transparent {
+		t.Background = ""
+	}
+	e.Commands.Theme = t
+	return nil
+}
+
+// detectBackground asks the terminal t for its background color and, if the theme is chosen
+// automatically, chooses it again now that the background is known. Returns the keys typed
+// while waiting for the answer.
+func (e *Editor) detectBackground(t *term.Terminal) []byte {
+	color, pending, err := t.Background()
+	if err != nil {
+		return pending
+	}
+	e.terminalBackground = color
+	if e.autoTheme {
+		e.SetTheme("auto")
+	}
+	return pending
+}
+
+// ToggleTheme switches between the dark and light themes, going to the one whose variant the
+// current theme is not.
+func (e *Editor) ToggleTheme() error {
+	if e.Commands.Theme.Variant == "light" {
+		return e.SetTheme("dark")
+	}
+	return e.SetTheme("light")
+}
+
+// variant returns the name of the theme used for the variant v.
+func (e *Editor) variant(v string) string {
+	name := e.themeDark
+	if v == "light" {
+		name = e.themeLight
+	}
+	if name == "" {
+		return v
+	}
+	return name
+}
@@ -0,0 +1,66 @@
+package editor
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/avalonbits/goted/command"
+	"github.com/avalonbits/goted/text"
+	"github.com/avalonbits/goted/view"
+)
+
+// undoTreePrefix starts the path of the buffer showing the undo tree of a buffer, followed by
+// its path.
+const undoTreePrefix = "undo:"
+
+// undoTreeKeys are the bindings of the undo tree, over the global ones.
+var undoTreeKeys = command.Keymap{
+	"Enter": "undo-tree-pick",
+	"Esc":   "switch-previous",
+}
+
+// undoTreeView is the undo tree being shown, in buffer, of source, with the state drawn on each
+// line.
+type undoTreeView struct {
+	buffer *text.Buffer
+	source *text.Buffer
+	seqs   []int
+}
+
+// UndoTree shows the undo tree of b, one state per line with the current one marked "@", in a
+// read-only buffer with the cursor on the current state, in which Enter moves b to the state
+// under the cursor.
+func (e *Editor) UndoTree(b *text.Buffer) error {
+	if strings.HasPrefix(b.Path(), undoTreePrefix) {
+		return fmt.Errorf("%w: already in an undo tree", command.ErrUsage)
+	}
+	states := b.UndoTree()
+	lines, seqs := view.UndoTree(states)
+	if err := e.preview(undoTreePrefix+b.Path(), strings.Join(lines, "\n")); err != nil {
+		return err
+	}
+	tb := e.Current()
+	tb.SetReadOnly(true)
+	e.keymaps[tb] = undoTreeKeys
+	e.undoTree = &undoTreeView{buffer: tb, source: b, seqs: seqs}
+	cur := slices.IndexFunc(seqs, func(seq int) bool { return states[seq].Current })
+	tb.GotoLine(max(cur, 0), 0)
+	return nil
+}
+
+// undoTreePick moves the buffer whose undo tree is shown in b to the state under the cursor,
+// and makes it current again.
+func (e *Editor) undoTreePick(b *text.Buffer) error {
+	t := e.undoTree
+	if t == nil || b != t.buffer {
+		return fmt.Errorf("%w: not the undo tree", command.ErrUsage)
+	}
+	if b.Line() >= len(t.seqs) {
+		return fmt.Errorf("%w: no state under the cursor", command.ErrUsage)
+	}
+	e.undoTree = nil
+	e.SetCurrent(t.source)
+	t.source.UndoTo(t.seqs[b.Line()])
+	return nil
+}
@@ -0,0 +1,91 @@
+package editor
+
+import (
+	"strings"
+
+	"github.com/avalonbits/goted/syntax"
+	"github.com/avalonbits/goted/text"
+)
+
+// maxDiffRun bounds how far a run of removed and added lines of a diff is looked through, up
+// and down, for the lines a changed line pairs with.
+const maxDiffRun = 200
+
+// wordDiffSpans returns the function render.Options.Emphasis takes for b, marking in the
+// diffs b holds the words each changed line changed, or nil if b holds none. In each run of
+// removed lines followed by added ones, the lines are paired in order, and the words of each
+// pair that differ are marked diff.removed.word and diff.added.word.
+func (e *Editor) wordDiffSpans(b *text.Buffer) func(n int) []syntax.Span {
+	ft := b.Options().FileType
+	if ft != "diff" && ft != "gitcommit" {
+		return nil
+	}
+	// Lines starting with "- " in commit messages are bullets, as they are highlighted.
+	bullets := ft == "gitcommit"
+	spans := map[int][]syntax.Span{}
+	return func(n int) []syntax.Span {
+		if s, ok := spans[n]; ok {
+			return s
+		}
+		if diffLineKind(b, n, bullets) == 0 {
+			return nil
+		}
+		first, last := n, n
+		for first > max(n-maxDiffRun, 0) && diffLineKind(b, first-1, bullets) != 0 {
+			first--
+		}
+		for last < min(n+maxDiffRun, b.Lines()-1) && diffLineKind(b, last+1, bullets) != 0 {
+			last++
+		}
+		for l := first; l <= last; {
+			var removed, added []int
+			for ; l <= last && diffLineKind(b, l, bullets) == '-'; l++ {
+				removed = append(removed, l)
+			}
+			for ; l <= last && diffLineKind(b, l, bullets) == '+'; l++ {
+				added = append(added, l)
+			}
+			for _, r := range removed {
+				spans[r] = nil
+			}
+			for _, a := range added {
+				spans[a] = nil
+			}
+			for i := range min(len(removed), len(added)) {
+				spans[removed[i]], spans[added[i]] = wordDiff(b, removed[i], added[i])
+			}
+		}
+		return spans[n]
+	}
+}
+
+// wordDiff returns the spans of the words that differ between the removed line r of b and the
+// added line a, in each.
+func wordDiff(b *text.Buffer, r, a int) (removed, added []syntax.Span) {
+	old, _ := b.LineRunes(r)
+	new, _ := b.LineRunes(a)
+	rs, as := text.DiffWords(old[1:], new[1:])
+	for _, s := range rs {
+		removed = append(removed, syntax.Span{Start: s.Start + 1, End: s.End + 1, Scope: "diff.removed.word"})
+	}
+	for _, s := range as {
+		added = append(added, syntax.Span{Start: s.Start + 1, End: s.End + 1, Scope: "diff.added.word"})
+	}
+	return removed, added
+}
+
+// diffLineKind returns '-' if line n of b is a removed line of a diff, '+' if it is an added
+// one, and 0 otherwise. With bullets, lines starting with "- " are not removed lines.
+func diffLineKind(b *text.Buffer, n int, bullets bool) byte {
+	line, _ := b.LineRunes(n)
+	s := string(line)
+	switch {
+	case strings.HasPrefix(s, "+++ ") || strings.HasPrefix(s, "--- "):
+		return 0
+	case strings.HasPrefix(s, "+"):
+		return '+'
+	case strings.HasPrefix(s, "-") && !(bullets && strings.HasPrefix(s, "- ")):
+		return '-'
+	}
+	return 0
+}
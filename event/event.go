@@ -0,0 +1,89 @@
+// Package event is the bus the editor announces what happens in a session on, such as a buffer
+// being opened or saved, so features and extensions can act on it without the editor calling
+// them.
+//
+// Events are plain structs, and handlers subscribe to one type of event with Subscribe:
+//
+//	event.Subscribe(bus, func(ev event.BufferSaved) {
+//		log.Println("saved", ev.Path)
+//	})
+//
+// Handlers run on the goroutine publishing, in the order they subscribed, so like commands they
+// may use the buffers freely but should be quick.
+package event
+
+import (
+	"reflect"
+
+	"github.com/avalonbits/goted/text"
+)
+
+// BufferOpened is published when a buffer is added to the session, whether for a file or made
+// by the editor, such as help.
+type BufferOpened struct {
+	Buffer *text.Buffer
+}
+
+// BufferSaved is published after a buffer was written to the file at Path.
+type BufferSaved struct {
+	Buffer *text.Buffer
+	Path   string
+}
+
+// CursorMoved is published after a key moved the cursor of the current buffer, or changed the
+// current buffer. Line and Col are 0-based.
+type CursorMoved struct {
+	Buffer    *text.Buffer
+	Line, Col int
+}
+
+// ModeChanged is published when a mode of a buffer, such as follow, is turned on or off.
+type ModeChanged struct {
+	Buffer *text.Buffer
+	Mode   string
+	On     bool
+}
+
+// FileTypeSet is published when a buffer gets its file type, after it was detected on opening
+// and modelines were applied, or when saving under a new name changes it.
+type FileTypeSet struct {
+	Buffer   *text.Buffer
+	FileType string
+}
+
+// Bus delivers events to the handlers subscribed to their type. The zero Bus is ready to use.
+type Bus struct {
+	handlers map[reflect.Type][]*handler
+}
+
+type handler struct {
+	fn func(any)
+}
+
+// Subscribe calls fn with every event of type E published on bus, until the returned function
+// is called.
+func Subscribe[E any](bus *Bus, fn func(E)) (cancel func()) {
+	if bus.handlers == nil {
+		bus.handlers = map[reflect.Type][]*handler{}
+	}
+	t := reflect.TypeFor[E]()
+	h := &handler{fn: func(ev any) { fn(ev.(E)) }}
+	bus.handlers[t] = append(bus.handlers[t], h)
+	return func() {
+		hs := bus.handlers[t]
+		for i, o := range hs {
+			if o == h {
+				bus.handlers[t] = append(hs[:i:i], hs[i+1:]...)
+				return
+			}
+		}
+	}
+}
+
+// Publish calls the handlers subscribed to events of type E with ev. Handlers subscribing or
+// cancelling while it runs take effect from the next event.
+func Publish[E any](bus *Bus, ev E) {
+	for _, h := range bus.handlers[reflect.TypeFor[E]()] {
+		h.fn(ev)
+	}
+}
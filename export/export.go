@@ -0,0 +1,137 @@
+// Package export renders buffers to highlighted HTML or ANSI text.
+package export
+
+import (
+	"bufio"
+	"fmt"
+	"html"
+	"io"
+	"strings"
+
+	"github.com/avalonbits/goted/syntax"
+	"github.com/avalonbits/goted/text"
+	"github.com/avalonbits/goted/theme"
+)
+
+// HTML writes b as a standalone HTML document, highlighted by hl (which may be nil) with the
+// styles of t inlined.
+func HTML(w io.Writer, b *text.Buffer, hl syntax.Highlighter, t theme.Theme) error {
+	out := bufio.NewWriter(w)
+
+	fmt.Fprintf(out, "<!DOCTYPE html>\n<html>\n<head>\n<meta charset=\"utf-8\">\n<title>%s</title>\n</head>\n<body>\n", html.EscapeString(title(b)))
+	fmt.Fprintf(out, "<pre style=\"color: %s; background-color: %s;\">", t.Foreground, t.Background)
+	render(b, hl, func(line []rune, scope string) {
+		if scope == "" {
+			out.WriteString(html.EscapeString(string(line)))
+			return
+		}
+		fmt.Fprintf(out, "<span style=\"%s\">%s</span>", css(t.Style(scope)), html.EscapeString(string(line)))
+	}, func() {
+		out.WriteByte('\n')
+	})
+	out.WriteString("</pre>\n</body>\n</html>\n")
+
+	return out.Flush()
+}
+
+// ANSI writes b as text with 24-bit color escape sequences, highlighted by hl (which may be nil)
+// with the styles of t.
+func ANSI(w io.Writer, b *text.Buffer, hl syntax.Highlighter, t theme.Theme) error {
+	out := bufio.NewWriter(w)
+
+	render(b, hl, func(line []rune, scope string) {
+		if scope == "" {
+			out.WriteString(string(line))
+			return
+		}
+		fmt.Fprintf(out, "%s%s\x1b[0m", SGR(t.Style(scope)), string(line))
+	}, func() {
+		out.WriteByte('\n')
+	})
+
+	return out.Flush()
+}
+
+// SGR returns the escape sequence selecting style s.
+func SGR(s theme.Style) string {
+	codes := []string{}
+	if s.Bold {
+		codes = append(codes, "1")
+	}
+	if s.Italic {
+		codes = append(codes, "3")
+	}
+	if s.Underline {
+		codes = append(codes, "4")
+	}
+	if r, g, b, ok := theme.RGB(s.FG); ok {
+		codes = append(codes, fmt.Sprintf("38;2;%d;%d;%d", r, g, b))
+	}
+	if r, g, b, ok := theme.RGB(s.BG); ok {
+		codes = append(codes, fmt.Sprintf("48;2;%d;%d;%d", r, g, b))
+	}
+	return "\x1b[" + strings.Join(codes, ";") + "m"
+}
+
+// render calls text for every run of each line of b with the scope it belongs to, "" for
+// unhighlighted runs, and newline between lines.
+func render(b *text.Buffer, hl syntax.Highlighter, text func([]rune, string), newline func()) {
+	states := syntax.NewStates(hl)
+	lines := func(n int) []rune {
+		line, _ := b.LineRunes(n)
+		return line
+	}
+	for n := range b.Lines() {
+		if n > 0 {
+			newline()
+		}
+
+		line, _ := b.LineRunes(n)
+		var spans []syntax.Span
+		switch {
+		case states != nil:
+			spans = states.Highlight(n, lines)
+		case hl != nil:
+			spans = hl.Highlight(line)
+		}
+
+		pos := 0
+		for _, s := range spans {
+			if s.Start > pos {
+				text(line[pos:s.Start], "")
+			}
+			text(line[s.Start:s.End], s.Scope)
+			pos = s.End
+		}
+		if pos < len(line) {
+			text(line[pos:], "")
+		}
+	}
+}
+
+func css(s theme.Style) string {
+	var rules []string
+	if s.FG != "" {
+		rules = append(rules, "color: "+s.FG)
+	}
+	if s.BG != "" {
+		rules = append(rules, "background-color: "+s.BG)
+	}
+	if s.Bold {
+		rules = append(rules, "font-weight: bold")
+	}
+	if s.Italic {
+		rules = append(rules, "font-style: italic")
+	}
+	if s.Underline {
+		rules = append(rules, "text-decoration: underline")
+	}
+	return strings.Join(rules, "; ")
+}
+
+func title(b *text.Buffer) string {
+	if b.Path() == "" {
+		return "[No Name]"
+	}
+	return b.Path()
+}
@@ -0,0 +1,203 @@
+package format
+
+import (
+	"bytes"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"os"
+	"path"
+	"path/filepath"
+	"runtime"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/avalonbits/goted/text"
+)
+
+// GoImports formats the Go file src as gofmt does and fixes its imports as goimports does for
+// the standard library: imports that are not used are removed and packages of the standard
+// library that are used but not imported are added. filename is only used in error messages.
+func GoImports(filename string, src []byte) ([]byte, error) {
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, filename, src, parser.ParseComments)
+	if err != nil {
+		return nil, err
+	}
+
+	used := map[string]bool{}
+	ast.Inspect(f, func(n ast.Node) bool {
+		if sel, ok := n.(*ast.SelectorExpr); ok {
+			if id, ok := sel.X.(*ast.Ident); ok && id.Obj == nil {
+				used[id.Name] = true
+			}
+		}
+		return true
+	})
+
+	type cut struct{ start, end int }
+	var cuts []cut
+	imported := map[string]bool{}
+	for _, spec := range f.Imports {
+		p, _ := strconv.Unquote(spec.Path.Value)
+		name := ImportName(p)
+		if spec.Name != nil {
+			name = spec.Name.Name
+		}
+		imported[name] = true
+		if name == "_" || name == "." || used[name] {
+			continue
+		}
+		start := fset.Position(spec.Pos()).Offset
+		if spec.Doc != nil {
+			start = fset.Position(spec.Doc.Pos()).Offset
+		}
+		cuts = append(cuts, cut{lineStart(src, start), lineEnd(src, fset.Position(spec.End()).Offset)})
+	}
+
+	var missing []string
+	for name := range used {
+		if imported[name] || declared(f, name) {
+			continue
+		}
+		if p, ok := stdPackage(name); ok {
+			missing = append(missing, strconv.Quote(p))
+		}
+	}
+	slices.Sort(missing)
+
+	out := src
+	if len(cuts) > 0 || len(missing) > 0 {
+		out = nil
+		at := len(src)
+		for i := len(cuts) - 1; i >= 0; i-- {
+			out = append(append([]byte{}, src[cuts[i].end:at]...), out...)
+			at = cuts[i].start
+		}
+		out = append(append([]byte{}, src[:at]...), out...)
+		// Removing every import of a block leaves an empty "import ()", which gofmt keeps.
+		out = addImports(out, missing)
+		if out, err = dropEmptyImports(filename, out); err != nil {
+			return nil, err
+		}
+	}
+	return format.Source(out)
+}
+
+// addImports adds the quoted import paths to the first import block of src, or as a new block
+// after the package clause if there is none.
+func addImports(src []byte, paths []string) []byte {
+	if len(paths) == 0 {
+		return src
+	}
+	lines := "\t" + strings.Join(paths, "\n\t") + "\n"
+
+	if i := bytes.Index(src, []byte("\nimport (")); i >= 0 {
+		at := i + len("\nimport (")
+		at = lineEnd(src, at)
+		return slices.Concat(src[:at], []byte(lines), src[at:])
+	}
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, "", src, parser.PackageClauseOnly)
+	if err != nil {
+		return src
+	}
+	at := lineEnd(src, fset.Position(f.Name.End()).Offset)
+	return slices.Concat(src[:at], []byte("\nimport (\n"+lines+")\n"), src[at:])
+}
+
+// dropEmptyImports removes the import declarations of src left without specs.
+func dropEmptyImports(filename string, src []byte) ([]byte, error) {
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, filename, src, parser.ImportsOnly|parser.ParseComments)
+	if err != nil {
+		return nil, err
+	}
+	for i := len(f.Decls) - 1; i >= 0; i-- {
+		d, ok := f.Decls[i].(*ast.GenDecl)
+		if !ok || d.Tok != token.IMPORT || len(d.Specs) > 0 {
+			continue
+		}
+		start := lineStart(src, fset.Position(d.Pos()).Offset)
+		end := lineEnd(src, fset.Position(d.End()).Offset)
+		src = slices.Concat(src[:start], src[end:])
+	}
+	return src, nil
+}
+
+// declared reports whether name is declared at the top level of f, so it is not a package.
+func declared(f *ast.File, name string) bool {
+	return f.Scope != nil && f.Scope.Lookup(name) != nil
+}
+
+// ImportName returns the name a package is imported as by default: the last element of its
+// path, without a major version suffix such as v2.
+func ImportName(p string) string {
+	name := path.Base(p)
+	if len(name) > 1 && name[0] == 'v' && strings.Trim(name[1:], "0123456789") == "" {
+		name = path.Base(path.Dir(p))
+	}
+	return strings.TrimPrefix(name, "go-")
+}
+
+var (
+	stdOnce sync.Once
+	stdPkgs map[string]string
+)
+
+// stdPackage returns the import path of the standard library package named name, preferring
+// the shortest path when several share the name, such as "rand".
+func stdPackage(name string) (string, bool) {
+	stdOnce.Do(func() {
+		stdPkgs = map[string]string{}
+		root := filepath.Join(runtime.GOROOT(), "src")
+		filepath.WalkDir(root, func(p string, d os.DirEntry, err error) error {
+			if err != nil || !d.IsDir() {
+				return nil
+			}
+			switch d.Name() {
+			case "internal", "vendor", "testdata", "cmd":
+				return filepath.SkipDir
+			}
+			rel, err := filepath.Rel(root, p)
+			if err != nil || rel == "." {
+				return nil
+			}
+			rel = filepath.ToSlash(rel)
+			base := path.Base(rel)
+			if old, ok := stdPkgs[base]; !ok || len(rel) < len(old) {
+				stdPkgs[base] = rel
+			}
+			return nil
+		})
+	})
+	p, ok := stdPkgs[name]
+	return p, ok
+}
+
+// lineStart returns the offset of the start of the line holding offset.
+func lineStart(src []byte, offset int) int {
+	return bytes.LastIndexByte(src[:offset], '\n') + 1
+}
+
+// lineEnd returns the offset after the line break ending the line holding offset.
+func lineEnd(src []byte, offset int) int {
+	if i := bytes.IndexByte(src[offset:], '\n'); i >= 0 {
+		return offset + i + 1
+	}
+	return len(src)
+}
+
+// Reformat replaces the text of b with out by editing only the lines that differ, so the cursor
+// and marks outside of them stay where they are. It is a single undo step. Returns false if
+// nothing changed.
+func Reformat(b *text.Buffer, out []rune) (bool, error) {
+	edits := text.LineEdits(b.Text(0, b.Len()), out)
+	if len(edits) == 0 {
+		return false, nil
+	}
+	return true, b.ApplyEdits(edits)
+}
@@ -0,0 +1,134 @@
+// Package format reformats structured text held in buffers.
+package format
+
+import (
+	"bytes"
+	"encoding/json"
+	"strconv"
+	"strings"
+
+	"github.com/avalonbits/goted/text"
+)
+
+// JSON pretty prints the JSON document src, indenting nested values with indent.
+func JSON(src []rune, indent string) ([]rune, error) {
+	var out bytes.Buffer
+	if err := json.Indent(&out, []byte(string(src)), "", indent); err != nil {
+		return nil, err
+	}
+	return []rune(out.String()), nil
+}
+
+// CompactJSON removes the insignificant whitespace from the JSON document src.
+func CompactJSON(src []rune) ([]rune, error) {
+	var out bytes.Buffer
+	if err := json.Compact(&out, []byte(string(src))); err != nil {
+		return nil, err
+	}
+	return []rune(out.String()), nil
+}
+
+// ReformatJSON applies fn to the selection of b, or to the whole buffer if there is nothing
+// selected. It is a single undo step and leaves the cursor at the start of the reformatted text.
+func ReformatJSON(b *text.Buffer, fn func([]rune) ([]rune, error)) error {
+	start, end, ok := b.Selection()
+	if !ok {
+		start, end = 0, b.Len()
+	}
+
+	out, err := fn(b.Text(start, end))
+	if err != nil {
+		return err
+	}
+	if err := b.Replace(start, end, out); err != nil {
+		return err
+	}
+
+	b.Deselect()
+	b.Seek(start)
+	return nil
+}
+
+// JSONPath returns the path, in JSONPath notation, of the value under offset in the JSON
+// document src, such as `$.items[2].name`. The document does not need to be valid past offset.
+func JSONPath(src []rune, offset int) string {
+	type level struct {
+		array bool
+		index int
+		key   string
+	}
+	var stack []level
+	expectKey := false
+	var str strings.Builder
+
+	for i := 0; i < len(src) && i < offset; i++ {
+		switch r := src[i]; r {
+		case '"':
+			str.Reset()
+			for i++; i < len(src) && src[i] != '"'; i++ {
+				if src[i] == '\\' && i+1 < len(src) {
+					str.WriteRune(src[i])
+					i++
+				}
+				str.WriteRune(src[i])
+			}
+			if expectKey && len(stack) > 0 {
+				key, err := strconv.Unquote(`"` + str.String() + `"`)
+				if err != nil {
+					key = str.String()
+				}
+				stack[len(stack)-1].key = key
+				expectKey = false
+			}
+		case '{':
+			stack = append(stack, level{})
+			expectKey = true
+		case '[':
+			stack = append(stack, level{array: true})
+		case '}', ']':
+			if len(stack) > 0 {
+				stack = stack[:len(stack)-1]
+			}
+			expectKey = false
+		case ',':
+			if len(stack) == 0 {
+				break
+			}
+			if top := &stack[len(stack)-1]; top.array {
+				top.index++
+			} else {
+				top.key, expectKey = "", true
+			}
+		}
+	}
+
+	var path strings.Builder
+	path.WriteByte('$')
+	for _, l := range stack {
+		switch {
+		case l.array:
+			path.WriteString("[" + strconv.Itoa(l.index) + "]")
+		case l.key == "":
+			return path.String()
+		case isIdent(l.key):
+			path.WriteString("." + l.key)
+		default:
+			path.WriteString("[" + strconv.Quote(l.key) + "]")
+		}
+	}
+	return path.String()
+}
+
+// BufferJSONPath returns the JSONPath of the value under the cursor of b.
+func BufferJSONPath(b *text.Buffer) string {
+	return JSONPath(b.Text(0, b.Cursor()), b.Cursor())
+}
+
+func isIdent(key string) bool {
+	for i, r := range key {
+		if r != '_' && r != '$' && !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || i > 0 && r >= '0' && r <= '9') {
+			return false
+		}
+	}
+	return key != ""
+}
@@ -0,0 +1,29 @@
+package format
+
+// TrimTrailing returns src without the spaces and tabs ending its lines.
+func TrimTrailing(src []rune) []rune {
+	out := make([]rune, 0, len(src))
+	blanks := 0
+	for _, r := range src {
+		switch {
+		case r == '\n':
+			out = append(out[:len(out)-blanks], r)
+			blanks = 0
+		case r == ' ' || r == '\t':
+			out = append(out, r)
+			blanks++
+		default:
+			out = append(out, r)
+			blanks = 0
+		}
+	}
+	return out[:len(out)-blanks]
+}
+
+// FinalNewline returns src ending in a newline, unless it is empty.
+func FinalNewline(src []rune) []rune {
+	if len(src) == 0 || src[len(src)-1] == '\n' {
+		return src
+	}
+	return append(src[:len(src):len(src)], '\n')
+}
@@ -0,0 +1,182 @@
+// Package graphics draws images inline in terminals that can show them, with the kitty
+// graphics protocol, the iTerm2 inline image protocol or sixel.
+//
+// Images are encoded into an escape sequence that draws them with their top left corner at the
+// cursor, scaled to fit a box of terminal cells. It is up to the caller to place the cursor
+// and to keep text from being drawn over the image.
+package graphics
+
+import (
+	"bytes"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"image"
+	"image/png"
+	"os"
+	"strings"
+
+	// Registered to decode the formats images are opened in.
+	_ "image/gif"
+	_ "image/jpeg"
+)
+
+// ErrProtocol is returned for unknown protocol names.
+var ErrProtocol = errors.New("graphics: unknown image protocol")
+
+// Protocol is a way of drawing images in a terminal.
+type Protocol string
+
+// The protocols, None standing for terminals that cannot show images.
+const (
+	None  Protocol = "none"
+	Kitty Protocol = "kitty"
+	ITerm Protocol = "iterm"
+	Sixel Protocol = "sixel"
+)
+
+// Default cell size, in pixels, for terminals that do not report theirs.
+const (
+	defaultCellWidth  = 8
+	defaultCellHeight = 16
+)
+
+// kittyChunk is the most base64 data the kitty protocol takes in one escape sequence.
+const kittyChunk = 4096
+
+// ParseProtocol returns the protocol setting names: "auto" detects one, and "off" is None.
+func ParseProtocol(name string, getenv func(string) string) (Protocol, error) {
+	switch name {
+	case "", "auto":
+		return Detect(getenv), nil
+	case "off", "none":
+		return None, nil
+	case "kitty", "iterm", "sixel":
+		return Protocol(name), nil
+	}
+	return None, fmt.Errorf("%w %q", ErrProtocol, name)
+}
+
+// Detect returns the protocol of the terminal the environment, read through getenv, names.
+// Terminals inside tmux or screen, which do not pass images through, get None.
+func Detect(getenv func(string) string) Protocol {
+	term, program := getenv("TERM"), getenv("TERM_PROGRAM")
+	switch {
+	case getenv("TMUX") != "" || strings.HasPrefix(term, "screen"):
+		return None
+	case getenv("KITTY_WINDOW_ID") != "" || term == "xterm-kitty" || program == "ghostty" || term == "xterm-ghostty":
+		return Kitty
+	case program == "iTerm.app" || program == "WezTerm" || getenv("LC_TERMINAL") == "iTerm2":
+		return ITerm
+	case program == "mlterm" || strings.Contains(term, "sixel") || program == "foot" || strings.HasPrefix(term, "foot"):
+		return Sixel
+	}
+	return None
+}
+
+// Decode reads the image in the file at path, a PNG, JPEG or GIF, returning it and its format.
+func Decode(path string) (image.Image, string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, "", err
+	}
+	defer f.Close()
+	return image.Decode(f)
+}
+
+// Fit returns the size in cells, at most cols by rows, that an image of width by height pixels
+// is shown at, keeping its aspect ratio and never enlarging it, on cells of cellWidth by
+// cellHeight pixels, or a default size if they are 0.
+func Fit(width, height, cols, rows, cellWidth, cellHeight int) (int, int) {
+	if cellWidth <= 0 || cellHeight <= 0 {
+		cellWidth, cellHeight = defaultCellWidth, defaultCellHeight
+	}
+	if width <= 0 || height <= 0 || cols <= 0 || rows <= 0 {
+		return 0, 0
+	}
+	scale := min(1, float64(cols*cellWidth)/float64(width), float64(rows*cellHeight)/float64(height))
+	w := max(int(float64(width)*scale+float64(cellWidth)-1)/cellWidth, 1)
+	h := max(int(float64(height)*scale+float64(cellHeight)-1)/cellHeight, 1)
+	return min(w, cols), min(h, rows)
+}
+
+// Encode returns the escape sequence drawing img with protocol p over cols by rows cells of
+// cellWidth by cellHeight pixels, as Fit computed them, from the cursor.
+func Encode(p Protocol, img image.Image, cols, rows, cellWidth, cellHeight int) (string, error) {
+	if cellWidth <= 0 || cellHeight <= 0 {
+		cellWidth, cellHeight = defaultCellWidth, defaultCellHeight
+	}
+	switch p {
+	case Kitty:
+		data, err := encodePNG(img)
+		if err != nil {
+			return "", err
+		}
+		return kitty(data, cols, rows), nil
+	case ITerm:
+		data, err := encodePNG(img)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("\x1b]1337;File=inline=1;size=%d;width=%d;height=%d;preserveAspectRatio=1:%s\a",
+			len(data), cols, rows, base64.StdEncoding.EncodeToString(data)), nil
+	case Sixel:
+		return sixel(scale(img, cols*cellWidth, rows*cellHeight)), nil
+	}
+	return "", fmt.Errorf("%w %q", ErrProtocol, p)
+}
+
+// Clear returns the escape sequence removing the images drawn with p, for protocols whose
+// images are not removed by drawing text over them, or "".
+func Clear(p Protocol) string {
+	if p == Kitty {
+		return "\x1b_Ga=d,q=2\x1b\\"
+	}
+	return ""
+}
+
+// kitty returns the kitty graphics commands transmitting and showing the PNG data over cols by
+// rows cells, split into chunks as the protocol requires. The cursor is left where it was.
+func kitty(data []byte, cols, rows int) string {
+	enc := base64.StdEncoding.EncodeToString(data)
+	var b strings.Builder
+	for i := 0; i < len(enc); i += kittyChunk {
+		chunk := enc[i:min(i+kittyChunk, len(enc))]
+		more := 0
+		if i+kittyChunk < len(enc) {
+			more = 1
+		}
+		if i == 0 {
+			fmt.Fprintf(&b, "\x1b_Ga=T,f=100,q=2,C=1,c=%d,r=%d,m=%d;%s\x1b\\", cols, rows, more, chunk)
+		} else {
+			fmt.Fprintf(&b, "\x1b_Gm=%d;%s\x1b\\", more, chunk)
+		}
+	}
+	return b.String()
+}
+
+func encodePNG(img image.Image) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// scale returns img scaled down, with nearest neighbor sampling and keeping its aspect ratio,
+// to fit width by height pixels.
+func scale(img image.Image, width, height int) image.Image {
+	b := img.Bounds()
+	f := min(1, float64(width)/float64(b.Dx()), float64(height)/float64(b.Dy()))
+	if f == 1 {
+		return img
+	}
+	w, h := max(int(float64(b.Dx())*f), 1), max(int(float64(b.Dy())*f), 1)
+	out := image.NewRGBA(image.Rect(0, 0, w, h))
+	for y := range h {
+		for x := range w {
+			out.Set(x, y, img.At(b.Min.X+x*b.Dx()/w, b.Min.Y+y*b.Dy()/h))
+		}
+	}
+	return out
+}
@@ -0,0 +1,85 @@
+package graphics
+
+import (
+	"fmt"
+	"image"
+	"strings"
+)
+
+// sixel returns the sixel sequence drawing img, its colors reduced to a 6x6x6 color cube.
+// Transparent pixels are left undrawn.
+func sixel(img image.Image) string {
+	b := img.Bounds()
+	w, h := b.Dx(), b.Dy()
+	index := make([]int, w*h)
+	used := map[int]bool{}
+	for y := range h {
+		for x := range w {
+			r, g, bl, a := img.At(b.Min.X+x, b.Min.Y+y).RGBA()
+			if a < 0x8000 {
+				index[y*w+x] = -1
+				continue
+			}
+			c := int(r*5/0xffff)*36 + int(g*5/0xffff)*6 + int(bl*5/0xffff)
+			index[y*w+x] = c
+			used[c] = true
+		}
+	}
+
+	var s strings.Builder
+	// P2=1 leaves pixels of color 0 alone, so transparent ones show the background.
+	fmt.Fprintf(&s, "\x1bP0;1;0q\"1;1;%d;%d", w, h)
+	for c := range 216 {
+		if used[c] {
+			fmt.Fprintf(&s, "#%d;2;%d;%d;%d", c, c/36*20, c/6%6*20, c%6*20)
+		}
+	}
+	for band := 0; band < h; band += 6 {
+		first := true
+		for c := range 216 {
+			if !used[c] {
+				continue
+			}
+			row := make([]byte, w)
+			drawn := false
+			for x := range w {
+				bits := 0
+				for i := range 6 {
+					if y := band + i; y < h && index[y*w+x] == c {
+						bits |= 1 << i
+					}
+				}
+				row[x] = byte('?' + bits)
+				drawn = drawn || bits != 0
+			}
+			if !drawn {
+				continue
+			}
+			if !first {
+				s.WriteByte('$')
+			}
+			first = false
+			fmt.Fprintf(&s, "#%d", c)
+			writeRuns(&s, row)
+		}
+		s.WriteByte('-')
+	}
+	s.WriteString("\x1b\\")
+	return s.String()
+}
+
+// writeRuns writes the sixels of row, with runs of the same sixel compressed as sixel allows.
+func writeRuns(s *strings.Builder, row []byte) {
+	for i := 0; i < len(row); {
+		j := i + 1
+		for j < len(row) && row[j] == row[i] {
+			j++
+		}
+		if n := j - i; n > 3 {
+			fmt.Fprintf(s, "!%d%c", n, row[i])
+		} else {
+			s.Write(row[i:j])
+		}
+		i = j
+	}
+}
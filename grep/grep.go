@@ -0,0 +1,282 @@
+// Package grep searches the files of a project for a regular expression and replaces matches
+// across files in two phases, through a preview that can be edited in between.
+//
+// A preview has a header naming the replacement and one line per matching line, with the
+// file, relative to the root, the line number and the line as the replacement leaves it:
+//
+//	# replace "Println" with "Printf" in /home/me/project
+//	cmd/main.go:12: 	fmt.Printf("hello")
+//	util.go:40: 	fmt.Printf(x)
+//
+// Removing a line from the preview skips it, and editing the text after the line number
+// writes that text instead. Plan turns a preview back into changes, and Apply writes them,
+// all of them or none.
+package grep
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/avalonbits/goted/storage"
+)
+
+// ErrPreview is returned for preview lines that do not name a match of the preview.
+var ErrPreview = errors.New("grep: bad preview line")
+
+// ErrChanged is returned by Apply when a file changed since it was searched.
+var ErrChanged = errors.New("grep: file changed since the search")
+
+// keyEnd is the end of the file and line key of a preview line.
+var keyEnd = regexp.MustCompile(`:[0-9]+: `)
+
+// maxFileSize is the size above which files are not searched, as they are unlikely to be
+// edited by hand.
+const maxFileSize = 8 << 20
+
+// Match is a line matching a search. Line is 1-based and Text does not include the line break.
+type Match struct {
+	Path string
+	Line int
+	Text string
+}
+
+// Search returns the lines of the files under root that match re, in file order, skipping the
+// directories whose base name matches one of exclude, binary files and very large files.
+func Search(root string, re *regexp.Regexp, exclude []string) ([]Match, error) {
+	var matches []Match
+	err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return nil
+		}
+		if d.IsDir() {
+			if path != root && excluded(d.Name(), exclude) {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !d.Type().IsRegular() {
+			return nil
+		}
+		if info, err := d.Info(); err != nil || info.Size() > maxFileSize {
+			return nil
+		}
+		data, err := os.ReadFile(path)
+		if err != nil || binary(data) {
+			return nil
+		}
+		for n, line := range lines(data) {
+			if re.MatchString(line) {
+				matches = append(matches, Match{Path: path, Line: n + 1, Text: line})
+			}
+		}
+		return nil
+	})
+	return matches, err
+}
+
+// Preview is a replacement planned across the files under Root. Matches are the lines it was
+// planned from, by file and line.
+type Preview struct {
+	Root        string
+	Pattern     string
+	Replacement string
+	Matches     map[string]Match
+}
+
+// Change replaces line Line, 1-based, of the file at Path, which read Old, with New.
+type Change struct {
+	Path string
+	Line int
+	Old  string
+	New  string
+}
+
+// NewPreview plans replacing the matches of re with repl, in which $1 stands for the first
+// group. Matches the replacement leaves unchanged are left out.
+func NewPreview(root string, re *regexp.Regexp, repl string, matches []Match) (*Preview, []Change) {
+	p := &Preview{Root: root, Pattern: re.String(), Replacement: repl, Matches: map[string]Match{}}
+	var changes []Change
+	for _, m := range matches {
+		text := re.ReplaceAllString(m.Text, repl)
+		if text == m.Text {
+			continue
+		}
+		p.Matches[p.key(m.Path, m.Line)] = m
+		changes = append(changes, Change{Path: m.Path, Line: m.Line, Old: m.Text, New: text})
+	}
+	return p, changes
+}
+
+// Format returns the text of the preview showing changes.
+func (p *Preview) Format(changes []Change) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "# replace %q with %q in %s\n", p.Pattern, p.Replacement, p.Root)
+	for _, c := range changes {
+		fmt.Fprintf(&b, "%s: %s\n", p.key(c.Path, c.Line), c.New)
+	}
+	return b.String()
+}
+
+// Plan returns the changes the edited preview text stands for. Lines that are empty or start
+// with # are ignored.
+func (p *Preview) Plan(text string) ([]Change, error) {
+	var changes []Change
+	seen := map[string]bool{}
+	for n, line := range strings.Split(text, "\n") {
+		if strings.TrimSpace(line) == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		key, repl, ok := p.cut(line)
+		m, found := p.Matches[key]
+		if !ok || !found {
+			return nil, fmt.Errorf("%w: line %d: %q matched nothing", ErrPreview, n+1, key)
+		}
+		if seen[key] {
+			return nil, fmt.Errorf("%w: line %d: %s is listed twice", ErrPreview, n+1, key)
+		}
+		seen[key] = true
+		changes = append(changes, Change{Path: m.Path, Line: m.Line, Old: m.Text, New: repl})
+	}
+	return changes, nil
+}
+
+// key returns how the line of a file is named in the preview.
+func (p *Preview) key(path string, line int) string {
+	if rel, err := filepath.Rel(p.Root, path); err == nil {
+		path = rel
+	}
+	return path + ":" + strconv.Itoa(line)
+}
+
+// cut splits a preview line into the file and line key and the replacement text, at the first
+// ":line: " that ends the key of a match, so that paths may have ": " in them too. A line with
+// no such key is split at the first ":line: ", to tell which key matched nothing.
+func (p *Preview) cut(line string) (key, text string, ok bool) {
+	locs := keyEnd.FindAllStringIndex(line, -1)
+	if len(locs) == 0 {
+		return "", "", false
+	}
+	for _, loc := range locs {
+		key = line[:loc[1]-2]
+		if _, found := p.Matches[key]; found {
+			return key, line[loc[1]:], true
+		}
+	}
+	return line[:locs[0][1]-2], line[locs[0][1]:], true
+}
+
+// Apply writes changes, first copying every file it changes into backupDir, under its path
+// relative to root. Every file is checked before any is written, so a file that changed since
+// the search fails with ErrChanged and writes nothing. If writing a file fails, the files
+// already written are restored, and those that could not be are named in the error, joined to
+// that of the write, so they can be taken from backupDir. Returns the files changed.
+func Apply(root string, changes []Change, backupDir string) ([]string, error) {
+	byFile := map[string][]Change{}
+	var files []string
+	for _, c := range changes {
+		if _, ok := byFile[c.Path]; !ok {
+			files = append(files, c.Path)
+		}
+		byFile[c.Path] = append(byFile[c.Path], c)
+	}
+	slices.Sort(files)
+
+	old := map[string][]byte{}
+	replaced := map[string][]byte{}
+	for _, path := range files {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		out, err := rewrite(data, byFile[path])
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+		old[path], replaced[path] = data, out
+	}
+
+	for _, path := range files {
+		backup := filepath.Join(backupDir, relative(root, path))
+		if err := os.MkdirAll(filepath.Dir(backup), 0o700); err != nil {
+			return nil, err
+		}
+		if err := os.WriteFile(backup, old[path], 0o600); err != nil {
+			return nil, err
+		}
+	}
+
+	var file storage.File
+	for i, path := range files {
+		if err := file.Write(path, replaced[path]); err != nil {
+			errs := []error{err}
+			for _, done := range files[:i] {
+				if err := file.Write(done, old[done]); err != nil {
+					backup := filepath.Join(backupDir, relative(root, done))
+					errs = append(errs, fmt.Errorf("%s not restored, its copy is %s: %w", done, backup, err))
+				}
+			}
+			return nil, errors.Join(errs...)
+		}
+	}
+	return files, nil
+}
+
+// rewrite returns data with the lines of changes replaced, keeping their line endings.
+func rewrite(data []byte, changes []Change) ([]byte, error) {
+	ls := bytes.SplitAfter(data, []byte("\n"))
+	for _, c := range changes {
+		if c.Line < 1 || c.Line > len(ls) {
+			return nil, fmt.Errorf("%w: line %d is gone", ErrChanged, c.Line)
+		}
+		line := ls[c.Line-1]
+		text := bytes.TrimRight(line, "\r\n")
+		if string(text) != c.Old {
+			return nil, fmt.Errorf("%w: line %d", ErrChanged, c.Line)
+		}
+		ls[c.Line-1] = append([]byte(c.New), line[len(text):]...)
+	}
+	return bytes.Join(ls, nil), nil
+}
+
+// relative returns path relative to root, or path without its volume and leading separator if
+// it is not under root.
+func relative(root, path string) string {
+	if rel, err := filepath.Rel(root, path); err == nil && !strings.HasPrefix(rel, "..") {
+		return rel
+	}
+	return strings.TrimLeft(path[len(filepath.VolumeName(path)):], `/\`)
+}
+
+// lines splits data into lines without their line breaks.
+func lines(data []byte) []string {
+	s := strings.Split(string(data), "\n")
+	for i, line := range s {
+		s[i] = strings.TrimSuffix(line, "\r")
+	}
+	if len(s) > 0 && s[len(s)-1] == "" {
+		s = s[:len(s)-1]
+	}
+	return s
+}
+
+// binary reports whether data looks like a binary file: it has a NUL byte near the start.
+func binary(data []byte) bool {
+	return bytes.IndexByte(data[:min(len(data), 8000)], 0) >= 0
+}
+
+func excluded(name string, patterns []string) bool {
+	for _, pattern := range patterns {
+		if ok, _ := filepath.Match(pattern, name); ok {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,98 @@
+// Package guard asks for confirmation before operations that are easy to regret: closing a
+// modified buffer, replacing a large number of matches, pasting a lot of text or opening a very
+// large file.
+//
+// The questions go through a Confirm function so interactive front ends can prompt the user
+// while scripts and headless sessions answer them automatically with Always.
+package guard
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/avalonbits/goted/text"
+)
+
+// ErrCanceled is returned by the operations a Guard refused.
+var ErrCanceled = errors.New("guard: canceled")
+
+// Limits are the thresholds above which an operation needs confirmation. A zero limit disables
+// the check.
+type Limits struct {
+	ReplaceMatches int   `json:"replace_matches"`
+	PasteLines     int   `json:"paste_lines"`
+	FileSize       int64 `json:"file_size"`
+}
+
+// DefaultLimits returns the built-in thresholds.
+func DefaultLimits() Limits {
+	return Limits{
+		ReplaceMatches: 1000,
+		PasteLines:     10_000,
+		FileSize:       100 << 20,
+	}
+}
+
+// Guard checks operations against Limits, calling Confirm when one is exceeded. A nil Confirm
+// refuses every operation that needs confirmation.
+type Guard struct {
+	Limits  Limits
+	Confirm func(prompt string) bool
+}
+
+// Always returns a Confirm function that answers every question with yes.
+func Always(yes bool) func(string) bool {
+	return func(string) bool { return yes }
+}
+
+// Close reports whether b may be closed, asking first if it has unsaved changes.
+func (g Guard) Close(b *text.Buffer) bool {
+	if !b.Modified() {
+		return true
+	}
+
+	name := filepath.Base(b.Path())
+	if b.Path() == "" {
+		name = "[No Name]"
+	}
+	return g.ask(fmt.Sprintf("%s has unsaved changes. Close anyway?", name))
+}
+
+// ReplaceAll reports whether a replacement affecting count matches may proceed.
+func (g Guard) ReplaceAll(count int) bool {
+	if g.Limits.ReplaceMatches <= 0 || count <= g.Limits.ReplaceMatches {
+		return true
+	}
+	return g.ask(fmt.Sprintf("Replace %d matches?", count))
+}
+
+// Paste reports whether pasting text spanning lines lines may proceed.
+func (g Guard) Paste(lines int) bool {
+	if g.Limits.PasteLines <= 0 || lines <= g.Limits.PasteLines {
+		return true
+	}
+	return g.ask(fmt.Sprintf("Paste %d lines?", lines))
+}
+
+// Open reports whether the file at path may be opened, asking first if it is larger than the
+// limit. Files that do not exist yet may always be opened.
+func (g Guard) Open(path string) (bool, error) {
+	info, err := os.Stat(path)
+	if os.IsNotExist(err) {
+		return true, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	if g.Limits.FileSize <= 0 || info.Size() <= g.Limits.FileSize {
+		return true, nil
+	}
+	return g.ask(fmt.Sprintf("%s is %d MiB. Open anyway?", filepath.Base(path), info.Size()>>20)), nil
+}
+
+func (g Guard) ask(prompt string) bool {
+	return g.Confirm != nil && g.Confirm(prompt)
+}
@@ -0,0 +1,171 @@
+// Package help provides the built-in documentation, shown in read-only help buffers.
+//
+// Help files are plain text in the style of vim help files: a word between stars, *topic*, is a
+// tag marking where a topic is described, and a word between bars, |topic|, links to it.
+package help
+
+import (
+	"bytes"
+	"embed"
+	"errors"
+	"fmt"
+	"io/fs"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/avalonbits/goted/command"
+	"github.com/avalonbits/goted/syntax"
+	"github.com/avalonbits/goted/text"
+)
+
+// PathPrefix starts the paths of help buffers. It is followed by the help file name.
+const PathPrefix = "help:"
+
+// FileType is the file type of help buffers.
+const FileType = "help"
+
+// ErrNoTopic is returned when there is no help for a topic.
+var ErrNoTopic = errors.New("help: no such topic")
+
+//go:embed doc/*.txt
+var docs embed.FS
+
+var (
+	tagPattern  = regexp.MustCompile(`\*([^*\s|]+)\*`)
+	linkPattern = regexp.MustCompile(`\|([^|\s*]+)\|`)
+)
+
+func init() {
+	syntax.Register(FileType, syntax.Rules{
+		{Pattern: tagPattern, Scope: "markup.heading"},
+		{Pattern: linkPattern, Scope: "markup.link"},
+		{Pattern: regexp.MustCompile(`^[A-Z][A-Z ]+$`), Scope: "markup.heading"},
+	})
+}
+
+// Location is where a topic is described: a line of a help file.
+type Location struct {
+	File string
+	Line int
+}
+
+// tags maps every topic to where it is described.
+var tags = sync.OnceValue(func() map[string]Location {
+	m := map[string]Location{}
+	files, _ := fs.Glob(docs, "doc/*.txt")
+	for _, file := range files {
+		data, _ := docs.ReadFile(file)
+		name := strings.TrimPrefix(file, "doc/")
+		for n, line := range strings.Split(string(data), "\n") {
+			for _, t := range tagPattern.FindAllStringSubmatch(line, -1) {
+				m[t[1]] = Location{File: name, Line: n}
+			}
+		}
+	}
+	return m
+})
+
+// Topics returns every topic, sorted.
+func Topics() []string {
+	var topics []string
+	for t := range tags() {
+		topics = append(topics, t)
+	}
+	slices.Sort(topics)
+	return topics
+}
+
+// Find returns where topic is described. Topics are matched exactly, then ignoring case and then
+// as the prefix of the first topic starting with it. A key chord bound in keymap, such as
+// "Ctrl+Z", finds the topic of its command.
+func Find(topic string, keymap command.Keymap) (Location, bool) {
+	if cmd, ok := keymap[topic]; ok {
+		topic, _, _ = strings.Cut(cmd, " ")
+	}
+
+	if loc, ok := tags()[topic]; ok {
+		return loc, true
+	}
+	topics := Topics()
+	for _, t := range topics {
+		if strings.EqualFold(t, topic) {
+			return tags()[t], true
+		}
+	}
+	for _, t := range topics {
+		if strings.HasPrefix(t, topic) {
+			return tags()[t], true
+		}
+	}
+	return Location{}, false
+}
+
+// IsHelp reports whether b is a help buffer.
+func IsHelp(b *text.Buffer) bool {
+	return strings.HasPrefix(b.Path(), PathPrefix)
+}
+
+// Show loads the help for topic into b, makes it read-only and puts the cursor on the topic.
+// An empty topic shows the index.
+func Show(b *text.Buffer, topic string, keymap command.Keymap) error {
+	if topic == "" {
+		topic = "index"
+	}
+	loc, ok := Find(topic, keymap)
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrNoTopic, topic)
+	}
+
+	if b.Path() != PathPrefix+loc.File {
+		data, err := docs.ReadFile("doc/" + loc.File)
+		if err != nil {
+			return err
+		}
+		if err := b.Load(bytes.NewReader(data)); err != nil {
+			return err
+		}
+		b.SetPath(PathPrefix + loc.File)
+		b.SetReadOnly(true)
+
+		o := b.Options()
+		o.FileType = FileType
+		b.SetOptions(o)
+	}
+
+	line, _ := b.LineRunes(loc.Line)
+	col := 0
+	for _, m := range tagPattern.FindAllStringSubmatchIndex(string(line), -1) {
+		if t := string(line)[m[2]:m[3]]; t == topic || tags()[t] == loc {
+			col = len([]rune(string(line)[:m[0]]))
+			break
+		}
+	}
+	b.GotoLine(loc.Line, col)
+	return nil
+}
+
+// LinkAt returns the topic of the link, or tag, under column col of line.
+func LinkAt(line []rune, col int) (string, bool) {
+	s := string(line)
+	for _, re := range []*regexp.Regexp{linkPattern, tagPattern} {
+		for _, m := range re.FindAllStringSubmatchIndex(s, -1) {
+			start, end := len([]rune(s[:m[0]])), len([]rune(s[:m[1]]))
+			if col >= start && col < end {
+				return s[m[2]:m[3]], true
+			}
+		}
+	}
+	return "", false
+}
+
+// Follow shows the topic of the link under the cursor of b.
+func Follow(b *text.Buffer, keymap command.Keymap) error {
+	line, _ := b.LineRunes(b.Line())
+	topic, ok := LinkAt(line, b.Column())
+	if !ok {
+		return fmt.Errorf("%w: no link under the cursor", ErrNoTopic)
+	}
+	return Show(b, topic, keymap)
+}
@@ -0,0 +1,187 @@
+// Package history keeps a copy of each save of a file, apart from version control, so that an
+// earlier save can be looked at, compared or brought back even in files git does not track.
+//
+// Each file has a directory of its own in the cache directory, holding one file per save named
+// after the time of the save. Saves identical to the one before are not kept again. The history
+// is bounded by Limits, pruned after each save.
+package history
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"os"
+	"path/filepath"
+	"slices"
+	"time"
+)
+
+// stamp is the layout of the names of saved versions, in UTC.
+const stamp = "20060102T150405.000000000"
+
+// Limits bound the history: versions older than MaxDays days are dropped, and then the oldest
+// ones while all of them together take more than MaxSize bytes. A zero limit disables the
+// bound.
+type Limits struct {
+	MaxSize int64 `json:"max_size"`
+	MaxDays int   `json:"max_days"`
+}
+
+// DefaultLimits returns the built-in bounds.
+func DefaultLimits() Limits {
+	return Limits{MaxSize: 100 << 20, MaxDays: 30}
+}
+
+// Version is a saved version of a file.
+type Version struct {
+	// Path is the file the version is kept in.
+	Path string
+	Time time.Time
+	Size int64
+}
+
+// Read returns the contents of v.
+func (v Version) Read() ([]byte, error) {
+	return os.ReadFile(v.Path)
+}
+
+// Dir returns the directory the history is kept in.
+func Dir() (string, error) {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "goted", "history"), nil
+}
+
+// fileDir returns the directory the versions of the file at path are kept in.
+func fileDir(path string) (string, error) {
+	dir, err := Dir()
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256([]byte(path))
+	return filepath.Join(dir, filepath.Base(path)+"-"+hex.EncodeToString(sum[:8])), nil
+}
+
+// Save keeps data as the version of the file at path saved at t, unless it is what the latest
+// version holds, and prunes the history to l.
+func Save(path string, data []byte, t time.Time, l Limits) error {
+	versions, err := List(path)
+	if err != nil {
+		return err
+	}
+	if len(versions) > 0 && versions[0].Size == int64(len(data)) {
+		if last, err := versions[0].Read(); err == nil && bytes.Equal(last, data) {
+			return nil
+		}
+	}
+
+	dir, err := fileDir(path)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return err
+	}
+	if err := os.WriteFile(filepath.Join(dir, t.UTC().Format(stamp)), data, 0o600); err != nil {
+		return err
+	}
+	return Prune(l, t)
+}
+
+// List returns the versions kept of the file at path, newest first.
+func List(path string) ([]Version, error) {
+	dir, err := fileDir(path)
+	if err != nil {
+		return nil, err
+	}
+	return list(dir)
+}
+
+// list returns the versions kept in dir, newest first.
+func list(dir string) ([]Version, error) {
+	entries, err := os.ReadDir(dir)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	var versions []Version
+	for _, entry := range entries {
+		t, err := time.Parse(stamp, entry.Name())
+		if err != nil || !entry.Type().IsRegular() {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil {
+			continue
+		}
+		versions = append(versions, Version{Path: filepath.Join(dir, entry.Name()), Time: t, Size: info.Size()})
+	}
+	slices.SortFunc(versions, func(a, b Version) int { return b.Time.Compare(a.Time) })
+	return versions, nil
+}
+
+// Prune removes the versions of every file that are past l as of now, and the directories of
+// files left with none. It keeps going after failures and returns the first.
+func Prune(l Limits, now time.Time) error {
+	dir, err := Dir()
+	if err != nil {
+		return err
+	}
+	entries, err := os.ReadDir(dir)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	var first error
+	remove := func(path string) {
+		if err := os.Remove(path); err != nil && first == nil {
+			first = err
+		}
+	}
+	var all []Version
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		fdir := filepath.Join(dir, entry.Name())
+		versions, err := list(fdir)
+		if err != nil {
+			if first == nil {
+				first = err
+			}
+			continue
+		}
+		kept := 0
+		for _, v := range versions {
+			if l.MaxDays > 0 && now.Sub(v.Time) > time.Duration(l.MaxDays)*24*time.Hour {
+				remove(v.Path)
+				continue
+			}
+			all = append(all, v)
+			kept++
+		}
+		if kept == 0 {
+			remove(fdir)
+		}
+	}
+
+	if l.MaxSize <= 0 {
+		return first
+	}
+	slices.SortFunc(all, func(a, b Version) int { return b.Time.Compare(a.Time) })
+	var size int64
+	for _, v := range all {
+		size += v.Size
+		if size > l.MaxSize {
+			remove(v.Path)
+		}
+	}
+	return first
+}
@@ -0,0 +1,92 @@
+// Package idle runs deferred maintenance work, such as writing undo files, while the user is not
+// typing, so it never adds to the latency of a keystroke.
+//
+// The scheduler does not start goroutines: the event loop tells it about input with Touch and,
+// when no input arrived for Wait, calls Run with a time budget. Tasks run on the loop's goroutine
+// and may touch the buffers freely, but each should be short since input is not read while it
+// runs.
+package idle
+
+import "time"
+
+// DefaultDelay is how long the user must be inactive before tasks run.
+const DefaultDelay = 500 * time.Millisecond
+
+// Scheduler holds the tasks waiting for the user to be idle.
+type Scheduler struct {
+	// Delay is how long after the last input tasks start running.
+	Delay time.Duration
+
+	last  time.Time
+	tasks []task
+}
+
+type task struct {
+	name string
+	fn   func()
+}
+
+// New returns a Scheduler with the default delay.
+func New() *Scheduler {
+	return &Scheduler{Delay: DefaultDelay, last: time.Now()}
+}
+
+// Touch records input from the user, holding tasks back for another Delay.
+func (s *Scheduler) Touch() {
+	s.last = time.Now()
+}
+
+// Schedule queues fn to run once the user is idle. Scheduling a name already queued replaces its
+// function but keeps its place in the queue, so work requested on every keystroke runs once.
+func (s *Scheduler) Schedule(name string, fn func()) {
+	for i := range s.tasks {
+		if s.tasks[i].name == name {
+			s.tasks[i].fn = fn
+			return
+		}
+	}
+	s.tasks = append(s.tasks, task{name, fn})
+}
+
+// Cancel removes the task queued under name, if any.
+func (s *Scheduler) Cancel(name string) {
+	for i := range s.tasks {
+		if s.tasks[i].name == name {
+			s.tasks = append(s.tasks[:i], s.tasks[i+1:]...)
+			return
+		}
+	}
+}
+
+// Pending returns how many tasks are queued.
+func (s *Scheduler) Pending() int {
+	return len(s.tasks)
+}
+
+// Wait returns how long until queued tasks may run, 0 if they may run now. It returns -1 if no
+// task is queued, so the event loop need not wake up.
+func (s *Scheduler) Wait() time.Duration {
+	if len(s.tasks) == 0 {
+		return -1
+	}
+	return max(s.Delay-time.Since(s.last), 0)
+}
+
+// Run runs queued tasks, oldest first, if the user has been idle for Delay. It stops once budget
+// is spent, leaving the rest for the next call, and returns how many tasks ran. A task that
+// schedules more work is picked up by a later call.
+func (s *Scheduler) Run(budget time.Duration) int {
+	if s.Wait() != 0 {
+		return 0
+	}
+
+	start := time.Now()
+	ran, queued := 0, len(s.tasks)
+	for ran < queued && len(s.tasks) > 0 && (ran == 0 || time.Since(start) < budget) {
+		t := s.tasks[0]
+		s.tasks = s.tasks[1:]
+		t.fn()
+		ran++
+	}
+	return ran
+}
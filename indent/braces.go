@@ -0,0 +1,168 @@
+package indent
+
+import (
+	"regexp"
+	"strings"
+)
+
+// maxLookBack is how many lines back the line opening a bracket is looked for.
+const maxLookBack = 5000
+
+// Braces indents the lines inside brackets one level deeper than the line opening them, and a
+// line starting with a closing bracket as deep as that line, as in C and Go. Pairs holds the
+// brackets as opening and closing pairs, "(){}[]" if empty. Brackets in strings, between the
+// quotes in Quotes, and in comments, after LineComment or between /* and */ on a line if
+// BlockComment is set, are not counted. A line that Labels matches, without its indentation
+// and comment, such as a case label in Go, goes as deep as the line opening the brackets
+// around it and the lines after it one level deeper.
+type Braces struct {
+	Pairs        string
+	Quotes       string
+	LineComment  string
+	BlockComment bool
+	Labels       *regexp.Regexp
+}
+
+// Indent implements Engine.
+func (br Braces) Indent(c Context, n int) int {
+	cur := br.code(c.Line(n))
+	if br.closes(cur) || matches(br.Labels, cur) {
+		if o, ok := br.opener(c, n, 1); ok {
+			return c.Width(c.Line(o))
+		}
+	}
+	p, ok := previous(c, n)
+	if !ok {
+		return 0
+	}
+	w, open := br.after(c, p)
+	if open || matches(br.Labels, br.code(c.Line(p))) {
+		w += c.Level()
+	}
+	return w
+}
+
+// after returns how deep the lines after line p go unless they open or close blocks: as deep
+// as p or, if p closes brackets it does not open, as the line opening them. open reports
+// whether p leaves brackets open.
+func (br Braces) after(c Context, p int) (w int, open bool) {
+	line := c.Line(p)
+	opens, closes := br.unmatched(line)
+	w = c.Width(line)
+	if closes > 0 {
+		if o, ok := br.opener(c, p, closes); ok {
+			w = c.Width(c.Line(o))
+		}
+	}
+	return w, opens > 0
+}
+
+// closes reports whether code, a line without its indentation, starts with a closing bracket.
+func (br Braces) closes(code string) bool {
+	if code == "" {
+		return false
+	}
+	i := strings.IndexRune(br.pairs(), []rune(code)[0])
+	return i >= 0 && i%2 == 1
+}
+
+// opener returns the line before line n opening the depth-th bracket left open before it.
+func (br Braces) opener(c Context, n, depth int) (int, bool) {
+	pairs := br.pairs()
+	for i := n - 1; i >= 0 && i >= n-maxLookBack; i-- {
+		brackets := br.brackets(c.Line(i))
+		for j := len(brackets) - 1; j >= 0; j-- {
+			if strings.IndexRune(pairs, brackets[j])%2 == 1 {
+				depth++
+				continue
+			}
+			if depth--; depth == 0 {
+				return i, true
+			}
+		}
+	}
+	return 0, false
+}
+
+// unmatched returns how many brackets line leaves open and how many it closes that it does not
+// open.
+func (br Braces) unmatched(line string) (opens, closes int) {
+	pairs := br.pairs()
+	for _, r := range br.brackets(line) {
+		switch {
+		case strings.IndexRune(pairs, r)%2 == 0:
+			opens++
+		case opens > 0:
+			opens--
+		default:
+			closes++
+		}
+	}
+	return opens, closes
+}
+
+// brackets returns the brackets of line outside strings and comments.
+func (br Braces) brackets(line string) []rune {
+	var out []rune
+	pairs := br.pairs()
+	br.scan(line, func(r rune) {
+		if strings.ContainsRune(pairs, r) {
+			out = append(out, r)
+		}
+	})
+	return out
+}
+
+// code returns line without its indentation, its trailing comment and the whitespace before
+// the comment.
+func (br Braces) code(line string) string {
+	line = strings.TrimSpace(line)
+	return strings.TrimSpace(line[:br.scan(line, nil)])
+}
+
+// scan calls fn with each character of line outside strings and comments, and returns the
+// byte offset of the line comment, or the length of line if there is none.
+func (br Braces) scan(line string, fn func(r rune)) int {
+	var quote rune
+	escaped, comment := false, -1
+	for i, r := range line {
+		switch {
+		case comment >= 0:
+			// The star opening the comment does not also close it.
+			if r == '/' && i > comment+2 && line[i-1] == '*' {
+				comment = -1
+			}
+		case quote != 0:
+			switch {
+			case escaped:
+				escaped = false
+			case r == '\\' && quote != '`':
+				escaped = true
+			case r == quote:
+				quote = 0
+			}
+		case br.LineComment != "" && strings.HasPrefix(line[i:], br.LineComment):
+			return i
+		case br.BlockComment && strings.HasPrefix(line[i:], "/*"):
+			comment = i
+		case strings.ContainsRune(br.Quotes, r):
+			quote = r
+		case fn != nil:
+			fn(r)
+		}
+	}
+	return len(line)
+}
+
+// pairs returns the brackets of br.
+func (br Braces) pairs() string {
+	if br.Pairs == "" {
+		return "(){}[]"
+	}
+	return br.Pairs
+}
+
+// matches reports whether re is set and matches s.
+func matches(re *regexp.Regexp, s string) bool {
+	return re != nil && re.MatchString(s)
+}
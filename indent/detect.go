@@ -0,0 +1,82 @@
+package indent
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Style is how a document is indented: with tabs, or with Width spaces a level.
+type Style struct {
+	Tabs  bool
+	Width int
+}
+
+// String returns the style as the status line shows it, such as "tabs" or "spaces:4".
+func (s Style) String() string {
+	if s.Tabs {
+		return "tabs"
+	}
+	return "spaces:" + strconv.Itoa(s.Width)
+}
+
+const (
+	// maxDetect is how many lines Detect looks at.
+	maxDetect = 5000
+
+	// minIndented is how many indented lines Detect needs to tell the style.
+	minIndented = 4
+)
+
+// Detect returns the indentation style of the first count lines of a document, as most of its
+// indented lines show it, and false if too few lines are indented to tell. The width of spaces
+// is the step most often taken between the indentation of a line and that of the line before,
+// among 2, 3, 4 and 8. Blank lines and the continuation lines of block comments, indented one
+// space past their opening, are skipped.
+func Detect(line func(n int) string, count int) (Style, bool) {
+	tabs, spaces := 0, 0
+	steps := map[int]int{}
+	prev := 0
+	for n := range min(count, maxDetect) {
+		l := line(n)
+		text := strings.TrimLeft(l, " \t")
+		if text == "" {
+			continue
+		}
+		lead := l[:len(l)-len(text)]
+		switch {
+		case strings.HasPrefix(text, "*") && strings.HasSuffix(lead, " "):
+			continue
+		case strings.HasPrefix(lead, "\t"):
+			tabs++
+			continue
+		case lead != "" && strings.Trim(lead, " ") == "":
+			spaces++
+		}
+		width := len(lead)
+		if strings.Contains(lead, "\t") {
+			prev = width
+			continue
+		}
+		if d := width - prev; d > 0 {
+			steps[d]++
+		}
+		prev = width
+	}
+
+	if tabs+spaces < minIndented {
+		return Style{}, false
+	}
+	if tabs > spaces {
+		return Style{Tabs: true}, true
+	}
+	best := 0
+	for _, w := range []int{2, 4, 8, 3} {
+		if steps[w] > steps[best] {
+			best = w
+		}
+	}
+	if best == 0 {
+		return Style{}, false
+	}
+	return Style{Width: best}, true
+}
@@ -0,0 +1,115 @@
+// Package indent works out how deep lines are indented by the rules of their language.
+//
+// An Engine gives the indentation of a line from the lines before it. Braces indents the lines
+// inside brackets, as in C and Go, and Keywords the lines inside blocks that words open and
+// close, as in Python and shell scripts. Engines are registered per file type.
+package indent
+
+import (
+	"regexp"
+	"strings"
+)
+
+// Context is what an engine knows of a document: its lines, the width of a tab and whether
+// indentation is made of tabs or of spaces.
+type Context struct {
+	Line     func(n int) string
+	TabWidth int
+	Tabs     bool
+}
+
+// Level returns the width of one level of indentation, in columns.
+func (c Context) Level() int {
+	return max(c.TabWidth, 1)
+}
+
+// Width returns the width of the leading whitespace of line, in columns.
+func (c Context) Width(line string) int {
+	w := 0
+	for _, r := range line {
+		switch r {
+		case ' ':
+			w++
+		case '\t':
+			w += c.Level() - w%c.Level()
+		default:
+			return w
+		}
+	}
+	return w
+}
+
+// String returns the indentation width columns wide: tabs and then the spaces left over if
+// indentation is made of tabs, or spaces.
+func (c Context) String(width int) string {
+	if !c.Tabs {
+		return strings.Repeat(" ", width)
+	}
+	return strings.Repeat("\t", width/c.Level()) + strings.Repeat(" ", width%c.Level())
+}
+
+// Engine gives the indentation of line n of a document, in columns, by the rules of a
+// language.
+type Engine interface {
+	Indent(c Context, n int) int
+}
+
+// Line returns the indentation line n should have by the rules of e.
+func Line(e Engine, c Context, n int) string {
+	return c.String(max(e.Indent(c, n), 0))
+}
+
+// engines maps file types to their engines.
+var engines = map[string]Engine{
+	"go": Braces{
+		Quotes:       "\"'`",
+		LineComment:  "//",
+		BlockComment: true,
+		Labels:       regexp.MustCompile(`^(case\b.*|default\s*):$`),
+	},
+	"c":          cLike,
+	"cpp":        cLike,
+	"java":       cLike,
+	"javascript": Braces{Quotes: "\"'`", LineComment: "//", BlockComment: true},
+	"typescript": Braces{Quotes: "\"'`", LineComment: "//", BlockComment: true},
+	"rust":       Braces{Quotes: `"`, LineComment: "//", BlockComment: true},
+	"css":        Braces{Quotes: `"'`, BlockComment: true},
+	"json":       Braces{Quotes: `"`},
+	"python": Keywords{
+		Braces:  Braces{Quotes: `"'`, LineComment: "#"},
+		Open:    regexp.MustCompile(`:$`),
+		Middle:  regexp.MustCompile(`^(else|elif|except|finally)\b`),
+		Exit:    regexp.MustCompile(`^(return|pass|break|continue|raise)\b`),
+		Offside: true,
+	},
+	"sh": Keywords{
+		Braces: Braces{Pairs: "{}", Quotes: `"'`, LineComment: "#"},
+		Open:   regexp.MustCompile(`\b(then|do)$`),
+		Close:  regexp.MustCompile(`^(fi|done)\b`),
+		Middle: regexp.MustCompile(`^(else|elif)\b`),
+	},
+}
+
+// cLike are the rules of C and the languages that look like it. Their case labels are left
+// indented as the lines inside the switch, as styles differ.
+var cLike = Braces{Quotes: `"'`, LineComment: "//", BlockComment: true}
+
+// For returns the engine for a file type, or nil if there is none.
+func For(fileType string) Engine {
+	return engines[fileType]
+}
+
+// Register sets the engine for a file type.
+func Register(fileType string, e Engine) {
+	engines[fileType] = e
+}
+
+// previous returns the last line before line n that is not blank.
+func previous(c Context, n int) (int, bool) {
+	for i := n - 1; i >= 0; i-- {
+		if strings.TrimSpace(c.Line(i)) != "" {
+			return i, true
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,51 @@
+package indent
+
+import (
+	"regexp"
+	"strings"
+)
+
+// Keywords indents by words as well as by the brackets of Braces, as in Python and shell
+// scripts. The lines after a line that Open matches go one level deeper, and a line that Close
+// matches one level out. A line that Middle matches, such as an else, goes one level out and
+// the lines after it back in, and the lines after a line that Exit matches, such as a return,
+// go one level out. Patterns match lines without their indentation and comment. In Offside
+// languages, whose blocks end where their indentation does, a line is not indented deeper than
+// its rules give, but keeps a shallower indentation unless the line before opens a block, since
+// only the indentation tells where a block ends.
+type Keywords struct {
+	Braces
+	Open    *regexp.Regexp
+	Close   *regexp.Regexp
+	Middle  *regexp.Regexp
+	Exit    *regexp.Regexp
+	Offside bool
+}
+
+// Indent implements Engine.
+func (k Keywords) Indent(c Context, n int) int {
+	line := c.Line(n)
+	cur := k.code(line)
+	if k.closes(cur) {
+		if o, ok := k.opener(c, n, 1); ok {
+			return c.Width(c.Line(o))
+		}
+	}
+	p, ok := previous(c, n)
+	if !ok {
+		return 0
+	}
+	w, open := k.after(c, p)
+	prev := k.code(c.Line(p))
+	open = open || matches(k.Open, prev) || matches(k.Middle, prev)
+	switch {
+	case open && !matches(k.Close, cur) && !matches(k.Middle, cur):
+		w += c.Level()
+	case !open && (matches(k.Exit, prev) || matches(k.Close, cur) || matches(k.Middle, cur)):
+		w -= c.Level()
+	}
+	if k.Offside && !open && strings.TrimSpace(line) != "" {
+		w = min(w, c.Width(line))
+	}
+	return max(w, 0)
+}
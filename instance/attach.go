@@ -0,0 +1,199 @@
+package instance
+
+import (
+	"bufio"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"sync"
+	"time"
+)
+
+// ErrDetached is returned by an Attachment once the editor closed its connection, as when its
+// window quits.
+var ErrDetached = errors.New("instance: detached")
+
+// Attached terminals send their keys and sizes to the editor in frames: a kind, the length of
+// the payload as 4 bytes in big endian and the payload. What the editor draws comes back as it
+// is written to a terminal.
+const (
+	frameInput  = 'i'
+	frameResize = 'r'
+)
+
+// maxFrame bounds the payload of a frame, which is no more than one read of a terminal.
+const maxFrame = 1 << 20
+
+// Event is what an attached terminal sent: keys typed, or that it was resized to Width by
+// Height cells if Input is nil.
+type Event struct {
+	Input         []byte
+	Width, Height int
+}
+
+// Client is a terminal attached to the running editor, to show the session in a window of its
+// own. What is written to it is drawn on the terminal.
+type Client struct {
+	// Events delivers what the terminal sends, and is closed once it detaches.
+	Events <-chan Event
+
+	conn net.Conn
+}
+
+// Write implements io.Writer.
+func (c *Client) Write(p []byte) (int, error) {
+	return c.conn.Write(p)
+}
+
+// Close detaches the terminal.
+func (c *Client) Close() error {
+	return c.conn.Close()
+}
+
+// attached returns the client of a terminal attached on conn, whose frames are read from r.
+func attached(conn net.Conn, r *bufio.Reader) *Client {
+	events := make(chan Event, queued)
+	go func() {
+		defer close(events)
+		skipNewline(r)
+		for {
+			kind, payload, err := readFrame(r)
+			if err != nil {
+				return
+			}
+			switch kind {
+			case frameInput:
+				events <- Event{Input: payload}
+			case frameResize:
+				if len(payload) == 4 {
+					w, h := binary.BigEndian.Uint16(payload), binary.BigEndian.Uint16(payload[2:])
+					events <- Event{Width: int(w), Height: int(h)}
+				}
+			}
+		}
+	}()
+	return &Client{Events: events, conn: conn}
+}
+
+// Attachment is the end of an attached terminal: what the editor draws is read from it, and
+// the keys typed and the new sizes of the terminal are sent with Input and Resize.
+type Attachment struct {
+	conn    net.Conn
+	r       *bufio.Reader
+	started bool
+	mu      sync.Mutex
+}
+
+// Attach asks the editor listening at path to show the session, with the files in req open, in
+// a window of its own on a terminal of width by height cells. The window shares the buffers of
+// the session but has its own current buffer and cursors. Relative paths are made absolute
+// first. Returns an error if no editor is listening.
+func Attach(path string, req Request, width, height int) (*Attachment, error) {
+	if err := absolute(req.Files); err != nil {
+		return nil, err
+	}
+	req.Attach, req.Width, req.Height = true, width, height
+
+	conn, err := dial(path)
+	if err != nil {
+		return nil, err
+	}
+	conn.SetDeadline(time.Now().Add(dialTimeout))
+	if err := json.NewEncoder(conn).Encode(req); err != nil {
+		conn.Close()
+		return nil, err
+	}
+	var resp response
+	dec := json.NewDecoder(conn)
+	if err := dec.Decode(&resp); err != nil {
+		conn.Close()
+		return nil, err
+	}
+	if resp.Error != "" {
+		conn.Close()
+		return nil, fmt.Errorf("instance: %s", resp.Error)
+	}
+	conn.SetDeadline(time.Time{})
+	return &Attachment{conn: conn, r: rest(dec, conn)}, nil
+}
+
+// Read reads what the editor draws. Returns ErrDetached once the editor closed the window.
+func (a *Attachment) Read(p []byte) (int, error) {
+	if !a.started {
+		skipNewline(a.r)
+		a.started = true
+	}
+	n, err := a.r.Read(p)
+	if err == io.EOF {
+		err = ErrDetached
+	}
+	return n, err
+}
+
+// Input sends keys typed on the terminal.
+func (a *Attachment) Input(data []byte) error {
+	return a.send(frameInput, data)
+}
+
+// Resize tells the editor the terminal is now width by height cells.
+func (a *Attachment) Resize(width, height int) error {
+	var payload [4]byte
+	binary.BigEndian.PutUint16(payload[:], uint16(min(width, 0xffff)))
+	binary.BigEndian.PutUint16(payload[2:], uint16(min(height, 0xffff)))
+	return a.send(frameResize, payload[:])
+}
+
+// Close detaches the terminal.
+func (a *Attachment) Close() error {
+	return a.conn.Close()
+}
+
+// send writes a frame of kind holding payload.
+func (a *Attachment) send(kind byte, payload []byte) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	for {
+		n := min(len(payload), maxFrame)
+		header := []byte{kind, 0, 0, 0, 0}
+		binary.BigEndian.PutUint32(header[1:], uint32(n))
+		if _, err := a.conn.Write(append(header, payload[:n]...)); err != nil {
+			return err
+		}
+		if payload = payload[n:]; len(payload) == 0 {
+			return nil
+		}
+	}
+}
+
+// rest returns what follows on conn the value dec decoded from it, some of which dec may have
+// read already. It starts with the newline ending the value, which skipNewline drops.
+func rest(dec *json.Decoder, conn net.Conn) *bufio.Reader {
+	return bufio.NewReader(io.MultiReader(dec.Buffered(), conn))
+}
+
+// skipNewline drops the newline r starts with, if any, waiting for what comes first.
+func skipNewline(r *bufio.Reader) {
+	if b, err := r.Peek(1); err == nil && b[0] == '\n' {
+		r.Discard(1)
+	}
+}
+
+// readFrame reads a frame from r.
+func readFrame(r io.Reader) (kind byte, payload []byte, err error) {
+	var header [5]byte
+	if _, err := io.ReadFull(r, header[:]); err != nil {
+		return 0, nil, err
+	}
+	n := binary.BigEndian.Uint32(header[1:])
+	if n > maxFrame {
+		return 0, nil, fmt.Errorf("instance: frame of %d bytes", n)
+	}
+	payload = make([]byte, n)
+	if _, err := io.ReadFull(r, payload); err != nil {
+		return 0, nil, err
+	}
+	return header[0], payload, nil
+}
@@ -0,0 +1,236 @@
+// Package instance lets a running editor receive files opened from other terminals.
+//
+// The first editor started listens on a Unix socket, in a directory only the user may enter,
+// and neither end talks to a socket or a process of another user. Later invocations send their
+// files to it as a JSON request and exit instead of starting a second editor. A socket left behind by an
+// editor that crashed is detected, since nothing answers on it, and replaced. An invocation
+// may also attach its terminal to the running editor, as tmux attach does, and then lives on
+// as the other end of a window of the session until it detaches.
+package instance
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+var (
+	// ErrRunning is returned by Listen when another editor is already listening.
+	ErrRunning = errors.New("instance: editor already running")
+
+	// ErrUnsafe is returned when the socket, or the directory it is in, may belong to another
+	// user, who would then see the files opened and the keys typed.
+	ErrUnsafe = errors.New("instance: socket is not private to the user")
+)
+
+const (
+	// dialTimeout bounds how long a client waits for the running editor.
+	dialTimeout = time.Second
+
+	// queued is how many requests are accepted before the editor handles them.
+	queued = 16
+)
+
+// File is a file to open, with the 1-based line and column to put the cursor at, 0 if none.
+type File struct {
+	Path string `json:"path"`
+	Line int    `json:"line,omitempty"`
+	Col  int    `json:"col,omitempty"`
+}
+
+// Request asks the running editor to open files. Paths are absolute. With Attach, it also asks
+// for a window on the terminal of the sender, of Width by Height cells, which the editor
+// draws into through Client.
+type Request struct {
+	Files  []File `json:"files"`
+	Attach bool   `json:"attach,omitempty"`
+	Width  int    `json:"width,omitempty"`
+	Height int    `json:"height,omitempty"`
+
+	Client *Client `json:"-"`
+}
+
+type response struct {
+	Error string `json:"error,omitempty"`
+}
+
+// Server receives the requests sent to the running editor.
+type Server struct {
+	// C delivers the requests, to be handled by the editor loop.
+	C <-chan Request
+
+	ln   net.Listener
+	path string
+}
+
+// SocketPath returns the socket used by the editors of the current user, in a directory of
+// its own that only the user may enter.
+func SocketPath() string {
+	dir := os.Getenv("XDG_RUNTIME_DIR")
+	if dir == "" {
+		dir = os.TempDir()
+	}
+	return filepath.Join(dir, fmt.Sprintf("goted-%d", os.Getuid()), "goted.sock")
+}
+
+// Listen starts listening on the socket at path, creating its directory if needed. Returns
+// ErrRunning if another editor answers there, and ErrUnsafe if the directory or a socket left
+// there is not the user's alone.
+func Listen(path string) (*Server, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return nil, err
+	}
+	conn, err := dial(path)
+	switch {
+	case err == nil:
+		conn.Close()
+		return nil, ErrRunning
+	case errors.Is(err, ErrUnsafe):
+		return nil, err
+	}
+	os.Remove(path)
+
+	ln, err := net.Listen("unix", path)
+	if err != nil {
+		return nil, err
+	}
+
+	c := make(chan Request, queued)
+	s := &Server{C: c, ln: ln, path: path}
+	go s.serve(c)
+	return s, nil
+}
+
+// dial connects to the editor listening at path, once the socket and its directory are found
+// to be the user's, and the editor to run as the user where that can be told.
+func dial(path string) (net.Conn, error) {
+	if err := private(path); err != nil {
+		return nil, err
+	}
+	conn, err := net.DialTimeout("unix", path, dialTimeout)
+	if err != nil {
+		return nil, err
+	}
+	if uid, ok := peer(conn); ok && uid != os.Getuid() {
+		conn.Close()
+		return nil, fmt.Errorf("%w: %s is served by user %d", ErrUnsafe, path, uid)
+	}
+	return conn, nil
+}
+
+// private returns ErrUnsafe unless the directory of the socket at path is one owned by the
+// user that nobody else may enter, and the socket, if there is one, is owned by the user.
+// Systems that do not tell owners are not checked.
+func private(path string) error {
+	dir := filepath.Dir(path)
+	fi, err := os.Lstat(dir)
+	if err != nil {
+		return err
+	}
+	uid, ok := owner(fi)
+	if !ok {
+		return nil
+	}
+	if !fi.IsDir() || uid != os.Getuid() || fi.Mode().Perm()&0o077 != 0 {
+		return fmt.Errorf("%w: %s must be a directory only the user may enter", ErrUnsafe, dir)
+	}
+
+	fi, err = os.Lstat(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	if uid, _ := owner(fi); fi.Mode().Type() != os.ModeSocket || uid != os.Getuid() {
+		return fmt.Errorf("%w: %s is not a socket of the user", ErrUnsafe, path)
+	}
+	return nil
+}
+
+// Close stops listening and removes the socket.
+func (s *Server) Close() error {
+	err := s.ln.Close()
+	os.Remove(s.path)
+	return err
+}
+
+func (s *Server) serve(c chan<- Request) {
+	defer close(c)
+	for {
+		conn, err := s.ln.Accept()
+		if err != nil {
+			return
+		}
+		if uid, ok := peer(conn); ok && uid != os.Getuid() {
+			conn.Close()
+			continue
+		}
+
+		var req Request
+		var resp response
+		conn.SetDeadline(time.Now().Add(dialTimeout))
+		dec := json.NewDecoder(conn)
+		if err := dec.Decode(&req); err != nil {
+			resp.Error = err.Error()
+		} else if req.Attach && (req.Width <= 0 || req.Height <= 0) {
+			resp.Error = fmt.Sprintf("bad terminal size %dx%d", req.Width, req.Height)
+		}
+		json.NewEncoder(conn).Encode(resp)
+		if resp.Error != "" {
+			conn.Close()
+			continue
+		}
+		if !req.Attach {
+			conn.Close()
+			c <- req
+			continue
+		}
+		// The connection stays open for the frames of the attached terminal.
+		conn.SetDeadline(time.Time{})
+		req.Client = attached(conn, rest(dec, conn))
+		c <- req
+	}
+}
+
+// Send asks the editor listening at path to open the files in req, making relative paths
+// absolute first. Returns an error if no editor is listening.
+func Send(path string, req Request) error {
+	if err := absolute(req.Files); err != nil {
+		return err
+	}
+
+	conn, err := dial(path)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	conn.SetDeadline(time.Now().Add(dialTimeout))
+	if err := json.NewEncoder(conn).Encode(req); err != nil {
+		return err
+	}
+	var resp response
+	if err := json.NewDecoder(conn).Decode(&resp); err != nil {
+		return err
+	}
+	if resp.Error != "" {
+		return fmt.Errorf("instance: %s", resp.Error)
+	}
+	return nil
+}
+
+// absolute makes the paths of files absolute.
+func absolute(files []File) error {
+	for i, f := range files {
+		abs, err := filepath.Abs(f.Path)
+		if err != nil {
+			return err
+		}
+		files[i].Path = abs
+	}
+	return nil
+}
@@ -0,0 +1,191 @@
+// Package locale translates the messages the editor shows.
+//
+// Messages are written in English in the code and translated as they are shown, by a catalog
+// of the language of the user: a JSON object mapping each English message to its translation.
+// The messages of a catalog may hold verbs, such as %s and %d, for the parts of a message that
+// vary, which the translation takes, in the same order or by number, as in %[2]s. The parts
+// are translated too, so an error message quoting another is translated as a whole.
+package locale
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// Language returns the language of the messages the user asked for in the environment, by
+// LC_ALL, LC_MESSAGES or LANG, such as "pt_BR", or "" for the messages as they are written.
+func Language() string {
+	for _, name := range []string{"LC_ALL", "LC_MESSAGES", "LANG"} {
+		v := os.Getenv(name)
+		if v == "" {
+			continue
+		}
+		// Drop the encoding and the modifier, as in fr_FR.UTF-8@euro.
+		v, _, _ = strings.Cut(v, ".")
+		v, _, _ = strings.Cut(v, "@")
+		if v == "C" || v == "POSIX" {
+			return ""
+		}
+		return v
+	}
+	return ""
+}
+
+// Dir returns the directory catalogs are read from, one language.json file each.
+func Dir() (string, error) {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "goted", "locale"), nil
+}
+
+// Catalog holds the translations of the messages into a language. The nil Catalog leaves
+// messages as they are.
+type Catalog struct {
+	exact    map[string]string
+	patterns []pattern
+}
+
+// pattern is a message with verbs, matched by re, and its translation.
+type pattern struct {
+	re          *regexp.Regexp
+	literal     int
+	translation string
+}
+
+// verb matches the verbs of a format, %% included.
+var verb = regexp.MustCompile(`%(?:\[\d+\])?[-+# 0]*\d*(?:\.\d+)?[a-zA-Z%]`)
+
+// Load reads the catalog of lang from dir: lang.json, or for a language with a region, such as
+// pt_BR, the file of the language alone, pt.json, if there is none for the region. Returns nil
+// and no error if there is no catalog for lang.
+func Load(dir, lang string) (*Catalog, error) {
+	if lang == "" {
+		return nil, nil
+	}
+	names := []string{lang}
+	if base, _, ok := strings.Cut(lang, "_"); ok {
+		names = append(names, base)
+	}
+	for _, name := range names {
+		path := filepath.Join(dir, name+".json")
+		data, err := os.ReadFile(path)
+		if errors.Is(err, os.ErrNotExist) {
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+		var entries map[string]string
+		if err := json.Unmarshal(data, &entries); err != nil {
+			return nil, fmt.Errorf("locale: %s: %w", path, err)
+		}
+		return New(entries), nil
+	}
+	return nil, nil
+}
+
+// New returns the catalog translating each message of entries, a key, to its value.
+func New(entries map[string]string) *Catalog {
+	c := &Catalog{exact: map[string]string{}}
+	for msg, translation := range entries {
+		if !verb.MatchString(strings.ReplaceAll(msg, "%%", "")) {
+			c.exact[strings.ReplaceAll(msg, "%%", "%")] = strings.ReplaceAll(translation, "%%", "%")
+			continue
+		}
+		re, literal := compile(msg)
+		c.patterns = append(c.patterns, pattern{re: re, literal: literal, translation: translation})
+	}
+	// The messages saying most in words are tried first, so that "file %s: %s" is not taken
+	// for "%s: %s".
+	slices.SortFunc(c.patterns, func(a, b pattern) int {
+		if a.literal != b.literal {
+			return b.literal - a.literal
+		}
+		return strings.Compare(a.re.String(), b.re.String())
+	})
+	return c
+}
+
+// compile returns the expression matching the messages msg formats, each verb a group, and
+// how many bytes of msg are not verbs.
+func compile(msg string) (*regexp.Regexp, int) {
+	var expr strings.Builder
+	expr.WriteString("^")
+	pos, literal := 0, 0
+	for _, m := range verb.FindAllStringIndex(msg, -1) {
+		expr.WriteString(regexp.QuoteMeta(msg[pos:m[0]]))
+		literal += m[0] - pos
+		switch v := msg[m[0]:m[1]]; v[len(v)-1] {
+		case '%':
+			expr.WriteString("%")
+			literal++
+		case 'd':
+			expr.WriteString(`(-?\d+)`)
+		case 'q':
+			expr.WriteString(`("(?:[^"\\]|\\.)*")`)
+		default:
+			expr.WriteString(`(.+?)`)
+		}
+		pos = m[1]
+	}
+	expr.WriteString(regexp.QuoteMeta(msg[pos:]))
+	literal += len(msg) - pos
+	expr.WriteString("$")
+	return regexp.MustCompile("(?s)" + expr.String()), literal
+}
+
+// Translate returns msg, a message as the code writes it, in the language of c, or msg itself
+// if c has no translation for it.
+func (c *Catalog) Translate(msg string) string {
+	if c == nil || msg == "" {
+		return msg
+	}
+	if t, ok := c.exact[msg]; ok {
+		return t
+	}
+	for _, p := range c.patterns {
+		m := p.re.FindStringSubmatch(msg)
+		if m == nil {
+			continue
+		}
+		args := m[1:]
+		for i, a := range args {
+			// A part standing for the whole message, as in "%s", is not translated again.
+			if a != msg && !strings.HasPrefix(a, `"`) {
+				args[i] = c.Translate(a)
+			}
+		}
+		return fill(p.translation, args)
+	}
+	return msg
+}
+
+// fill replaces the verbs of translation with args, in order or by number.
+func fill(translation string, args []string) string {
+	next := 0
+	return verb.ReplaceAllStringFunc(translation, func(v string) string {
+		if v == "%%" {
+			return "%"
+		}
+		i := next
+		if n, ok := strings.CutPrefix(v, "%["); ok {
+			n, _, _ = strings.Cut(n, "]")
+			if k, err := strconv.Atoi(n); err == nil {
+				i = k - 1
+			}
+		}
+		next = i + 1
+		if i < 0 || i >= len(args) {
+			return v
+		}
+		return args[i]
+	})
+}
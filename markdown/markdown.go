@@ -0,0 +1,338 @@
+// Package markdown renders Markdown source as styled lines for display in a terminal.
+//
+// Only the common block constructs are recognized: ATX headings, paragraphs, bullet and
+// numbered lists, block quotes, fenced code blocks, tables, horizontal rules and images on a
+// line of their own. Inline bold,
+// italic and code spans are styled with their markers removed.
+package markdown
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+
+	"github.com/avalonbits/goted/syntax"
+)
+
+// Line is a rendered line. Source is the source line it was rendered from. Image is the path
+// or URL of the image a line standing for an image shows, for front ends that can draw it in
+// place of the text.
+type Line struct {
+	Text   []rune
+	Spans  []syntax.Span
+	Source int
+	Image  string
+}
+
+var (
+	headingRE = regexp.MustCompile(`^(#{1,6})\s+(.*?)\s*#*\s*$`)
+	listRE    = regexp.MustCompile(`^(\s*)([-*+]|\d+[.)])\s+(.*)$`)
+	ruleRE    = regexp.MustCompile(`^\s*[-*_](\s*[-*_]){2,}\s*$`)
+	tableRE   = regexp.MustCompile(`^\s*\|`)
+	imageRE   = regexp.MustCompile(`^\s*!\[([^\]]*)\]\(\s*<?([^)\s>]+)>?(?:\s+"[^"]*")?\s*\)\s*$`)
+	tableSep  = regexp.MustCompile(`^\s*\|?(\s*:?-+:?\s*\|)+\s*:?-*:?\s*$`)
+)
+
+// Render lays out the Markdown document src, one entry per source line, to fit width columns.
+func Render(src []string, width int) []Line {
+	width = max(width, 10)
+
+	r := renderer{width: width}
+	for n := 0; n < len(src); n++ {
+		line := src[n]
+		trimmed := strings.TrimSpace(line)
+
+		switch {
+		case strings.HasPrefix(trimmed, "```") || strings.HasPrefix(trimmed, "~~~"):
+			n = r.code(src, n)
+
+		case trimmed == "":
+			r.blank(n)
+
+		case headingRE.MatchString(line):
+			m := headingRE.FindStringSubmatch(line)
+			r.heading(n, len(m[1]), m[2])
+
+		case imageRE.MatchString(line):
+			m := imageRE.FindStringSubmatch(line)
+			alt := []rune("[image: " + m[1] + "]")
+			alt = alt[:min(len(alt), width)]
+			r.add(Line{Text: alt, Spans: []syntax.Span{{Start: 0, End: len(alt), Scope: "markup.link"}}, Source: n, Image: m[2]})
+
+		case ruleRE.MatchString(line):
+			r.add(Line{Text: []rune(strings.Repeat("─", width)), Spans: []syntax.Span{{Start: 0, End: width, Scope: "markup.rule"}}, Source: n})
+
+		case tableRE.MatchString(line):
+			n = r.table(src, n)
+
+		case strings.HasPrefix(trimmed, ">"):
+			n = r.quote(src, n)
+
+		case listRE.MatchString(line):
+			m := listRE.FindStringSubmatch(line)
+			bullet := "• "
+			if unicode.IsDigit(rune(m[2][0])) {
+				bullet = m[2] + " "
+			}
+			r.wrap(n, inline(m[3]), strings.Repeat(" ", len(m[1]))+bullet, strings.Repeat(" ", len(m[1])+len([]rune(bullet))), "markup.list")
+
+		default:
+			n = r.paragraph(src, n)
+		}
+	}
+	return r.lines
+}
+
+type renderer struct {
+	width int
+	lines []Line
+}
+
+func (r *renderer) add(l Line) {
+	r.lines = append(r.lines, l)
+}
+
+func (r *renderer) blank(n int) {
+	if len(r.lines) > 0 && len(r.lines[len(r.lines)-1].Text) > 0 {
+		r.add(Line{Source: n})
+	}
+}
+
+func (r *renderer) heading(n, level int, title string) {
+	t := inline(title)
+	for i := range t.scopes {
+		if t.scopes[i] == "" {
+			t.scopes[i] = "markup.heading"
+		}
+	}
+	r.wrap(n, t, "", "", "")
+
+	if level <= 2 {
+		underline := "═"
+		if level == 2 {
+			underline = "─"
+		}
+		size := min(len(t.text), r.width)
+		r.add(Line{Text: []rune(strings.Repeat(underline, size)), Spans: []syntax.Span{{Start: 0, End: size, Scope: "markup.heading"}}, Source: n})
+	}
+}
+
+// code renders the fenced block starting at line n and returns the line of its closing fence.
+func (r *renderer) code(src []string, n int) int {
+	fence := strings.TrimSpace(src[n])[:3]
+	end := n + 1
+	for ; end < len(src); end++ {
+		if strings.HasPrefix(strings.TrimSpace(src[end]), fence) {
+			break
+		}
+		text := []rune("  " + strings.ReplaceAll(src[end], "\t", "    "))
+		r.add(Line{Text: text, Spans: []syntax.Span{{Start: 0, End: len(text), Scope: "markup.raw"}}, Source: end})
+	}
+	return end
+}
+
+// paragraph renders the paragraph starting at line n and returns its last line.
+func (r *renderer) paragraph(src []string, n int) int {
+	end := n
+	parts := []string{strings.TrimSpace(src[n])}
+	for end+1 < len(src) && continues(src[end+1]) {
+		end++
+		parts = append(parts, strings.TrimSpace(src[end]))
+	}
+
+	r.wrap(n, inline(strings.Join(parts, " ")), "", "", "")
+	return end
+}
+
+// quote renders the block quote starting at line n and returns its last line.
+func (r *renderer) quote(src []string, n int) int {
+	end := n
+	var parts []string
+	for ; end < len(src) && strings.HasPrefix(strings.TrimSpace(src[end]), ">"); end++ {
+		parts = append(parts, strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(src[end]), ">")))
+	}
+
+	t := inline(strings.Join(parts, " "))
+	for i := range t.scopes {
+		if t.scopes[i] == "" {
+			t.scopes[i] = "markup.quote"
+		}
+	}
+	r.wrap(n, t, "│ ", "│ ", "markup.quote")
+	return end - 1
+}
+
+// table renders the table starting at line n with aligned columns and returns its last line.
+func (r *renderer) table(src []string, n int) int {
+	var rows [][]string
+	var seps []bool
+	end := n
+	for ; end < len(src) && tableRE.MatchString(src[end]); end++ {
+		row := strings.Trim(strings.TrimSpace(src[end]), "|")
+		cells := strings.Split(row, "|")
+		for i := range cells {
+			cells[i] = strings.TrimSpace(cells[i])
+		}
+		rows = append(rows, cells)
+		seps = append(seps, tableSep.MatchString(src[end]))
+	}
+
+	var widths []int
+	for i, row := range rows {
+		if seps[i] {
+			continue
+		}
+		for j, cell := range row {
+			if j == len(widths) {
+				widths = append(widths, 0)
+			}
+			widths[j] = max(widths[j], len(inline(cell).text))
+		}
+	}
+
+	for i, row := range rows {
+		var t styled
+		if seps[i] {
+			for j, w := range widths {
+				if j > 0 {
+					t.append("┼", "markup.table")
+				}
+				t.append(strings.Repeat("─", w+2), "markup.table")
+			}
+		} else {
+			for j, w := range widths {
+				if j > 0 {
+					t.append("│", "markup.table")
+				}
+				cell := styled{}
+				if j < len(row) {
+					cell = inline(row[j])
+				}
+				t.append(" ", "")
+				t.text = append(t.text, cell.text...)
+				t.scopes = append(t.scopes, cell.scopes...)
+				t.append(strings.Repeat(" ", w-len(cell.text)+1), "")
+			}
+		}
+		r.add(t.line(n + i))
+	}
+	return end - 1
+}
+
+// wrap word wraps t, starting the first line with first and the following ones with rest. The
+// prefixes are styled with scope.
+func (r *renderer) wrap(n int, t styled, first, rest, scope string) {
+	prefix := first
+	for {
+		avail := max(r.width-len([]rune(prefix)), 1)
+		cut := len(t.text)
+		if cut > avail {
+			cut = avail
+			for cut > 0 && t.text[cut] != ' ' {
+				cut--
+			}
+			if cut == 0 {
+				cut = avail
+			}
+		}
+
+		var l styled
+		l.append(prefix, scope)
+		l.text = append(l.text, t.text[:cut]...)
+		l.scopes = append(l.scopes, t.scopes[:cut]...)
+		r.add(l.line(n))
+
+		for cut < len(t.text) && t.text[cut] == ' ' {
+			cut++
+		}
+		t.text, t.scopes = t.text[cut:], t.scopes[cut:]
+		if len(t.text) == 0 {
+			return
+		}
+		prefix = rest
+	}
+}
+
+// continues reports whether line continues the paragraph before it.
+func continues(line string) bool {
+	trimmed := strings.TrimSpace(line)
+	return trimmed != "" &&
+		!headingRE.MatchString(line) &&
+		!listRE.MatchString(line) &&
+		!tableRE.MatchString(line) &&
+		!strings.HasPrefix(trimmed, ">") &&
+		!strings.HasPrefix(trimmed, "```") &&
+		!strings.HasPrefix(trimmed, "~~~")
+}
+
+// styled is text with a scope per rune.
+type styled struct {
+	text   []rune
+	scopes []string
+}
+
+func (s *styled) append(text, scope string) {
+	for _, r := range text {
+		s.text = append(s.text, r)
+		s.scopes = append(s.scopes, scope)
+	}
+}
+
+// line converts s into a Line, merging runs of runes with the same scope into spans.
+func (s styled) line(source int) Line {
+	l := Line{Text: s.text, Source: source}
+	for i := 0; i < len(s.scopes); {
+		j := i
+		for j < len(s.scopes) && s.scopes[j] == s.scopes[i] {
+			j++
+		}
+		if s.scopes[i] != "" {
+			l.Spans = append(l.Spans, syntax.Span{Start: i, End: j, Scope: s.scopes[i]})
+		}
+		i = j
+	}
+	return l
+}
+
+// inline parses the bold, italic and code spans of text, dropping their markers.
+func inline(text string) styled {
+	var s styled
+	src := []rune(text)
+	for i := 0; i < len(src); i++ {
+		switch {
+		case src[i] == '`':
+			if end := index(src, i+1, "`"); end >= 0 {
+				s.append(string(src[i+1:end]), "markup.raw")
+				i = end
+				continue
+			}
+		case hasAt(src, i, "**") || hasAt(src, i, "__"):
+			if end := index(src, i+2, string(src[i:i+2])); end > i+2 {
+				s.append(string(src[i+2:end]), "markup.bold")
+				i = end + 1
+				continue
+			}
+		case (src[i] == '*' || src[i] == '_') && i+1 < len(src) && src[i+1] != ' ':
+			if end := index(src, i+1, string(src[i])); end > i+1 {
+				s.append(string(src[i+1:end]), "markup.italic")
+				i = end
+				continue
+			}
+		}
+		s.append(string(src[i]), "")
+	}
+	return s
+}
+
+func hasAt(src []rune, i int, marker string) bool {
+	return strings.HasPrefix(string(src[i:min(i+len(marker), len(src))]), marker)
+}
+
+func index(src []rune, from int, marker string) int {
+	for i := from; i < len(src); i++ {
+		if hasAt(src, i, marker) {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,73 @@
+package markdown
+
+import (
+	"sort"
+
+	"github.com/avalonbits/goted/text"
+)
+
+// Preview keeps the rendering of a Markdown buffer up to date as it is edited. Rendering is
+// lazy: edits only mark the preview stale and the next call to Lines renders it again.
+type Preview struct {
+	b     *text.Buffer
+	width int
+	lines []Line
+	stale bool
+}
+
+// NewPreview returns a preview of b laid out for width columns.
+func NewPreview(b *text.Buffer, width int) *Preview {
+	p := &Preview{b: b, width: width, stale: true}
+	b.OnChange(func(text.Change) {
+		p.stale = true
+	})
+	return p
+}
+
+// Resize changes the width the preview is laid out for.
+func (p *Preview) Resize(width int) {
+	if width != p.width {
+		p.width, p.stale = width, true
+	}
+}
+
+// Lines returns the rendered preview.
+func (p *Preview) Lines() []Line {
+	if p.stale {
+		src := make([]string, p.b.Lines())
+		for n := range src {
+			line, _ := p.b.LineRunes(n)
+			src[n] = string(line)
+		}
+		p.lines, p.stale = Render(src, p.width), false
+	}
+	return p.lines
+}
+
+// PreviewLine returns the first preview line rendered from source line n or, if n renders to
+// nothing, from the closest source line before it. It is used to scroll the preview along with
+// the source.
+func (p *Preview) PreviewLine(n int) int {
+	lines := p.Lines()
+	i := sort.Search(len(lines), func(i int) bool {
+		return lines[i].Source > n
+	})
+	if i == 0 {
+		return 0
+	}
+
+	src := lines[i-1].Source
+	for i > 1 && lines[i-2].Source == src {
+		i--
+	}
+	return i - 1
+}
+
+// SourceLine returns the source line preview line n was rendered from.
+func (p *Preview) SourceLine(n int) int {
+	lines := p.Lines()
+	if len(lines) == 0 {
+		return 0
+	}
+	return lines[min(max(n, 0), len(lines)-1)].Source
+}
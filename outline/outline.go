@@ -0,0 +1,246 @@
+// Package outline lists the symbols a file declares, such as its functions, types and
+// headings, for an outline of a buffer and for finding symbols across a project.
+//
+// Go files are parsed with go/parser. Markdown files list their headings, and other files the
+// declarations found by keyword, such as def, class, function or fn, nested by indentation.
+package outline
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/avalonbits/goted/text"
+)
+
+// maxFileSize is the size above which Project skips a file, as it is unlikely to be source.
+const maxFileSize = 1 << 20
+
+// Symbol is something a file declares. Line and Col are 0-based, Col in runes. Depth is how
+// deeply it is nested in the symbols before it, such as a method under its type.
+type Symbol struct {
+	Name  string
+	Kind  string
+	Line  int
+	Col   int
+	Depth int
+}
+
+// Located is a symbol and the file declaring it.
+type Located struct {
+	Path string
+	Symbol
+}
+
+// declRE matches declarations by keyword in languages other than Go and Markdown.
+var declRE = regexp.MustCompile(`^([ \t]*)(?:(?:export|pub|public|private|static|async|default)\s+)*(def|class|function|fn|struct|enum|trait|interface|impl|module|type)\s+([A-Za-z_$][\w$]*)`)
+
+// headingRE matches a Markdown ATX heading.
+var headingRE = regexp.MustCompile(`^(#{1,6})\s+(.+?)\s*#*\s*$`)
+
+// Symbols returns the symbols declared in src, a file of type fileType as text.DetectFileType
+// names them, in the order they appear.
+func Symbols(fileType, src string) []Symbol {
+	switch fileType {
+	case "go":
+		return goSymbols(src)
+	case "markdown":
+		return headings(src)
+	}
+	return declarations(src)
+}
+
+// Project returns the symbols declared in the files under root whose type is known, skipping
+// the directories matching exclude, by base name, and files too large to be source.
+func Project(root string, exclude []string) ([]Located, error) {
+	var all []Located
+	err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return nil
+		}
+		if d.IsDir() {
+			if path != root && excluded(d.Name(), exclude) {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		fileType := text.DetectFileType(path)
+		if fileType == "" {
+			return nil
+		}
+		if info, err := d.Info(); err != nil || info.Size() > maxFileSize {
+			return nil
+		}
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil
+		}
+		for _, s := range Symbols(fileType, string(data)) {
+			all = append(all, Located{Path: path, Symbol: s})
+		}
+		return nil
+	})
+	return all, err
+}
+
+func excluded(name string, patterns []string) bool {
+	for _, pattern := range patterns {
+		if ok, _ := filepath.Match(pattern, name); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// goSymbols returns the top level declarations of a Go file, with the methods of the types it
+// declares listed under them. Files with syntax errors list what parses.
+func goSymbols(src string) []Symbol {
+	fset := token.NewFileSet()
+	f, _ := parser.ParseFile(fset, "", src, parser.SkipObjectResolution)
+	if f == nil {
+		return nil
+	}
+
+	types := map[string]bool{}
+	for _, decl := range f.Decls {
+		if d, ok := decl.(*ast.GenDecl); ok && d.Tok == token.TYPE {
+			for _, spec := range d.Specs {
+				types[spec.(*ast.TypeSpec).Name.Name] = true
+			}
+		}
+	}
+
+	var syms []Symbol
+	methods := map[string][]Symbol{}
+	symbol := func(name, kind string, pos token.Pos, depth int) Symbol {
+		p := fset.Position(pos)
+		return Symbol{
+			Name:  name,
+			Kind:  kind,
+			Line:  p.Line - 1,
+			Col:   len([]rune(lineAt(src, p.Offset)[:p.Column-1])),
+			Depth: depth,
+		}
+	}
+	for _, decl := range f.Decls {
+		switch d := decl.(type) {
+		case *ast.FuncDecl:
+			if d.Recv == nil || len(d.Recv.List) == 0 {
+				syms = append(syms, symbol(d.Name.Name, "func", d.Name.Pos(), 0))
+				continue
+			}
+			recv := receiver(d.Recv.List[0].Type)
+			if types[recv] {
+				methods[recv] = append(methods[recv], symbol(d.Name.Name, "method", d.Name.Pos(), 1))
+			} else {
+				syms = append(syms, symbol(recv+"."+d.Name.Name, "method", d.Name.Pos(), 0))
+			}
+		case *ast.GenDecl:
+			for _, spec := range d.Specs {
+				switch s := spec.(type) {
+				case *ast.TypeSpec:
+					syms = append(syms, symbol(s.Name.Name, "type", s.Name.Pos(), 0))
+				case *ast.ValueSpec:
+					kind := "var"
+					if d.Tok == token.CONST {
+						kind = "const"
+					}
+					for _, name := range s.Names {
+						if name.Name != "_" {
+							syms = append(syms, symbol(name.Name, kind, name.Pos(), 0))
+						}
+					}
+				}
+			}
+		}
+	}
+
+	var out []Symbol
+	for _, s := range syms {
+		out = append(out, s)
+		if s.Kind == "type" {
+			out = append(out, methods[s.Name]...)
+			delete(methods, s.Name)
+		}
+	}
+	return out
+}
+
+// receiver returns the name of the type of a method receiver.
+func receiver(expr ast.Expr) string {
+	for {
+		switch e := expr.(type) {
+		case *ast.StarExpr:
+			expr = e.X
+		case *ast.IndexExpr:
+			expr = e.X
+		case *ast.IndexListExpr:
+			expr = e.X
+		case *ast.Ident:
+			return e.Name
+		default:
+			return ""
+		}
+	}
+}
+
+// lineAt returns src from the start of the line holding offset.
+func lineAt(src string, offset int) string {
+	start := strings.LastIndexByte(src[:offset], '\n') + 1
+	return src[start:]
+}
+
+// headings returns the headings of a Markdown file, nested by level. Lines in fenced code
+// blocks are not headings.
+func headings(src string) []Symbol {
+	var syms []Symbol
+	fenced := false
+	for n, line := range strings.Split(src, "\n") {
+		if strings.HasPrefix(strings.TrimSpace(line), "```") {
+			fenced = !fenced
+			continue
+		}
+		m := headingRE.FindStringSubmatchIndex(line)
+		if fenced || m == nil {
+			continue
+		}
+		syms = append(syms, Symbol{
+			Name:  line[m[4]:m[5]],
+			Kind:  "heading",
+			Line:  n,
+			Col:   len([]rune(line[:m[4]])),
+			Depth: m[3] - m[2] - 1,
+		})
+	}
+	return syms
+}
+
+// declarations returns the declarations found by keyword, nested by indentation.
+func declarations(src string) []Symbol {
+	var syms []Symbol
+	var indents []int
+	for n, line := range strings.Split(src, "\n") {
+		m := declRE.FindStringSubmatchIndex(line)
+		if m == nil {
+			continue
+		}
+		indent := len(strings.ReplaceAll(line[m[2]:m[3]], "\t", "    "))
+		for len(indents) > 0 && indents[len(indents)-1] >= indent {
+			indents = indents[:len(indents)-1]
+		}
+		syms = append(syms, Symbol{
+			Name:  line[m[6]:m[7]],
+			Kind:  line[m[4]:m[5]],
+			Line:  n,
+			Col:   len([]rune(line[:m[6]])),
+			Depth: len(indents),
+		})
+		indents = append(indents, indent)
+	}
+	return syms
+}
@@ -0,0 +1,264 @@
+// Package plugin gates what extensions may do outside the editor behind capabilities, so a
+// snippet from an untrusted source cannot read files, reach the network or run commands, and
+// so exfiltrate buffers, without the user having agreed to it.
+//
+// A plugin declares the capabilities it needs in a manifest:
+//
+//	{
+//		"name": "todo-sync",
+//		"description": "Sync TODO comments with an issue tracker",
+//		"permissions": ["files", "network"]
+//	}
+//
+// Authorize asks the user to grant the capabilities declared and not granted before, and
+// remembers the answers for that manifest file as it is: one that moved or changed is asked about
+// again. Plugins then go through the Host it returns for anything outside the
+// editor, which refuses what was not both declared and granted.
+package plugin
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"slices"
+	"strings"
+)
+
+var (
+	// ErrManifest is returned for manifests that cannot be used.
+	ErrManifest = errors.New("plugin: bad manifest")
+
+	// ErrDenied is returned when a plugin uses a capability it was not granted.
+	ErrDenied = errors.New("plugin: permission denied")
+)
+
+// Capability is a kind of access outside the editor.
+type Capability string
+
+const (
+	// Files allows reading and writing files.
+	Files Capability = "files"
+	// Network allows connections to other hosts.
+	Network Capability = "network"
+	// Shell allows running commands.
+	Shell Capability = "shell"
+)
+
+// capabilities are the known capabilities, with how they are described when asking for them.
+var capabilities = map[Capability]string{
+	Files:   "read and write files",
+	Network: "connect to the network",
+	Shell:   "run commands",
+}
+
+// Manifest is what a plugin declares about itself. Path is the absolute path it was read
+// from and Hash that of its contents, which grants are tied to.
+type Manifest struct {
+	Name        string       `json:"name"`
+	Description string       `json:"description,omitempty"`
+	Permissions []Capability `json:"permissions,omitempty"`
+
+	Path string `json:"-"`
+	Hash string `json:"-"`
+}
+
+// ReadManifest reads the manifest at path, refusing one with no name or with unknown
+// capabilities.
+func ReadManifest(path string) (Manifest, error) {
+	var m Manifest
+	path, err := filepath.Abs(path)
+	if err != nil {
+		return m, err
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return m, err
+	}
+	sum := sha256.Sum256(data)
+	m.Path, m.Hash = path, hex.EncodeToString(sum[:])
+	if err := json.Unmarshal(data, &m); err != nil {
+		return m, fmt.Errorf("%w: %s: %v", ErrManifest, path, err)
+	}
+	if strings.TrimSpace(m.Name) == "" {
+		return m, fmt.Errorf("%w: %s: no name", ErrManifest, path)
+	}
+	for _, c := range m.Permissions {
+		if _, ok := capabilities[c]; !ok {
+			return m, fmt.Errorf("%w: %s: unknown permission %q", ErrManifest, path, c)
+		}
+	}
+	return m, nil
+}
+
+// Grants are the capabilities the user granted, by the path of the manifest of the plugin, so
+// that a plugin cannot take the name of another to get what it was granted.
+type Grants map[string]Grant
+
+// Grant is what the user granted to the plugin with the manifest of Hash, named Name. A
+// manifest that changed since is asked about again.
+type Grant struct {
+	Name         string       `json:"name"`
+	Hash         string       `json:"hash"`
+	Capabilities []Capability `json:"capabilities"`
+}
+
+// GrantsPath returns the file the grants of the user are kept in.
+func GrantsPath() (string, error) {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "goted", "plugins", "grants.json"), nil
+}
+
+// LoadGrants returns the grants kept in the file at path, none if it is missing.
+func LoadGrants(path string) (Grants, error) {
+	g := Grants{}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return g, nil
+	} else if err != nil {
+		return nil, err
+	}
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, fmt.Errorf("plugin: %s: %w", path, err)
+	}
+	// Grants kept by plugin name, as older versions did, are dropped, to be asked again.
+	for key, r := range raw {
+		var gr Grant
+		if json.Unmarshal(r, &gr) == nil && filepath.IsAbs(key) {
+			g[key] = gr
+		}
+	}
+	return g, nil
+}
+
+// Save writes the grants to the file at path, readable by the user only.
+func (g Grants) Save(path string) error {
+	data, err := json.MarshalIndent(g, "", "\t")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return err
+	}
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, append(data, '\n'), 0o600); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+// Revoke forgets what was granted to the plugins named name, wherever their manifest is, so it is
+// asked for again. Returns whether anything was granted to them.
+func (g Grants) Revoke(name string) bool {
+	n := len(g)
+	maps.DeleteFunc(g, func(_ string, gr Grant) bool { return gr.Name == name })
+	return len(g) < n
+}
+
+// Authorize returns the host for the plugin m, with the capabilities it declares and g grants
+// to its manifest, as long as the manifest did not change since. Capabilities declared but not
+// granted yet are asked for with confirm, all at once, and recorded in g if the user agrees; a
+// nil confirm refuses them, so headless sessions never grant anything. Capabilities granted but
+// no longer declared are dropped. Returns whether g changed and needs saving.
+func Authorize(m Manifest, g Grants, confirm func(prompt string) bool) (*Host, bool) {
+	old, ok := g[m.Path]
+	var granted []Capability
+	if ok && old.Hash == m.Hash {
+		granted = slices.DeleteFunc(slices.Clone(old.Capabilities), func(c Capability) bool {
+			return !slices.Contains(m.Permissions, c)
+		})
+	}
+	changed := ok && (old.Hash != m.Hash || old.Name != m.Name || len(granted) != len(old.Capabilities))
+
+	var ask []Capability
+	for _, c := range m.Permissions {
+		if !slices.Contains(granted, c) && !slices.Contains(ask, c) {
+			ask = append(ask, c)
+		}
+	}
+	if len(ask) > 0 && confirm != nil && confirm(prompt(m.Name, ask)) {
+		granted = append(granted, ask...)
+		changed = true
+	}
+	if changed {
+		g[m.Path] = Grant{Name: m.Name, Hash: m.Hash, Capabilities: granted}
+	}
+	if len(granted) == 0 {
+		delete(g, m.Path)
+	}
+	return &Host{Manifest: m, granted: granted}, changed
+}
+
+// prompt returns the question asking to grant caps to the plugin name.
+func prompt(name string, caps []Capability) string {
+	what := make([]string, len(caps))
+	for i, c := range caps {
+		what[i] = capabilities[c]
+	}
+	return fmt.Sprintf("Allow the plugin %s to %s?", name, strings.Join(what, ", "))
+}
+
+// Host is what a plugin reaches outside the editor through. Each method checks its capability
+// first, failing with ErrDenied if the plugin was not granted it.
+type Host struct {
+	Manifest Manifest
+	granted  []Capability
+}
+
+// Allowed reports whether the plugin may use c.
+func (h *Host) Allowed(c Capability) bool {
+	return slices.Contains(h.granted, c)
+}
+
+// check returns ErrDenied, saying why, if the plugin may not use c.
+func (h *Host) check(c Capability) error {
+	if h.Allowed(c) {
+		return nil
+	}
+	if !slices.Contains(h.Manifest.Permissions, c) {
+		return fmt.Errorf("%w: %s may not %s: its manifest does not ask to", ErrDenied, h.Manifest.Name, capabilities[c])
+	}
+	return fmt.Errorf("%w: %s may not %s: not granted", ErrDenied, h.Manifest.Name, capabilities[c])
+}
+
+// ReadFile reads the file at path, with the Files capability.
+func (h *Host) ReadFile(path string) ([]byte, error) {
+	if err := h.check(Files); err != nil {
+		return nil, err
+	}
+	return os.ReadFile(path)
+}
+
+// WriteFile writes data to the file at path, with the Files capability.
+func (h *Host) WriteFile(path string, data []byte, perm os.FileMode) error {
+	if err := h.check(Files); err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, perm)
+}
+
+// Command returns the command to run name with args, with the Shell capability.
+func (h *Host) Command(name string, args ...string) (*exec.Cmd, error) {
+	if err := h.check(Shell); err != nil {
+		return nil, err
+	}
+	return exec.Command(name, args...), nil
+}
+
+// HTTPClient returns a client to make requests with, with the Network capability.
+func (h *Host) HTTPClient() (*http.Client, error) {
+	if err := h.check(Network); err != nil {
+		return nil, err
+	}
+	return &http.Client{}, nil
+}
@@ -0,0 +1,129 @@
+// Package profile exposes the editor's performance data for diagnosing slowdowns on user
+// machines. It is off unless Serve is called, as goted does with --debug.
+//
+// Serve starts an HTTP server with the net/http/pprof handlers under /debug/pprof/ and the
+// expvar variables under /debug/vars, including "goted", the time spent in each instrumented
+// section of the editor, and "goted_log", the latest lines of its debug log. Sections also show
+// up as regions in execution traces fetched from /debug/pprof/trace.
+//
+// RecordStartup times the phases of startup apart from that, for the report goted writes with
+// --startup-profile.
+package profile
+
+import (
+	"context"
+	"expvar"
+	"fmt"
+	"net"
+	"net/http"
+	_ "net/http/pprof"
+	"runtime/trace"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Sections instrumented by the editor.
+const (
+	Render    = "render"
+	Highlight = "highlight"
+	Input     = "input"
+)
+
+var (
+	enabled atomic.Bool
+
+	mu       sync.Mutex
+	sections = map[string]*Section{}
+	log      []string
+)
+
+// maxLog is how many lines the debug log keeps.
+const maxLog = 500
+
+// Section holds the time spent in a section of the editor.
+type Section struct {
+	Count int64         `json:"count"`
+	Total time.Duration `json:"total_ns"`
+	Max   time.Duration `json:"max_ns"`
+}
+
+func init() {
+	expvar.Publish("goted", expvar.Func(func() any {
+		mu.Lock()
+		defer mu.Unlock()
+		snapshot := make(map[string]Section, len(sections))
+		for name, s := range sections {
+			snapshot[name] = *s
+		}
+		return snapshot
+	}))
+	expvar.Publish("goted_log", expvar.Func(func() any {
+		mu.Lock()
+		defer mu.Unlock()
+		return append([]string(nil), log...)
+	}))
+}
+
+// Serve starts recording sections and serves the profiling handlers on addr, such as
+// "localhost:6060", in the background. Returns the address listened on, which tells the port
+// picked for ":0".
+func Serve(addr string) (string, error) {
+	l, err := net.Listen("tcp", addr)
+	if err != nil {
+		return "", err
+	}
+	enabled.Store(true)
+	go http.Serve(l, http.DefaultServeMux)
+	return l.Addr().String(), nil
+}
+
+// Enabled reports whether sections are being recorded.
+func Enabled() bool {
+	return enabled.Load()
+}
+
+// Start marks the start of the named section and returns the function marking its end, as in:
+//
+//	defer profile.Start(profile.Render)()
+//
+// It does nothing unless Serve was called.
+func Start(name string) func() {
+	if !enabled.Load() {
+		return func() {}
+	}
+
+	region := trace.StartRegion(context.Background(), name)
+	start := time.Now()
+	return func() {
+		d := time.Since(start)
+		region.End()
+
+		mu.Lock()
+		defer mu.Unlock()
+		s, ok := sections[name]
+		if !ok {
+			s = &Section{}
+			sections[name] = s
+		}
+		s.Count++
+		s.Total += d
+		s.Max = max(s.Max, d)
+	}
+}
+
+// Logf adds a line to the debug log, formatted as fmt.Sprintf does and stamped with the time,
+// dropping the oldest once it holds maxLog lines. It does nothing unless Serve was called.
+func Logf(format string, args ...any) {
+	if !enabled.Load() {
+		return
+	}
+	line := time.Now().Format("15:04:05.000 ") + fmt.Sprintf(format, args...)
+
+	mu.Lock()
+	defer mu.Unlock()
+	if len(log) == maxLog {
+		log = append(log[:0], log[1:]...)
+	}
+	log = append(log, line)
+}
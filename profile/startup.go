@@ -0,0 +1,95 @@
+package profile
+
+import (
+	"fmt"
+	"io"
+	"text/tabwriter"
+	"time"
+)
+
+// Phases of startup timed by the editor.
+const (
+	Config      = "config"
+	Init        = "init"
+	Locale      = "locale"
+	Theme       = "theme"
+	Open        = "open"
+	Terminal    = "terminal"
+	FirstRender = "first render"
+	Preload     = "preload"
+)
+
+// Phase is a timed phase of startup: when it started, since RecordStartup was called, and how
+// long it took.
+type Phase struct {
+	Name  string
+	Start time.Duration
+	Took  time.Duration
+}
+
+var (
+	recording bool
+	begin     time.Time
+	phases    []Phase
+)
+
+// RecordStartup starts recording the phases of startup, timed from now. goted calls it first
+// thing with --startup-profile.
+func RecordStartup() {
+	mu.Lock()
+	defer mu.Unlock()
+	recording, begin, phases = true, time.Now(), nil
+}
+
+// StartPhase marks the start of the named phase of startup and returns the function marking
+// its end, as Start does for sections. It does nothing unless RecordStartup was called.
+func StartPhase(name string) func() {
+	mu.Lock()
+	on := recording
+	mu.Unlock()
+	if !on {
+		return func() {}
+	}
+
+	start := time.Now()
+	return func() {
+		took := time.Since(start)
+		mu.Lock()
+		defer mu.Unlock()
+		phases = append(phases, Phase{Name: name, Start: start.Sub(begin), Took: took})
+	}
+}
+
+// Phases returns the phases of startup recorded, in the order they ended.
+func Phases() []Phase {
+	mu.Lock()
+	defer mu.Unlock()
+	return append([]Phase(nil), phases...)
+}
+
+// StartupReport writes the phases of startup recorded as a table, with when the first frame
+// was drawn if it was.
+func StartupReport(w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, "phase\tstart\ttook")
+	var ready time.Duration
+	for _, p := range Phases() {
+		fmt.Fprintf(tw, "%s\t%s\t%s\n", p.Name, round(p.Start), round(p.Took))
+		if p.Name == FirstRender && ready == 0 {
+			ready = p.Start + p.Took
+		}
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+	if ready > 0 {
+		_, err := fmt.Fprintf(w, "first frame after %s\n", round(ready))
+		return err
+	}
+	return nil
+}
+
+// round rounds d for reports, to the microsecond.
+func round(d time.Duration) time.Duration {
+	return d.Round(time.Microsecond)
+}
@@ -0,0 +1,111 @@
+// Package redact finds text that looks like secrets, such as access keys, tokens and private
+// keys, so it can be masked on screen while screens are shared.
+//
+// A Pattern is a regular expression. If it has a group, the text of the first group is the
+// secret, so "password = hunter22" masks only "hunter22"; otherwise all of the match is.
+package redact
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"unicode/utf8"
+)
+
+// ErrPattern is returned for patterns that are not valid regular expressions.
+var ErrPattern = errors.New("redact: bad pattern")
+
+// Pattern is a kind of secret and the regular expression that finds it.
+type Pattern struct {
+	Name string
+	RE   *regexp.Regexp
+}
+
+// builtin are the patterns found by default, by name.
+var builtin = map[string]string{
+	"aws-access-key":  `\b(?:AKIA|ASIA|AGPA|AIDA|AROA)[0-9A-Z]{16}\b`,
+	"aws-secret-key":  `(?i)aws_?secret_?(?:access_?)?key["']?\s*[:=]\s*["']?([A-Za-z0-9/+=]{40})\b`,
+	"github-token":    `\b(?:gh[pousr]_[A-Za-z0-9]{36,}|github_pat_[A-Za-z0-9_]{40,})\b`,
+	"gitlab-token":    `\bglpat-[A-Za-z0-9_-]{20,}\b`,
+	"slack-token":     `\bxox[abopsr]-[A-Za-z0-9-]{10,}\b`,
+	"stripe-key":      `\b[rs]k_(?:live|test)_[A-Za-z0-9]{16,}\b`,
+	"google-api-key":  `\bAIza[0-9A-Za-z_-]{35}\b`,
+	"jwt":             `\beyJ[A-Za-z0-9_-]{8,}\.eyJ[A-Za-z0-9_-]{8,}\.[A-Za-z0-9_-]{8,}\b`,
+	"private-key":     `-----BEGIN[A-Z ]* PRIVATE KEY( BLOCK)?-----[\s\S]*?-----END[A-Z ]* PRIVATE KEY( BLOCK)?-----`,
+	"url-password":    `\b[a-z][a-z0-9+.-]*://[^/\s:@]+:([^/\s@]+)@`,
+	"password-assign": `(?i)\b(?:password|passwd|pwd|secret|token|api_?key|access_?key|client_?secret)["']?\s*[:=]\s*["']?([^\s"'` + "`" + `,;]{8,})`,
+}
+
+// Patterns returns the built-in patterns, changed by custom: a name maps to the regular
+// expression of a pattern to add or replace, or to "" to leave out a built-in one. They are
+// sorted by name.
+func Patterns(custom map[string]string) ([]Pattern, error) {
+	exprs := map[string]string{}
+	for name, expr := range builtin {
+		exprs[name] = expr
+	}
+	for name, expr := range custom {
+		exprs[name] = expr
+	}
+
+	var ps []Pattern
+	for name, expr := range exprs {
+		if expr == "" {
+			continue
+		}
+		re, err := regexp.Compile(expr)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s: %v", ErrPattern, name, err)
+		}
+		ps = append(ps, Pattern{Name: name, RE: re})
+	}
+	slices.SortFunc(ps, func(a, b Pattern) int { return strings.Compare(a.Name, b.Name) })
+	return ps, nil
+}
+
+// Range is a secret found in a text, from rune offset Start to End.
+type Range struct {
+	Start, End int
+}
+
+// Find returns where src holds secrets found by patterns, in order and merged where they
+// overlap.
+func Find(src string, patterns []Pattern) []Range {
+	var byteRanges []Range
+	for _, p := range patterns {
+		for _, m := range p.RE.FindAllStringSubmatchIndex(src, -1) {
+			start, end := m[0], m[1]
+			if len(m) >= 4 && m[2] >= 0 {
+				start, end = m[2], m[3]
+			}
+			if start < end {
+				byteRanges = append(byteRanges, Range{start, end})
+			}
+		}
+	}
+	slices.SortFunc(byteRanges, func(a, b Range) int { return a.Start - b.Start })
+
+	var ranges []Range
+	for _, r := range byteRanges {
+		if n := len(ranges); n > 0 && r.Start <= ranges[n-1].End {
+			ranges[n-1].End = max(ranges[n-1].End, r.End)
+			continue
+		}
+		ranges = append(ranges, r)
+	}
+
+	// Turn byte offsets into rune offsets in one pass.
+	at, runes := 0, 0
+	offset := func(b int) int {
+		runes += utf8.RuneCountInString(src[at:b])
+		at = b
+		return runes
+	}
+	for i, r := range ranges {
+		ranges[i].Start = offset(r.Start)
+		ranges[i].End = offset(r.End)
+	}
+	return ranges
+}
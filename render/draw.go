@@ -0,0 +1,172 @@
+package render
+
+import (
+	"strings"
+
+	"github.com/avalonbits/goted/profile"
+	"github.com/avalonbits/goted/screen"
+	"github.com/avalonbits/goted/syntax"
+	"github.com/avalonbits/goted/text"
+	"github.com/avalonbits/goted/theme"
+)
+
+// Draw draws the lines of b starting at line top into the rectangle r of g, highlighted by hl,
+// which may be nil, with the styles of t, the selection in the selection UI style and the spans
+// o.Redact returns masked. Lines are wrapped at the right edge of r if o.Wrap is set and cut
+// there otherwise. With o.ElasticTabs, the tab separated columns of adjacent lines line up. Returns the screen position of the cursor and whether it is inside r.
+func Draw(g *screen.Grid, r screen.Rect, b *text.Buffer, top int, hl syntax.Highlighter, t theme.Theme, o Options) (x, y int, ok bool) {
+	base := theme.Style{FG: t.Foreground, BG: t.Background}
+	g.Fill(r, base)
+	selStart, selEnd, selected := b.Selection()
+	selection := t.Style("ui.selection")
+	lineRunes := func(n int) []rune {
+		line, _ := b.LineRunes(n)
+		return line
+	}
+
+	var first int
+	var elastic [][]int
+	if o.ElasticTabs {
+		first, elastic = elasticLines(b, top, r.Height)
+	}
+
+	row := 0
+	for n := top; n < b.Lines() && row < r.Height; n++ {
+		line, _ := b.LineRunes(n)
+		if elastic != nil {
+			o.Elastic = elastic[n-first]
+		}
+		offset := b.Offset(n, 0)
+		lineBase := base
+		if o.LineScope != nil {
+			if scope := o.LineScope(n); scope != "" {
+				lineBase = overlay(base, t.Style(scope))
+			}
+		}
+		scopes := make([]string, len(line))
+		if hl != nil {
+			done := profile.Start(profile.Highlight)
+			var spans []syntax.Span
+			if o.States != nil {
+				spans = o.States.Highlight(n, lineRunes)
+			} else {
+				spans = hl.Highlight(line)
+			}
+			done()
+			for _, s := range spans {
+				for i := s.Start; i < s.End && i < len(scopes); i++ {
+					scopes[i] = s.Scope
+				}
+			}
+		}
+		if o.Emphasis != nil {
+			for _, s := range o.Emphasis(n) {
+				for i := max(s.Start, 0); i < s.End && i < len(scopes); i++ {
+					scopes[i] = s.Scope
+				}
+			}
+		}
+		var masked []bool
+		if o.Redact != nil {
+			for _, s := range o.Redact(n) {
+				if masked == nil {
+					masked = make([]bool, len(line))
+				}
+				for i := max(s.Start, 0); i < s.End && i < len(line); i++ {
+					masked[i], scopes[i] = true, s.Scope
+				}
+			}
+		}
+
+		rows := []int{0}
+		if o.Wrap {
+			rows = Wrap(line, r.Width, o)
+		}
+		cells := Layout(line, o)
+		next := 0
+		for i, start := range rows {
+			if row >= r.Height {
+				break
+			}
+			end := len(line)
+			if i+1 < len(rows) {
+				end = rows[i+1]
+			}
+
+			if lineBase != base {
+				g.Fill(screen.Rect{X: r.X, Y: r.Y + row, Width: r.Width, Height: 1}, lineBase)
+			}
+			left := -1
+			for ; next < len(cells) && cells[next].Col < end; next++ {
+				c := cells[next]
+				if left < 0 {
+					left = c.X
+				}
+				cx := c.X - left
+				if cx >= r.Width {
+					continue
+				}
+				style := lineBase
+				if scope := scopes[c.Col]; scope != "" {
+					style = overlay(lineBase, t.Style(scope))
+				}
+				if selected && offset+c.Col >= selStart && offset+c.Col < selEnd {
+					style = overlay(style, selection)
+				}
+				if cx+c.Width > r.Width {
+					g.Fill(screen.Rect{X: r.X + cx, Y: r.Y + row, Width: r.Width - cx, Height: 1}, style)
+					continue
+				}
+				if masked != nil && masked[c.Col] && c.Text != " " && line[c.Col] != '\t' {
+					c.Text = strings.Repeat("*", c.Width)
+				}
+				g.Print(r.X+cx, r.Y+row, c.Text, style)
+			}
+
+			if i == 0 {
+				guides(g, r, row, left, t, o.Guides)
+			}
+
+			col := b.Column()
+			if n == b.Line() && col >= start && (col < end || i == len(rows)-1) {
+				x = ScreenColumn(line, col, o)
+				if left >= 0 {
+					x -= left
+				} else if start > 0 {
+					x -= ScreenColumn(line, start, o)
+				}
+				y, ok = row, x < r.Width
+			}
+			row++
+		}
+	}
+	return r.X + x, r.Y + y, ok
+}
+
+// guides marks the guide columns of row, whose first cell is at screen column left, or -1 if it
+// is empty.
+func guides(g *screen.Grid, r screen.Rect, row, left int, t theme.Theme, cols []int) {
+	style := t.Style("ui.guide")
+	for _, col := range cols {
+		x := col - max(left, 0)
+		if x < 0 || x >= r.Width {
+			continue
+		}
+		c := g.Cell(r.X+x, r.Y+row)
+		if c.Width == 0 {
+			continue
+		}
+		g.Put(r.X+x, r.Y+row, c.Text, c.Width, overlay(c.Style, style))
+	}
+}
+
+// overlay returns s with the colors it leaves empty taken from base.
+func overlay(base, s theme.Style) theme.Style {
+	if s.FG == "" {
+		s.FG = base.FG
+	}
+	if s.BG == "" {
+		s.BG = base.BG
+	}
+	return s
+}
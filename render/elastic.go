@@ -0,0 +1,97 @@
+package render
+
+import (
+	"slices"
+
+	"github.com/avalonbits/goted/text"
+)
+
+// elasticPadding is how many columns Draw leaves between elastic columns.
+const elasticPadding = 2
+
+// maxElastic bounds how many lines past those drawn Draw looks at, each way, for the rest of the
+// columns they are in, so that a huge table stays quick to draw.
+const maxElastic = 1000
+
+// ElasticTabs computes elastic tabstops for a run of lines: tab separated columns of adjacent
+// lines line up, each as wide as its widest cell plus padding. The result holds, for every line,
+// the width of each tab terminated cell, to be passed as Options.Elastic when laying it out.
+// Only the display changes; the text keeps its plain tabs.
+//
+// A column block ends at the first line that has no cell in that column, so unrelated tables
+// separated by such a line are aligned independently.
+func ElasticTabs(lines [][]rune, padding int) [][]int {
+	cells := make([][]int, len(lines))
+	for n, line := range lines {
+		var widths []int
+		start := 0
+		for i, r := range line {
+			if r == '\t' {
+				widths = append(widths, textWidth(line[start:i])+padding)
+				start = i + 1
+			}
+		}
+		cells[n] = widths
+	}
+
+	out := make([][]int, len(lines))
+	for n := range cells {
+		out[n] = slices.Clone(cells[n])
+	}
+
+	for col := 0; ; col++ {
+		found := false
+		for n := 0; n < len(cells); {
+			if len(cells[n]) <= col {
+				n++
+				continue
+			}
+
+			found = true
+			end, width := n, 0
+			for ; end < len(cells) && len(cells[end]) > col; end++ {
+				width = max(width, cells[end][col])
+			}
+			for ; n < end; n++ {
+				out[n][col] = width
+			}
+		}
+		if !found {
+			return out
+		}
+	}
+}
+
+// elasticLines returns the first line of the columns the count lines of b from top are in,
+// before top if the tab separated lines there go on above it, and the widths of the cells of
+// each line from it on, as ElasticTabs computes them.
+func elasticLines(b *text.Buffer, top, count int) (int, [][]int) {
+	hasTab := func(n int) bool {
+		line, _ := b.LineRunes(n)
+		return slices.Contains(line, '\t')
+	}
+	first := top
+	for first > max(top-maxElastic, 0) && hasTab(first-1) {
+		first--
+	}
+	end := min(top+count, b.Lines())
+	stop := min(end+maxElastic, b.Lines())
+	for end < stop && hasTab(end) {
+		end++
+	}
+
+	lines := make([][]rune, end-first)
+	for i := range lines {
+		lines[i], _ = b.LineRunes(first + i)
+	}
+	return first, ElasticTabs(lines, elasticPadding)
+}
+
+// textWidth returns the screen width of text, which has no tabs.
+func textWidth(text []rune) int {
+	width := 0
+	for _, c := range Layout(text, Options{}) {
+		width += c.Width
+	}
+	return width
+}
@@ -0,0 +1,135 @@
+// Package render lays out buffer text on a grid of terminal cells.
+package render
+
+import (
+	"fmt"
+
+	"github.com/avalonbits/goted/syntax"
+	"github.com/avalonbits/goted/unichar"
+)
+
+// BidiMode selects how lines mixing left to right and right to left text are displayed.
+type BidiMode int
+
+const (
+	// BidiLogical draws characters in the order they are stored. Right to left runs read
+	// backwards, but cursor columns always match screen columns. It is the only mode supported
+	// so far; LineHasRTL lets front ends warn about lines it cannot show correctly.
+	BidiLogical BidiMode = iota
+)
+
+// Cell is a grapheme cluster placed on screen. Col is the rune column of the cluster in the
+// line, X the screen column it starts at and Width how many screen columns it covers. Tabs and
+// control characters are expanded into the text they are shown as.
+type Cell struct {
+	Text  string
+	Col   int
+	X     int
+	Width int
+}
+
+// Options control line layout.
+type Options struct {
+	TabWidth int
+	Bidi     BidiMode
+
+	// Guides are screen columns, counted from 0, marked with the guide UI style on every line,
+	// as vim's colorcolumn does.
+	Guides []int
+
+	// LineScope, if set, returns the scope whose style colors the whole of line n, such as the
+	// sides of a merge conflict, or "".
+	LineScope func(n int) string
+
+	// Wrap breaks lines longer than the screen into several rows instead of cutting them.
+	Wrap bool
+
+	// States, if set, holds the states the lines of the buffer start in for a stateful
+	// highlighter, such as that of Markdown, which highlights them in those states.
+	States *syntax.States
+
+	// Emphasis, if set, returns spans of line n whose scopes are laid over those of the
+	// highlighting, such as the words a line of a diff changed.
+	Emphasis func(n int) []syntax.Span
+
+	// Redact, if set, returns the spans of line n to mask, as the rune columns of likely
+	// secrets: each cell in them is drawn as asterisks, in the style of the scope of the span,
+	// while the text stays as it is.
+	Redact func(n int) []syntax.Span
+
+	// Elastic, if set, holds the width of each tab terminated cell of the line as computed by
+	// ElasticTabs. Tabs then stretch to the end of their cell instead of the next tab stop.
+	Elastic []int
+
+	// ElasticTabs makes Draw compute Elastic for each line it draws, from the lines around it.
+	ElasticTabs bool
+}
+
+// Layout splits line into the cells it is displayed as.
+func Layout(line []rune, o Options) []Cell {
+	tab := max(o.TabWidth, 1)
+
+	var cells []Cell
+	x, cellStart, tabs := 0, 0, 0
+	for col := 0; col < len(line); {
+		end := unichar.ClusterEnd(line, col)
+		cluster := line[col:end]
+
+		c := Cell{Text: string(cluster), Col: col, X: x, Width: unichar.ClusterWidth(cluster)}
+		switch r := cluster[0]; {
+		case r == '\t':
+			c.Width = tab - x%tab
+			if tabs < len(o.Elastic) {
+				c.Width = max(cellStart+o.Elastic[tabs]-x, 1)
+			}
+			c.Text = fmt.Sprintf("%*s", c.Width, "")
+			cellStart, tabs = x+c.Width, tabs+1
+		case r < 0x20 || r == 0x7F:
+			c.Text, c.Width = "^"+string(r^0x40), 2
+		case c.Width == 0:
+			// A stray combining mark or joiner: give it a column of its own so it is visible
+			// and reachable.
+			c.Text, c.Width = " "+c.Text, 1
+		}
+
+		cells = append(cells, c)
+		x += c.Width
+		col = end
+	}
+	return cells
+}
+
+// ScreenColumn returns the screen column rune column col of line is drawn at. Columns inside a
+// cluster map to the start of the cluster; columns past the end continue one column per rune.
+func ScreenColumn(line []rune, col int, o Options) int {
+	x := 0
+	cells := Layout(line, o)
+	for i, c := range cells {
+		if i+1 < len(cells) && col < cells[i+1].Col || i+1 == len(cells) && col < len(line) {
+			return c.X
+		}
+		x = c.X + c.Width
+	}
+	return x + col - len(line)
+}
+
+// RuneColumn returns the rune column drawn at screen column x of line. Screen columns covered by
+// a wide cluster map to its start; columns past the end of the line map to its length.
+func RuneColumn(line []rune, x int, o Options) int {
+	for _, c := range Layout(line, o) {
+		if x < c.X+c.Width {
+			return c.Col
+		}
+	}
+	return len(line)
+}
+
+// LineHasRTL reports whether line holds right to left text.
+func LineHasRTL(line []rune) bool {
+	for _, r := range line {
+		if unichar.IsRTL(r) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,62 @@
+package render
+
+import (
+	"github.com/avalonbits/goted/screen"
+	"github.com/avalonbits/goted/syntax"
+	"github.com/avalonbits/goted/theme"
+	"github.com/avalonbits/goted/unichar"
+)
+
+// PopupRow is a row of popup text. Spans, in runes of Text, are drawn with their scope's style
+// over the popup's.
+type PopupRow struct {
+	Text  string
+	Spans []syntax.Span
+}
+
+// DrawPopup draws rows, from row top on, into the box r of g with the popup UI style of t, with
+// a column of padding on each side. When not every row fits, the right padding column shows
+// which part of the rows is in view with the popup.thumb style.
+func DrawPopup(g *screen.Grid, r screen.Rect, rows []PopupRow, top int, t theme.Theme) {
+	style := overlay(theme.Style{FG: t.Foreground, BG: t.Background}, t.Style("ui.popup"))
+	g.Fill(r, style)
+	for i := 0; i < r.Height && top+i < len(rows); i++ {
+		popupRow(g, r.X+1, r.Y+i, rows[top+i], style, t)
+	}
+
+	if len(rows) <= r.Height || r.Width < 2 {
+		return
+	}
+	thumb := overlay(style, t.Style("ui.popup.thumb"))
+	first := top * r.Height / len(rows)
+	last := max((top+r.Height)*r.Height/len(rows), first+1)
+	for y := first; y < min(last, r.Height); y++ {
+		g.Put(r.X+r.Width-1, r.Y+y, " ", 1, thumb)
+	}
+}
+
+// DrawRows draws rows, from row top on, into the box r of g in the colors of t, as a pane beside
+// a buffer shows text laid out for it, such as a Markdown preview.
+func DrawRows(g *screen.Grid, r screen.Rect, rows []PopupRow, top int, t theme.Theme) {
+	style := theme.Style{FG: t.Foreground, BG: t.Background}
+	g.Fill(r, style)
+	for i := 0; i < r.Height && top+i < len(rows); i++ {
+		popupRow(g, r.X, r.Y+i, rows[top+i], style, t)
+	}
+}
+
+// popupRow draws row from column x of row y.
+func popupRow(g *screen.Grid, x, y int, row PopupRow, style theme.Style, t theme.Theme) {
+	text := []rune(row.Text)
+	for i := 0; i < len(text); {
+		end := unichar.ClusterEnd(text, i)
+		s := style
+		for _, span := range row.Spans {
+			if i >= span.Start && i < span.End {
+				s = overlay(style, t.Style(span.Scope))
+			}
+		}
+		x = g.Print(x, y, string(text[i:end]), s)
+		i = end
+	}
+}
@@ -0,0 +1,32 @@
+package render
+
+import "unicode"
+
+// Wrap returns the rune columns where each screen row of line starts when it is wrapped to
+// width columns; the first is always 0. Rows break after the last space that fits, or anywhere
+// if a word is longer than the row.
+func Wrap(line []rune, width int, o Options) []int {
+	rows := []int{0}
+	if width <= 0 {
+		return rows
+	}
+
+	cells := Layout(line, o)
+	start, breakAt := 0, -1
+	for i := 0; i < len(cells); i++ {
+		c := cells[i]
+		if c.X+c.Width-cells[start].X > width && i > start {
+			next := i
+			if breakAt > start {
+				next = breakAt
+			}
+			rows = append(rows, cells[next].Col)
+			start, breakAt, i = next, -1, next-1
+			continue
+		}
+		if unicode.IsSpace([]rune(c.Text)[0]) {
+			breakAt = i + 1
+		}
+	}
+	return rows
+}
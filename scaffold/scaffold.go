@@ -0,0 +1,175 @@
+// Package scaffold fills the buffers of files that do not exist yet from per file type templates.
+//
+// Templates are plain text with ${name} placeholders: ${header} is the license header, commented
+// for the file type; ${package} is the Go package name inferred from the directory; ${name} is
+// the file name without its extension; ${year} is the current year and ${cursor} marks where the
+// cursor is left. Unknown placeholders are kept as they are.
+package scaffold
+
+import (
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/avalonbits/goted/text"
+)
+
+// Builtin holds the templates used when the template directory has none for a file type.
+var Builtin = map[string]string{
+	"go":     "${header}package ${package}\n\n${cursor}",
+	"sh":     "#!/bin/sh\n${header}\n${cursor}",
+	"python": "#!/usr/bin/env python3\n${header}\n${cursor}",
+	"html":   "<!DOCTYPE html>\n<html>\n<head>\n<title>${name}</title>\n</head>\n<body>\n${cursor}\n</body>\n</html>\n",
+}
+
+// commentPrefixes maps file types to the line comment used for the license header.
+var commentPrefixes = map[string]string{
+	"c":          "//",
+	"cpp":        "//",
+	"go":         "//",
+	"java":       "//",
+	"javascript": "//",
+	"rust":       "//",
+	"typescript": "//",
+	"dockerfile": "#",
+	"make":       "#",
+	"python":     "#",
+	"sh":         "#",
+	"toml":       "#",
+	"yaml":       "#",
+}
+
+var placeholder = regexp.MustCompile(`\$\{(\w+)\}`)
+
+// Options control how new files are filled.
+type Options struct {
+	// Dir holds user templates, one per file type named after it, such as "go" or "python".
+	// They take precedence over the built-in ones.
+	Dir string
+
+	// Header is the license header put in place of ${header}. It may use ${year}.
+	Header string
+}
+
+// Dir returns the location of the user template directory.
+func Dir() (string, error) {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "goted", "templates"), nil
+}
+
+// Template returns the template for fileType, looking in dir first.
+func Template(dir, fileType string) (string, bool) {
+	if fileType == "" {
+		return "", false
+	}
+	if dir != "" {
+		if data, err := os.ReadFile(filepath.Join(dir, fileType)); err == nil {
+			return string(data), true
+		}
+	}
+	tmpl, ok := Builtin[fileType]
+	return tmpl, ok
+}
+
+// Fill inserts the template for the file type of b, leaving the cursor at its ${cursor}
+// placeholder. It is meant for new buffers bound to a file that does not exist. Returns false
+// if there is no template for the file type.
+func Fill(b *text.Buffer, o Options) (bool, error) {
+	fileType := b.Options().FileType
+	if fileType == "" {
+		fileType = text.DetectFileType(b.Path())
+	}
+	tmpl, ok := Template(o.Dir, fileType)
+	if !ok {
+		return false, nil
+	}
+
+	year := strconv.Itoa(time.Now().Year())
+	vars := map[string]string{
+		"year": year,
+		"name": strings.TrimSuffix(filepath.Base(b.Path()), filepath.Ext(b.Path())),
+	}
+	if o.Header != "" {
+		vars["header"] = comment(Expand(o.Header, vars), commentPrefixes[fileType])
+	} else {
+		vars["header"] = ""
+	}
+	if fileType == "go" {
+		vars["package"] = GoPackage(filepath.Dir(b.Path()))
+	}
+
+	expanded := Expand(tmpl, vars)
+	cursor := 0
+	if i := strings.Index(expanded, "${cursor}"); i >= 0 {
+		cursor = len([]rune(expanded[:i]))
+		expanded = strings.ReplaceAll(expanded, "${cursor}", "")
+	}
+
+	start := b.Cursor()
+	if err := b.Insert([]rune(expanded)); err != nil {
+		return false, err
+	}
+	b.Seek(start + cursor)
+	return true, nil
+}
+
+// Expand replaces the ${name} placeholders of tmpl found in vars. ${cursor} is always kept.
+func Expand(tmpl string, vars map[string]string) string {
+	return placeholder.ReplaceAllStringFunc(tmpl, func(m string) string {
+		if v, ok := vars[m[2:len(m)-1]]; ok {
+			return v
+		}
+		return m
+	})
+}
+
+// GoPackage returns the package name for a new Go file in dir: the package of the other Go files
+// there, or one derived from the directory name.
+func GoPackage(dir string) string {
+	names, _ := filepath.Glob(filepath.Join(dir, "*.go"))
+	fset := token.NewFileSet()
+	for _, name := range names {
+		if strings.HasSuffix(name, "_test.go") {
+			continue
+		}
+		if f, err := parser.ParseFile(fset, name, nil, parser.PackageClauseOnly); err == nil {
+			return f.Name.Name
+		}
+	}
+
+	abs, err := filepath.Abs(dir)
+	if err != nil {
+		return "main"
+	}
+	pkg := strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return unicode.ToLower(r)
+		}
+		return -1
+	}, filepath.Base(abs))
+	if pkg == "" || unicode.IsDigit([]rune(pkg)[0]) {
+		return "main"
+	}
+	return pkg
+}
+
+// comment turns every line of header into a line comment with prefix and ends it with a blank
+// line. Without a prefix the header is used as is.
+func comment(header, prefix string) string {
+	lines := strings.Split(strings.TrimRight(header, "\n"), "\n")
+	if prefix != "" {
+		for i, line := range lines {
+			lines[i] = strings.TrimRight(prefix+" "+line, " ")
+		}
+	}
+	return strings.Join(lines, "\n") + "\n\n"
+}
@@ -0,0 +1,135 @@
+// Package screen draws onto an in-memory grid of cells that is then written to the terminal.
+//
+// Front ends draw every frame onto the grid of a Screen and call Flush, which compares it with
+// what the terminal already shows and only sends the cells that changed. Since grids are plain
+// values with a text dump, rendering can be checked without a terminal.
+package screen
+
+import (
+	"strings"
+
+	"github.com/avalonbits/goted/theme"
+	"github.com/avalonbits/goted/unichar"
+)
+
+// Cell is a grapheme cluster drawn at a screen position. Clusters wider than one column are
+// followed by continuation cells of width 0, which draw nothing.
+type Cell struct {
+	Text  string
+	Width int
+	Style theme.Style
+}
+
+// blank is the cell of an empty screen position.
+var blank = Cell{Text: " ", Width: 1}
+
+// Rect is a rectangle of screen cells.
+type Rect struct {
+	X, Y          int
+	Width, Height int
+}
+
+// Grid is a rectangle of cells.
+type Grid struct {
+	width, height int
+	cells         []Cell
+}
+
+// NewGrid returns a blank grid of width by height cells.
+func NewGrid(width, height int) *Grid {
+	g := &Grid{}
+	g.Resize(width, height)
+	return g
+}
+
+// Size returns the width and height of the grid.
+func (g *Grid) Size() (int, int) {
+	return g.width, g.height
+}
+
+// Bounds returns the rectangle covered by the grid.
+func (g *Grid) Bounds() Rect {
+	return Rect{Width: g.width, Height: g.height}
+}
+
+// Resize changes the size of the grid and blanks it.
+func (g *Grid) Resize(width, height int) {
+	g.width, g.height = max(width, 0), max(height, 0)
+	g.cells = make([]Cell, g.width*g.height)
+	g.Fill(g.Bounds(), theme.Style{})
+}
+
+// Cell returns the cell at column x of row y. Positions outside the grid are blank.
+func (g *Grid) Cell(x, y int) Cell {
+	if x < 0 || y < 0 || x >= g.width || y >= g.height {
+		return blank
+	}
+	return g.cells[y*g.width+x]
+}
+
+// Fill blanks the cells of r with style.
+func (g *Grid) Fill(r Rect, style theme.Style) {
+	c := blank
+	c.Style = style
+	for y := max(r.Y, 0); y < min(r.Y+r.Height, g.height); y++ {
+		for x := max(r.X, 0); x < min(r.X+r.Width, g.width); x++ {
+			g.cells[y*g.width+x] = c
+		}
+	}
+}
+
+// Put draws the cluster text, width columns wide, at column x of row y. Clusters that do not fit
+// on the row are drawn as blanks.
+func (g *Grid) Put(x, y int, text string, width int, style theme.Style) {
+	if y < 0 || y >= g.height || x < 0 || x >= g.width {
+		return
+	}
+	if x+width > g.width {
+		g.Fill(Rect{X: x, Y: y, Width: g.width - x, Height: 1}, style)
+		return
+	}
+
+	row := g.cells[y*g.width : (y+1)*g.width]
+	// Blank what is left of wide clusters partly covered by this one.
+	for i := x; i > 0 && row[i].Width == 0; i-- {
+		row[i-1] = Cell{Text: " ", Width: 1, Style: row[i-1].Style}
+	}
+	for i := x + width; i < g.width && row[i].Width == 0; i++ {
+		row[i] = Cell{Text: " ", Width: 1, Style: row[i].Style}
+	}
+
+	row[x] = Cell{Text: text, Width: width, Style: style}
+	for i := 1; i < width; i++ {
+		row[x+i] = Cell{Style: style}
+	}
+}
+
+// Print draws s from column x of row y and returns the column after it.
+func (g *Grid) Print(x, y int, s string, style theme.Style) int {
+	text := []rune(s)
+	for i := 0; i < len(text); {
+		end := unichar.ClusterEnd(text, i)
+		w := max(unichar.ClusterWidth(text[i:end]), 1)
+		g.Put(x, y, string(text[i:end]), w, style)
+		x, i = x+w, end
+	}
+	return x
+}
+
+// Row returns the text of row y, without trailing blanks.
+func (g *Grid) Row(y int) string {
+	var row strings.Builder
+	for x := range g.width {
+		row.WriteString(g.Cell(x, y).Text)
+	}
+	return strings.TrimRight(row.String(), " ")
+}
+
+// String returns the text of the grid, one line per row, without styles.
+func (g *Grid) String() string {
+	rows := make([]string, g.height)
+	for y := range rows {
+		rows[y] = g.Row(y)
+	}
+	return strings.Join(rows, "\n")
+}
@@ -0,0 +1,150 @@
+package screen
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	"github.com/avalonbits/goted/export"
+	"github.com/avalonbits/goted/theme"
+)
+
+// Screen is a terminal drawn through a grid. It remembers what the terminal shows so Flush only
+// sends the cells that changed.
+type Screen struct {
+	out   io.Writer
+	front *Grid
+	back  *Grid
+
+	cursorX, cursorY int
+	cursorShown      bool
+	full             bool
+
+	graphics []Graphic
+	shown    []Graphic
+}
+
+// Graphic is an image the terminal draws itself, over the cells from column X of row Y on. Seq
+// draws it from the cursor, and Clear, if set, removes it, for terminals whose images stay when
+// text is drawn over them.
+type Graphic struct {
+	X, Y  int
+	Seq   string
+	Clear string
+}
+
+// New returns a Screen of width by height cells writing to out. The first Flush redraws every
+// cell.
+func New(out io.Writer, width, height int) *Screen {
+	return &Screen{out: out, front: NewGrid(width, height), back: NewGrid(width, height), full: true}
+}
+
+// Grid returns the grid to draw the next frame on. It keeps the previous frame until redrawn.
+func (s *Screen) Grid() *Grid {
+	return s.back
+}
+
+// Size returns the width and height of the screen.
+func (s *Screen) Size() (int, int) {
+	return s.back.Size()
+}
+
+// Resize changes the size of the screen. The grid is blanked and the next Flush redraws every
+// cell.
+func (s *Screen) Resize(width, height int) {
+	s.front.Resize(width, height)
+	s.back.Resize(width, height)
+	s.full = true
+}
+
+// Invalidate makes the next Flush redraw every cell, as needed after the terminal was used by
+// another program.
+func (s *Screen) Invalidate() {
+	s.full = true
+}
+
+// SetGraphics makes gs the images shown from the next Flush on, which redraws every cell when
+// they change so the images they replace are gone. The cells under them should be blank.
+func (s *Screen) SetGraphics(gs []Graphic) {
+	s.graphics = gs
+}
+
+// ShowCursor places the terminal cursor at column x of row y after the next Flush.
+func (s *Screen) ShowCursor(x, y int) {
+	s.cursorX, s.cursorY, s.cursorShown = x, y, true
+}
+
+// HideCursor hides the terminal cursor after the next Flush.
+func (s *Screen) HideCursor() {
+	s.cursorShown = false
+}
+
+// Flush writes the cells of the grid that differ from what the terminal shows, moving the
+// cursor and changing the style only when needed.
+func (s *Screen) Flush() error {
+	out := bufio.NewWriter(s.out)
+	out.WriteString("\x1b[?25l")
+
+	x, y := -1, -1
+	var style theme.Style
+	styled := false
+	if !slices.Equal(s.graphics, s.shown) {
+		for _, g := range s.shown {
+			out.WriteString(g.Clear)
+		}
+		s.full = true
+	}
+	if s.full {
+		out.WriteString("\x1b[0m\x1b[2J")
+		style, styled = theme.Style{}, true
+	}
+
+	width, height := s.back.Size()
+	for row := range height {
+		for col := range width {
+			c := s.back.Cell(col, row)
+			if c.Width == 0 || !s.full && c == s.front.Cell(col, row) {
+				continue
+			}
+
+			if row != y || col != x {
+				fmt.Fprintf(out, "\x1b[%d;%dH", row+1, col+1)
+			}
+			if !styled || c.Style != style {
+				out.WriteString(sgr(c.Style))
+				style, styled = c.Style, true
+			}
+			out.WriteString(c.Text)
+			x, y = col+c.Width, row
+		}
+	}
+
+	if s.full {
+		for _, g := range s.graphics {
+			fmt.Fprintf(out, "\x1b[%d;%dH%s", g.Y+1, g.X+1, g.Seq)
+		}
+		s.shown = s.graphics
+	}
+
+	if s.cursorShown {
+		fmt.Fprintf(out, "\x1b[%d;%dH\x1b[?25h", s.cursorY+1, s.cursorX+1)
+	}
+	if err := out.Flush(); err != nil {
+		return err
+	}
+
+	copy(s.front.cells, s.back.cells)
+	s.full = false
+	return nil
+}
+
+// sgr returns the escape sequence resetting the style and selecting style.
+func sgr(style theme.Style) string {
+	seq := export.SGR(style)
+	if seq == "\x1b[m" {
+		return "\x1b[0m"
+	}
+	return strings.Replace(seq, "\x1b[", "\x1b[0;", 1)
+}
@@ -0,0 +1,176 @@
+package storage
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"net/url"
+	"os/exec"
+	"strings"
+	"time"
+)
+
+// ErrStatus is returned, wrapped, when an HTTP server answers with an unexpected status.
+var ErrStatus = errors.New("storage: unexpected HTTP status")
+
+// ErrURL is returned for remote paths that do not name a host and a file.
+var ErrURL = errors.New("storage: bad remote path")
+
+// httpTimeout is how long HTTP waits for a server by default.
+const httpTimeout = 30 * time.Second
+
+// HTTP stores contents at HTTP URLs: Read fetches them with GET and Write replaces them with
+// PUT, as WebDAV servers and object stores accept. A 404 reads as nothing stored.
+type HTTP struct {
+	// Client sends the requests. If it is nil, a client that waits at most 30 seconds is used.
+	Client *http.Client
+}
+
+// Read returns the body of a GET of the URL path.
+func (h HTTP) Read(path string) ([]byte, error) {
+	resp, err := h.client().Get(path)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	switch {
+	case resp.StatusCode == http.StatusNotFound:
+		return nil, &fs.PathError{Op: "read", Path: path, Err: ErrNotExist}
+	case resp.StatusCode/100 != 2:
+		return nil, fmt.Errorf("%w: GET %s: %s", ErrStatus, path, resp.Status)
+	}
+	return io.ReadAll(resp.Body)
+}
+
+// Write sends data in a PUT to the URL path. The server is trusted to replace the contents
+// only once it has received all of them.
+func (h HTTP) Write(path string, data []byte) error {
+	req, err := http.NewRequest(http.MethodPut, path, bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/octet-stream")
+	resp, err := h.client().Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	io.Copy(io.Discard, resp.Body)
+	if resp.StatusCode/100 != 2 {
+		return fmt.Errorf("%w: PUT %s: %s", ErrStatus, path, resp.Status)
+	}
+	return nil
+}
+
+func (h HTTP) client() *http.Client {
+	if h.Client != nil {
+		return h.Client
+	}
+	return &http.Client{Timeout: httpTimeout}
+}
+
+// SSH stores contents in files on other hosts, named by URLs such as
+// sftp://user@host:2222/etc/hosts, reached with the ssh command, so the keys, agent and host
+// settings of the user apply. Paths are absolute on the host, and "~" starts the home
+// directory there, as in sftp://host/~/notes.txt. Writes go to a temporary file on the host,
+// renamed over the file once complete, as File does locally, keeping its mode.
+//
+// The sftp and ssh schemes both use the ssh command: there is no SFTP client among the
+// dependencies of this module, and the shell commands work with any host the sftp one does.
+type SSH struct {
+	// Command is the ssh command run, "ssh" if it is empty.
+	Command string
+}
+
+// Read returns the contents of the file the URL path names.
+func (s SSH) Read(path string) ([]byte, error) {
+	dest, file, err := s.split(path)
+	if err != nil {
+		return nil, err
+	}
+	var stderr bytes.Buffer
+	cmd := s.cmd(dest, "if test -e "+file+"; then cat "+file+"; else exit 3; fi")
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	var exit *exec.ExitError
+	if errors.As(err, &exit) && exit.ExitCode() == 3 {
+		return nil, &fs.PathError{Op: "read", Path: path, Err: ErrNotExist}
+	}
+	if err != nil {
+		return nil, sshError("read", path, err, stderr.String())
+	}
+	return out, nil
+}
+
+// Write replaces the file the URL path names with data.
+func (s SSH) Write(path string, data []byte) error {
+	dest, file, err := s.split(path)
+	if err != nil {
+		return err
+	}
+	// Copying the file first gives the temporary file its mode, then its contents are replaced.
+	tmp := file + `".goted~"`
+	script := "{ cp -p " + file + " " + tmp + " 2>/dev/null || :; } && cat > " + tmp + " && mv -f " + tmp + " " + file + " || { rm -f " + tmp + "; exit 1; }"
+	var stderr bytes.Buffer
+	cmd := s.cmd(dest, script)
+	cmd.Stdin, cmd.Stderr = bytes.NewReader(data), &stderr
+	if err := cmd.Run(); err != nil {
+		return sshError("write", path, err, stderr.String())
+	}
+	return nil
+}
+
+// destination is where ssh connects to.
+type destination struct {
+	host, port string
+}
+
+// split returns the ssh destination and the path on the host, quoted for the shell, that the
+// URL path names.
+func (s SSH) split(path string) (dest destination, file string, err error) {
+	u, err := url.Parse(path)
+	if err != nil {
+		return dest, "", fmt.Errorf("%w: %v", ErrURL, err)
+	}
+	if u.Hostname() == "" || u.Path == "" || u.Path == "/" {
+		return dest, "", fmt.Errorf("%w: %s needs a host and a file", ErrURL, path)
+	}
+	dest = destination{host: u.Hostname(), port: u.Port()}
+	if u.User != nil {
+		dest.host = u.User.Username() + "@" + dest.host
+	}
+	if rest, ok := strings.CutPrefix(u.Path, "/~/"); ok {
+		return dest, `"$HOME"/` + quote(rest), nil
+	}
+	return dest, quote(u.Path), nil
+}
+
+// cmd returns the command running script on dest. It never asks for a password, since the
+// terminal belongs to the editor: keys or an agent must let it in.
+func (s SSH) cmd(dest destination, script string) *exec.Cmd {
+	name := s.Command
+	if name == "" {
+		name = "ssh"
+	}
+	args := []string{"-o", "BatchMode=yes"}
+	if dest.port != "" {
+		args = append(args, "-p", dest.port)
+	}
+	return exec.Command(name, append(args, "--", dest.host, script)...)
+}
+
+// quote quotes s for the POSIX shell.
+func quote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
+
+// sshError returns err for op on path, with what ssh printed.
+func sshError(op, path string, err error, stderr string) error {
+	if msg := strings.TrimSpace(stderr); msg != "" {
+		err = fmt.Errorf("%w: %s", err, msg)
+	}
+	return &fs.PathError{Op: op, Path: path, Err: err}
+}
@@ -0,0 +1,182 @@
+// Package storage abstracts where the contents of a buffer live. A Backend reads and writes the
+// whole contents stored under a path; buffers are loaded from, and saved to, the backend their
+// path belongs to, so files on other machines or only in memory are edited as local files are.
+//
+// Paths starting with a registered prefix, such as "https://" or "mem:", belong to the backend
+// registered for it, and other paths are local files. The built-in backends are:
+//
+//	/home/me/notes.txt              a local file, see File
+//	mem:scratch                     memory, for as long as the editor runs, see Memory
+//	http://host/doc.txt             read with GET and written with PUT, see HTTP
+//	sftp://user@host/etc/hosts      a file on a host reached with ssh, see SSH
+package storage
+
+import (
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+// ErrNotExist is returned, wrapped, by backends for paths that hold nothing yet. It is
+// fs.ErrNotExist, so errors.Is(err, os.ErrNotExist) holds too.
+var ErrNotExist = fs.ErrNotExist
+
+// Backend stores the contents of buffers under paths.
+type Backend interface {
+	// Read returns what is stored under path, or an error wrapping ErrNotExist if nothing is.
+	Read(path string) ([]byte, error)
+
+	// Write stores data under path, replacing what was there all at once: if it fails, what
+	// was stored before is left as it was.
+	Write(path string, data []byte) error
+}
+
+var (
+	mu       sync.RWMutex
+	backends = map[string]Backend{
+		"mem:":     NewMemory(),
+		"http://":  HTTP{},
+		"https://": HTTP{},
+		"sftp://":  SSH{},
+		"ssh://":   SSH{},
+	}
+)
+
+// Register sets the backend for paths starting with prefix, replacing any registered for it.
+// A nil backend removes it.
+func Register(prefix string, b Backend) {
+	mu.Lock()
+	defer mu.Unlock()
+	if b == nil {
+		delete(backends, prefix)
+		return
+	}
+	backends[prefix] = b
+}
+
+// For returns the backend path belongs to: the one registered for the longest prefix of it,
+// or File if none is.
+func For(path string) Backend {
+	if b, ok := lookup(path); ok {
+		return b
+	}
+	return File{}
+}
+
+// Remote reports whether path belongs to a registered backend rather than being a local file.
+// Such paths are not resolved against the working directory, and local features such as undo
+// files and project settings do not apply to them.
+func Remote(path string) bool {
+	_, ok := lookup(path)
+	return ok
+}
+
+func lookup(path string) (Backend, bool) {
+	mu.RLock()
+	defer mu.RUnlock()
+	var best string
+	var found Backend
+	for prefix, b := range backends {
+		if strings.HasPrefix(path, prefix) && len(prefix) > len(best) {
+			best, found = prefix, b
+		}
+	}
+	return found, found != nil
+}
+
+// File stores contents in local files.
+type File struct{}
+
+// Read returns the contents of the file at path.
+func (File) Read(path string) ([]byte, error) {
+	return os.ReadFile(path)
+}
+
+// Write replaces the file at path with data through a temporary file in the same directory,
+// renamed over it once written and synced, so a failed or interrupted write leaves the file as
+// it was. The file keeps its mode, and a symbolic link keeps pointing at it, since the file it
+// points to is the one replaced. New files are created, with mode 0666 less the umask, and
+// removed again if writing them fails.
+func (File) Write(path string, data []byte) error {
+	if target, err := filepath.EvalSymlinks(path); err == nil {
+		path = target
+	}
+	info, err := os.Stat(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return create(path, data)
+	} else if err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), "."+filepath.Base(path)+".*~")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if err := writeSync(tmp, data); err != nil {
+		return err
+	}
+	if err := os.Chmod(tmp.Name(), info.Mode().Perm()); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+// create writes data to a new file at path.
+func create(path string, data []byte) error {
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o666)
+	if err != nil {
+		return err
+	}
+	if err := writeSync(f, data); err != nil {
+		os.Remove(path)
+		return err
+	}
+	return nil
+}
+
+// writeSync writes data to f, syncs it to disk and closes it.
+func writeSync(f *os.File, data []byte) error {
+	if _, err := f.Write(data); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Sync(); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// Memory stores contents in memory, for as long as the process runs.
+type Memory struct {
+	mu    sync.Mutex
+	files map[string][]byte
+}
+
+// NewMemory returns an empty Memory.
+func NewMemory() *Memory {
+	return &Memory{files: map[string][]byte{}}
+}
+
+// Read returns a copy of what is stored under path.
+func (m *Memory) Read(path string) ([]byte, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	data, ok := m.files[path]
+	if !ok {
+		return nil, &fs.PathError{Op: "read", Path: path, Err: ErrNotExist}
+	}
+	return append([]byte(nil), data...), nil
+}
+
+// Write stores a copy of data under path.
+func (m *Memory) Write(path string, data []byte) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.files[path] = append([]byte(nil), data...)
+	return nil
+}
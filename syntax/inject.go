@@ -0,0 +1,243 @@
+package syntax
+
+import (
+	"regexp"
+	"strings"
+)
+
+// State is where a line starts for a Stateful highlighter, such as inside a fenced code block
+// and in which language. The empty State is the start of a document.
+type State string
+
+// Stateful is a Highlighter whose lines depend on the lines before them, such as that of
+// Markdown, whose fenced code blocks are highlighted as the language the fence names.
+// HighlightState highlights line starting in state in and returns the state it ends in. The
+// Highlight method of a Stateful highlighter highlights a line as if it started a document.
+type Stateful interface {
+	Highlighter
+	HighlightState(line []rune, in State) ([]Span, State)
+}
+
+// States keeps the state each line of a document starts in for a Stateful highlighter, so that
+// a line is highlighted in the state the lines before it leave, without highlighting them
+// again each time. Lines are only highlighted up to the last one asked for, and an edit only
+// drops the states from the line it changed, so a change near the top of a long document costs
+// the lines down to the ones shown.
+type States struct {
+	hl     Stateful
+	states []State
+}
+
+// NewStates returns the states of a document highlighted by hl, or nil if hl is not Stateful.
+func NewStates(hl Highlighter) *States {
+	s, ok := hl.(Stateful)
+	if !ok {
+		return nil
+	}
+	return &States{hl: s, states: []State{""}}
+}
+
+// Invalidate drops the states of the lines after line n, after line n was changed.
+func (s *States) Invalidate(n int) {
+	if n+1 < len(s.states) {
+		s.states = s.states[:max(n+1, 1)]
+	}
+}
+
+// Highlight returns the spans of line n of the document, whose lines line returns, in the state
+// the lines before it leave.
+func (s *States) Highlight(n int, line func(n int) []rune) []Span {
+	for len(s.states) <= n {
+		i := len(s.states) - 1
+		_, next := s.hl.HighlightState(line(i), s.states[i])
+		s.states = append(s.states, next)
+	}
+	spans, _ := s.hl.HighlightState(line(n), s.states[n])
+	return spans
+}
+
+// Injection highlights the spans of Host in Scope, such as the strings of a language, with the
+// highlighter of the language embedded in them, or as Host does if there is none. Inject
+// returns that highlighter for the text of a span, and the part of it in that language, such
+// as the text between the quotes of a string, or nil. The rest of the span keeps Scope.
+type Injection struct {
+	Host   Highlighter
+	Scope  string
+	Inject func(text []rune) (hl Highlighter, start, end int)
+}
+
+// Highlight implements Highlighter.
+func (in Injection) Highlight(line []rune) []Span {
+	spans := in.Host.Highlight(line)
+	var out []Span
+	for _, s := range spans {
+		if s.Scope != in.Scope {
+			out = append(out, s)
+			continue
+		}
+		hl, start, end := in.Inject(line[s.Start:s.End])
+		if hl == nil {
+			out = append(out, s)
+			continue
+		}
+		out = append(out, embed(s, hl.Highlight(line[s.Start+start:s.Start+end]), s.Start+start)...)
+	}
+	return out
+}
+
+// Embedded highlights the regions of a line that Pattern matches, such as the actions of a
+// template, with Inner, and the text between them with Outer. Each region and each run of
+// text between them is highlighted on its own. Regions without a span of Inner take Scope.
+type Embedded struct {
+	Outer   Highlighter
+	Inner   Highlighter
+	Pattern *regexp.Regexp
+	Scope   string
+}
+
+// Highlight implements Highlighter.
+func (e Embedded) Highlight(line []rune) []Span {
+	var out []Span
+	pos := 0
+	outer := func(end int) {
+		if end > pos && e.Outer != nil {
+			for _, s := range e.Outer.Highlight(line[pos:end]) {
+				out = append(out, Span{Start: s.Start + pos, End: s.End + pos, Scope: s.Scope})
+			}
+		}
+	}
+	for _, m := range runeMatches(e.Pattern, line) {
+		outer(m[0])
+		region := Span{Start: m[0], End: m[1], Scope: e.Scope}
+		out = append(out, embed(region, e.Inner.Highlight(line[m[0]:m[1]]), m[0])...)
+		pos = m[1]
+	}
+	outer(len(line))
+	return out
+}
+
+// Fenced highlights Markdown and the like, whose fenced code blocks, opened by a line of ```
+// or ~~~ naming a language such as go, are highlighted as that language, by the highlighter
+// its file type has, the fence lines and blocks in languages with none taking Scope. Lines
+// outside blocks are highlighted by Text.
+type Fenced struct {
+	Text  Highlighter
+	Scope string
+}
+
+// Highlight implements Highlighter.
+func (f *Fenced) Highlight(line []rune) []Span {
+	spans, _ := f.HighlightState(line, "")
+	return spans
+}
+
+// HighlightState implements Stateful. Inside a block, the state holds the fence, the language
+// and the state of its highlighter, if it is Stateful too, separated by NULs.
+func (f *Fenced) HighlightState(line []rune, in State) ([]Span, State) {
+	trimmed := strings.TrimSpace(string(line))
+	var whole []Span
+	if len(line) > 0 {
+		whole = []Span{{Start: 0, End: len(line), Scope: f.Scope}}
+	}
+	if in == "" {
+		fence, lang, ok := openFence(trimmed)
+		if !ok {
+			return f.Text.Highlight(line), ""
+		}
+		return whole, State(fence + "\x00" + lang + "\x00")
+	}
+
+	fence, rest, _ := strings.Cut(string(in), "\x00")
+	lang, inner, _ := strings.Cut(rest, "\x00")
+	if strings.HasPrefix(trimmed, fence) && strings.Trim(trimmed, fence[:1]) == "" {
+		return whole, ""
+	}
+	hl := ForFileType(Language(lang))
+	var spans []Span
+	switch s := hl.(type) {
+	case nil:
+		return whole, in
+	case Stateful:
+		var next State
+		spans, next = s.HighlightState(line, State(inner))
+		inner = string(next)
+	default:
+		spans = s.Highlight(line)
+	}
+	return spans, State(fence + "\x00" + lang + "\x00" + inner)
+}
+
+// openFence reports whether line, without its indentation, opens a fenced code block, and
+// returns its fence and the language its info string names.
+func openFence(line string) (fence, lang string, ok bool) {
+	if !strings.HasPrefix(line, "```") && !strings.HasPrefix(line, "~~~") {
+		return "", "", false
+	}
+	n := len(line) - len(strings.TrimLeft(line, line[:1]))
+	info := strings.TrimSpace(line[n:])
+	if line[0] == '`' && strings.Contains(info, "`") {
+		return "", "", false
+	}
+	lang, _, _ = strings.Cut(info, " ")
+	return line[:n], strings.Trim(lang, "{}."), true
+}
+
+// languages maps the names code blocks give languages by, other than their file types, to the
+// file types.
+var languages = map[string]string{
+	"golang": "go",
+	"py":     "python",
+	"js":     "javascript",
+	"ts":     "typescript",
+	"rs":     "rust",
+	"bash":   "sh",
+	"shell":  "sh",
+	"yml":    "yaml",
+	"md":     "markdown",
+	"htm":    "html",
+	"c++":    "cpp",
+	"tmpl":   "gotemplate",
+}
+
+// Language returns the file type of the language name, as code blocks name languages, such as
+// go, py or JavaScript.
+func Language(name string) string {
+	name = strings.ToLower(name)
+	if ft, ok := languages[name]; ok {
+		return ft
+	}
+	return name
+}
+
+// embed returns the spans of outer with inner, the spans of a part of it starting at rune
+// offset, put in their places, and the rest of outer in its scope.
+func embed(outer Span, inner []Span, offset int) []Span {
+	var out []Span
+	pos := outer.Start
+	for _, s := range inner {
+		s.Start, s.End = s.Start+offset, s.End+offset
+		if s.Start > pos && outer.Scope != "" {
+			out = append(out, Span{Start: pos, End: s.Start, Scope: outer.Scope})
+		}
+		out = append(out, s)
+		pos = s.End
+	}
+	if pos < outer.End && outer.Scope != "" {
+		out = append(out, Span{Start: pos, End: outer.End, Scope: outer.Scope})
+	}
+	return out
+}
+
+// runeMatches returns the matches of re in line, as rune offsets.
+func runeMatches(re *regexp.Regexp, line []rune) [][2]int {
+	s := string(line)
+	var out [][2]int
+	pos, runes := 0, 0
+	for _, m := range re.FindAllStringIndex(s, -1) {
+		start := runes + len([]rune(s[pos:m[0]]))
+		end := start + len([]rune(s[m[0]:m[1]]))
+		out = append(out, [2]int{start, end})
+		pos, runes = m[1], end
+	}
+	return out
+}
@@ -0,0 +1,82 @@
+package syntax
+
+import (
+	"regexp"
+	"sync"
+)
+
+// markdownRules highlight the lines of Markdown outside fenced code blocks.
+var markdownRules = sync.OnceValue(func() Rules {
+	return Rules{
+		{regexp.MustCompile(`^#{1,6}\s.*$`), "markup.heading"},
+		{regexp.MustCompile(`^\s*>.*$`), "comment"},
+		{regexp.MustCompile("`[^`]+`"), "markup.code"},
+		{regexp.MustCompile(`!?\[[^\]]*\]\([^)]*\)`), "markup.link"},
+		{regexp.MustCompile(`\*\*[^*]+\*\*|__[^_]+__`), "markup.strong"},
+		{regexp.MustCompile(`\*[^*\s][^*]*\*|\b_[^_\s][^_]*_\b`), "markup.emphasis"},
+	}
+})
+
+// htmlRules highlight HTML. Text that looks like an attribute or a string outside a tag is
+// highlighted as one too, as tags are not told apart from the text around them.
+var htmlRules = sync.OnceValue(func() Rules {
+	return Rules{
+		{regexp.MustCompile(`<!--.*?(?:-->|$)`), "comment"},
+		{regexp.MustCompile(`</?[A-Za-z][\w:.-]*|/?>`), "tag"},
+		{regexp.MustCompile(`"[^"]*"|'[^']*'`), "string"},
+		{regexp.MustCompile(`\b[A-Za-z_:][\w:.-]*=`), "attribute"},
+		{regexp.MustCompile(`&(?:#\d+|#x[0-9a-fA-F]+|\w+);`), "constant"},
+	}
+})
+
+// templateRules highlight the actions of Go templates, with their delimiters.
+var templateRules = sync.OnceValue(func() Rules {
+	return Rules{
+		{regexp.MustCompile(`\{\{-?|-?\}\}`), "template"},
+		{regexp.MustCompile(`/\*.*?\*/`), "comment"},
+		{regexp.MustCompile("\"(\\\\.|[^\"\\\\])*\"|`[^`]*`"), "string"},
+		{regexp.MustCompile(`\b(if|else|end|range|with|define|template|block|break|continue|and|or|not|len|index|slice|print|printf|println|eq|ne|lt|le|gt|ge|call|html|js|urlquery)\b`), "keyword"},
+		{regexp.MustCompile(`\$\w*|\.\w[\w.]*`), "constant"},
+		{regexp.MustCompile(`\b(true|false|nil)\b`), "constant"},
+		{regexp.MustCompile(`\b\d+(\.\d+)?\b`), "number"},
+	}
+})
+
+// templateAction matches an action of a Go template, which runs to the end of the line if it
+// is not closed on it.
+var templateAction = sync.OnceValue(func() *regexp.Regexp {
+	return regexp.MustCompile(`\{\{.*?(?:\}\}|$)`)
+})
+
+// sqlRules highlight SQL.
+var sqlRules = sync.OnceValue(func() Rules {
+	return Rules{
+		{regexp.MustCompile(`--.*$`), "comment"},
+		{regexp.MustCompile(`/\*.*?(\*/|$)`), "comment"},
+		{regexp.MustCompile(`'(''|[^'])*'`), "string"},
+		{regexp.MustCompile(`(?i)\b(select|from|where|and|or|not|in|is|null|like|between|exists|insert|into|values|update|set|delete|create|alter|drop|table|index|view|unique|primary|foreign|key|references|default|join|inner|left|right|outer|full|cross|on|using|group|order|by|having|limit|offset|union|all|distinct|as|case|when|then|else|end|with|returning|asc|desc|begin|commit|rollback|truncate|merge|conflict|do|nothing)\b`), "keyword"},
+		{regexp.MustCompile(`(?i)\b(int|integer|bigint|smallint|serial|bigserial|real|float|double|numeric|decimal|boolean|bool|char|varchar|text|date|time|timestamp|timestamptz|interval|uuid|json|jsonb|blob|bytea)\b`), "type"},
+		{regexp.MustCompile(`(?i)\b(true|false)\b`), "constant"},
+		{regexp.MustCompile(`\$\d+|\?|:\w+|@\w+`), "constant"},
+		{regexp.MustCompile(`\b\d+(\.\d+)?\b`), "number"},
+	}
+})
+
+// sqlQuery matches the start of a string that holds an SQL statement: a statement keyword in
+// upper case, or in any case followed by the words that go with it.
+var sqlQuery = sync.OnceValue(func() *regexp.Regexp {
+	return regexp.MustCompile(`^\s*(?:(?:SELECT|INSERT|UPDATE|DELETE|WITH|CREATE|ALTER|DROP|TRUNCATE|MERGE)\b|(?i:(?:select|with)\s.*\bfrom\b|insert\s+into\b|update\s+\S+\s+set\b|delete\s+from\b|(?:create|alter|drop)\s+(?:table|index|view|unique)\b))`)
+})
+
+// sqlString returns the SQL highlighter and the text between the quotes of text, a Go string
+// literal, if it holds an SQL statement.
+func sqlString(text []rune) (Highlighter, int, int) {
+	start, end := 1, len(text)
+	if len(text) >= 2 && text[end-1] == text[0] {
+		end--
+	}
+	if end <= start || !sqlQuery().MatchString(string(text[start:end])) {
+		return nil, 0, 0
+	}
+	return sqlRules(), start, end
+}
@@ -0,0 +1,156 @@
+// Package syntax splits lines of text into highlighted spans.
+package syntax
+
+import (
+	"regexp"
+	"slices"
+	"sync"
+	"unicode/utf8"
+)
+
+// Span marks the runes Start..End of a line as belonging to Scope, such as "keyword" or
+// "string". Scopes are dotted names; more specific scopes ("comment.doc") fall back to their
+// parents when styled.
+type Span struct {
+	Start int
+	End   int
+	Scope string
+}
+
+// Highlighter computes the spans of a line. Spans are sorted and do not overlap.
+type Highlighter interface {
+	Highlight(line []rune) []Span
+}
+
+// Rule assigns Scope to the text matched by Pattern.
+type Rule struct {
+	Pattern *regexp.Regexp
+	Scope   string
+}
+
+// Rules is a Highlighter that applies regular expressions to each line on its own. At every
+// position the earliest match wins, ties going to the first rule. Constructs that span lines,
+// such as block comments, are only recognized on the lines where they start and end.
+type Rules []Rule
+
+// Highlight implements Highlighter.
+func (r Rules) Highlight(line []rune) []Span {
+	s := string(line)
+
+	type match struct {
+		start, end, rule int
+	}
+	var matches []match
+	for i, rule := range r {
+		for _, m := range rule.Pattern.FindAllStringIndex(s, -1) {
+			if m[1] > m[0] {
+				matches = append(matches, match{m[0], m[1], i})
+			}
+		}
+	}
+	slices.SortFunc(matches, func(a, b match) int {
+		if a.start != b.start {
+			return a.start - b.start
+		}
+		return a.rule - b.rule
+	})
+
+	var spans []Span
+	pos, runes := 0, 0
+	for _, m := range matches {
+		if m.start < pos {
+			continue
+		}
+
+		start := runes + utf8.RuneCountInString(s[pos:m.start])
+		end := start + utf8.RuneCountInString(s[m.start:m.end])
+		spans = append(spans, Span{Start: start, End: end, Scope: r[m.rule].Scope})
+		pos, runes = m.end, end
+	}
+	return spans
+}
+
+// goRules highlight Go.
+var goRules = sync.OnceValue(func() Rules {
+	return Rules{
+		{regexp.MustCompile(`//.*$`), "comment"},
+		{regexp.MustCompile(`/\*.*?(\*/|$)`), "comment"},
+		{regexp.MustCompile("\"(\\\\.|[^\"\\\\])*\"|`[^`]*`?"), "string"},
+		{regexp.MustCompile(`'(\\.|[^'\\])+'`), "string"},
+		{regexp.MustCompile(`\b(break|case|chan|const|continue|default|defer|else|fallthrough|for|func|go|goto|if|import|interface|map|package|range|return|select|struct|switch|type|var)\b`), "keyword"},
+		{regexp.MustCompile(`\b(true|false|nil|iota)\b`), "constant"},
+		{regexp.MustCompile(`\b(0[xX][0-9a-fA-F_]+|\d[\d_]*(\.\d+)?([eE][-+]?\d+)?)\b`), "number"},
+		{regexp.MustCompile(`\b(any|bool|byte|comparable|complex64|complex128|error|float32|float64|int|int8|int16|int32|int64|rune|string|uint|uint8|uint16|uint32|uint64|uintptr)\b`), "type"},
+	}
+})
+
+// diffHeaders highlight the headers of the files and hunks of unified diffs.
+var diffHeaders = sync.OnceValue(func() Rules {
+	return Rules{
+		{regexp.MustCompile(`^(diff --git|index |--- |\+\+\+ ).*$`), "diff.header"},
+		{regexp.MustCompile(`^@@.*?@@`), "diff.header"},
+	}
+})
+
+// fileTypes build the highlighter of each file type. The grammars are only built, and their
+// patterns compiled, when a file of their type is first highlighted, so the editor does not
+// compile them all before it can draw.
+var fileTypes = map[string]func() Highlighter{
+	// Strings holding SQL statements are highlighted as SQL.
+	"go": lazy(func() Highlighter {
+		return Injection{Host: goRules(), Scope: "string", Inject: sqlString}
+	}),
+	// Log levels in upper case, or as level=error, and ISO 8601 timestamps.
+	"log": lazy(func() Highlighter {
+		return Rules{
+			{regexp.MustCompile(`\b\d{4}-\d\d-\d\d[T ]\d\d:\d\d:\d\d(?:[.,]\d+)?(?:Z|[+-]\d\d:?\d\d)?`), "log.time"},
+			{regexp.MustCompile(`\b(?:FATAL|PANIC|CRIT(?:ICAL)?|ERROR|ERR)\b|\blevel=(?:fatal|panic|error)\b`), "log.error"},
+			{regexp.MustCompile(`\b(?:WARN(?:ING)?)\b|\blevel=warn(?:ing)?\b`), "log.warn"},
+			{regexp.MustCompile(`\b(?:INFO|NOTICE)\b|\blevel=info\b`), "log.info"},
+			{regexp.MustCompile(`\b(?:DEBUG|TRACE)\b|\blevel=(?:debug|trace)\b`), "log.debug"},
+		}
+	}),
+	// Verbose commit messages end with the diff being committed, after the comments. Lines
+	// starting with "- " are more likely bullets of the message than removed lines.
+	"gitcommit": lazy(func() Highlighter {
+		return slices.Concat(Rules{{regexp.MustCompile(`^#.*$`), "comment"}}, diffHeaders(), Rules{
+			{regexp.MustCompile(`^\+.*$`), "diff.added"},
+			{regexp.MustCompile(`^-($|[^ ]).*$`), "diff.removed"},
+		})
+	}),
+	"diff": lazy(func() Highlighter {
+		return slices.Concat(diffHeaders(), Rules{
+			{regexp.MustCompile(`^\+.*$`), "diff.added"},
+			{regexp.MustCompile(`^-.*$`), "diff.removed"},
+		})
+	}),
+	"markdown": lazy(func() Highlighter {
+		return &Fenced{Text: markdownRules(), Scope: "markup.code"}
+	}),
+	"html": lazy(func() Highlighter { return htmlRules() }),
+	"xml":  lazy(func() Highlighter { return htmlRules() }),
+	"gotemplate": lazy(func() Highlighter {
+		return Embedded{Outer: htmlRules(), Inner: templateRules(), Pattern: templateAction()}
+	}),
+	"sql": lazy(func() Highlighter { return sqlRules() }),
+}
+
+// lazy returns a function calling build the first time it is called and returning what it
+// built from then on.
+func lazy(build func() Highlighter) func() Highlighter {
+	return sync.OnceValue(build)
+}
+
+// ForFileType returns the highlighter for a file type, or nil if there is none, building it
+// if it is the first time it is asked for.
+func ForFileType(fileType string) Highlighter {
+	if build, ok := fileTypes[fileType]; ok {
+		return build()
+	}
+	return nil
+}
+
+// Register sets the highlighter for a file type.
+func Register(fileType string, h Highlighter) {
+	fileTypes[fileType] = func() Highlighter { return h }
+}
@@ -0,0 +1,108 @@
+package term
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrNoReply is returned when the terminal does not answer a query.
+var ErrNoReply = errors.New("term: no reply from the terminal")
+
+// BackgroundTimeout is how long Background waits for the terminal to answer.
+const BackgroundTimeout = 200 * time.Millisecond
+
+const (
+	// queryBackground asks for the background color, with OSC 11.
+	queryBackground = "\x1b]11;?\x1b\\"
+	// queryAttributes asks for the primary device attributes, which every terminal answers,
+	// so a terminal ignoring queryBackground is known not to support it as soon as this reply
+	// arrives.
+	queryAttributes = "\x1b[c"
+)
+
+// Background asks the terminal, which must be started, for its background color and returns
+// it as "#rrggbb". Keys typed while waiting for the reply are returned in pending, to be read
+// as input. Terminals that do not report their background give ErrNoReply.
+func (t *Terminal) Background() (color string, pending []byte, err error) {
+	if _, err := t.Out.WriteString(queryBackground + queryAttributes); err != nil {
+		return "", nil, err
+	}
+
+	var data []byte
+	deadline := time.Now().Add(BackgroundTimeout)
+	for {
+		wait := time.Until(deadline)
+		if wait <= 0 {
+			return "", data, ErrNoReply
+		}
+		chunk, err := readTimeout(t.In, wait)
+		if err != nil {
+			return "", data, err
+		}
+		if len(chunk) == 0 {
+			return "", data, ErrNoReply
+		}
+		data = append(data, chunk...)
+		if color, rest, done := parseReplies(data); done {
+			if color == "" {
+				return "", rest, ErrNoReply
+			}
+			return color, rest, nil
+		}
+	}
+}
+
+// parseReplies takes the replies to queryBackground and queryAttributes out of data, returning
+// the background color, if it was reported, what is left of data, and whether the reply to
+// queryAttributes, which comes last, arrived.
+func parseReplies(data []byte) (color string, rest []byte, done bool) {
+	rest = data
+	if i := bytes.Index(rest, []byte("\x1b]11;")); i >= 0 {
+		body := rest[i+len("\x1b]11;"):]
+		end, size := bytes.IndexByte(body, '\a'), 1
+		if st := bytes.Index(body, []byte("\x1b\\")); st >= 0 && (end < 0 || st < end) {
+			end, size = st, 2
+		}
+		if end < 0 {
+			return "", data, false
+		}
+		color = parseColor(string(body[:end]))
+		rest = append(rest[:i:i], body[end+size:]...)
+	}
+
+	i := bytes.Index(rest, []byte("\x1b[?"))
+	if i < 0 {
+		return color, rest, false
+	}
+	end := bytes.IndexByte(rest[i:], 'c')
+	if end < 0 {
+		return color, rest, false
+	}
+	return color, append(rest[:i:i], rest[i+end+1:]...), true
+}
+
+// parseColor turns an X11 color specification as terminals report it, "rgb:rrrr/gggg/bbbb"
+// with one to four hex digits each, into "#rrggbb". Returns "" for other forms.
+func parseColor(spec string) string {
+	rgb, ok := strings.CutPrefix(spec, "rgb:")
+	if !ok {
+		rgb, ok = strings.CutPrefix(spec, "rgba:")
+	}
+	parts := strings.Split(rgb, "/")
+	if !ok || len(parts) < 3 {
+		return ""
+	}
+	var c [3]uint64
+	for i, p := range parts[:3] {
+		n, err := strconv.ParseUint(p, 16, 16)
+		if err != nil || len(p) == 0 || len(p) > 4 {
+			return ""
+		}
+		c[i] = n * 255 / (1<<(4*len(p)) - 1)
+	}
+	return fmt.Sprintf("#%02x%02x%02x", c[0], c[1], c[2])
+}
@@ -0,0 +1,298 @@
+// Package term decodes terminal input and records it for replay.
+package term
+
+import (
+	"bytes"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+)
+
+const esc = 0x1b
+
+// EscTimeout is how long to wait after an escape for the rest of an escape sequence before
+// taking it as the Esc key.
+const EscTimeout = 25 * time.Millisecond
+
+// pasteStart and pasteEnd surround pasted text in bracketed paste mode.
+const (
+	pasteStart = "\x1b[200~"
+	pasteEnd   = "\x1b[201~"
+)
+
+// pastePrefix starts the key Keys returns for pasted text, followed by the text.
+const pastePrefix = "Paste:"
+
+// clickPrefix starts the key Keys returns for a click of the left mouse button, followed by the
+// column and row of the cell clicked, counted from 0, as in "Click:12,3".
+const clickPrefix = "Click:"
+
+// csiKeys maps the final byte of CSI and SS3 sequences to keys.
+var csiKeys = map[byte]string{
+	'A': "Up",
+	'B': "Down",
+	'C': "Right",
+	'D': "Left",
+	'H': "Home",
+	'F': "End",
+	'P': "F1",
+	'Q': "F2",
+	'R': "F3",
+	'S': "F4",
+	'Z': "Shift+Tab",
+}
+
+// tildeKeys maps the number of "CSI n ~" sequences to keys.
+var tildeKeys = map[int]string{
+	1: "Home", 2: "Insert", 3: "Delete", 4: "End", 5: "PageUp", 6: "PageDown", 7: "Home", 8: "End",
+	11: "F1", 12: "F2", 13: "F3", 14: "F4", 15: "F5", 17: "F6", 18: "F7", 19: "F8", 20: "F9",
+	21: "F10", 23: "F11", 24: "F12",
+}
+
+// Keys decodes terminal input into key chord names as used by command.Keymap: "Ctrl+Z",
+// "Alt+Up", "Enter", "Space" or the typed character itself, such as "a" or "é". Text pasted in
+// bracketed paste mode is one key, which Pasted tells apart, however long it is. Input ending
+// in an incomplete escape sequence or UTF-8 character is returned in rest, to be prepended to
+// the next read. If final is set, as when no more input arrived in time, a trailing escape is
+// taken as the Esc key instead. An incomplete character is kept even then, as input methods may
+// send the bytes of a character in separate writes, so no key is ever part of a character.
+func Keys(data []byte, final bool) (keys []string, rest []byte) {
+	for len(data) > 0 {
+		key, n := decode(data, final)
+		if n == 0 {
+			return keys, data
+		}
+		if key != "" {
+			keys = append(keys, key)
+		}
+		data = data[n:]
+	}
+	return keys, nil
+}
+
+// decode returns the first key of data and how many bytes it took. Returns 0 bytes if data
+// holds an incomplete key.
+func decode(data []byte, final bool) (string, int) {
+	c := data[0]
+	switch {
+	case c == esc:
+		if len(data) == 1 {
+			if final {
+				return "Esc", 1
+			}
+			return "", 0
+		}
+		if bytes.HasPrefix(data, []byte(pasteStart)) {
+			return decodePaste(data)
+		}
+		if bytes.HasPrefix(data, []byte("\x1b[<")) {
+			return decodeMouse(data, final)
+		}
+		if data[1] == '[' || data[1] == 'O' {
+			return decodeCSI(data, final)
+		}
+		key, n := decode(data[1:], final)
+		if n == 0 && final {
+			return "Esc", 1
+		}
+		if n == 0 || key == "" {
+			return key, n
+		}
+		return addAlt(key), n + 1
+	case c == '\r' || c == '\n':
+		return "Enter", 1
+	case c == '\t':
+		return "Tab", 1
+	case c == 0x7f || c == 0x08:
+		return "Backspace", 1
+	case c == 0:
+		return "Ctrl+Space", 1
+	case c < 0x1b:
+		return "Ctrl+" + string(rune('A'+c-1)), 1
+	case c < 0x20:
+		return "Ctrl+" + string(rune('\\'+c-0x1c)), 1
+	case c == ' ':
+		return "Space", 1
+	}
+
+	if !utf8.FullRune(data) {
+		return "", 0
+	}
+	r, n := utf8.DecodeRune(data)
+	if r == utf8.RuneError {
+		return "", n
+	}
+	return string(r), n
+}
+
+// Escaping reports whether rest, as returned by Keys, starts with an escape that may be the Esc
+// key, so that Keys must be called with final set once no more input arrives in time. A paste
+// waiting for its end is not.
+func Escaping(rest []byte) bool {
+	return len(rest) > 0 && rest[0] == esc && !bytes.HasPrefix(rest, []byte(pasteStart))
+}
+
+// Pasting reports whether rest, whose last added bytes just arrived, is a paste that still has
+// not ended, so decoding it again can wait until more arrives. Large pastes arrive in many
+// reads, and this saves searching all of them for the end after each one.
+func Pasting(rest []byte, added int) bool {
+	if !bytes.HasPrefix(rest, []byte(pasteStart)) {
+		return false
+	}
+	from := max(len(pasteStart), len(rest)-added-len(pasteEnd)+1)
+	return !bytes.Contains(rest[from:], []byte(pasteEnd))
+}
+
+// Pasted returns the text of key if it is a paste, as Keys returns it.
+func Pasted(key string) (string, bool) {
+	return strings.CutPrefix(key, pastePrefix)
+}
+
+// decodePaste decodes the paste at the start of data. Line breaks, which terminals send as
+// carriage returns, become line feeds, and invalid UTF-8 replacement characters. Returns 0
+// bytes until the end of the paste arrives.
+func decodePaste(data []byte) (string, int) {
+	end := bytes.Index(data[len(pasteStart):], []byte(pasteEnd))
+	if end < 0 {
+		return "", 0
+	}
+	text := string(data[len(pasteStart) : len(pasteStart)+end])
+	text = strings.ReplaceAll(strings.ReplaceAll(text, "\r\n", "\n"), "\r", "\n")
+	return pastePrefix + strings.ToValidUTF8(text, "\uFFFD"), len(pasteStart) + end + len(pasteEnd)
+}
+
+// Clicked returns the cell a click, as Keys returns it, was on.
+func Clicked(key string) (x, y int, ok bool) {
+	pos, ok := strings.CutPrefix(key, clickPrefix)
+	if !ok {
+		return 0, 0, false
+	}
+	col, row, ok := strings.Cut(pos, ",")
+	x, err1 := strconv.Atoi(col)
+	y, err2 := strconv.Atoi(row)
+	return x, y, ok && err1 == nil && err2 == nil
+}
+
+// decodeMouse decodes the SGR mouse report at the start of data, ESC [ < button ; x ; y and M
+// for a press or m for a release, counted from 1. Only presses of the left button are keys; the
+// other reports are dropped.
+func decodeMouse(data []byte, final bool) (string, int) {
+	i := 3
+	for i < len(data) && (data[i] >= '0' && data[i] <= '9' || data[i] == ';') {
+		i++
+	}
+	if i == len(data) {
+		if final {
+			return "", len(data)
+		}
+		return "", 0
+	}
+
+	params := strings.Split(string(data[3:i]), ";")
+	if data[i] != 'M' || len(params) != 3 || params[0] != "0" {
+		return "", i + 1
+	}
+	x, err1 := strconv.Atoi(params[1])
+	y, err2 := strconv.Atoi(params[2])
+	if err1 != nil || err2 != nil || x < 1 || y < 1 {
+		return "", i + 1
+	}
+	return clickPrefix + strconv.Itoa(x-1) + "," + strconv.Itoa(y-1), i + 1
+}
+
+// decodeCSI decodes the escape sequence at the start of data, of the form ESC [ params final
+// or ESC O final.
+func decodeCSI(data []byte, final bool) (string, int) {
+	i := 2
+	for i < len(data) && (data[i] >= '0' && data[i] <= '9' || data[i] == ';') {
+		i++
+	}
+	if i == len(data) {
+		if final {
+			return "", len(data)
+		}
+		return "", 0
+	}
+
+	params := strings.Split(string(data[2:i]), ";")
+	mods := 1
+	if len(params) > 1 {
+		if m, err := strconv.Atoi(params[1]); err == nil {
+			mods = m
+		}
+	}
+
+	var key string
+	switch {
+	case data[i] == 'u':
+		n, _ := strconv.Atoi(params[0])
+		key = codeKey(n, mods)
+	case data[i] == '~' && params[0] == "27" && len(params) == 3:
+		n, _ := strconv.Atoi(params[2])
+		key = codeKey(n, mods)
+	case data[i] == '~':
+		n, _ := strconv.Atoi(params[0])
+		key = tildeKeys[n]
+	default:
+		key = csiKeys[data[i]]
+	}
+	if key == "" {
+		return "", i + 1
+	}
+	return withModifiers(key, mods), i + 1
+}
+
+// codeKey names the key with code point n sent as "CSI n ; mods u" or "CSI 27 ; mods ; n ~",
+// as terminals do for chords such as Ctrl+Tab that have no byte of their own. Letters are upper
+// case with Ctrl, as for the chords sent as control bytes.
+func codeKey(n, mods int) string {
+	switch n {
+	case 9:
+		return "Tab"
+	case 13:
+		return "Enter"
+	case 27:
+		return "Esc"
+	case 32:
+		return "Space"
+	case 127:
+		return "Backspace"
+	}
+	if n < 0x21 || n > unicode.MaxRune {
+		return ""
+	}
+	if (mods-1)&4 != 0 {
+		return string(unicode.ToUpper(rune(n)))
+	}
+	return string(rune(n))
+}
+
+// withModifiers prefixes key with the modifiers in the xterm encoding mods: 1 plus 1 for Shift,
+// 2 for Alt and 4 for Ctrl.
+func withModifiers(key string, mods int) string {
+	mods--
+	var prefix string
+	if mods&4 != 0 {
+		prefix += "Ctrl+"
+	}
+	if mods&2 != 0 {
+		prefix += "Alt+"
+	}
+	if mods&1 != 0 && !strings.HasPrefix(key, "Shift+") {
+		prefix += "Shift+"
+	}
+	return prefix + key
+}
+
+// addAlt adds the Alt modifier to key, sent by terminals as an escape before the key.
+func addAlt(key string) string {
+	if strings.Contains(key, "Alt+") {
+		return key
+	}
+	if rest, ok := strings.CutPrefix(key, "Ctrl+"); ok {
+		return "Ctrl+Alt+" + rest
+	}
+	return "Alt+" + key
+}
@@ -0,0 +1,53 @@
+//go:build !(linux || darwin || freebsd || netbsd || openbsd || dragonfly || windows)
+
+package term
+
+import (
+	"errors"
+	"os"
+	"os/signal"
+	"time"
+)
+
+// ttyPath is the device of the controlling terminal, and ttyOutPath the same device, opened
+// for the screen when standard output is redirected.
+const (
+	ttyPath    = "/dev/tty"
+	ttyOutPath = "/dev/tty"
+)
+
+// errUnsupported is returned by the terminal mode functions on systems without termios.
+var errUnsupported = errors.New("term: not supported on this system")
+
+// State is a saved terminal mode.
+type State struct{}
+
+// MakeRaw puts the terminal open as f in raw mode. It is not supported on this system.
+func MakeRaw(f *os.File) (*State, error) {
+	return nil, errUnsupported
+}
+
+// Restore puts the terminal open as f back in the mode s. It is not supported on this system.
+func Restore(f *os.File, s *State) error {
+	return errUnsupported
+}
+
+// readTimeout reads what arrives on the terminal within timeout. It is not supported on this
+// system.
+func readTimeout(f *os.File, timeout time.Duration) ([]byte, error) {
+	return nil, errUnsupported
+}
+
+// stop stops the process. It is not supported on this system.
+func stop() error {
+	return errUnsupported
+}
+
+// NotifySuspend sends on c when another process asks the editor to stop. This system has no
+// job control, so nothing is ever sent.
+func NotifySuspend(c chan<- os.Signal) {}
+
+// NotifyTerminate sends on c when the editor is asked to quit.
+func NotifyTerminate(c chan<- os.Signal) {
+	signal.Notify(c, os.Interrupt)
+}
@@ -0,0 +1,101 @@
+//go:build linux || darwin || freebsd || netbsd || openbsd || dragonfly
+
+package term
+
+import (
+	"errors"
+	"io"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+	"unsafe"
+)
+
+// ttyPath is the device of the controlling terminal, and ttyOutPath the same device, opened
+// for the screen when standard output is redirected.
+const (
+	ttyPath    = "/dev/tty"
+	ttyOutPath = "/dev/tty"
+)
+
+// State is a saved terminal mode.
+type State struct {
+	termios syscall.Termios
+}
+
+// MakeRaw puts the terminal open as f in raw mode, so every key reaches the editor unprocessed,
+// and returns the previous mode.
+func MakeRaw(f *os.File) (*State, error) {
+	var s State
+	if err := ioctl(f, ioctlGetTermios, &s.termios); err != nil {
+		return nil, err
+	}
+
+	raw := s.termios
+	raw.Iflag &^= syscall.IGNBRK | syscall.BRKINT | syscall.PARMRK | syscall.ISTRIP | syscall.INLCR | syscall.IGNCR | syscall.ICRNL | syscall.IXON
+	raw.Oflag &^= syscall.OPOST
+	raw.Lflag &^= syscall.ECHO | syscall.ECHONL | syscall.ICANON | syscall.ISIG | syscall.IEXTEN
+	raw.Cflag &^= syscall.CSIZE | syscall.PARENB
+	raw.Cflag |= syscall.CS8
+	raw.Cc[syscall.VMIN] = 1
+	raw.Cc[syscall.VTIME] = 0
+	if err := ioctl(f, ioctlSetTermios, &raw); err != nil {
+		return nil, err
+	}
+	return &s, nil
+}
+
+// Restore puts the terminal open as f back in the mode s.
+func Restore(f *os.File, s *State) error {
+	return ioctl(f, ioctlSetTermios, &s.termios)
+}
+
+// readTimeout reads what arrives on the terminal open as f, which must be in raw mode, within
+// timeout, returning nothing if nothing does.
+func readTimeout(f *os.File, timeout time.Duration) ([]byte, error) {
+	var s syscall.Termios
+	if err := ioctl(f, ioctlGetTermios, &s); err != nil {
+		return nil, err
+	}
+	t := s
+	t.Cc[syscall.VMIN] = 0
+	t.Cc[syscall.VTIME] = uint8(min(max((timeout+time.Second/10-1)/(time.Second/10), 1), 255))
+	if err := ioctl(f, ioctlSetTermios, &t); err != nil {
+		return nil, err
+	}
+	defer ioctl(f, ioctlSetTermios, &s)
+
+	buf := make([]byte, 256)
+	n, err := f.Read(buf)
+	if err != nil && n == 0 && errors.Is(err, io.EOF) {
+		err = nil
+	}
+	return buf[:n], err
+}
+
+// stop stops the process group, as the shell does on Ctrl-Z, and returns once it is continued.
+// It sends SIGSTOP rather than SIGTSTP, which NotifySuspend may be catching.
+func stop() error {
+	return syscall.Kill(0, syscall.SIGSTOP)
+}
+
+// NotifySuspend sends on c when another process asks the editor to stop with SIGTSTP. In raw
+// mode Ctrl-Z reaches the editor as a key instead.
+func NotifySuspend(c chan<- os.Signal) {
+	signal.Notify(c, syscall.SIGTSTP)
+}
+
+// NotifyTerminate sends on c when the editor is asked to quit with SIGTERM or loses its terminal
+// with SIGHUP.
+func NotifyTerminate(c chan<- os.Signal) {
+	signal.Notify(c, syscall.SIGTERM, syscall.SIGHUP)
+}
+
+func ioctl(f *os.File, req uintptr, t *syscall.Termios) error {
+	_, _, errno := syscall.Syscall(syscall.SYS_IOCTL, f.Fd(), req, uintptr(unsafe.Pointer(t)))
+	if errno != 0 {
+		return errno
+	}
+	return nil
+}
@@ -0,0 +1,148 @@
+//go:build windows
+
+package term
+
+import (
+	"errors"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+	"unsafe"
+)
+
+// Console modes, as SetConsoleMode takes them.
+const (
+	enableProcessedInput       = 0x0001
+	enableLineInput            = 0x0002
+	enableEchoInput            = 0x0004
+	enableVirtualTerminalInput = 0x0200
+
+	enableProcessedOutput           = 0x0001
+	enableVirtualTerminalProcessing = 0x0004
+	disableNewlineAutoReturn        = 0x0008
+)
+
+// ttyPath is the console input, opened when standard input is redirected.
+const ttyPath = "CONIN$"
+
+// ttyOutPath is the console output, opened when standard output is redirected.
+const ttyOutPath = "CONOUT$"
+
+// utf8CodePage is the console code page for UTF-8.
+const utf8CodePage = 65001
+
+// waitTimeout is what WaitForSingleObject returns when the time runs out.
+const waitTimeout = 0x102
+
+var (
+	kernel32                       = syscall.NewLazyDLL("kernel32.dll")
+	procSetConsoleMode             = kernel32.NewProc("SetConsoleMode")
+	procGetConsoleCP               = kernel32.NewProc("GetConsoleCP")
+	procSetConsoleCP               = kernel32.NewProc("SetConsoleCP")
+	procGetConsoleOutputCP         = kernel32.NewProc("GetConsoleOutputCP")
+	procSetConsoleOutputCP         = kernel32.NewProc("SetConsoleOutputCP")
+	procGetConsoleScreenBufferInfo = kernel32.NewProc("GetConsoleScreenBufferInfo")
+)
+
+// errNoVT is returned by MakeRaw on consoles older than Windows 10, which do not understand the
+// escape sequences the editor draws with.
+var errNoVT = errors.New("term: the console does not support virtual terminal sequences")
+
+// State is a saved console mode: that of the input and of the output, and their code pages.
+type State struct {
+	in, out     uint32
+	inCP, outCP uintptr
+}
+
+// MakeRaw puts the console open as f in raw mode, and returns the previous mode. Keys then
+// arrive as the escape sequences a terminal sends, and the output, which is standard output,
+// interprets escape sequences. Both sides use UTF-8.
+func MakeRaw(f *os.File) (*State, error) {
+	in, out := syscall.Handle(f.Fd()), syscall.Stdout
+	var s State
+	if err := syscall.GetConsoleMode(in, &s.in); err != nil {
+		return nil, err
+	}
+	if err := syscall.GetConsoleMode(out, &s.out); err != nil {
+		return nil, err
+	}
+	s.inCP, _, _ = procGetConsoleCP.Call()
+	s.outCP, _, _ = procGetConsoleOutputCP.Call()
+
+	if err := setConsoleMode(out, s.out|enableProcessedOutput|enableVirtualTerminalProcessing|disableNewlineAutoReturn); err != nil {
+		return nil, errNoVT
+	}
+	raw := s.in&^(enableProcessedInput|enableLineInput|enableEchoInput) | enableVirtualTerminalInput
+	if err := setConsoleMode(in, raw); err != nil {
+		setConsoleMode(out, s.out)
+		return nil, errNoVT
+	}
+	procSetConsoleCP.Call(utf8CodePage)
+	procSetConsoleOutputCP.Call(utf8CodePage)
+	return &s, nil
+}
+
+// Restore puts the console open as f back in the mode s.
+func Restore(f *os.File, s *State) error {
+	procSetConsoleCP.Call(s.inCP)
+	procSetConsoleOutputCP.Call(s.outCP)
+	err := setConsoleMode(syscall.Stdout, s.out)
+	if err2 := setConsoleMode(syscall.Handle(f.Fd()), s.in); err == nil {
+		err = err2
+	}
+	return err
+}
+
+// readTimeout reads what arrives on the console open as f, which must be in raw mode, within
+// timeout, returning nothing if nothing does.
+func readTimeout(f *os.File, timeout time.Duration) ([]byte, error) {
+	ev, err := syscall.WaitForSingleObject(syscall.Handle(f.Fd()), uint32(timeout/time.Millisecond))
+	if err != nil {
+		return nil, err
+	}
+	if ev == waitTimeout {
+		return nil, nil
+	}
+	buf := make([]byte, 256)
+	n, err := f.Read(buf)
+	return buf[:n], err
+}
+
+// stop stops the process. Windows has no job control, so it is not supported.
+func stop() error {
+	return errors.New("term: suspending is not supported on Windows")
+}
+
+// NotifySuspend sends on c when another process asks the editor to stop. Windows has no job
+// control, so nothing is ever sent.
+func NotifySuspend(c chan<- os.Signal) {}
+
+// NotifyTerminate sends on c when the editor is asked to quit, as when its console window is
+// closed.
+func NotifyTerminate(c chan<- os.Signal) {
+	signal.Notify(c, os.Interrupt, syscall.SIGTERM)
+}
+
+func setConsoleMode(h syscall.Handle, mode uint32) error {
+	if r, _, err := procSetConsoleMode.Call(uintptr(h), uintptr(mode)); r == 0 {
+		return err
+	}
+	return nil
+}
+
+// screenBufferInfo is the CONSOLE_SCREEN_BUFFER_INFO of the console API.
+type screenBufferInfo struct {
+	size, cursor             struct{ X, Y int16 }
+	attributes               uint16
+	left, top, right, bottom int16
+	maximum                  struct{ X, Y int16 }
+}
+
+func getScreenBufferInfo(h syscall.Handle) (screenBufferInfo, error) {
+	var info screenBufferInfo
+	if r, _, err := procGetConsoleScreenBufferInfo.Call(uintptr(h), uintptr(unsafe.Pointer(&info))); r == 0 {
+		return info, err
+	}
+	return info, nil
+}
@@ -0,0 +1,68 @@
+package term
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+	"time"
+)
+
+// Chunk is one read of terminal input and when it arrived, relative to the start of the
+// recording.
+type Chunk struct {
+	At   time.Duration `json:"at"`
+	Data []byte        `json:"data"`
+}
+
+// Recorder passes terminal input through while writing every read to a recording, one JSON
+// encoded Chunk per line. Reads are kept apart since they tell a lone Esc from the start of an
+// escape sequence.
+type Recorder struct {
+	r     io.Reader
+	enc   *json.Encoder
+	start time.Time
+}
+
+// NewRecorder returns a Recorder reading from r and recording to w.
+func NewRecorder(r io.Reader, w io.Writer) *Recorder {
+	return &Recorder{r: r, enc: json.NewEncoder(w), start: time.Now()}
+}
+
+// Read implements io.Reader.
+func (r *Recorder) Read(p []byte) (int, error) {
+	n, err := r.r.Read(p)
+	if n > 0 {
+		if err := r.enc.Encode(Chunk{At: time.Since(r.start), Data: p[:n]}); err != nil {
+			return n, err
+		}
+	}
+	return n, err
+}
+
+// ReadRecording reads the chunks written by a Recorder.
+func ReadRecording(r io.Reader) ([]Chunk, error) {
+	var chunks []Chunk
+	sc := bufio.NewScanner(r)
+	sc.Buffer(nil, 1<<20)
+	for sc.Scan() {
+		var c Chunk
+		if err := json.Unmarshal(sc.Bytes(), &c); err != nil {
+			return nil, err
+		}
+		chunks = append(chunks, c)
+	}
+	return chunks, sc.Err()
+}
+
+// ReplayKeys decodes the keys typed in a recording. Input is taken as final when no more
+// arrived within EscTimeout, as when reading from the terminal.
+func ReplayKeys(chunks []Chunk) []string {
+	var keys, decoded []string
+	var rest []byte
+	for i, c := range chunks {
+		final := i == len(chunks)-1 || chunks[i+1].At-c.At > EscTimeout
+		decoded, rest = Keys(append(rest, c.Data...), final)
+		keys = append(keys, decoded...)
+	}
+	return keys
+}
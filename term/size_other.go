@@ -0,0 +1,30 @@
+//go:build !(linux || darwin || freebsd || netbsd || openbsd || dragonfly || windows)
+
+package term
+
+import (
+	"errors"
+	"os"
+	"strconv"
+)
+
+// Size returns the width and height, in cells, of the terminal open as f. Without a way to ask
+// the terminal, it uses the COLUMNS and LINES environment variables.
+func Size(f *os.File) (int, int, error) {
+	width, err1 := strconv.Atoi(os.Getenv("COLUMNS"))
+	height, err2 := strconv.Atoi(os.Getenv("LINES"))
+	if err1 != nil || err2 != nil {
+		return 0, 0, errors.New("term: unknown terminal size")
+	}
+	return width, height, nil
+}
+
+// CellSize returns the width and height, in pixels, of a cell of the terminal open as f. It is
+// not known on this system.
+func CellSize(f *os.File) (int, int, error) {
+	return 0, 0, errors.New("term: unknown cell size")
+}
+
+// NotifyResize sends on c whenever the terminal is resized. Resizes cannot be detected on this
+// system, so nothing is ever sent.
+func NotifyResize(c chan<- os.Signal) {}
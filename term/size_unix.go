@@ -0,0 +1,40 @@
+//go:build linux || darwin || freebsd || netbsd || openbsd || dragonfly
+
+package term
+
+import (
+	"errors"
+	"os"
+	"os/signal"
+	"syscall"
+	"unsafe"
+)
+
+// Size returns the width and height, in cells, of the terminal open as f.
+func Size(f *os.File) (int, int, error) {
+	var ws struct{ Row, Col, X, Y uint16 }
+	_, _, errno := syscall.Syscall(syscall.SYS_IOCTL, f.Fd(), uintptr(syscall.TIOCGWINSZ), uintptr(unsafe.Pointer(&ws)))
+	if errno != 0 {
+		return 0, 0, errno
+	}
+	return int(ws.Col), int(ws.Row), nil
+}
+
+// CellSize returns the width and height, in pixels, of a cell of the terminal open as f.
+// Terminals that do not report their size in pixels give an error.
+func CellSize(f *os.File) (int, int, error) {
+	var ws struct{ Row, Col, X, Y uint16 }
+	_, _, errno := syscall.Syscall(syscall.SYS_IOCTL, f.Fd(), uintptr(syscall.TIOCGWINSZ), uintptr(unsafe.Pointer(&ws)))
+	if errno != 0 {
+		return 0, 0, errno
+	}
+	if ws.X == 0 || ws.Y == 0 || ws.Col == 0 || ws.Row == 0 {
+		return 0, 0, errors.New("term: unknown cell size")
+	}
+	return int(ws.X / ws.Col), int(ws.Y / ws.Row), nil
+}
+
+// NotifyResize sends on c whenever the terminal is resized. signal.Stop(c) stops it.
+func NotifyResize(c chan<- os.Signal) {
+	signal.Notify(c, syscall.SIGWINCH)
+}
@@ -0,0 +1,53 @@
+//go:build windows
+
+package term
+
+import (
+	"errors"
+	"os"
+	"syscall"
+	"time"
+)
+
+// resizeInterval is how often NotifyResize checks the size of the console.
+const resizeInterval = 250 * time.Millisecond
+
+// Size returns the width and height, in cells, of the window of the console open as f.
+func Size(f *os.File) (int, int, error) {
+	info, err := getScreenBufferInfo(syscall.Handle(f.Fd()))
+	if err != nil {
+		return 0, 0, err
+	}
+	return int(info.right-info.left) + 1, int(info.bottom-info.top) + 1, nil
+}
+
+// CellSize returns the width and height, in pixels, of a cell of the console open as f. The
+// console does not report it.
+func CellSize(f *os.File) (int, int, error) {
+	return 0, 0, errors.New("term: unknown cell size")
+}
+
+// resized is sent by NotifyResize.
+type resized struct{}
+
+func (resized) Signal()        {}
+func (resized) String() string { return "window resized" }
+
+// NotifyResize sends on c whenever the console is resized. Windows has no signal for it, so the
+// size of standard output is checked every resizeInterval for as long as the editor runs.
+func NotifyResize(c chan<- os.Signal) {
+	go func() {
+		w, h, _ := Size(os.Stdout)
+		for range time.Tick(resizeInterval) {
+			nw, nh, err := Size(os.Stdout)
+			if err != nil || nw == w && nh == h {
+				continue
+			}
+			w, h = nw, nh
+			select {
+			case c <- resized{}:
+			default:
+			}
+		}
+	}()
+}
@@ -0,0 +1,106 @@
+package term
+
+import (
+	"os"
+)
+
+const (
+	enterAltScreen = "\x1b[?1049h\x1b[?2004h"
+	leaveAltScreen = "\x1b[?2004l\x1b[?1049l\x1b[?25h"
+
+	// mouseOn reports button presses in the SGR encoding, which has no limit on the screen
+	// size, and mouseOff stops it.
+	mouseOn  = "\x1b[?1000h\x1b[?1006h"
+	mouseOff = "\x1b[?1006l\x1b[?1000l"
+
+	// keysOn asks xterm and the terminals that follow it to send chords such as Ctrl+Tab and
+	// Ctrl+Shift+D, which have no bytes of their own, as CSI 27 sequences (modifyOtherKeys), and
+	// keysOff stops it. Other terminals ignore both.
+	keysOn  = "\x1b[>4;1m"
+	keysOff = "\x1b[>4m"
+)
+
+// Terminal is the terminal the editor runs in: raw mode on the alternate screen while started,
+// so the shell contents are back as they were once it stops, with bracketed paste on so pastes
+// can be told from typing and modified keys reported so chords such as Ctrl+Tab can be typed.
+type Terminal struct {
+	In  *os.File
+	Out *os.File
+
+	// Mouse turns mouse reporting on while started, so that clicks come as keys, see Clicked.
+	// The terminal then only selects text with Shift held.
+	Mouse bool
+
+	state *State
+}
+
+// Open returns the controlling terminal. Input comes from standard input and the screen goes
+// to standard output unless they are redirected, as with "cmd | goted - | cmd2", in which case
+// the terminal device, or the console on Windows, is opened in their place.
+func Open() (*Terminal, error) {
+	in := os.Stdin
+	if !isTerminal(in) {
+		var err error
+		if in, err = os.Open(ttyPath); err != nil {
+			return nil, err
+		}
+	}
+	out := os.Stdout
+	if !isTerminal(out) {
+		var err error
+		if out, err = os.OpenFile(ttyOutPath, os.O_WRONLY, 0); err != nil {
+			return nil, err
+		}
+	}
+	return &Terminal{In: in, Out: out}, nil
+}
+
+// isTerminal reports whether f is a terminal rather than a file or a pipe.
+func isTerminal(f *os.File) bool {
+	info, err := f.Stat()
+	return err == nil && info.Mode()&os.ModeCharDevice != 0
+}
+
+// Start enters raw mode and the alternate screen, and turns bracketed paste and modified key
+// reporting on, and mouse reporting if Mouse is set.
+func (t *Terminal) Start() error {
+	s, err := MakeRaw(t.In)
+	if err != nil {
+		return err
+	}
+	t.state = s
+	setup := enterAltScreen + keysOn
+	if t.Mouse {
+		setup += mouseOn
+	}
+	_, err = t.Out.WriteString(setup)
+	return err
+}
+
+// Stop leaves the alternate screen and restores the mode the terminal had before Start.
+func (t *Terminal) Stop() error {
+	if t.state == nil {
+		return nil
+	}
+	if t.Mouse {
+		t.Out.WriteString(mouseOff)
+	}
+	t.Out.WriteString(keysOff + leaveAltScreen)
+	err := Restore(t.In, t.state)
+	t.state = nil
+	return err
+}
+
+// Suspend gives the terminal back to the shell and stops the editor, as Ctrl-Z does in other
+// programs. Once the shell continues the editor, with fg, the terminal is set up again; the
+// caller must then redraw everything, as with screen.Screen.Invalidate.
+func (t *Terminal) Suspend() error {
+	if err := t.Stop(); err != nil {
+		return err
+	}
+	if err := stop(); err != nil {
+		t.Start()
+		return err
+	}
+	return t.Start()
+}
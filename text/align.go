@@ -0,0 +1,82 @@
+package text
+
+import (
+	"slices"
+	"strings"
+	"unicode/utf8"
+)
+
+// AlignLines pads the selected lines, or those of the paragraph around the cursor if there is no
+// selection, so that delim lines up vertically across them. Only the first delimiter of each line is aligned unless
+// all is set, in which case every field is aligned as a table column. Lines without the
+// delimiter are left alone. It is a single undo step.
+func (b *Buffer) AlignLines(delim string, all bool) error {
+	if delim == "" {
+		return nil
+	}
+	first, last := b.lineRange()
+	if b.anchor == nil {
+		if b.blank(first) {
+			return nil
+		}
+		first, last = b.paragraphStart(first), b.paragraphEnd(last)
+	}
+
+	return b.transformLines(first, last, func(lines [][]rune) [][]rune {
+		rows := make([][]string, len(lines))
+		var widths []int
+		for i, line := range lines {
+			s := string(line)
+			if !strings.Contains(s, delim) {
+				continue
+			}
+
+			var fields []string
+			if all {
+				fields = strings.Split(s, delim)
+			} else {
+				fields = strings.SplitN(s, delim, 2)
+			}
+			for j := range fields {
+				if j == 0 {
+					fields[j] = strings.TrimRight(fields[j], " \t")
+				} else {
+					fields[j] = strings.TrimSpace(fields[j])
+				}
+				if j == len(fields)-1 {
+					continue
+				}
+				if j == len(widths) {
+					widths = append(widths, 0)
+				}
+				widths[j] = max(widths[j], utf8.RuneCountInString(fields[j]))
+			}
+			rows[i] = fields
+		}
+
+		for i, fields := range rows {
+			if fields == nil {
+				continue
+			}
+
+			var sb strings.Builder
+			for j, field := range fields {
+				if j > 0 {
+					if widths[j-1] > 0 {
+						sb.WriteByte(' ')
+					}
+					sb.WriteString(delim)
+					if field != "" {
+						sb.WriteByte(' ')
+					}
+				}
+				sb.WriteString(field)
+				if j < len(fields)-1 {
+					sb.WriteString(strings.Repeat(" ", widths[j]-utf8.RuneCountInString(field)))
+				}
+			}
+			lines[i] = []rune(strings.TrimRight(sb.String(), " \t"))
+		}
+		return slices.Clip(lines)
+	})
+}
@@ -0,0 +1,82 @@
+package text
+
+import (
+	"slices"
+	"strings"
+	"unicode"
+)
+
+// callLookback is how many lines before the cursor CallAt reads to find the call it is in.
+const callLookback = 50
+
+// notCalls are the keywords that are followed by a parenthesis without calling anything.
+var notCalls = []string{"if", "for", "while", "switch", "return", "func", "function", "catch", "elif", "and", "or", "not", "in"}
+
+// Call is a function call around a position: the name called, with its qualifier as in
+// strings.Cut, the offset of its open parenthesis and the argument, counted from 0, the
+// position is in.
+type Call struct {
+	Name string
+	Open int
+	Arg  int
+}
+
+// CallAt returns the innermost call whose parentheses hold offset, closed or not yet, as while
+// the call is being typed. Arguments are split at the commas outside nested brackets and
+// strings. Returns false if offset is not inside the parentheses of a call.
+func (b *Buffer) CallAt(offset int) (Call, bool) {
+	offset = min(max(offset, 0), b.Len())
+	from := b.lineStart(max(b.lineAt(offset)-callLookback, 0))
+
+	type open struct {
+		bracket rune
+		at      int
+		commas  int
+	}
+	var stack []open
+	var quote rune
+	for i := from; i < offset; i++ {
+		r := b.chars.At(i)
+		switch {
+		case quote != 0:
+			if r == quote && b.chars.At(i-1) != '\\' || r == '\n' && quote != '`' {
+				quote = 0
+			}
+		case r == '"' || r == '\'' || r == '`':
+			quote = r
+		case strings.ContainsRune("([{", r):
+			stack = append(stack, open{bracket: r, at: i})
+		case strings.ContainsRune(")]}", r):
+			if len(stack) > 0 {
+				stack = stack[:len(stack)-1]
+			}
+		case r == ',' && len(stack) > 0:
+			stack[len(stack)-1].commas++
+		}
+	}
+
+	for i := len(stack) - 1; i >= 0; i-- {
+		if stack[i].bracket != '(' {
+			continue
+		}
+		name := b.calleeName(stack[i].at)
+		if name == "" || slices.Contains(notCalls, name) {
+			return Call{}, false
+		}
+		return Call{Name: name, Open: stack[i].at, Arg: stack[i].commas}, true
+	}
+	return Call{}, false
+}
+
+// calleeName returns the dotted name right before the parenthesis at open.
+func (b *Buffer) calleeName(open int) string {
+	start := open
+	for start > 0 && (isWord(b.chars.At(start-1)) || b.chars.At(start-1) == '.') {
+		start--
+	}
+	name := strings.Trim(string(b.Text(start, open)), ".")
+	if name == "" || !isWord([]rune(name)[0]) || unicode.IsDigit([]rune(name)[0]) {
+		return ""
+	}
+	return name
+}
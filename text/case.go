@@ -0,0 +1,207 @@
+package text
+
+import (
+	"encoding/base64"
+	"net/url"
+	"strings"
+	"unicode"
+)
+
+// TextTransform rewrites a run of text. It is the signature used by TransformText.
+type TextTransform func(text []rune) ([]rune, error)
+
+// ToUpper converts text to upper case.
+func ToUpper(text []rune) ([]rune, error) {
+	return mapRunes(text, unicode.ToUpper), nil
+}
+
+// ToLower converts text to lower case.
+func ToLower(text []rune) ([]rune, error) {
+	return mapRunes(text, unicode.ToLower), nil
+}
+
+// ToTitle upper cases the first letter of every word and lower cases the rest.
+func ToTitle(text []rune) ([]rune, error) {
+	out := make([]rune, len(text))
+	prev := ' '
+	for i, r := range text {
+		if isWord(prev) {
+			out[i] = unicode.ToLower(r)
+		} else {
+			out[i] = unicode.ToTitle(r)
+		}
+		prev = r
+	}
+	return out, nil
+}
+
+// ToSnake converts each line of text to snake_case.
+func ToSnake(text []rune) ([]rune, error) {
+	return eachLine(text, func(line []rune) []rune {
+		var out []rune
+		for i, word := range splitWords(line) {
+			if i > 0 {
+				out = append(out, '_')
+			}
+			out = append(out, mapRunes(word, unicode.ToLower)...)
+		}
+		return out
+	}), nil
+}
+
+// ToCamel converts each line of text to camelCase.
+func ToCamel(text []rune) ([]rune, error) {
+	return eachLine(text, func(line []rune) []rune {
+		var out []rune
+		for i, word := range splitWords(line) {
+			word = mapRunes(word, unicode.ToLower)
+			if i > 0 {
+				word[0] = unicode.ToTitle(word[0])
+			}
+			out = append(out, word...)
+		}
+		return out
+	}), nil
+}
+
+// eachLine returns text with fn applied to every line on its own, keeping the line breaks and
+// the indentation of the lines.
+func eachLine(text []rune, fn func(line []rune) []rune) []rune {
+	var out []rune
+	for i, line := range strings.Split(string(text), "\n") {
+		if i > 0 {
+			out = append(out, '\n')
+		}
+		body := strings.TrimRight(line, "\r")
+		indent := len(body) - len(strings.TrimLeft(body, " \t"))
+		out = append(out, []rune(body[:indent])...)
+		out = append(out, fn([]rune(body[indent:]))...)
+		out = append(out, []rune(line[len(body):])...)
+	}
+	return out
+}
+
+// ROT13 rotates ASCII letters by 13 places.
+func ROT13(text []rune) ([]rune, error) {
+	return mapRunes(text, func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z':
+			return 'a' + (r-'a'+13)%26
+		case r >= 'A' && r <= 'Z':
+			return 'A' + (r-'A'+13)%26
+		}
+		return r
+	}), nil
+}
+
+// URLEncode escapes text for use in a URL query.
+func URLEncode(text []rune) ([]rune, error) {
+	return []rune(url.QueryEscape(string(text))), nil
+}
+
+// URLDecode reverses URLEncode.
+func URLDecode(text []rune) ([]rune, error) {
+	s, err := url.QueryUnescape(string(text))
+	return []rune(s), err
+}
+
+// Base64Encode encodes the UTF-8 form of text as standard base64.
+func Base64Encode(text []rune) ([]rune, error) {
+	return []rune(base64.StdEncoding.EncodeToString([]byte(string(text)))), nil
+}
+
+// Base64Decode reverses Base64Encode.
+func Base64Decode(text []rune) ([]rune, error) {
+	data, err := base64.StdEncoding.DecodeString(string(text))
+	return []rune(string(data)), err
+}
+
+// TransformText replaces the selection, or the word under the cursor if there is no selection,
+// with the result of fn. It is a single undo step. The selection, if any, is kept over the new
+// text.
+func (b *Buffer) TransformText(fn TextTransform) error {
+	start, end, ok := b.Selection()
+	if !ok {
+		start, end = b.wordAt(b.chars.cursor)
+	}
+	if start == end {
+		return nil
+	}
+
+	text, err := fn(b.Text(start, end))
+	if err != nil {
+		return err
+	}
+
+	cursor, atStart := b.chars.cursor, b.chars.cursor == start && ok
+	if err := b.replace(start, end-start, text); err != nil {
+		return err
+	}
+
+	newEnd := start + len(text)
+	switch {
+	case atStart:
+		b.anchor.offset = newEnd
+		b.Seek(start)
+	case ok:
+		b.anchor.offset = start
+	default:
+		b.Seek(min(cursor, newEnd))
+	}
+	return nil
+}
+
+// wordAt returns the bounds of the word around offset. If offset is not on or right after a
+// word, the range is empty.
+func (b *Buffer) wordAt(offset int) (start, end int) {
+	start, end = offset, offset
+	for start > 0 && isWord(b.chars.At(start-1)) {
+		start--
+	}
+	for end < b.chars.Used() && isWord(b.chars.At(end)) {
+		end++
+	}
+	return start, end
+}
+
+func isWord(r rune) bool {
+	return r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+func mapRunes(text []rune, fn func(rune) rune) []rune {
+	out := make([]rune, len(text))
+	for i, r := range text {
+		out[i] = fn(r)
+	}
+	return out
+}
+
+// splitWords breaks an identifier or phrase into words at spaces, punctuation and case changes,
+// so that "HTTPServer_error" becomes "HTTP", "Server", "error".
+func splitWords(text []rune) [][]rune {
+	var words [][]rune
+	var word []rune
+	flush := func() {
+		if len(word) > 0 {
+			words = append(words, word)
+			word = nil
+		}
+	}
+
+	for i, r := range text {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			flush()
+			continue
+		}
+		if i > 0 && unicode.IsUpper(r) && len(word) > 0 {
+			prev := text[i-1]
+			nextLower := i+1 < len(text) && unicode.IsLower(text[i+1])
+			if !unicode.IsUpper(prev) || nextLower {
+				flush()
+			}
+		}
+		word = append(word, r)
+	}
+	flush()
+	return words
+}
@@ -0,0 +1,38 @@
+package text
+
+import "fmt"
+
+// ChurnThreshold is the average number of runes moved across the gaps per edit above which
+// Churn.High reports the buffer. An edit moves the runes of one block at most, however far it is
+// from the previous one, so the storage needs no switching to another backend when both ends of
+// a huge file are edited in turn; past half a block, edits keep landing away from the gap of
+// their block, or splitting full blocks.
+const ChurnThreshold = blockSize / 2
+
+// Churn counts the work done by the gap buffers of the blocks since the buffer was loaded: how
+// many edits were made and how many runes were moved across the gaps to bring them where the
+// edits happened.
+type Churn struct {
+	Edits int
+	Moved int
+}
+
+// PerEdit returns the average number of runes moved per edit.
+func (c Churn) PerEdit() int {
+	return c.Moved / max(c.Edits, 1)
+}
+
+// High reports whether edits move more than ChurnThreshold runes on average. A few edits are
+// needed before it does, so a single jump across the file does not count.
+func (c Churn) High() bool {
+	return c.Edits >= 16 && c.PerEdit() > ChurnThreshold
+}
+
+func (c Churn) String() string {
+	return fmt.Sprintf("%d runes moved in %d edits, %d per edit", c.Moved, c.Edits, c.PerEdit())
+}
+
+// Churn returns the churn of the blocks since the buffer was loaded.
+func (b *Buffer) Churn() Churn {
+	return Churn{Edits: b.edits, Moved: b.chars.moved}
+}
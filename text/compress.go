@@ -0,0 +1,100 @@
+package text
+
+import (
+	"bytes"
+	"compress/bzip2"
+	"compress/gzip"
+	"errors"
+	"io"
+)
+
+// ErrCompression is returned when loading or saving a buffer in a compression format that is not
+// supported in that direction.
+var ErrCompression = errors.New("text: unsupported compression")
+
+// Compression is the compression format of the file of a buffer.
+type Compression int
+
+const (
+	NoCompression Compression = iota
+	Gzip
+	Bzip2
+)
+
+func (c Compression) String() string {
+	switch c {
+	case Gzip:
+		return "gzip"
+	case Bzip2:
+		return "bzip2"
+	}
+	return ""
+}
+
+var (
+	magicGzip = []byte{0x1f, 0x8b}
+	magicBzip = []byte("BZh")
+	magicZstd = []byte{0x28, 0xb5, 0x2f, 0xfd}
+)
+
+// gzip extra flags telling the level the data was compressed with.
+const (
+	gzipBest    = 2
+	gzipFastest = 4
+)
+
+// decompress returns the data held in a gzip or bzip2 stream, its compression format and the
+// gzip level it was written with. Data that is not compressed is returned as is.
+func decompress(data []byte) ([]byte, Compression, int, error) {
+	switch {
+	case bytes.HasPrefix(data, magicGzip):
+		r, err := gzip.NewReader(bytes.NewReader(data))
+		if err != nil {
+			break
+		}
+		out, err := io.ReadAll(r)
+		if err != nil {
+			return nil, 0, 0, err
+		}
+
+		level := gzip.DefaultCompression
+		switch data[8] {
+		case gzipBest:
+			level = gzip.BestCompression
+		case gzipFastest:
+			level = gzip.BestSpeed
+		}
+		return out, Gzip, level, nil
+	case bytes.HasPrefix(data, magicBzip):
+		out, err := io.ReadAll(bzip2.NewReader(bytes.NewReader(data)))
+		if err != nil {
+			break
+		}
+		return out, Bzip2, 0, nil
+	case bytes.HasPrefix(data, magicZstd):
+		return nil, 0, 0, ErrCompression
+	}
+	return data, NoCompression, 0, nil
+}
+
+// compress returns data compressed in format c at level. Only gzip can be written.
+func compress(data []byte, c Compression, level int) ([]byte, error) {
+	switch c {
+	case NoCompression:
+		return data, nil
+	case Gzip:
+		var out bytes.Buffer
+		w, err := gzip.NewWriterLevel(&out, level)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := w.Write(data); err != nil {
+			return nil, err
+		}
+		if err := w.Close(); err != nil {
+			return nil, err
+		}
+		return out.Bytes(), nil
+	}
+	return nil, ErrCompression
+}
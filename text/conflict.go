@@ -0,0 +1,167 @@
+package text
+
+import (
+	"errors"
+	"strings"
+)
+
+var (
+	// ErrNoConflict is returned when resolving a conflict with the cursor outside of any.
+	ErrNoConflict = errors.New("text: no merge conflict at the cursor")
+
+	// ErrNoBase is returned when keeping the base of a conflict that does not show it.
+	ErrNoBase = errors.New("text: the conflict has no base")
+)
+
+// Conflict is a merge conflict left in the text by git or diff3, as the lines of its markers:
+// Start is the "<<<<<<<" line, Base the "|||||||" line of diff3 style conflicts or -1, Middle
+// the "=======" line and End the ">>>>>>>" line. Ours lies between Start and Base or Middle and
+// theirs between Middle and End.
+type Conflict struct {
+	Start  int
+	Base   int
+	Middle int
+	End    int
+}
+
+// Side is the part of a conflict kept when resolving it.
+type Side int
+
+const (
+	Ours Side = iota
+	Theirs
+	Both
+	Base
+)
+
+// conflicts caches the conflicts of a buffer at some version of it.
+type conflicts struct {
+	version int
+	list    []Conflict
+	ok      bool
+}
+
+// Conflicts returns the merge conflicts in the buffer, in order. Markers that do not form a
+// whole conflict are ignored. They are found again only after the buffer changed, so they can
+// be highlighted on every redraw.
+func (b *Buffer) Conflicts() []Conflict {
+	c := &b.conflicts
+	if c.ok && c.version == b.version {
+		return c.list
+	}
+
+	c.list, c.version, c.ok = nil, b.version, true
+	cur := Conflict{Start: -1}
+	for n, line := range strings.Split(string(b.Text(0, b.Len())), "\n") {
+		switch {
+		case marker(line, "<<<<<<<"):
+			cur = Conflict{Start: n, Base: -1, Middle: -1}
+		case cur.Start < 0:
+		case marker(line, "|||||||") && cur.Middle < 0:
+			cur.Base = n
+		case line == "=======" && cur.Middle < 0:
+			cur.Middle = n
+		case marker(line, ">>>>>>>") && cur.Middle >= 0:
+			cur.End = n
+			c.list = append(c.list, cur)
+			cur = Conflict{Start: -1}
+		}
+	}
+	return c.list
+}
+
+// marker reports whether line is the conflict marker m, alone or followed by a label.
+func marker(line, m string) bool {
+	rest, ok := strings.CutPrefix(line, m)
+	return ok && (rest == "" || rest[0] == ' ')
+}
+
+// ConflictAt returns the conflict holding line n.
+func (b *Buffer) ConflictAt(n int) (Conflict, bool) {
+	for _, c := range b.Conflicts() {
+		if c.Start <= n && n <= c.End {
+			return c, true
+		}
+	}
+	return Conflict{}, false
+}
+
+// NextConflict moves the cursor to the start of the first conflict below the cursor line, or
+// the last one above it if dir is negative. Returns false if there is none.
+func (b *Buffer) NextConflict(dir int) bool {
+	list := b.Conflicts()
+	n := b.Line()
+	if dir < 0 {
+		for i := len(list) - 1; i >= 0; i-- {
+			if list[i].Start < n {
+				b.GotoLine(list[i].Start, 0)
+				return true
+			}
+		}
+		return false
+	}
+	for _, c := range list {
+		if c.Start > n {
+			b.GotoLine(c.Start, 0)
+			return true
+		}
+	}
+	return false
+}
+
+// ResolveConflict replaces the conflict holding the cursor with the side kept: our lines, their
+// lines, ours followed by theirs, or the base lines of a diff3 style conflict. It is a single
+// undo step and leaves the cursor at the start of the kept text.
+func (b *Buffer) ResolveConflict(side Side) error {
+	c, ok := b.ConflictAt(b.Line())
+	if !ok {
+		return ErrNoConflict
+	}
+
+	oursEnd := c.Middle
+	if c.Base >= 0 {
+		oursEnd = c.Base
+	}
+	ours, theirs := [2]int{c.Start + 1, oursEnd}, [2]int{c.Middle + 1, c.End}
+	var ranges [][2]int
+	switch side {
+	case Ours:
+		ranges = [][2]int{ours}
+	case Theirs:
+		ranges = [][2]int{theirs}
+	case Both:
+		ranges = [][2]int{ours, theirs}
+	case Base:
+		if c.Base < 0 {
+			return ErrNoBase
+		}
+		ranges = [][2]int{{c.Base + 1, c.Middle}}
+	}
+
+	var kept []string
+	for _, r := range ranges {
+		for n := r[0]; n < r[1]; n++ {
+			line, _ := b.line(n)
+			kept = append(kept, string(line))
+		}
+	}
+
+	start, end := b.lineStart(c.Start), b.lineStart(c.End)+b.lines.Size(c.End)
+	repl := strings.Join(kept, "\n")
+	if len(kept) == 0 {
+		// Take the line break after the conflict too, or the one before it at the end of the
+		// buffer, so no empty line is left in its place.
+		if end < b.Len() {
+			end++
+		} else if start > 0 {
+			start--
+		}
+	}
+	return b.Transaction(func() error {
+		if err := b.replace(start, end-start, []rune(repl)); err != nil {
+			return err
+		}
+		b.Seek(min(start, b.Len()))
+		return nil
+	})
+}
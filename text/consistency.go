@@ -0,0 +1,64 @@
+package text
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrInconsistent is returned by ValidateConsistency when the line table does not match the
+// text, which means some edit forgot to update it.
+var ErrInconsistent = errors.New("text: line table does not match text")
+
+// ValidateConsistency checks the line table against the text: the number of lines, the size of
+// each and the line of the cursor. It reads the whole buffer, so it is meant for debugging.
+func (b *Buffer) ValidateConsistency() error {
+	sizes, current := b.lineSizes()
+	if n := b.lines.Count(); n != len(sizes) {
+		return fmt.Errorf("%w: %d lines, table says %d", ErrInconsistent, len(sizes), n)
+	}
+	for n, size := range sizes {
+		if got := b.lines.Size(n); got != size {
+			return fmt.Errorf("%w: line %d has %d runes, table says %d", ErrInconsistent, n+1, size, got)
+		}
+	}
+	if got := b.lines.Current(); got != current {
+		return fmt.Errorf("%w: cursor is on line %d, table says %d", ErrInconsistent, current+1, got+1)
+	}
+	return nil
+}
+
+// Repair rebuilds the line table from the text if ValidateConsistency finds it wrong, returning
+// the error found, or nil if the table was right.
+func (b *Buffer) Repair() error {
+	err := b.ValidateConsistency()
+	if err != nil {
+		b.rebuildLines()
+	}
+	return err
+}
+
+// rebuildLines replaces the line table with one computed from the text.
+func (b *Buffer) rebuildLines() {
+	sizes, current := b.lineSizes()
+	b.lines.reset(sizes, current)
+}
+
+// lineSizes returns the size of every line of the text and the line the cursor is on.
+func (b *Buffer) lineSizes() (sizes []int, current int) {
+	size := 0
+	for i, r := range b.chars.text() {
+		if i == b.chars.cursor {
+			current = len(sizes)
+		}
+		if r == '\n' {
+			sizes = append(sizes, size)
+			size = 0
+		} else {
+			size++
+		}
+	}
+	if b.chars.cursor == b.chars.Used() {
+		current = len(sizes)
+	}
+	return append(sizes, size), current
+}
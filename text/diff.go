@@ -0,0 +1,252 @@
+package text
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// Hunk is a run of lines that differ between two texts: lines A to AEnd of the old text were
+// replaced by lines B to BEnd of the new one. Either run may be empty.
+type Hunk struct {
+	A, AEnd int
+	B, BEnd int
+}
+
+// DiffLines returns the hunks turning the lines a into the lines b, using the shortest edit
+// script found by Myers' algorithm.
+func DiffLines(a, b []string) []Hunk {
+	n, m := len(a), len(b)
+	max := n + m
+	v := make([]int, 2*max+2)
+	var trace [][]int
+
+	var d int
+search:
+	for d = 0; d <= max; d++ {
+		trace = append(trace, append([]int(nil), v...))
+		for k := -d; k <= d; k += 2 {
+			var x int
+			if k == -d || k != d && v[max+k-1] < v[max+k+1] {
+				x = v[max+k+1]
+			} else {
+				x = v[max+k-1] + 1
+			}
+			y := x - k
+			for x < n && y < m && a[x] == b[y] {
+				x, y = x+1, y+1
+			}
+			v[max+k] = x
+			if x >= n && y >= m {
+				break search
+			}
+		}
+	}
+
+	// Walk back through the trace, collecting the matching lines.
+	type step struct{ x, y int }
+	var matches []step
+	x, y := n, m
+	for ; d > 0; d-- {
+		v := trace[d]
+		k := x - y
+		var prev int
+		if k == -d || k != d && v[max+k-1] < v[max+k+1] {
+			prev = k + 1
+		} else {
+			prev = k - 1
+		}
+		px := v[max+prev]
+		py := px - prev
+		for x > px && y > py {
+			matches = append(matches, step{x - 1, y - 1})
+			x, y = x-1, y-1
+		}
+		x, y = px, py
+	}
+	for x > 0 && y > 0 {
+		matches = append(matches, step{x - 1, y - 1})
+		x, y = x-1, y-1
+	}
+
+	var hunks []Hunk
+	ax, by := 0, 0
+	for i := len(matches) - 1; i >= 0; i-- {
+		s := matches[i]
+		if s.x > ax || s.y > by {
+			hunks = append(hunks, Hunk{ax, s.x, by, s.y})
+		}
+		ax, by = s.x+1, s.y+1
+	}
+	if ax < n || by < m {
+		hunks = append(hunks, Hunk{ax, n, by, m})
+	}
+	return hunks
+}
+
+// UnifiedDiff returns the lines a, of the file named from, turned into the lines b, of the
+// file named to, as a unified diff with context lines of context around each change. Lines do
+// not end in line breaks. It is empty if a and b are the same.
+func UnifiedDiff(from, to string, a, b []string, context int) string {
+	hunks := DiffLines(a, b)
+	if len(hunks) == 0 {
+		return ""
+	}
+	var s strings.Builder
+	fmt.Fprintf(&s, "--- %s\n+++ %s\n", from, to)
+	for i := 0; i < len(hunks); {
+		j := i + 1
+		for j < len(hunks) && hunks[j].A-hunks[j-1].AEnd <= 2*context {
+			j++
+		}
+		first, last := hunks[i], hunks[j-1]
+		aStart := max(first.A-context, 0)
+		bStart := first.B - (first.A - aStart)
+		aEnd := min(last.AEnd+context, len(a))
+		bEnd := last.BEnd + (aEnd - last.AEnd)
+		fmt.Fprintf(&s, "@@ -%s +%s @@\n", hunkRange(aStart, aEnd), hunkRange(bStart, bEnd))
+		x := aStart
+		for _, h := range hunks[i:j] {
+			for ; x < h.A; x++ {
+				s.WriteString(" " + a[x] + "\n")
+			}
+			for _, l := range a[h.A:h.AEnd] {
+				s.WriteString("-" + l + "\n")
+			}
+			for _, l := range b[h.B:h.BEnd] {
+				s.WriteString("+" + l + "\n")
+			}
+			x = h.AEnd
+		}
+		for ; x < aEnd; x++ {
+			s.WriteString(" " + a[x] + "\n")
+		}
+		i = j
+	}
+	return s.String()
+}
+
+// hunkRange returns the lines start to end of a hunk as a unified diff names them: the first,
+// counting from 1, and how many there are, or the line before for an empty range.
+func hunkRange(start, end int) string {
+	if start == end {
+		return fmt.Sprintf("%d,0", start)
+	}
+	return fmt.Sprintf("%d,%d", start+1, end-start)
+}
+
+// LineEdits returns the edits turning the text old into the text new, one for each hunk of
+// changed lines, so that applying them leaves the unchanged lines and a cursor on them alone.
+func LineEdits(old, new []rune) []TextEdit {
+	a, aStarts := splitLines(old)
+	b, bStarts := splitLines(new)
+
+	var edits []TextEdit
+	for _, h := range DiffLines(a, b) {
+		edits = append(edits, TextEdit{
+			Start: aStarts[h.A],
+			End:   aStarts[h.AEnd],
+			Text:  new[bStarts[h.B]:bStarts[h.BEnd]],
+		})
+	}
+	return edits
+}
+
+// DiffWords returns the runs of runes that differ between the lines a and b: those removed
+// from a and those added in b. The lines are split into words, runs of blanks and single other
+// runes, which are compared as DiffLines compares lines. Lines with less than half of their
+// words in common are not taken for an edit of one another, and give no runs.
+func DiffWords(a, b []rune) (removed, added []Range) {
+	wa, aStarts := splitTokens(a)
+	wb, bStarts := splitTokens(b)
+	hunks := DiffLines(wa, wb)
+
+	kept := make([]bool, len(wa))
+	for i := range kept {
+		kept[i] = true
+	}
+	for _, h := range hunks {
+		for i := h.A; i < h.AEnd; i++ {
+			kept[i] = false
+		}
+	}
+	common, words := 0, 0
+	for i, w := range wa {
+		if !isBlank(rune(w[0])) {
+			words++
+			if kept[i] {
+				common++
+			}
+		}
+	}
+	for _, w := range wb {
+		if !isBlank(rune(w[0])) {
+			words++
+		}
+	}
+	if 4*common < words {
+		return nil, nil
+	}
+
+	for _, h := range hunks {
+		if h.A < h.AEnd {
+			removed = append(removed, Range{aStarts[h.A], aStarts[h.AEnd]})
+		}
+		if h.B < h.BEnd {
+			added = append(added, Range{bStarts[h.B], bStarts[h.BEnd]})
+		}
+	}
+	return removed, added
+}
+
+// splitTokens splits line into words of letters, digits and underscores, runs of blanks and
+// single other runes, with the offset each starts at and a last entry for the end of the line.
+func splitTokens(line []rune) ([]string, []int) {
+	var words []string
+	starts := []int{0}
+	for start := 0; start < len(line); {
+		end := start + 1
+		switch kind := wordKind(line[start]); kind {
+		case 'w', 's':
+			for end < len(line) && wordKind(line[end]) == kind {
+				end++
+			}
+		}
+		words = append(words, string(line[start:end]))
+		starts = append(starts, end)
+		start = end
+	}
+	return words, starts
+}
+
+// wordKind tells what r is part of for splitTokens: 'w' for a word, 's' for blanks and 'p'
+// for other runes.
+func wordKind(r rune) byte {
+	switch {
+	case r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r):
+		return 'w'
+	case isBlank(r):
+		return 's'
+	}
+	return 'p'
+}
+
+// splitLines splits text into lines, keeping their line breaks, with the offset each starts at
+// and a last entry for the end of the text.
+func splitLines(text []rune) ([]string, []int) {
+	var lines []string
+	starts := []int{0}
+	for start := 0; start < len(text); {
+		end := start
+		for end < len(text) && text[end] != '\n' {
+			end++
+		}
+		if end < len(text) {
+			end++
+		}
+		lines = append(lines, string(text[start:end]))
+		starts = append(starts, end)
+		start = end
+	}
+	return lines, starts
+}
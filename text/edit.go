@@ -0,0 +1,214 @@
+package text
+
+import "unicode"
+
+// Change describes a single edit applied to the buffer: Removed was replaced by Inserted
+// starting at rune Offset.
+type Change struct {
+	Offset   int
+	Removed  []rune
+	Inserted []rune
+}
+
+// OnChange registers fn to be called after every edit to the buffer.
+func (b *Buffer) OnChange(fn func(Change)) {
+	b.listeners = append(b.listeners, fn)
+}
+
+// Len returns the number of runes in the buffer.
+func (b *Buffer) Len() int {
+	return b.chars.Used()
+}
+
+// Text returns a copy of the runes between offsets start and end, clamped to the buffer. Only
+// that range is copied.
+func (b *Buffer) Text(start, end int) []rune {
+	start = min(max(start, 0), b.chars.Used())
+	end = min(max(end, start), b.chars.Used())
+	return b.chars.slice(start, end)
+}
+
+// String returns the whole text of the buffer, for tests and small buffers.
+func (b *Buffer) String() string {
+	return string(b.chars.text())
+}
+
+// Lines returns the number of lines in the buffer.
+func (b *Buffer) Lines() int {
+	return b.lines.Count()
+}
+
+// Cursor returns the rune offset of the cursor.
+func (b *Buffer) Cursor() int {
+	return b.chars.cursor
+}
+
+// Line returns the line number of the cursor.
+func (b *Buffer) Line() int {
+	return b.lines.Current()
+}
+
+// Column returns the rune column of the cursor in the current line.
+func (b *Buffer) Column() int {
+	col := 0
+	for i := b.chars.cursor - 1; i >= 0 && b.chars.At(i) != '\n'; i-- {
+		col++
+	}
+	return col
+}
+
+// Seek moves the cursor to offset, clamped to the buffer contents.
+func (b *Buffer) Seek(offset int) {
+	offset = min(max(offset, 0), b.chars.Used())
+
+	if cursor := b.chars.cursor; offset > cursor {
+		b.lines.Down(b.chars.newlines(cursor, offset))
+		b.chars.Next(offset - cursor)
+	} else {
+		b.lines.Up(b.chars.newlines(offset, cursor))
+		b.chars.Prev(cursor - offset)
+	}
+}
+
+// Insert adds text at the cursor and moves the cursor past it.
+func (b *Buffer) Insert(text []rune) error {
+	return b.replace(b.chars.cursor, 0, text)
+}
+
+// Delete removes count runes after the cursor.
+func (b *Buffer) Delete(count int) error {
+	return b.replace(b.chars.cursor, count, nil)
+}
+
+// Replace replaces the runes between start and end with text, leaving the cursor after it.
+func (b *Buffer) Replace(start, end int, text []rune) error {
+	return b.replace(start, end-start, text)
+}
+
+// Append adds text at the end of the buffer, even if it is read-only, as when the file it shows
+// grows. The cursor stays where it is and the buffer is left as modified as it was, since it
+// still holds what the file does. Read-only buffers keep no undo history of it.
+func (b *Buffer) Append(text []rune) error {
+	cursor := b.chars.cursor
+	readOnly, modified := b.readOnly, b.modified
+	b.readOnly = false
+	err := b.replace(b.chars.Used(), 0, text)
+	b.readOnly, b.modified = readOnly, modified
+	if readOnly {
+		b.history = history{}
+	}
+	b.Seek(cursor)
+	return err
+}
+
+// SplitLine breaks the current line at the cursor, as pressing Enter does. With indent, the new
+// line starts with the leading whitespace of the current one. The cursor ends up on the new line,
+// after any indentation.
+func (b *Buffer) SplitLine(indent bool) error {
+	text := []rune{'\n'}
+	if indent {
+		line, _ := b.line(b.Line())
+		text = append(text, leadingSpace(line[:b.Column()])...)
+	}
+	return b.Insert(text)
+}
+
+// JoinLines merges count lines, starting at the current one, into a single line. With trim, the
+// leading whitespace of each joined line is replaced by a single space, unless the line being
+// joined is empty or the previous one already ends in whitespace. The cursor ends up at the last
+// join point. Returns how many line breaks were removed.
+func (b *Buffer) JoinLines(count int, trim bool) (int, error) {
+	b.Begin()
+	defer b.End()
+
+	joined := 0
+	for ; joined < count-1 && b.Line() < b.lines.Count()-1; joined++ {
+		cur, _ := b.line(b.Line())
+		next, _ := b.line(b.Line() + 1)
+
+		end := b.chars.cursor - b.Column() + len(cur)
+		removed := 1
+		var sep []rune
+		if trim {
+			indent := leadingSpace(next)
+			removed += len(indent)
+			if len(next) > len(indent) && (len(cur) == 0 || !unicode.IsSpace(cur[len(cur)-1])) {
+				sep = []rune{' '}
+			}
+		}
+
+		if err := b.replace(end, removed, sep); err != nil {
+			return joined, err
+		}
+		b.Seek(end)
+	}
+	return joined, nil
+}
+
+// replace removes count runes at offset and inserts text in their place, keeping the line table
+// in sync and notifying listeners. The cursor ends up after the inserted text.
+func (b *Buffer) replace(offset, count int, text []rune) error {
+	if b.readOnly {
+		return ErrReadOnly
+	}
+	offset = min(max(offset, 0), b.chars.Used())
+	count = min(max(count, 0), b.chars.Used()-offset)
+
+	breaks := 0
+	for _, r := range text {
+		if r == '\n' {
+			breaks++
+		}
+	}
+	if b.chars.Used()-count+len(text) > b.chars.Capacity() || b.lines.Count()+breaks > b.lines.Capacity() {
+		return ErrFull
+	}
+
+	b.Seek(offset)
+	removed := make([]rune, 0, count)
+	for range count {
+		r, _ := b.chars.Peek()
+		b.chars.Delete()
+		if r == '\n' {
+			b.lines.Join()
+		} else {
+			b.lines.Dec()
+		}
+		removed = append(removed, r)
+	}
+
+	col := b.Column()
+	for _, r := range text {
+		b.chars.Put(r)
+		if r == '\n' {
+			b.lines.SplitAt(col)
+			col = 0
+		} else {
+			b.lines.Inc()
+			col++
+		}
+	}
+
+	change := Change{Offset: offset, Removed: removed, Inserted: append([]rune(nil), text...)}
+	b.modified = true
+	b.edits++
+	b.version++
+	b.lines.Unstick()
+	for _, m := range b.marks {
+		m.adjust(change)
+	}
+	b.history.record(change)
+	for _, fn := range b.listeners {
+		fn(change)
+	}
+	return nil
+}
+
+// leadingSpace returns the whitespace prefix of line.
+func leadingSpace(line []rune) []rune {
+	i := 0
+	for i < len(line) && (line[i] == ' ' || line[i] == '\t') {
+		i++
+	}
+	return line[:i]
+}
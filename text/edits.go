@@ -0,0 +1,91 @@
+package text
+
+import (
+	"errors"
+	"slices"
+)
+
+var (
+	// ErrOverlap is returned by ApplyEdits when two edits touch the same text.
+	ErrOverlap = errors.New("text: overlapping edits")
+
+	// ErrRange is returned by ApplyEdits when an edit falls outside the buffer.
+	ErrRange = errors.New("text: edit out of range")
+)
+
+// TextEdit replaces the runes between Start and End with Text.
+type TextEdit struct {
+	Start int
+	End   int
+	Text  []rune
+}
+
+// ApplyEdits applies a set of non-overlapping edits, all expressed as offsets into the buffer
+// as it is before any of them is applied, as LSP workspace edits and formatters produce them.
+// Edits may be given in any order; insertions at the same offset are applied in the order
+// given. Either all edits are applied, as a single undo step, or none is. The cursor stays on
+// the same text.
+func (b *Buffer) ApplyEdits(edits []TextEdit) error {
+	sorted, err := b.sortEdits(edits)
+	if err != nil {
+		return err
+	}
+
+	cursor := b.chars.cursor
+	err = b.Transaction(func() error {
+		for i := len(sorted) - 1; i >= 0; i-- {
+			e := sorted[i]
+			if err := b.replace(e.Start, e.End-e.Start, e.Text); err != nil {
+				return err
+			}
+
+			switch {
+			case e.End <= cursor:
+				cursor += len(e.Text) - (e.End - e.Start)
+			case e.Start < cursor:
+				cursor = e.Start + len(e.Text)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	b.Seek(cursor)
+	return nil
+}
+
+// CheckEdits reports whether ApplyEdits would accept edits, without applying them, so edits
+// spanning several buffers can be checked before any is applied.
+func (b *Buffer) CheckEdits(edits []TextEdit) error {
+	if b.readOnly {
+		return ErrReadOnly
+	}
+	_, err := b.sortEdits(edits)
+	return err
+}
+
+// sortEdits returns edits sorted by offset, failing if they overlap or fall outside the buffer.
+func (b *Buffer) sortEdits(edits []TextEdit) ([]TextEdit, error) {
+	sorted := slices.Clone(edits)
+	slices.SortStableFunc(sorted, func(x, y TextEdit) int {
+		return x.Start - y.Start
+	})
+
+	for i, e := range sorted {
+		if e.Start < 0 || e.End < e.Start || e.End > b.chars.Used() {
+			return nil, ErrRange
+		}
+		if i > 0 && e.Start < sorted[i-1].End {
+			return nil, ErrOverlap
+		}
+	}
+	return sorted, nil
+}
+
+// Offset returns the rune offset of column col on line n, both clamped to the buffer contents.
+func (b *Buffer) Offset(n, col int) int {
+	n = min(max(n, 0), b.lines.Count()-1)
+	return b.lineStart(n) + min(max(col, 0), b.lines.Size(n))
+}
@@ -0,0 +1,185 @@
+package text
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"strings"
+	"unicode/utf16"
+	"unicode/utf8"
+)
+
+// LineEnding is how lines are terminated in the file of a buffer. The buffer itself always
+// separates lines with a single '\n'.
+type LineEnding int
+
+const (
+	LF LineEnding = iota
+	CRLF
+)
+
+func (le LineEnding) String() string {
+	if le == CRLF {
+		return "crlf"
+	}
+	return "lf"
+}
+
+// Encoding is the character encoding of the file of a buffer.
+type Encoding string
+
+const (
+	UTF8    Encoding = "utf-8"
+	UTF8BOM Encoding = "utf-8-bom"
+	UTF16LE Encoding = "utf-16le"
+	UTF16BE Encoding = "utf-16be"
+	Latin1  Encoding = "latin1"
+)
+
+var (
+	bomUTF8    = []byte{0xef, 0xbb, 0xbf}
+	bomUTF16LE = []byte{0xff, 0xfe}
+	bomUTF16BE = []byte{0xfe, 0xff}
+)
+
+// Encodings lists the supported encodings.
+var Encodings = []Encoding{UTF8, UTF8BOM, UTF16LE, UTF16BE, Latin1}
+
+// FileFormat is how the contents of a buffer are written to its file.
+type FileFormat struct {
+	LineEnding LineEnding
+	Encoding   Encoding
+
+	// Compression is the format the file is compressed with and Level, for gzip, the level.
+	Compression Compression
+	Level       int
+}
+
+// String returns the format as shown in the status line, such as "utf-8 crlf" or
+// "utf-8 lf gzip".
+func (f FileFormat) String() string {
+	s := string(f.Encoding) + " " + f.LineEnding.String()
+	if f.Compression != NoCompression {
+		s += " " + f.Compression.String()
+	}
+	return s
+}
+
+// FileFormat returns the format the buffer is saved with.
+func (b *Buffer) FileFormat() FileFormat {
+	return b.format
+}
+
+// SetFileFormat changes the format the buffer is saved with. This marks the buffer modified
+// since the next save changes the file. Returns an error if the encoding is not supported or
+// cannot represent all the characters of the buffer.
+func (b *Buffer) SetFileFormat(f FileFormat) error {
+	if f.Compression == Bzip2 && b.format.Compression != Bzip2 {
+		return ErrCompression
+	}
+	if n, at := b.Unencodable(f.Encoding); n > 0 {
+		return fmt.Errorf("text: %d characters cannot be encoded in %s, first at line %d", n, f.Encoding, b.LineOf(at)+1)
+	} else if n < 0 {
+		return fmt.Errorf("text: unknown encoding %q", f.Encoding)
+	}
+
+	if f != b.format {
+		b.format = f
+		b.modified = true
+	}
+	return nil
+}
+
+// Unencodable returns how many characters of the buffer enc cannot represent and the offset of
+// the first one. Returns -1 if enc is not supported.
+func (b *Buffer) Unencodable(enc Encoding) (int, int) {
+	var limit rune
+	switch enc {
+	case UTF8, UTF8BOM, UTF16LE, UTF16BE:
+		limit = utf8.MaxRune
+	case Latin1:
+		limit = 0xff
+	default:
+		return -1, 0
+	}
+
+	count, first := 0, 0
+	for i := range b.chars.Used() {
+		if r := b.chars.At(i); r > limit || (r >= 0xd800 && r <= 0xdfff) {
+			if count == 0 {
+				first = i
+			}
+			count++
+		}
+	}
+	return count, first
+}
+
+// decode returns the text held in data and the format it was written in. Files with a byte order
+// mark are UTF-8 or UTF-16, files that are not valid UTF-8 are taken as Latin-1. Lines end with
+// CRLF only if every line of the file does, otherwise the carriage returns are kept as text.
+func decode(data []byte) ([]rune, FileFormat) {
+	var s string
+	var f FileFormat
+
+	switch {
+	case bytes.HasPrefix(data, bomUTF8):
+		s, f.Encoding = string(data[len(bomUTF8):]), UTF8BOM
+	case bytes.HasPrefix(data, bomUTF16LE):
+		s, f.Encoding = decodeUTF16(data[len(bomUTF16LE):], binary.LittleEndian), UTF16LE
+	case bytes.HasPrefix(data, bomUTF16BE):
+		s, f.Encoding = decodeUTF16(data[len(bomUTF16BE):], binary.BigEndian), UTF16BE
+	case utf8.Valid(data):
+		s, f.Encoding = string(data), UTF8
+	default:
+		r := make([]rune, len(data))
+		for i, c := range data {
+			r[i] = rune(c)
+		}
+		s, f.Encoding = string(r), Latin1
+	}
+
+	if lf := strings.Count(s, "\n"); lf > 0 && strings.Count(s, "\r\n") == lf {
+		s, f.LineEnding = strings.ReplaceAll(s, "\r\n", "\n"), CRLF
+	}
+	return []rune(s), f
+}
+
+func decodeUTF16(data []byte, order binary.ByteOrder) string {
+	units := make([]uint16, len(data)/2)
+	for i := range units {
+		units[i] = order.Uint16(data[2*i:])
+	}
+	return string(utf16.Decode(units))
+}
+
+// encode returns text written in format f.
+func encode(text []rune, f FileFormat) []byte {
+	if f.LineEnding == CRLF {
+		text = []rune(strings.ReplaceAll(string(text), "\n", "\r\n"))
+	}
+
+	switch f.Encoding {
+	case UTF8BOM:
+		return append(bytes.Clone(bomUTF8), string(text)...)
+	case UTF16LE:
+		return encodeUTF16(bomUTF16LE, text, binary.LittleEndian)
+	case UTF16BE:
+		return encodeUTF16(bomUTF16BE, text, binary.BigEndian)
+	case Latin1:
+		data := make([]byte, len(text))
+		for i, r := range text {
+			data[i] = byte(r)
+		}
+		return data
+	}
+	return []byte(string(text))
+}
+
+func encodeUTF16(bom []byte, text []rune, order binary.AppendByteOrder) []byte {
+	data := bytes.Clone(bom)
+	for _, u := range utf16.Encode(text) {
+		data = order.AppendUint16(data, u)
+	}
+	return data
+}
@@ -0,0 +1,177 @@
+package text
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf16"
+	"unicode/utf8"
+)
+
+// escapeStyle is how the string literals of a file type escape characters outside ASCII.
+type escapeStyle int
+
+const (
+	// escapeC is \uXXXX, or \UXXXXXXXX past the basic plane, with \xNN escaping bytes, as in
+	// Go, C, TOML and YAML.
+	escapeC escapeStyle = iota
+	// escapeUTF16 is \uXXXX, with surrogate pairs past the basic plane, as in JSON, JavaScript
+	// and Java.
+	escapeUTF16
+	// escapeBraces is \u{X}, as in Rust.
+	escapeBraces
+	// escapePython is \xNN up to U+00FF and then as escapeC, \xNN escaping characters.
+	escapePython
+)
+
+// escapeStyles are the escape styles of the file types that do not use escapeC.
+var escapeStyles = map[string]escapeStyle{
+	"json":       escapeUTF16,
+	"javascript": escapeUTF16,
+	"typescript": escapeUTF16,
+	"java":       escapeUTF16,
+	"rust":       escapeBraces,
+	"python":     escapePython,
+}
+
+// EscapeUnicode returns the transform replacing the characters outside ASCII with the escapes
+// string literals of fileType use for them, as DetectFileType names file types: \u00e9 for é
+// in Go and C, \ud83d\ude00 for an emoji in JSON and JavaScript, \u{1f600} in Rust and \xe9 in
+// Python.
+func EscapeUnicode(fileType string) TextTransform {
+	style := escapeStyles[fileType]
+	return func(text []rune) ([]rune, error) {
+		var out strings.Builder
+		for _, r := range text {
+			switch {
+			case r < utf8.RuneSelf:
+				out.WriteRune(r)
+			case style == escapeBraces:
+				fmt.Fprintf(&out, `\u{%x}`, r)
+			case style == escapePython && r <= 0xff:
+				fmt.Fprintf(&out, `\x%02x`, r)
+			case r <= 0xffff:
+				fmt.Fprintf(&out, `\u%04x`, r)
+			case style == escapeUTF16:
+				hi, lo := utf16.EncodeRune(r)
+				fmt.Fprintf(&out, `\u%04x\u%04x`, hi, lo)
+			default:
+				fmt.Fprintf(&out, `\U%08x`, r)
+			}
+		}
+		return []rune(out.String()), nil
+	}
+}
+
+// UnescapeUnicode returns the transform reversing EscapeUnicode for fileType: \xNN, \uXXXX,
+// \UXXXXXXXX and \u{X} escapes become the characters they stand for. Where \xNN escapes bytes,
+// a run of them is read as UTF-8. Escapes standing for control characters, quotes or a
+// backslash are kept, as the literal needs them, and so are other escapes, such as \n.
+func UnescapeUnicode(fileType string) TextTransform {
+	bytes := escapeStyles[fileType] == escapeC
+	return func(text []rune) ([]rune, error) {
+		var out []rune
+		for i := 0; i < len(text); {
+			if text[i] != '\\' || i+1 == len(text) {
+				out = append(out, text[i])
+				i++
+				continue
+			}
+			if text[i+1] == 'x' && bytes {
+				if rs, n := unescapeBytes(text[i:]); n > 0 {
+					out = append(out, rs...)
+					i += n
+					continue
+				}
+			} else if r, n := unescapeRune(text[i:]); n > 0 && literal(r) {
+				out = append(out, r)
+				i += n
+				continue
+			}
+			// Another escape, kept with the character it escapes.
+			out = append(out, text[i], text[i+1])
+			i += 2
+		}
+		return out, nil
+	}
+}
+
+// unescapeRune reads the escape at the start of text and returns the character it stands for
+// and its length in runes, or a length of 0 if it is not a character escape. High and low
+// surrogates escaped in a row are read as a pair.
+func unescapeRune(text []rune) (rune, int) {
+	hex := func(start, n int) (rune, bool) {
+		if start+n > len(text) {
+			return 0, false
+		}
+		v, err := strconv.ParseUint(string(text[start:start+n]), 16, 32)
+		return rune(v), err == nil
+	}
+
+	switch text[1] {
+	case 'x':
+		if r, ok := hex(2, 2); ok {
+			return r, 4
+		}
+	case 'U':
+		if r, ok := hex(2, 8); ok {
+			return r, 10
+		}
+	case 'u':
+		if len(text) > 2 && text[2] == '{' {
+			end := 3
+			for end < len(text) && end < 10 && text[end] != '}' {
+				end++
+			}
+			if r, ok := hex(3, end-3); ok && end < len(text) && text[end] == '}' {
+				return r, end + 1
+			}
+			return 0, 0
+		}
+		r, ok := hex(2, 4)
+		if !ok {
+			return 0, 0
+		}
+		if utf16.IsSurrogate(r) && len(text) >= 12 && text[6] == '\\' && text[7] == 'u' {
+			if lo, ok := hex(8, 4); ok {
+				if pair := utf16.DecodeRune(r, lo); pair != utf8.RuneError {
+					return pair, 12
+				}
+			}
+		}
+		return r, 6
+	}
+	return 0, 0
+}
+
+// unescapeBytes reads the run of \xNN escapes at the start of text as UTF-8 and returns the
+// characters it stands for and its length in runes, stopping before an escape that would
+// give a character to keep escaped. Returns a length of 0 if not even one character is read.
+func unescapeBytes(text []rune) ([]rune, int) {
+	var buf []byte
+	var out []rune
+	n, read := 0, 0
+	for read+4 <= len(text) && text[read] == '\\' && text[read+1] == 'x' {
+		v, err := strconv.ParseUint(string(text[read+2:read+4]), 16, 8)
+		if err != nil {
+			break
+		}
+		buf = append(buf, byte(v))
+		read += 4
+		if !utf8.FullRune(buf) {
+			continue
+		}
+		r, size := utf8.DecodeRune(buf)
+		if r == utf8.RuneError && size <= 1 || !literal(r) {
+			break
+		}
+		out, buf, n = append(out, r), buf[:0], read
+	}
+	return out, n
+}
+
+// literal reports whether r may stand for itself in a string literal.
+func literal(r rune) bool {
+	return r <= unicode.MaxRune && !utf16.IsSurrogate(r) && !unicode.IsControl(r) && !strings.ContainsRune("\"'`\\", r)
+}
@@ -0,0 +1,109 @@
+package text
+
+// expansion remembers the selections ExpandSelection grew from, for ShrinkSelection.
+type expansion struct {
+	stack []Range
+	last  Range
+}
+
+// ExpandSelection grows the selection, or the empty one at the cursor, to the smallest enclosing
+// region: the word, the quoted string, the inside of brackets and then the brackets, the line,
+// the paragraph and finally the whole buffer. Regions come from scanning the text, since there
+// is no syntax tree to use. Returns false if the whole buffer is already selected.
+func (b *Buffer) ExpandSelection() bool {
+	cur := b.selectionRange()
+	if cur != b.expansion.last {
+		b.expansion.stack = nil
+	}
+
+	next, ok := b.grow(cur)
+	if !ok {
+		return false
+	}
+	b.expansion.stack = append(b.expansion.stack, cur)
+	b.expansion.last = next
+	b.Select(next.Start)
+	b.Seek(next.End)
+	return true
+}
+
+// ShrinkSelection goes back to the selection the last ExpandSelection grew from. Returns false
+// if the selection was not grown, or was changed since.
+func (b *Buffer) ShrinkSelection() bool {
+	e := &b.expansion
+	if len(e.stack) == 0 || b.selectionRange() != e.last {
+		return false
+	}
+
+	prev := e.stack[len(e.stack)-1]
+	e.stack = e.stack[:len(e.stack)-1]
+	e.last = prev
+	if prev.Start == prev.End {
+		b.Deselect()
+	} else {
+		b.Select(prev.Start)
+	}
+	b.Seek(prev.End)
+	return true
+}
+
+// selectionRange returns the selection, or the empty range at the cursor.
+func (b *Buffer) selectionRange() Range {
+	if start, end, ok := b.Selection(); ok {
+		return Range{start, end}
+	}
+	return Range{b.chars.cursor, b.chars.cursor}
+}
+
+// grow returns the smallest region strictly enclosing r.
+func (b *Buffer) grow(r Range) (Range, bool) {
+	var candidates []Range
+	add := func(c Range) {
+		if c.Start <= r.Start && r.End <= c.End && c.End-c.Start > r.End-r.Start {
+			candidates = append(candidates, c)
+		}
+	}
+
+	if inner, _, ok := b.wordObject(r.Start); ok {
+		add(inner)
+	}
+	for _, q := range quotes {
+		if inner, around, ok := b.quoteObject(q, r.Start); ok {
+			add(inner)
+			add(around)
+		}
+	}
+	for o, c := range bracketPairs {
+		at := r.Start
+		for {
+			s, e, ok := b.enclosing(o, c, at)
+			if !ok {
+				break
+			}
+			if e+1 >= r.End && (s < r.Start || e+1 > r.End) {
+				add(Range{s + 1, e})
+				add(Range{s, e + 1})
+				break
+			}
+			at = s - 1
+		}
+	}
+	first, last := b.lineAt(r.Start), b.lineAt(r.End)
+	add(Range{b.lineStart(first), b.lineStart(last) + b.lines.Size(last)})
+	if inner, around, ok := b.paragraphObject(r.Start); ok {
+		add(inner)
+		add(around)
+	}
+	add(Range{0, b.Len()})
+
+	if len(candidates) == 0 {
+		return Range{}, false
+	}
+	best := candidates[0]
+	for _, c := range candidates[1:] {
+		if c.End-c.Start < best.End-best.Start {
+			best = c
+		}
+	}
+	return best, true
+}
@@ -0,0 +1,181 @@
+package text
+
+import (
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/avalonbits/goted/storage"
+)
+
+// ErrNoFile is returned when saving a buffer that is not bound to a file.
+var ErrNoFile = errors.New("text: buffer has no file")
+
+// ErrRemote is returned when renaming the file of a buffer that is not a local file.
+var ErrRemote = errors.New("text: only local files can be renamed")
+
+// fileTypes maps file extensions, without the dot, to file types.
+var fileTypes = map[string]string{
+	"c":        "c",
+	"h":        "c",
+	"cc":       "cpp",
+	"cpp":      "cpp",
+	"hpp":      "cpp",
+	"css":      "css",
+	"csv":      "csv",
+	"diff":     "diff",
+	"go":       "go",
+	"htm":      "html",
+	"html":     "html",
+	"java":     "java",
+	"js":       "javascript",
+	"json":     "json",
+	"log":      "log",
+	"md":       "markdown",
+	"markdown": "markdown",
+	"patch":    "diff",
+	"py":       "python",
+	"rs":       "rust",
+	"sql":      "sql",
+	"tmpl":     "gotemplate",
+	"gohtml":   "gotemplate",
+	"sh":       "sh",
+	"bash":     "sh",
+	"toml":     "toml",
+	"ts":       "typescript",
+	"tsv":      "tsv",
+	"txt":      "text",
+	"xml":      "xml",
+	"yaml":     "yaml",
+	"yml":      "yaml",
+}
+
+// fileNames maps file names that have no telling extension to file types.
+var fileNames = map[string]string{
+	"Makefile":       "make",
+	"GNUmakefile":    "make",
+	"Dockerfile":     "dockerfile",
+	"go.mod":         "gomod",
+	"go.sum":         "gosum",
+	"COMMIT_EDITMSG": "gitcommit",
+}
+
+// DetectFileType returns the file type of the file at path based on its name, ignoring any
+// compression or encryption extension, or "" if it is not known.
+func DetectFileType(path string) string {
+	name := filepath.Base(path)
+	for _, ext := range []string{".gpg", ".pgp", ".asc", ".age", ".gz", ".bz2", ".zst"} {
+		name = strings.TrimSuffix(name, ext)
+	}
+	if kind, ok := fileNames[name]; ok {
+		return kind
+	}
+	return fileTypes[strings.ToLower(strings.TrimPrefix(filepath.Ext(name), "."))]
+}
+
+// OnRebind registers fn to be called after the buffer is bound to another file, so file
+// watchers and language servers can follow it.
+func (b *Buffer) OnRebind(fn func(old, path string)) {
+	b.rebinders = append(b.rebinders, fn)
+}
+
+// SaveFile writes the buffer to the file it is bound to, through the storage backend its path
+// belongs to, see storage.For, or the one set with SetStorage.
+func (b *Buffer) SaveFile() error {
+	if b.path == "" {
+		return ErrNoFile
+	}
+	return b.write(b.path)
+}
+
+// SaveAs writes the buffer to the file at path and binds the buffer to it. The original file,
+// if any, is left untouched. The file is compressed with gzip if path ends in ".gz".
+func (b *Buffer) SaveAs(path string) error {
+	b.compressFor(path)
+	if err := b.write(path); err != nil {
+		return err
+	}
+	b.rebind(path)
+	return nil
+}
+
+// Rename moves the file of the buffer, along with its undo file, to path, writes the buffer
+// there and binds the buffer to it. A buffer with no file is saved as path. Both paths must be
+// local files.
+func (b *Buffer) Rename(path string) error {
+	old := b.path
+	if old == "" {
+		return b.SaveAs(path)
+	}
+	if storage.Remote(old) || storage.Remote(path) {
+		return ErrRemote
+	}
+
+	if err := os.Rename(old, path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	if err := os.Rename(UndoPath(old), UndoPath(path)); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	b.compressFor(path)
+	if err := b.write(path); err != nil {
+		return err
+	}
+	b.rebind(path)
+	return nil
+}
+
+// SetStorage makes the buffer save through s, whatever its path, as encrypted files do to be
+// encrypted again. A nil s goes back to the backend the path belongs to.
+func (b *Buffer) SetStorage(s storage.Backend) {
+	b.store = s
+}
+
+// Storage returns the backend set with SetStorage, or nil if none is.
+func (b *Buffer) Storage() storage.Backend {
+	return b.store
+}
+
+// write saves the contents of the buffer to path in its storage backend, replacing what it
+// held at once, and marks the buffer unmodified once it is stored.
+func (b *Buffer) write(path string) error {
+	var data bytes.Buffer
+	if _, err := b.WriteTo(&data); err != nil {
+		return err
+	}
+	s := b.store
+	if s == nil {
+		s = storage.For(path)
+	}
+	if err := s.Write(path, data.Bytes()); err != nil {
+		return err
+	}
+	b.modified = false
+	return nil
+}
+
+// rebind binds the buffer to path, detecting its file type again, and notifies the rebind
+// listeners.
+func (b *Buffer) rebind(path string) {
+	old := b.path
+	b.path = path
+	b.options.FileType = DetectFileType(path)
+	for _, fn := range b.rebinders {
+		fn(old, path)
+	}
+}
+
+// compressFor sets the compression of the buffer from the extension of path when it is saved
+// under a new name: ".gz" files are written with gzip, other files are not compressed.
+func (b *Buffer) compressFor(path string) {
+	gz := strings.EqualFold(filepath.Ext(path), ".gz")
+	switch {
+	case gz && b.format.Compression != Gzip:
+		b.format.Compression, b.format.Level = Gzip, gzip.DefaultCompression
+	case !gz && b.format.Compression != NoCompression:
+		b.format.Compression, b.format.Level = NoCompression, 0
+	}
+}
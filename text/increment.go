@@ -0,0 +1,143 @@
+package text
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var numberRE = regexp.MustCompile(`(\d{4})-(\d{2})-(\d{2})|0[xX][0-9a-fA-F]+|-?\d+`)
+
+// Increment adds delta to the number under or after the cursor on the current line and leaves
+// the cursor on its last character. Decimal numbers, 0x prefixed hex numbers and YYYY-MM-DD
+// dates are recognized; for dates the year, month or day under the cursor is changed, the day if
+// the cursor is before the date. Returns false if there is no number to change.
+func (b *Buffer) Increment(delta int) (bool, error) {
+	line, _ := b.line(b.Line())
+	start := b.chars.cursor - b.Column()
+
+	from, to, ok := findNumber(string(line), RuneToByte(line, b.Column()))
+	if !ok {
+		return false, nil
+	}
+	return true, b.incrementAt(start, line, from, to, RuneToByte(line, b.Column()), delta)
+}
+
+// IncrementLines adds delta to the first number of every selected line, or of the current line
+// if there is no selection. With sequential, the n-th line changed gets n*delta instead, turning
+// a column of zeros into 1, 2, 3... It is a single undo step. Returns how many numbers changed.
+func (b *Buffer) IncrementLines(delta int, sequential bool) (int, error) {
+	first, last := b.lineRange()
+	cursor := b.chars.cursor
+
+	changed := 0
+	err := b.Transaction(func() error {
+		for n := first; n <= last; n++ {
+			line, _ := b.line(n)
+			from, to, ok := findNumber(string(line), 0)
+			if !ok {
+				continue
+			}
+
+			changed++
+			step := delta
+			if sequential {
+				step *= changed
+			}
+			if err := b.incrementAt(b.lineStart(n), line, from, to, from, step); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+
+	if b.anchor != nil {
+		b.Seek(cursor)
+	}
+	return changed, err
+}
+
+// incrementAt replaces the number at bytes from..to of line, which starts at offset start, with
+// its value plus delta. at is the byte column used to pick the date component to change.
+func (b *Buffer) incrementAt(start int, line []rune, from, to, at, delta int) error {
+	s := string(line)
+	number, err := addNumber(s[from:to], at-from, delta)
+	if err != nil {
+		return err
+	}
+
+	offset := start + ByteToRune(line, from)
+	if err := b.replace(offset, ByteToRune(line, to)-ByteToRune(line, from), []rune(number)); err != nil {
+		return err
+	}
+	b.Seek(offset + len([]rune(number)) - 1)
+	return nil
+}
+
+// findNumber returns the byte bounds of the first number in line that ends after column col.
+func findNumber(line string, col int) (from, to int, ok bool) {
+	for _, m := range numberRE.FindAllStringIndex(line, -1) {
+		if m[1] <= col {
+			continue
+		}
+
+		from, to = m[0], m[1]
+		if line[from] == '-' && from > 0 && isWord(rune(line[from-1])) {
+			from++
+		}
+		return from, to, true
+	}
+	return 0, 0, false
+}
+
+// addNumber adds delta to number, keeping its format. at is the byte offset inside number used
+// to pick the date component to change.
+func addNumber(number string, at, delta int) (string, error) {
+	if m := numberRE.FindStringSubmatch(number); m[1] != "" {
+		t, err := time.Parse(time.DateOnly, number)
+		if err != nil {
+			return "", err
+		}
+
+		switch {
+		case at >= 0 && at < 4:
+			t = t.AddDate(delta, 0, 0)
+		case at >= 5 && at < 7:
+			t = t.AddDate(0, delta, 0)
+		default:
+			t = t.AddDate(0, 0, delta)
+		}
+		return t.Format(time.DateOnly), nil
+	}
+
+	if digits, ok := strings.CutPrefix(strings.ToLower(number), "0x"); ok {
+		n, err := strconv.ParseUint(digits, 16, 64)
+		if err != nil {
+			return "", err
+		}
+
+		out := fmt.Sprintf("%0*x", len(digits), n+uint64(delta))
+		if strings.ContainsAny(number[2:], "ABCDEF") && !strings.ContainsAny(number[2:], "abcdef") {
+			out = strings.ToUpper(out)
+		}
+		return number[:2] + out, nil
+	}
+
+	n, err := strconv.ParseInt(number, 10, 64)
+	if err != nil {
+		return "", err
+	}
+
+	width := 0
+	if digits := strings.TrimPrefix(number, "-"); len(digits) > 1 && digits[0] == '0' {
+		width = len(digits)
+	}
+
+	n += int64(delta)
+	if n < 0 {
+		return fmt.Sprintf("-%0*d", width, -n), nil
+	}
+	return fmt.Sprintf("%0*d", width, n), nil
+}
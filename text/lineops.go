@@ -0,0 +1,219 @@
+package text
+
+import "strings"
+
+// DuplicateLines inserts a copy of the selected lines, or the current line, below them. The
+// cursor and selection move onto the copy. It is a single undo step.
+func (b *Buffer) DuplicateLines() error {
+	first, last := b.lineRange()
+	start, end := b.lineStart(first), b.lineStart(last)+b.lines.Size(last)
+
+	block := append([]rune{'\n'}, b.Text(start, end)...)
+	cursor := b.chars.cursor + len(block)
+	anchor := -1
+	if b.anchor != nil {
+		anchor = b.anchor.offset + len(block)
+	}
+
+	return b.Transaction(func() error {
+		if err := b.replace(end, 0, block); err != nil {
+			return err
+		}
+		if anchor >= 0 {
+			b.anchor.offset = anchor
+		}
+		b.Seek(cursor)
+		return nil
+	})
+}
+
+// MoveLines moves the selected lines, or the current line, delta lines down (or up, if delta is
+// negative). Marks, the cursor and the selection move with the text. It is a single undo step.
+// Returns how many lines the block actually moved.
+func (b *Buffer) MoveLines(delta int) (int, error) {
+	moved := 0
+	err := b.Transaction(func() error {
+		for ; moved < max(delta, -delta); moved++ {
+			first, last := b.lineRange()
+			if delta < 0 && first == 0 || delta > 0 && last == b.lines.Count()-1 {
+				break
+			}
+
+			if delta < 0 {
+				first--
+			} else {
+				last++
+			}
+			if err := b.swapLines(first, last, delta < 0); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+
+	if delta < 0 {
+		moved = -moved
+	}
+	return moved, err
+}
+
+// swapLines swaps lines first..last-1 with line last if top is false, or line first with lines
+// first+1..last if top is true.
+func (b *Buffer) swapLines(first, last int, top bool) error {
+	split := last
+	if top {
+		split = first + 1
+	}
+
+	aStart, aEnd := b.lineStart(first), b.lineStart(split)-1
+	bStart, bEnd := aEnd+1, b.lineStart(last)+b.lines.Size(last)
+
+	remap := func(offset int) int {
+		switch {
+		case offset >= aStart && offset <= aEnd:
+			return offset + bEnd - aEnd
+		case offset > aEnd && offset <= bEnd:
+			return offset + aStart - bStart
+		}
+		return offset
+	}
+
+	moved := map[*Mark]int{}
+	for _, m := range b.marks {
+		moved[m] = remap(m.offset)
+	}
+	cursor := remap(b.chars.cursor)
+
+	text := append(append(b.Text(bStart, bEnd), '\n'), b.Text(aStart, aEnd)...)
+	if err := b.replace(aStart, bEnd-aStart, text); err != nil {
+		return err
+	}
+
+	for m, offset := range moved {
+		m.offset = offset
+	}
+	b.Seek(cursor)
+	return nil
+}
+
+// Reindent replaces the indentation of the selected lines, or of the current line, with what
+// indent returns for each, in one undo step, and empties blank lines. indent is called for the
+// lines in order, so it sees the lines before one as they were reindented. The cursor keeps
+// its place in the text of its line. Returns how many lines changed.
+func (b *Buffer) Reindent(indent func(n int) string) (int, error) {
+	first, last := b.lineRange()
+	return b.reindent(first, last, blankOr(indent))
+}
+
+// ReindentAll is Reindent over the selected lines, or the whole buffer if there is no
+// selection.
+func (b *Buffer) ReindentAll(indent func(n int) string) (int, error) {
+	first, last := 0, b.lines.Count()-1
+	if b.anchor != nil {
+		first, last = b.lineRange()
+	}
+	return b.reindent(first, last, blankOr(indent))
+}
+
+// Retab rewrites the indentation of the selected lines, or of the whole buffer if there is no
+// selection, as tabs, with spaces for the columns left over, or as spaces, tab stops being
+// width columns apart, in one undo step. The cursor keeps its place in the text of its line.
+// Returns how many lines changed.
+func (b *Buffer) Retab(tabs bool, width int) (int, error) {
+	width = max(width, 1)
+	first, last := 0, b.lines.Count()-1
+	if b.anchor != nil {
+		first, last = b.lineRange()
+	}
+	return b.reindent(first, last, func(_ int, line []rune) []rune {
+		cols := 0
+		for _, r := range leadingSpace(line) {
+			if r == '\t' {
+				cols += width - cols%width
+			} else {
+				cols++
+			}
+		}
+		if !tabs {
+			return []rune(strings.Repeat(" ", cols))
+		}
+		return []rune(strings.Repeat("\t", cols/width) + strings.Repeat(" ", cols%width))
+	})
+}
+
+// blankOr adapts indent, giving the indentation of a line by its number, into a function for
+// reindent that gives blank lines none.
+func blankOr(indent func(n int) string) func(n int, line []rune) []rune {
+	return func(n int, line []rune) []rune {
+		if len(leadingSpace(line)) == len(line) {
+			return nil
+		}
+		return []rune(indent(n))
+	}
+}
+
+// reindent replaces the indentation of lines first to last with what indent returns for each,
+// given its number and its runes, as Reindent does.
+func (b *Buffer) reindent(first, last int, indent func(n int, line []rune) []rune) (int, error) {
+	cursor := b.Line()
+	col := b.Column() - len(leadingSpace(b.mustLine(cursor)))
+	changed := 0
+	err := b.Transaction(func() error {
+		for n := first; n <= last; n++ {
+			line := b.mustLine(n)
+			lead := leadingSpace(line)
+			want := indent(n, line)
+			if string(lead) == string(want) {
+				continue
+			}
+			if err := b.replace(b.lineStart(n), len(lead), want); err != nil {
+				return err
+			}
+			changed++
+		}
+		return nil
+	})
+	indented := len(leadingSpace(b.mustLine(cursor)))
+	b.Seek(b.Offset(cursor, indented+max(col, 0)))
+	return changed, err
+}
+
+// mustLine returns the runes of line n, which exists.
+func (b *Buffer) mustLine(n int) []rune {
+	line, _ := b.line(n)
+	return line
+}
+
+// lineRange returns the first and last lines covered by the selection, or the current line if
+// there is none. A selection ending at the start of a line does not include that line.
+func (b *Buffer) lineRange() (first, last int) {
+	start, end, ok := b.Selection()
+	if !ok {
+		return b.Line(), b.Line()
+	}
+
+	first, last = b.lineAt(start), b.lineAt(end)
+	if last > first && end == b.lineStart(last) {
+		last--
+	}
+	return first, last
+}
+
+// lineStart returns the offset of the first rune of line n.
+func (b *Buffer) lineStart(n int) int {
+	start := 0
+	for i := range min(n, b.lines.Count()) {
+		start += b.lines.Size(i) + 1
+	}
+	return start
+}
+
+// lineAt returns the line holding offset.
+func (b *Buffer) lineAt(offset int) int {
+	n, start := 0, 0
+	for n < b.lines.Count()-1 && offset > start+b.lines.Size(n) {
+		start += b.lines.Size(n) + 1
+		n++
+	}
+	return n
+}
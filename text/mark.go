@@ -0,0 +1,67 @@
+package text
+
+// Mark is a position in the buffer that follows the text around it as the buffer is edited.
+type Mark struct {
+	offset int
+}
+
+// Offset returns the current rune offset of the mark.
+func (m *Mark) Offset() int {
+	return m.offset
+}
+
+// adjust moves the mark to account for change. Marks inside removed text collapse to the start
+// of the change; marks at or after its end shift by the size difference.
+func (m *Mark) adjust(c Change) {
+	switch end := c.Offset + len(c.Removed); {
+	case m.offset < c.Offset:
+	case m.offset >= end && (len(c.Removed) > 0 || m.offset > c.Offset):
+		m.offset += len(c.Inserted) - len(c.Removed)
+	default:
+		m.offset = c.Offset
+	}
+}
+
+// NewMark creates a mark at offset, clamped to the buffer contents.
+func (b *Buffer) NewMark(offset int) *Mark {
+	m := &Mark{offset: min(max(offset, 0), b.chars.Used())}
+	b.marks = append(b.marks, m)
+	return m
+}
+
+// DeleteMark stops tracking m.
+func (b *Buffer) DeleteMark(m *Mark) {
+	for i, o := range b.marks {
+		if o == m {
+			b.marks = append(b.marks[:i], b.marks[i+1:]...)
+			return
+		}
+	}
+}
+
+// Select starts a selection anchored at offset. The selection spans from the anchor to the cursor.
+func (b *Buffer) Select(offset int) {
+	b.Deselect()
+	b.anchor = b.NewMark(offset)
+}
+
+// Deselect clears the selection.
+func (b *Buffer) Deselect() {
+	if b.anchor != nil {
+		b.DeleteMark(b.anchor)
+		b.anchor = nil
+	}
+}
+
+// Selection returns the selected range. Returns false if there is no selection.
+func (b *Buffer) Selection() (start, end int, ok bool) {
+	if b.anchor == nil {
+		return 0, 0, false
+	}
+
+	start, end = b.anchor.offset, b.chars.cursor
+	if start > end {
+		start, end = end, start
+	}
+	return start, end, true
+}
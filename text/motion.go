@@ -0,0 +1,118 @@
+package text
+
+// MoveUp moves the cursor count lines up, keeping its column where the line is long enough.
+// The column sticks across consecutive vertical moves, so it comes back after passing through
+// shorter lines. Returns how many lines it moved.
+func (b *Buffer) MoveUp(count int) int {
+	return b.moveVertical(-count)
+}
+
+// MoveDown moves the cursor count lines down, as MoveUp does. Returns how many lines it moved.
+func (b *Buffer) MoveDown(count int) int {
+	return b.moveVertical(count)
+}
+
+func (b *Buffer) moveVertical(count int) int {
+	from := b.Line()
+	line, col := b.lines.Vertical(count, b.Column(), b.chars.cursor)
+	b.GotoLine(line, col)
+	b.lines.Stick(b.chars.cursor)
+	return max(line-from, from-line)
+}
+
+// GotoLine moves the cursor to column col of line n, both clamped to the buffer contents.
+func (b *Buffer) GotoLine(n, col int) {
+	b.Seek(b.Offset(n, col))
+}
+
+// LineOf returns the line holding offset.
+func (b *Buffer) LineOf(offset int) int {
+	return b.lineAt(offset)
+}
+
+// SmartHome moves the cursor to the first non-blank rune of the line or, if it is already
+// there, to the start of the line, so pressing it twice toggles between the two.
+func (b *Buffer) SmartHome() {
+	line, _ := b.line(b.Line())
+	first := len(leadingSpace(line))
+	if b.Column() == first {
+		first = 0
+	}
+	b.GotoLine(b.Line(), first)
+}
+
+// SmartEnd moves the cursor past the last non-blank rune of the line or, if it is already
+// there, to the end of the line, past any trailing blanks.
+func (b *Buffer) SmartEnd() {
+	line, _ := b.line(b.Line())
+	last := len(line)
+	for last > 0 && (line[last-1] == ' ' || line[last-1] == '\t') {
+		last--
+	}
+	if b.Column() == last {
+		last = len(line)
+	}
+	b.GotoLine(b.Line(), last)
+}
+
+// BlockStart moves the cursor to the first line of the indentation block holding the cursor
+// line: the lines above it indented at least as deep, blank lines included. If the cursor is
+// already there, it moves to the line above indented less, the header of the block, so
+// repeating it climbs out of nested blocks. The cursor lands on the first non-blank rune.
+func (b *Buffer) BlockStart() {
+	b.gotoBlockEdge(-1)
+}
+
+// BlockEnd moves the cursor to the last line of the indentation block holding the cursor line
+// or, if it is already there, to the line below indented less, as BlockStart does upwards.
+func (b *Buffer) BlockEnd() {
+	b.gotoBlockEdge(1)
+}
+
+func (b *Buffer) gotoBlockEdge(dir int) {
+	n := b.Line()
+	depth, ok := b.indentDepth(n)
+	for !ok && n+dir >= 0 && n+dir < b.Lines() {
+		n += dir
+		depth, ok = b.indentDepth(n)
+	}
+
+	edge := n
+	for i := n + dir; i >= 0 && i < b.Lines(); i += dir {
+		d, ok := b.indentDepth(i)
+		if !ok {
+			continue
+		}
+		if d < depth {
+			if edge == b.Line() {
+				edge = i
+			}
+			break
+		}
+		edge = i
+	}
+
+	line, _ := b.line(edge)
+	b.GotoLine(edge, len(leadingSpace(line)))
+}
+
+// indentDepth returns the width of the indentation of line n, in columns. Returns false for
+// blank lines, which belong to whatever block surrounds them.
+func (b *Buffer) indentDepth(n int) (int, bool) {
+	line, _ := b.line(n)
+	space := leadingSpace(line)
+	if len(space) == len(line) {
+		return 0, false
+	}
+
+	tab := max(b.Options().TabWidth, 1)
+	width := 0
+	for _, r := range space {
+		if r == '\t' {
+			width += tab - width%tab
+		} else {
+			width++
+		}
+	}
+	return width, true
+}
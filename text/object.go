@@ -0,0 +1,297 @@
+package text
+
+import (
+	"errors"
+	"strings"
+)
+
+// ErrNoObject is returned when there is no text object of the kind asked for at the cursor.
+var ErrNoObject = errors.New("text: no such text object at the cursor")
+
+// Range is the runes from Start up to, but not including, End.
+type Range struct {
+	Start int
+	End   int
+}
+
+// Object returns the text object named name around offset, as vim's text objects: inner is the
+// object itself and around adds its delimiters or the blanks after it. The names are:
+//
+//	w            the word, around with the blanks after it
+//	" ' `        the quoted string on the line
+//	( ) b        the parenthesized block, and [ ], { } B and < > for the other brackets
+//	t            the innermost markup element, inner being its content
+//	a            the argument of a call or item of a list, around with its comma
+//	p            the paragraph, around with the blank lines after it
+//
+// Function objects need a parser this package does not have. Returns false if there is no such
+// object around offset.
+func (b *Buffer) Object(name string, offset int) (inner, around Range, ok bool) {
+	switch {
+	case name == "w":
+		return b.wordObject(offset)
+	case name == "p":
+		return b.paragraphObject(offset)
+	case name == "a":
+		return b.argumentObject(offset)
+	case name == "t" || strings.HasPrefix(name, "<") && len(name) > 1:
+		return b.tagObject(offset)
+	case name == "b":
+		name = "("
+	case name == "B":
+		name = "{"
+	}
+
+	open, close := Pair(name)
+	o, c := []rune(open), []rune(close)
+	if len(o) != 1 || len(c) != 1 {
+		return Range{}, Range{}, false
+	}
+	if o[0] == c[0] {
+		return b.quoteObject(o[0], offset)
+	}
+	start, end, ok := b.enclosing(o[0], c[0], offset)
+	return Range{start + 1, end}, Range{start, end + 1}, ok
+}
+
+// SelectObject selects the text object named name at the cursor, see Object, with its
+// delimiters if around is set. The cursor goes to the end of the object.
+func (b *Buffer) SelectObject(name string, around bool) error {
+	r, err := b.object(name, around)
+	if err != nil {
+		return err
+	}
+	b.Select(r.Start)
+	b.Seek(r.End)
+	return nil
+}
+
+// DeleteObject deletes the text object named name at the cursor, see Object, with its
+// delimiters if around is set.
+func (b *Buffer) DeleteObject(name string, around bool) error {
+	r, err := b.object(name, around)
+	if err != nil {
+		return err
+	}
+	b.Deselect()
+	return b.Replace(r.Start, r.End, nil)
+}
+
+func (b *Buffer) object(name string, around bool) (Range, error) {
+	inner, outer, ok := b.Object(name, b.chars.cursor)
+	switch {
+	case !ok:
+		return Range{}, ErrNoObject
+	case around:
+		return outer, nil
+	}
+	return inner, nil
+}
+
+// wordObject returns the word at offset and, around, the blanks after it or, at the end of a
+// line, before it.
+func (b *Buffer) wordObject(offset int) (inner, around Range, ok bool) {
+	start, end := b.wordAt(offset)
+	if start == end {
+		return Range{}, Range{}, false
+	}
+	inner, around = Range{start, end}, Range{start, end}
+	for around.End < b.Len() && isBlank(b.chars.At(around.End)) {
+		around.End++
+	}
+	if around.End == end {
+		for around.Start > 0 && isBlank(b.chars.At(around.Start-1)) {
+			around.Start--
+		}
+	}
+	return inner, around, true
+}
+
+// quoteObject returns the string quoted with q around offset on its line. Quotes pair up from the
+// start of the line, skipping those escaped with a backslash.
+func (b *Buffer) quoteObject(q rune, offset int) (inner, around Range, ok bool) {
+	n := b.lineAt(offset)
+	line, _ := b.line(n)
+	base := b.lineStart(n)
+	col := offset - base
+
+	var at []int
+	for i, r := range line {
+		if r == q && (i == 0 || line[i-1] != '\\') {
+			at = append(at, i)
+		}
+	}
+	for i := 0; i+1 < len(at); i += 2 {
+		if at[i] <= col && col <= at[i+1] {
+			return Range{base + at[i] + 1, base + at[i+1]}, Range{base + at[i], base + at[i+1] + 1}, true
+		}
+	}
+	return Range{}, Range{}, false
+}
+
+// enclosing returns the offsets of the brackets o and c closest around offset, counting a
+// bracket at offset as inside.
+func (b *Buffer) enclosing(o, c rune, offset int) (start, end int, ok bool) {
+	start = -1
+	for i, depth := min(offset, b.Len()-1), 0; i >= 0; i-- {
+		r := b.chars.At(i)
+		if r == c && i != offset {
+			depth++
+		} else if r == o {
+			if depth == 0 {
+				start = i
+				break
+			}
+			depth--
+		}
+	}
+	if start < 0 {
+		return 0, 0, false
+	}
+	for i, depth := start+1, 0; i < b.Len(); i++ {
+		switch b.chars.At(i) {
+		case o:
+			depth++
+		case c:
+			if depth == 0 {
+				return start, i, true
+			}
+			depth--
+		}
+	}
+	return 0, 0, false
+}
+
+// tagObject returns the innermost markup element around offset.
+func (b *Buffer) tagObject(offset int) (inner, around Range, ok bool) {
+	s := string(b.Text(0, b.Len()))
+	// Tag offsets are in bytes and offset in runes.
+	cursor := len(string(b.Text(0, offset)))
+
+	type open struct {
+		name       string
+		start, end int
+	}
+	var stack []open
+	var os, oe, cs, ce int
+	best := -1
+	for _, m := range tagRE.FindAllStringSubmatchIndex(s, -1) {
+		name := s[m[4]:m[5]]
+		switch {
+		case m[7] > m[6]:
+			// Self-closing tags hold nothing.
+		case m[3] == m[2]:
+			stack = append(stack, open{name, m[0], m[1]})
+		default:
+			i := len(stack) - 1
+			for i >= 0 && stack[i].name != name {
+				i--
+			}
+			if i < 0 {
+				continue
+			}
+			o := stack[i]
+			stack = stack[:i]
+			if o.start <= cursor && cursor < m[1] && o.start > best {
+				best = o.start
+				os, oe, cs, ce = o.start, o.end, m[0], m[1]
+			}
+		}
+	}
+	if best < 0 {
+		return Range{}, Range{}, false
+	}
+	runeAt := func(i int) int { return len([]rune(s[:i])) }
+	return Range{runeAt(oe), runeAt(cs)}, Range{runeAt(os), runeAt(ce)}, true
+}
+
+// argumentObject returns the comma separated item around offset inside the closest brackets,
+// and around it with the comma and blanks that separate it from the next item, or from the
+// previous one for the last item.
+func (b *Buffer) argumentObject(offset int) (inner, around Range, ok bool) {
+	start, end := -1, -1
+	for o, c := range map[rune]rune{'(': ')', '[': ']', '{': '}'} {
+		if s, e, ok := b.enclosing(o, c, offset); ok && s > start {
+			start, end = s, e
+		}
+	}
+	if start < 0 || offset == start || offset == end {
+		return Range{}, Range{}, false
+	}
+
+	// Split at the commas outside nested brackets and quotes.
+	commas := []int{start}
+	depth := 0
+	var quote rune
+	for i := start + 1; i < end; i++ {
+		r := b.chars.At(i)
+		switch {
+		case quote != 0:
+			if r == quote && b.chars.At(i-1) != '\\' {
+				quote = 0
+			}
+		case r == '"' || r == '\'' || r == '`':
+			quote = r
+		case strings.ContainsRune("([{", r):
+			depth++
+		case strings.ContainsRune(")]}", r):
+			depth--
+		case r == ',' && depth == 0:
+			commas = append(commas, i)
+		}
+	}
+	commas = append(commas, end)
+
+	for i := 0; i+1 < len(commas); i++ {
+		from, to := commas[i], commas[i+1]
+		if offset <= from || offset > to {
+			continue
+		}
+		inner = Range{from + 1, to}
+		for inner.Start < inner.End && isSpace(b.chars.At(inner.Start)) {
+			inner.Start++
+		}
+		for inner.End > inner.Start && isSpace(b.chars.At(inner.End-1)) {
+			inner.End--
+		}
+		around = inner
+		switch {
+		case i+2 < len(commas):
+			around.End = to + 1
+			for around.End < end && isSpace(b.chars.At(around.End)) {
+				around.End++
+			}
+		case i > 0:
+			around.Start = from
+		}
+		return inner, around, true
+	}
+	return Range{}, Range{}, false
+}
+
+// paragraphObject returns the lines of the paragraph around offset, with their last line break,
+// and around them the blank lines after it.
+func (b *Buffer) paragraphObject(offset int) (inner, around Range, ok bool) {
+	n := b.lineAt(offset)
+	if b.blank(n) {
+		return Range{}, Range{}, false
+	}
+	first, last := b.paragraphStart(n), b.paragraphEnd(n)
+	lineEnd := func(n int) int { return min(b.lineStart(n)+b.lines.Size(n)+1, b.Len()) }
+
+	inner = Range{b.lineStart(first), lineEnd(last)}
+	around = inner
+	for last+1 < b.Lines() && b.blank(last+1) {
+		last++
+		around.End = lineEnd(last)
+	}
+	return inner, around, true
+}
+
+func isBlank(r rune) bool {
+	return r == ' ' || r == '\t'
+}
+
+func isSpace(r rune) bool {
+	return r == ' ' || r == '\t' || r == '\n'
+}
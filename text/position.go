@@ -0,0 +1,115 @@
+package text
+
+import (
+	"unicode/utf16"
+	"unicode/utf8"
+)
+
+// LSP positions count columns in UTF-16 code units while the buffer stores runes. The helpers
+// below convert columns between runes, UTF-8 bytes and UTF-16 code units for a single line.
+// Columns past the end of the line are clamped to the line length.
+
+// RuneToUTF16 returns the UTF-16 column for rune column col in line.
+func RuneToUTF16(line []rune, col int) int {
+	col = min(max(col, 0), len(line))
+
+	units := 0
+	for _, r := range line[:col] {
+		units += utf16Len(r)
+	}
+	return units
+}
+
+// UTF16ToRune returns the rune column for UTF-16 column units in line. A column that falls
+// between the two halves of a surrogate pair resolves to the rune holding the pair.
+func UTF16ToRune(line []rune, units int) int {
+	for col, r := range line {
+		units -= utf16Len(r)
+		if units < 0 {
+			return col
+		}
+	}
+	return len(line)
+}
+
+// RuneToByte returns the UTF-8 byte column for rune column col in line.
+func RuneToByte(line []rune, col int) int {
+	col = min(max(col, 0), len(line))
+
+	size := 0
+	for _, r := range line[:col] {
+		size += utf8.RuneLen(r)
+	}
+	return size
+}
+
+// ByteToRune returns the rune column for UTF-8 byte column size in line. A column that falls
+// inside a multi-byte sequence resolves to the rune holding it.
+func ByteToRune(line []rune, size int) int {
+	for col, r := range line {
+		size -= utf8.RuneLen(r)
+		if size < 0 {
+			return col
+		}
+	}
+	return len(line)
+}
+
+// utf16Len is utf16.RuneLen with invalid runes counted as the replacement character they are
+// encoded as.
+func utf16Len(r rune) int {
+	if n := utf16.RuneLen(r); n > 0 {
+		return n
+	}
+	return 1
+}
+
+// UTF16Column converts rune column col on line n to UTF-16 code units.
+// Returns false if the line does not exist.
+func (b *Buffer) UTF16Column(n, col int) (int, bool) {
+	line, ok := b.line(n)
+	if !ok {
+		return 0, false
+	}
+	return RuneToUTF16(line, col), true
+}
+
+// RuneColumn converts UTF-16 column units on line n to a rune column.
+// Returns false if the line does not exist.
+func (b *Buffer) RuneColumn(n, units int) (int, bool) {
+	line, ok := b.line(n)
+	if !ok {
+		return 0, false
+	}
+	return UTF16ToRune(line, units), true
+}
+
+// ByteColumn converts rune column col on line n to UTF-8 bytes.
+// Returns false if the line does not exist.
+func (b *Buffer) ByteColumn(n, col int) (int, bool) {
+	line, ok := b.line(n)
+	if !ok {
+		return 0, false
+	}
+	return RuneToByte(line, col), true
+}
+
+// LineRunes returns a copy of the runes on line n, without the line break.
+// Returns false if the line does not exist.
+func (b *Buffer) LineRunes(n int) ([]rune, bool) {
+	return b.line(n)
+}
+
+// line returns a copy of the runes on line n, without the line break.
+func (b *Buffer) line(n int) ([]rune, bool) {
+	if n < 0 || n >= b.lines.Count() {
+		return nil, false
+	}
+
+	start := 0
+	for i := range n {
+		start += b.lines.Size(i) + 1
+	}
+
+	return b.chars.slice(start, start+b.lines.Size(n)), true
+}
@@ -0,0 +1,348 @@
+package text
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+// DefaultTextWidth is the width Reflow wraps to when the buffer has no text width set.
+const DefaultTextWidth = 80
+
+// proseLeader matches the start of a line of prose that is kept when it is wrapped: the
+// indentation and comment marker, then a list bullet.
+var proseLeader = regexp.MustCompile(`^([ \t]*(?:(?://+|#+|--|;+|>+)[ \t]?)?)((?:[-*+]|\d+[.)])[ \t]+)?`)
+
+// ParagraphForward moves the cursor count paragraphs down, to the blank line after each, as
+// vim's } does. Past the last paragraph it goes to the end of the buffer.
+func (b *Buffer) ParagraphForward(count int) {
+	n := b.Line()
+	for range count {
+		for n < b.Lines()-1 && b.blank(n) {
+			n++
+		}
+		for n < b.Lines()-1 && !b.blank(n) {
+			n++
+		}
+	}
+	if !b.blank(n) {
+		b.Seek(b.Len())
+		return
+	}
+	b.GotoLine(n, 0)
+}
+
+// ParagraphBackward moves the cursor count paragraphs up, to the blank line before each, as
+// vim's { does. Past the first paragraph it goes to the start of the buffer.
+func (b *Buffer) ParagraphBackward(count int) {
+	n := b.Line()
+	for range count {
+		for n > 0 && b.blank(n) {
+			n--
+		}
+		for n > 0 && !b.blank(n) {
+			n--
+		}
+	}
+	b.GotoLine(n, 0)
+}
+
+// SentenceForward moves the cursor to the start of the next sentence. Sentences end with a
+// period, question mark or exclamation mark followed by a space or the end of the line, and at
+// the end of a paragraph. Past the last sentence it goes to the end of the buffer.
+func (b *Buffer) SentenceForward() {
+	n := b.Line()
+	first := n
+	if !b.blank(n) {
+		first = b.paragraphStart(n)
+	}
+	for n < b.Lines()-1 && !b.blank(n) {
+		n++
+	}
+	for n < b.Lines()-1 && b.blank(n) {
+		n++
+	}
+	last := b.paragraphEnd(n)
+
+	start := b.lineStart(first)
+	for _, s := range sentenceStarts(b.Text(start, b.lineStart(last)+b.lines.Size(last))) {
+		if start+s > b.chars.cursor {
+			b.Seek(start + s)
+			return
+		}
+	}
+	b.Seek(b.Len())
+}
+
+// SentenceBackward moves the cursor to the start of the sentence holding it or, if it is
+// already there, of the previous one.
+func (b *Buffer) SentenceBackward() {
+	n := b.Line()
+	for {
+		for n > 0 && b.blank(n) {
+			n--
+		}
+		first := b.paragraphStart(n)
+		start := b.lineStart(first)
+		starts := sentenceStarts(b.Text(start, b.chars.cursor))
+		if len(starts) > 0 && start+starts[len(starts)-1] < b.chars.cursor {
+			b.Seek(start + starts[len(starts)-1])
+			return
+		}
+		if first == 0 {
+			b.Seek(0)
+			return
+		}
+		n = first - 1
+	}
+}
+
+// Reflow rewraps the selected lines, or the paragraph holding the cursor, so no line is wider
+// than width columns, or the text width of the buffer if width is 0. Each paragraph keeps the
+// indentation and comment marker of its first line, such as "// " or "# ", and list items keep
+// their bullet, with their following lines aligned after it. Lines left empty after the
+// comment marker separate paragraphs. It is a single undo step.
+func (b *Buffer) Reflow(width int) error {
+	if width <= 0 {
+		width = b.options.TextWidth
+	}
+	if width <= 0 {
+		width = DefaultTextWidth
+	}
+
+	first, last := b.lineRange()
+	if b.anchor == nil {
+		marker := commentMarker(b.lineText(first))
+		within := func(n int) bool {
+			line := b.lineText(n)
+			return !proseBlank(line) && commentMarker(line) == marker
+		}
+		for first > 0 && within(first-1) {
+			first--
+		}
+		for last < b.Lines()-1 && within(last+1) {
+			last++
+		}
+	}
+
+	tab := max(b.options.TabWidth, 1)
+	return b.transformLines(first, last, func(lines [][]rune) [][]rune {
+		var out, item [][]rune
+		flush := func() {
+			if len(item) > 0 {
+				out = append(out, fill(item, width, tab)...)
+				item = nil
+			}
+		}
+		for _, line := range lines {
+			m := proseLeader.FindStringSubmatchIndex(string(line))
+			switch {
+			case proseBlank(string(line)):
+				flush()
+				out = append(out, []rune(strings.TrimRight(string(line), " \t")))
+				continue
+			case m[4] >= 0 || len(item) > 0 && commentMarker(string(item[0])) != commentMarker(string(line)):
+				flush()
+			}
+			item = append(item, line)
+		}
+		flush()
+		return out
+	})
+}
+
+// fill joins the words of the lines of a paragraph and wraps them at width columns. The first
+// line keeps its leader and the others are prefixed with its comment part, followed by spaces
+// as wide as its bullet, if any.
+func fill(lines [][]rune, width, tab int) [][]rune {
+	head := string(lines[0])
+	m := proseLeader.FindStringSubmatchIndex(head)
+	comment, leader, indent := continuation(head, tab)
+
+	var words []string
+	words = append(words, strings.Fields(head[m[1]:])...)
+	for _, line := range lines[1:] {
+		s := string(line)
+		if rest, ok := strings.CutPrefix(strings.TrimLeft(s, " \t"), strings.TrimSpace(comment)); ok {
+			s = rest
+		}
+		words = append(words, strings.Fields(s)...)
+	}
+	if len(words) == 0 {
+		return [][]rune{[]rune(strings.TrimRight(leader, " \t"))}
+	}
+
+	var out [][]rune
+	line, used := leader, columns(leader, tab)
+	fresh := true
+	for _, w := range words {
+		size := len([]rune(w))
+		if !fresh && used+1+size > width {
+			out = append(out, []rune(line))
+			line, used, fresh = indent, columns(indent, tab), true
+		}
+		if !fresh {
+			line += " "
+			used++
+		}
+		line += w
+		used += size
+		fresh = false
+	}
+	return append(out, []rune(line))
+}
+
+// continuation splits the leader off head, the first line of a paragraph, and returns its
+// comment part, the whole leader and the prefix of the lines that continue the paragraph.
+func continuation(head string, tab int) (comment, leader, indent string) {
+	m := proseLeader.FindStringSubmatchIndex(head)
+	comment, leader, indent = head[:m[3]], head[:m[1]], head[:m[3]]
+	if m[4] >= 0 {
+		indent += strings.Repeat(" ", columns(head[m[4]:m[5]], tab))
+	}
+	return comment, leader, indent
+}
+
+// WrapLine breaks the cursor line at the last blank before the text width, if the text before
+// the cursor goes past it, as typing does in buffers with AutoWrap set. The new line continues
+// the comment marker and list bullet of the line, as Reflow does. The blanks at the break are
+// removed and the cursor stays on the rune it was on. Returns whether the line was broken.
+func (b *Buffer) WrapLine() (bool, error) {
+	width := b.options.TextWidth
+	if width <= 0 {
+		width = DefaultTextWidth
+	}
+	tab := max(b.options.TabWidth, 1)
+
+	wrapped := false
+	for {
+		line, _ := b.line(b.Line())
+		col := b.Column()
+		if columns(string(line[:col]), tab) <= width {
+			return wrapped, nil
+		}
+
+		_, leader, indent := continuation(string(line), tab)
+		skip := len([]rune(leader))
+		at := -1
+		for i := skip; i < col; i++ {
+			if (line[i] == ' ' || line[i] == '\t') && (at < 0 || columns(string(line[:i]), tab) <= width) {
+				at = i
+			}
+		}
+		if at < 0 {
+			return wrapped, nil
+		}
+		start, end := at, at
+		for start > skip && (line[start-1] == ' ' || line[start-1] == '\t') {
+			start--
+		}
+		for end < col && (line[end] == ' ' || line[end] == '\t') {
+			end++
+		}
+		if start == skip {
+			return wrapped, nil
+		}
+
+		offset := b.chars.cursor - col
+		cursor := b.chars.cursor - (end - start) + 1 + len([]rune(indent))
+		if err := b.replace(offset+start, end-start, []rune("\n"+indent)); err != nil {
+			return wrapped, err
+		}
+		b.Seek(cursor)
+		wrapped = true
+	}
+}
+
+// columns returns how many columns s takes on screen, with tabs expanded.
+func columns(s string, tab int) int {
+	width := 0
+	for _, r := range s {
+		if r == '\t' {
+			width += tab - width%tab
+		} else {
+			width++
+		}
+	}
+	return width
+}
+
+// commentMarker returns the comment marker starting line, if any.
+func commentMarker(line string) string {
+	m := proseLeader.FindStringSubmatchIndex(line)
+	return strings.TrimSpace(line[:m[3]])
+}
+
+// proseBlank reports whether line holds nothing but an indentation and a comment marker.
+func proseBlank(line string) bool {
+	m := proseLeader.FindStringSubmatchIndex(line)
+	return strings.TrimSpace(line[m[3]:]) == ""
+}
+
+// sentenceStarts returns the offsets in text where sentences start.
+func sentenceStarts(text []rune) []int {
+	var starts []int
+	start := true
+	for i := 0; i < len(text); i++ {
+		r := text[i]
+		switch {
+		case unicode.IsSpace(r):
+			if r == '\n' && blankAfter(text[i+1:]) {
+				start = true
+			}
+		case start:
+			starts = append(starts, i)
+			start = false
+		case r == '.' || r == '?' || r == '!':
+			j := i + 1
+			for j < len(text) && strings.ContainsRune(`)]"'`, text[j]) {
+				j++
+			}
+			if j == len(text) || unicode.IsSpace(text[j]) {
+				start = true
+				i = j - 1
+			}
+		}
+	}
+	return starts
+}
+
+// blankAfter reports whether text starts with a blank line.
+func blankAfter(text []rune) bool {
+	for _, r := range text {
+		if r == '\n' {
+			return true
+		}
+		if r != ' ' && r != '\t' {
+			return false
+		}
+	}
+	return false
+}
+
+// blank reports whether line n holds only whitespace.
+func (b *Buffer) blank(n int) bool {
+	return strings.TrimSpace(b.lineText(n)) == ""
+}
+
+// lineText returns the text of line n.
+func (b *Buffer) lineText(n int) string {
+	line, _ := b.line(n)
+	return string(line)
+}
+
+// paragraphStart returns the first line of the paragraph holding line n.
+func (b *Buffer) paragraphStart(n int) int {
+	for n > 0 && !b.blank(n-1) {
+		n--
+	}
+	return n
+}
+
+// paragraphEnd returns the last line of the paragraph holding line n.
+func (b *Buffer) paragraphEnd(n int) int {
+	for n < b.Lines()-1 && !b.blank(n+1) {
+		n++
+	}
+	return n
+}
@@ -0,0 +1,86 @@
+package text
+
+import (
+	"bufio"
+	"errors"
+	"io"
+	"unicode/utf8"
+)
+
+// ErrStale is returned by a Reader once the buffer it reads was edited.
+var ErrStale = errors.New("text: buffer changed while reading")
+
+// Reader reads the text of a buffer from an offset without copying it, for parsers, spell
+// checkers and regular expressions, as regexp.MatchReader takes an io.RuneReader. Read gives
+// the text as UTF-8, so a Reader can also be wrapped in a bufio.Reader or scanner. Any edit to
+// the buffer invalidates the Reader: it then fails with ErrStale.
+type Reader struct {
+	b       *Buffer
+	offset  int
+	version int
+	last    int
+	pending []byte
+}
+
+// NewReader returns a Reader positioned at offset, clamped to the buffer contents.
+func (b *Buffer) NewReader(offset int) *Reader {
+	return &Reader{b: b, offset: min(max(offset, 0), b.chars.Used()), version: b.version, last: -1}
+}
+
+// Offset returns the offset of the next rune to be read.
+func (r *Reader) Offset() int {
+	return r.offset
+}
+
+// ReadRune reads the next rune, with size being its length in UTF-8.
+func (r *Reader) ReadRune() (ch rune, size int, err error) {
+	if r.b.version != r.version {
+		return 0, 0, ErrStale
+	}
+	if r.offset >= r.b.chars.Used() {
+		r.last = -1
+		return 0, 0, io.EOF
+	}
+
+	ch = r.b.chars.At(r.offset)
+	r.last = r.offset
+	r.offset++
+	return ch, utf8.RuneLen(ch), nil
+}
+
+// UnreadRune steps back over the rune returned by the last ReadRune.
+func (r *Reader) UnreadRune() error {
+	if r.b.version != r.version {
+		return ErrStale
+	}
+	if r.last < 0 {
+		return bufio.ErrInvalidUnreadRune
+	}
+	r.offset, r.last = r.last, -1
+	return nil
+}
+
+// Read reads the text as UTF-8. A rune that does not fit in p is finished by the next Read.
+func (r *Reader) Read(p []byte) (int, error) {
+	n := copy(p, r.pending)
+	r.pending = r.pending[n:]
+	r.last = -1
+
+	var buf [utf8.UTFMax]byte
+	for n < len(p) {
+		ch, _, err := r.ReadRune()
+		if err != nil {
+			if n > 0 && err == io.EOF {
+				err = nil
+			}
+			r.last = -1
+			return n, err
+		}
+		size := utf8.EncodeRune(buf[:], ch)
+		copied := copy(p[n:], buf[:size])
+		r.pending = append(r.pending[:0], buf[copied:size]...)
+		n += copied
+	}
+	r.last = -1
+	return n, nil
+}
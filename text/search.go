@@ -0,0 +1,97 @@
+package text
+
+import (
+	"regexp"
+	"slices"
+	"unicode/utf8"
+)
+
+// FindAll returns the rune ranges of the non-overlapping matches of re in the buffer.
+func (b *Buffer) FindAll(re *regexp.Regexp) [][2]int {
+	s := string(b.Text(0, b.chars.Used()))
+	var matches [][2]int
+	offsets := byteOffsets(s)
+	for _, m := range re.FindAllStringIndex(s, -1) {
+		matches = append(matches, [2]int{offsets(m[0]), offsets(m[1])})
+	}
+	return matches
+}
+
+// FindSubmatches returns the rune offsets of the first n matches of re, all of them if n is
+// negative, each with those of its groups as regexp.Regexp.FindStringSubmatchIndex gives them:
+// pairs of start and end, -1 for groups that did not take part in the match.
+func (b *Buffer) FindSubmatches(re *regexp.Regexp, n int) [][]int {
+	s := string(b.Text(0, b.chars.Used()))
+	var matches [][]int
+	pos, runes := 0, 0
+	for _, m := range re.FindAllStringSubmatchIndex(s, n) {
+		// Groups are not in increasing order, so each is counted from the start of the match.
+		runes += utf8.RuneCountInString(s[pos:m[0]])
+		pos = m[0]
+		for i, off := range m {
+			if off >= 0 {
+				m[i] = runes + utf8.RuneCountInString(s[pos:off])
+			}
+		}
+		matches = append(matches, m)
+	}
+	return matches
+}
+
+// ReplaceAll replaces every match of re with repl, expanding $1 style group references as
+// regexp.Regexp.Expand does. It is a single undo step. Returns how many matches were replaced.
+func (b *Buffer) ReplaceAll(re *regexp.Regexp, repl string) (int, error) {
+	s := string(b.Text(0, b.chars.Used()))
+	offsets := byteOffsets(s)
+
+	var edits []TextEdit
+	for _, m := range re.FindAllStringSubmatchIndex(s, -1) {
+		text := []rune(string(re.ExpandString(nil, repl, s, m)))
+		edits = append(edits, TextEdit{Start: offsets(m[0]), End: offsets(m[1]), Text: text})
+	}
+
+	if err := b.ApplyEdits(edits); err != nil {
+		return 0, err
+	}
+	return len(edits), nil
+}
+
+// byteOffsets returns a function converting increasing byte offsets in s to rune offsets.
+func byteOffsets(s string) func(int) int {
+	pos, runes := 0, 0
+	return func(offset int) int {
+		if offset < pos {
+			pos, runes = 0, 0
+		}
+		runes += utf8.RuneCountInString(s[pos:offset])
+		pos = offset
+		return runes
+	}
+}
+
+// WordEdits returns the edits replacing every whole-word occurrence of word with repl, where a
+// whole word is not preceded or followed by a letter, digit or underscore.
+func (b *Buffer) WordEdits(word, repl string) []TextEdit {
+	w := []rune(word)
+	if len(w) == 0 {
+		return nil
+	}
+	text := b.Text(0, b.chars.Used())
+
+	var edits []TextEdit
+	for i := 0; i+len(w) <= len(text); i++ {
+		if !slices.Equal(text[i:i+len(w)], w) ||
+			i > 0 && isWord(text[i-1]) || i+len(w) < len(text) && isWord(text[i+len(w)]) {
+			continue
+		}
+		edits = append(edits, TextEdit{Start: i, End: i + len(w), Text: []rune(repl)})
+		i += len(w) - 1
+	}
+	return edits
+}
+
+// WordAt returns the word around the cursor, or "" if the cursor is not on or right after one.
+func (b *Buffer) WordAt() string {
+	start, end := b.wordAt(b.chars.cursor)
+	return string(b.Text(start, end))
+}
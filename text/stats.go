@@ -0,0 +1,76 @@
+package text
+
+import (
+	"fmt"
+	"unicode"
+)
+
+// Stats are the counts shown by wc: lines, words, runes and bytes. Lines counts a last line with
+// no line break but not the empty one after a final line break. Words are runs of runes that
+// are not white space and bytes are counted in UTF-8 with plain line breaks, whatever the file
+// format the buffer is saved in.
+type Stats struct {
+	Lines int
+	Words int
+	Runes int
+	Bytes int
+}
+
+func (s Stats) String() string {
+	return fmt.Sprintf("%d lines, %d words, %d characters, %d bytes", s.Lines, s.Words, s.Runes, s.Bytes)
+}
+
+// stats caches the Stats of a range of a buffer at some version of it.
+type stats struct {
+	version    int
+	start, end int
+	value      Stats
+	ok         bool
+}
+
+// Stats returns the statistics of the whole buffer. They are computed again only after the
+// buffer changed, so showing them on every redraw is cheap.
+func (b *Buffer) Stats() Stats {
+	return b.rangeStats(&b.stats[0], 0, b.chars.Used())
+}
+
+// SelectionStats returns the statistics of the selection, cached as Stats does. Returns false if
+// there is no selection.
+func (b *Buffer) SelectionStats() (Stats, bool) {
+	start, end, ok := b.Selection()
+	if !ok {
+		return Stats{}, false
+	}
+	return b.rangeStats(&b.stats[1], start, end), true
+}
+
+// rangeStats returns the statistics of the text between start and end, from c if it holds them.
+func (b *Buffer) rangeStats(c *stats, start, end int) Stats {
+	if c.ok && c.version == b.version && c.start == start && c.end == end {
+		return c.value
+	}
+
+	var s Stats
+	if start < end {
+		s.Lines = 1
+	}
+	space := true
+	r := b.NewReader(start)
+	for r.Offset() < end {
+		ch, size, _ := r.ReadRune()
+		s.Runes++
+		s.Bytes += size
+		if ch == '\n' && r.Offset() < end {
+			s.Lines++
+		}
+		if unicode.IsSpace(ch) {
+			space = true
+		} else if space {
+			space = false
+			s.Words++
+		}
+	}
+
+	*c = stats{version: b.version, start: start, end: end, value: s, ok: true}
+	return s
+}
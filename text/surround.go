@@ -0,0 +1,133 @@
+package text
+
+import (
+	"errors"
+	"regexp"
+)
+
+// ErrNoSurround is returned when the cursor is not inside the pair to delete or change.
+var ErrNoSurround = errors.New("text: no surrounding pair")
+
+// bracketPairs maps the opening brackets to their closing ones.
+var bracketPairs = map[rune]rune{'(': ')', '[': ']', '{': '}', '<': '>'}
+
+// quotes are the delimiters cycled through by CycleQuotes.
+const quotes = "\"'`"
+
+// tagRE matches an opening, closing or self-closing markup tag.
+var tagRE = regexp.MustCompile(`<(/?)([A-Za-z][\w:.-]*)[^<>]*?(/?)>`)
+
+// Pair returns the delimiters named by spec: a bracket, opening or closing, gives both brackets,
+// a tag such as "<em>" or "<a href=x>" gives it and its closing tag, and any other character is
+// used on both sides, as quotes are.
+func Pair(spec string) (open, close string) {
+	r := []rune(spec)
+	if len(r) > 1 && r[0] == '<' {
+		name := tagRE.FindStringSubmatch(spec)
+		if name != nil {
+			return spec, "</" + name[2] + ">"
+		}
+	}
+	if len(r) != 1 {
+		return spec, spec
+	}
+	for o, c := range bracketPairs {
+		if r[0] == o || r[0] == c {
+			return string(o), string(c)
+		}
+	}
+	return spec, spec
+}
+
+// Surround puts the delimiters named by spec, see Pair, around the selection or the word under
+// the cursor. The cursor and selection stay on the same text. It is a single undo step.
+func (b *Buffer) Surround(spec string) error {
+	start, end, ok := b.Selection()
+	if !ok {
+		start, end = b.wordAt(b.chars.cursor)
+	}
+	open, close := Pair(spec)
+	return b.rewrap(start, start, end, end, open, close)
+}
+
+// DeleteSurround removes the closest pair named by spec around the cursor, see Pair. Use "t" or
+// "<" for the closest markup element. It is a single undo step.
+func (b *Buffer) DeleteSurround(spec string) error {
+	os, oe, cs, ce, ok := b.findSurround(spec)
+	if !ok {
+		return ErrNoSurround
+	}
+	return b.rewrap(os, oe, cs, ce, "", "")
+}
+
+// ChangeSurround replaces the closest pair named by from around the cursor with the pair named
+// by to, as DeleteSurround and Surround do. It is a single undo step.
+func (b *Buffer) ChangeSurround(from, to string) error {
+	os, oe, cs, ce, ok := b.findSurround(from)
+	if !ok {
+		return ErrNoSurround
+	}
+	open, close := Pair(to)
+	return b.rewrap(os, oe, cs, ce, open, close)
+}
+
+// CycleQuotes changes the closest quotes around the cursor on its line to the next kind, from
+// double to single quotes, single quotes to backticks and backticks back to double quotes.
+func (b *Buffer) CycleQuotes() error {
+	best, bs := -1, 0
+	for i, q := range quotes {
+		os, _, _, _, ok := b.findSurround(string(q))
+		if ok && (best < 0 || os > bs) {
+			best, bs = i, os
+		}
+	}
+	if best < 0 {
+		return ErrNoSurround
+	}
+	return b.ChangeSurround(quotes[best:best+1], quotes[(best+1)%len(quotes):(best+1)%len(quotes)+1])
+}
+
+// rewrap replaces the opening delimiter between os and oe with open and the closing one between
+// cs and ce with close, keeping the cursor and selection on the same text.
+func (b *Buffer) rewrap(os, oe, cs, ce int, open, close string) error {
+	move := func(offset int) int {
+		switch {
+		case offset >= ce:
+			return offset + len([]rune(open)) - (oe - os) + len([]rune(close)) - (ce - cs)
+		case offset >= oe:
+			return offset + len([]rune(open)) - (oe - os)
+		case offset > os:
+			return os
+		}
+		return offset
+	}
+	cursor := move(b.chars.cursor)
+	anchor := -1
+	if b.anchor != nil {
+		anchor = move(b.anchor.offset)
+	}
+
+	return b.Transaction(func() error {
+		if err := b.replace(cs, ce-cs, []rune(close)); err != nil {
+			return err
+		}
+		if err := b.replace(os, oe-os, []rune(open)); err != nil {
+			return err
+		}
+		if anchor >= 0 {
+			b.anchor.offset = anchor
+		}
+		b.Seek(cursor)
+		return nil
+	})
+}
+
+// findSurround returns the bounds of the opening and closing delimiters of the closest pair
+// named by spec around the cursor: a bracket, a quote or a markup tag, see Object.
+func (b *Buffer) findSurround(spec string) (os, oe, cs, ce int, ok bool) {
+	if spec == "w" || spec == "p" || spec == "a" {
+		return 0, 0, 0, 0, false
+	}
+	inner, around, ok := b.Object(spec, b.chars.cursor)
+	return around.Start, inner.Start, inner.End, around.End, ok
+}
@@ -1,68 +1,263 @@
 package text
 
 import (
-	"bufio"
+	"errors"
 	"io"
+
+	"github.com/avalonbits/goted/storage"
 )
 
+var (
+	// ErrFull is returned when an edit does not fit in the buffer.
+	ErrFull = errors.New("text: buffer is full")
+
+	// ErrReadOnly is returned when editing a read-only buffer.
+	ErrReadOnly = errors.New("text: buffer is read-only")
+)
+
+// Options are the per buffer editing settings.
+type Options struct {
+	TabWidth  int
+	ExpandTab bool
+	FileType  string
+
+	// TextWidth is the width Reflow wraps lines to.
+	TextWidth int
+
+	// Guides are the columns marked on screen, such as 50 and 72 for commit messages.
+	Guides []int
+
+	// AutoWrap breaks lines going past TextWidth while typing, see WrapLine.
+	AutoWrap bool
+
+	// SmartEnd makes line-end stop past the last non-blank rune first, see SmartEnd.
+	SmartEnd bool
+
+	// ElasticTabs lines up the tab separated columns of adjacent lines on screen instead of
+	// stopping tabs every TabWidth columns. The text keeps its tabs.
+	ElasticTabs bool
+}
+
 // Buffer represents the text being edited.
 type Buffer struct {
-	chars *chars
-	lines *lines
+	chars    *chars
+	lines    *lines
+	path     string
+	store    storage.Backend
+	options  Options
+	format   FileFormat
+	modified bool
+	readOnly bool
+	edits    int
+	version  int
+
+	listeners []func(Change)
+	rebinders []func(old, path string)
+	marks     []*Mark
+	anchor    *Mark
+	history   history
+	stats     [2]stats
+	conflicts conflicts
+	expansion expansion
 }
 
 func New(size int) *Buffer {
 	return &Buffer{
-		chars: newChars(size),
-		lines: newLines(32_000),
+		chars:   newChars(size),
+		lines:   newLines(size + 1),
+		options: Options{TabWidth: 4, TextWidth: DefaultTextWidth},
+		format:  FileFormat{Encoding: UTF8},
 	}
 }
 
-func (b *Buffer) Save(out io.Writer) error {
-	bufOut := bufio.NewWriter(out)
+// Path returns the file the buffer is bound to, or "" for a buffer with no file.
+func (b *Buffer) Path() string {
+	return b.path
+}
 
-	for _, text := range [][]rune{b.chars.prefix(), b.chars.suffix()} {
-		for _, r := range text {
-			if _, err := bufOut.WriteRune(r); err != nil {
-				return err
-			}
-		}
+// SetPath binds the buffer to the file at path.
+func (b *Buffer) SetPath(path string) {
+	b.path = path
+}
+
+// Options returns the editing settings of the buffer.
+func (b *Buffer) Options() Options {
+	return b.options
+}
+
+// SetOptions replaces the editing settings of the buffer.
+func (b *Buffer) SetOptions(o Options) {
+	b.options = o
+}
+
+// Modified reports whether the buffer changed since it was loaded or saved.
+func (b *Buffer) Modified() bool {
+	return b.modified
+}
+
+// Version returns a number that changes whenever the text of the buffer does, to tell when
+// something computed from it is out of date.
+func (b *Buffer) Version() int {
+	return b.version
+}
+
+// ReadOnly reports whether edits to the buffer are refused.
+func (b *Buffer) ReadOnly() bool {
+	return b.readOnly
+}
+
+// SetReadOnly sets whether edits to the buffer, including undo and redo, are refused with
+// ErrReadOnly. Load still replaces the contents of a read-only buffer.
+func (b *Buffer) SetReadOnly(readOnly bool) {
+	b.readOnly = readOnly
+}
+
+// Load replaces the contents of the buffer with the text read from in, detecting its line
+// endings, encoding and compression. The undo history is cleared and the buffer is marked
+// unmodified.
+func (b *Buffer) Load(in io.Reader) error {
+	data, err := io.ReadAll(in)
+	if err != nil {
+		return err
+	}
+	data, compression, level, err := decompress(data)
+	if err != nil {
+		return err
+	}
+
+	text, format := decode(data)
+	readOnly := b.readOnly
+	b.readOnly = false
+	err = b.replace(0, b.chars.Used(), text)
+	b.readOnly = readOnly
+	if err != nil {
+		return err
+	}
+	format.Compression, format.Level = compression, level
+	b.format = format
+	b.history = history{}
+	b.modified = false
+	b.Seek(0)
+	b.rebuildLines()
+	b.edits, b.chars.moved = 0, 0
+	return nil
+}
+
+// WriteTo writes the contents of the buffer to w in its file format. Unlike Save, it leaves the
+// buffer marked as it was. Buffers loaded from bzip2 files cannot be written.
+func (b *Buffer) WriteTo(w io.Writer) (int64, error) {
+	text := b.chars.text()
+	data, err := compress(encode(text, b.format), b.format.Compression, b.format.Level)
+	if err != nil {
+		return 0, err
 	}
+	n, err := w.Write(data)
+	return int64(n), err
+}
 
-	return bufOut.Flush()
+// Save writes the contents of the buffer to out in its file format and marks it unmodified.
+func (b *Buffer) Save(out io.Writer) error {
+	if _, err := b.WriteTo(out); err != nil {
+		return err
+	}
+	b.modified = false
+	return nil
 }
 
 // chars is a character buffer used to store the text for the editor.
-// It uses a gap buffer as its backing store.
+// It is a list of fixed-size blocks, each a gap buffer of its own, so an edit only moves text
+// within the block it happens in, however far it is from the previous one. cur is the block
+// the cursor was last found in and start the offset of its first rune, so walking to nearby
+// offsets is cheap.
 type chars struct {
-	buf    []rune
+	blocks []*block
+	size   int
+	used   int
 	cursor int
-	curEnd int
+
+	cur   int
+	start int
+
+	// moved counts the runes moved across the gaps of the blocks.
+	moved int
+}
+
+// blockSize is the capacity, in runes, of a block.
+const blockSize = 4096
+
+// block is a gap buffer of at most blockSize runes.
+type block struct {
+	buf    [blockSize]rune
+	gap    int
+	gapEnd int
+}
+
+func newBlock() *block {
+	return &block{gapEnd: blockSize}
+}
+
+// len returns the number of runes in the block.
+func (bl *block) len() int {
+	return blockSize - (bl.gapEnd - bl.gap)
+}
+
+// at returns the rune at offset i of the block.
+func (bl *block) at(i int) rune {
+	if i < bl.gap {
+		return bl.buf[i]
+	}
+	return bl.buf[bl.gapEnd+i-bl.gap]
+}
+
+// moveGap moves the gap to offset i of the block and returns how many runes it moved.
+func (bl *block) moveGap(i int) int {
+	switch {
+	case i < bl.gap:
+		n := bl.gap - i
+		copy(bl.buf[bl.gapEnd-n:bl.gapEnd], bl.buf[i:bl.gap])
+		bl.gap, bl.gapEnd = i, bl.gapEnd-n
+		return n
+	case i > bl.gap:
+		n := i - bl.gap
+		copy(bl.buf[bl.gap:bl.gap+n], bl.buf[bl.gapEnd:bl.gapEnd+n])
+		bl.gap, bl.gapEnd = i, bl.gapEnd+n
+		return n
+	}
+	return 0
 }
 
 // Newchars returns a *chars with the appropriate size.
 func newChars(size int) *chars {
-	return &chars{
-		buf:    make([]rune, size),
-		cursor: 0,
-		curEnd: size,
-	}
+	return &chars{size: size}
 }
 
 // Clear clease the gap buffer.
 func (gb *chars) Clear() {
-	gb.cursor = 0
-	gb.curEnd = cap(gb.buf)
+	*gb = chars{size: gb.size, moved: gb.moved}
 }
 
 // Capacity returns the capacity of the gap buffer.
 func (gb *chars) Capacity() int {
-	return cap(gb.buf)
+	return gb.size
 }
 
 // Used returns how much of the capacity of the gap buffer has beend used.
 func (gb *chars) Used() int {
-	return gb.cursor + cap(gb.buf) - gb.curEnd
+	return gb.used
+}
+
+// locate makes the block holding offset i current: the one with start <= i < start+len, or the
+// last block if i is the end of the text.
+func (gb *chars) locate(i int) *block {
+	for gb.cur > 0 && i < gb.start {
+		gb.cur--
+		gb.start -= gb.blocks[gb.cur].len()
+	}
+	for gb.cur < len(gb.blocks)-1 && i >= gb.start+gb.blocks[gb.cur].len() {
+		gb.start += gb.blocks[gb.cur].len()
+		gb.cur++
+	}
+	return gb.blocks[gb.cur]
 }
 
 // Put stores a value in the gap buffer at th current position and advances the cursor.
@@ -71,20 +266,58 @@ func (gb *chars) Put(val rune) bool {
 	if gb.Capacity() == gb.Used() {
 		return false
 	}
+	if len(gb.blocks) == 0 {
+		gb.blocks = []*block{newBlock()}
+	}
 
-	gb.buf[gb.cursor] = val
+	bl := gb.locate(gb.cursor)
+	if bl.len() == blockSize {
+		gb.split()
+		bl = gb.locate(gb.cursor)
+	}
+	gb.moved += bl.moveGap(gb.cursor - gb.start)
+	bl.buf[bl.gap] = val
+	bl.gap++
+	gb.used++
 	gb.cursor++
 	return true
 }
 
+// split moves the second half of the current block, which is full, to a new block after it.
+func (gb *chars) split() {
+	bl := gb.blocks[gb.cur]
+	gb.moved += bl.moveGap(blockSize)
+
+	half := blockSize / 2
+	next := newBlock()
+	next.gap = copy(next.buf[:], bl.buf[half:])
+	gb.moved += next.gap
+	bl.gap = half
+
+	gb.blocks = append(gb.blocks, nil)
+	copy(gb.blocks[gb.cur+2:], gb.blocks[gb.cur+1:])
+	gb.blocks[gb.cur+1] = next
+}
+
 // Delete removes the value under the cursor and retreats all values after the cursor one position.
 // If there is no value to remove, returns false.
 func (gb *chars) Delete() bool {
-	if gb.curEnd >= cap(gb.buf) {
+	if gb.cursor >= gb.used {
 		return false
 	}
 
-	gb.curEnd++
+	bl := gb.locate(gb.cursor)
+	gb.moved += bl.moveGap(gb.cursor - gb.start)
+	bl.gapEnd++
+	gb.used--
+
+	if bl.len() == 0 {
+		gb.blocks = append(gb.blocks[:gb.cur], gb.blocks[gb.cur+1:]...)
+		if gb.cur == len(gb.blocks) && gb.cur > 0 {
+			gb.cur--
+			gb.start -= gb.blocks[gb.cur].len()
+		}
+	}
 	return true
 }
 
@@ -96,52 +329,91 @@ func (gb *chars) Backspace() bool {
 	}
 
 	gb.cursor--
-	return true
+	return gb.Delete()
 }
 
 // Next advances the cursor count positions and returns how many positions it actually advanced.
+// No text moves until the next edit.
 func (gb *chars) Next(count int) int {
-	target := count
-	for count > 0 && gb.curEnd < cap(gb.buf) {
-		gb.buf[gb.cursor] = gb.buf[gb.curEnd]
-
-		gb.cursor++
-		gb.curEnd++
-		count--
-	}
-
-	return target - count
+	n := max(min(count, gb.used-gb.cursor), 0)
+	gb.cursor += n
+	return n
 }
 
 // Prev retreats the cursor count positions and returns how many positions it actually retreated.
+// No text moves until the next edit.
 func (gb *chars) Prev(count int) int {
-	target := count
-	for count > 0 && gb.cursor > 0 {
-		gb.curEnd--
-		gb.cursor--
-		count--
-
-		gb.buf[gb.curEnd] = gb.buf[gb.cursor]
-	}
-
-	return target - count
+	n := max(min(count, gb.cursor), 0)
+	gb.cursor -= n
+	return n
 }
 
 // Peak returns the value under the cursor.
 func (gb *chars) Peek() (rune, bool) {
-	if gb.curEnd == cap(gb.buf) {
+	if gb.cursor >= gb.used {
 		return 0, false
 	}
+	return gb.At(gb.cursor), true
+}
+
+// At returns the value at position i, skipping over the gaps.
+func (gb *chars) At(i int) rune {
+	bl := gb.locate(i)
+	return bl.at(i - gb.start)
+}
+
+// newlines returns how many line breaks the text between offsets from and to holds.
+func (gb *chars) newlines(from, to int) int {
+	if from >= to {
+		return 0
+	}
 
-	return gb.buf[gb.curEnd], true
+	n := 0
+	gb.locate(from)
+	i, start := gb.cur, gb.start
+	for ; i < len(gb.blocks) && start < to; i++ {
+		bl := gb.blocks[i]
+		lo, hi := max(from-start, 0), min(to-start, bl.len())
+		for _, part := range [][]rune{bl.buf[:bl.gap], bl.buf[bl.gapEnd:]} {
+			for j := max(lo, 0); j < min(hi, len(part)); j++ {
+				if part[j] == '\n' {
+					n++
+				}
+			}
+			lo, hi = lo-len(part), hi-len(part)
+		}
+		start += bl.len()
+	}
+	return n
 }
 
-func (gb *chars) prefix() []rune {
-	return gb.buf[:gb.cursor]
+// slice returns a copy of the text between offsets start and end, which must be in range,
+// copying block by block around the gaps.
+func (gb *chars) slice(start, end int) []rune {
+	text := make([]rune, 0, max(end-start, 0))
+	if start >= end {
+		return text
+	}
+
+	gb.locate(start)
+	i, from := gb.cur, gb.start
+	for ; i < len(gb.blocks) && from < end; i++ {
+		bl := gb.blocks[i]
+		lo, hi := max(start-from, 0), min(end-from, bl.len())
+		if lo < bl.gap {
+			text = append(text, bl.buf[lo:min(hi, bl.gap)]...)
+		}
+		if hi > bl.gap {
+			text = append(text, bl.buf[bl.gapEnd+max(lo-bl.gap, 0):bl.gapEnd+hi-bl.gap]...)
+		}
+		from += bl.len()
+	}
+	return text
 }
 
-func (gb *chars) suffix() []rune {
-	return gb.buf[gb.curEnd:]
+// text returns a copy of the whole text.
+func (gb *chars) text() []rune {
+	return gb.slice(0, gb.used)
 }
 
 // lines is a line count buffer, used to track how much chars per line the teext editor has.
@@ -150,14 +422,76 @@ type lines struct {
 	buf    []int
 	cursor int
 	curEnd int
+	limit  int
+
+	// goal is the sticky column of vertical moves, valid while the cursor is still at offset
+	// goalAt, where the last one left it.
+	goal   int
+	goalAt int
 }
 
+// newLines returns a table of up to size lines. Its storage starts small and grows as lines
+// are added.
 func newLines(size int) *lines {
+	n := min(size, 1024)
 	return &lines{
-		buf:    make([]int, size),
+		buf:    make([]int, n),
 		cursor: 0,
-		curEnd: size,
+		curEnd: n,
+		limit:  size,
+		goalAt: -1,
+	}
+}
+
+// grow makes room for at least n lines, within the limit, moving the lines after the gap to
+// the end of the larger storage.
+func (l *lines) grow(n int) {
+	if n <= len(l.buf) {
+		return
+	}
+
+	buf := make([]int, min(max(n, 2*len(l.buf)), l.limit))
+	after := len(l.buf) - l.curEnd
+	copy(buf, l.buf[:l.cursor+1])
+	copy(buf[len(buf)-after:], l.buf[l.curEnd:])
+	l.buf, l.curEnd = buf, len(buf)-after
+}
+
+// reset replaces the table with the line sizes, making line current the current one.
+func (l *lines) reset(sizes []int, current int) {
+	l.grow(len(sizes))
+	l.cursor = current
+	l.curEnd = len(l.buf) - (len(sizes) - current - 1)
+	copy(l.buf, sizes[:current+1])
+	copy(l.buf[l.curEnd:], sizes[current+1:])
+}
+
+// ColumnClamp returns col clamped to the columns of line n.
+func (l *lines) ColumnClamp(n, col int) int {
+	return min(max(col, 0), l.Size(n))
+}
+
+// Vertical returns the line count lines below the current one, above if count is negative,
+// clamped to the table, and the column to go to on it. The column is the sticky one if the
+// cursor, at offset at, is where the previous vertical move left it, and col otherwise, so
+// moving through a short line does not lose the column of the longer ones around it.
+func (l *lines) Vertical(count, col, at int) (line, column int) {
+	if at != l.goalAt {
+		l.goal = col
 	}
+	line = min(max(l.cursor+count, 0), l.Count()-1)
+	return line, l.ColumnClamp(line, l.goal)
+}
+
+// Stick records that a vertical move left the cursor at offset at, keeping the sticky column
+// for the next one.
+func (l *lines) Stick(at int) {
+	l.goalAt = at
+}
+
+// Unstick drops the sticky column, as after an edit.
+func (l *lines) Unstick() {
+	l.goalAt = -1
 }
 
 // Current returns the current line number.
@@ -165,14 +499,27 @@ func (l *lines) Current() int {
 	return l.cursor
 }
 
+// Count returns the number of lines in the buffer, including the current one.
+func (l *lines) Count() int {
+	return l.cursor + 1 + len(l.buf) - l.curEnd
+}
+
+// Size returns the character count for line n.
+func (l *lines) Size(n int) int {
+	if n <= l.cursor {
+		return l.buf[n]
+	}
+	return l.buf[l.curEnd+n-l.cursor-1]
+}
+
 // Capacity returns the number of lines supported.
 func (l *lines) Capacity() int {
-	return cap(l.buf)
+	return l.limit
 }
 
 // Used returns how many lines were created.
 func (l *lines) Used() int {
-	return l.cursor + cap(l.buf) - l.curEnd
+	return l.cursor + len(l.buf) - l.curEnd
 }
 
 // Up moves the line pointer up.
@@ -194,7 +541,7 @@ func (l *lines) Up(count int) int {
 func (l *lines) Down(count int) int {
 	target := count
 
-	for count > 0 && l.curEnd < cap(l.buf) {
+	for count > 0 && l.curEnd < len(l.buf) {
 		l.cursor++
 		l.buf[l.cursor] = l.buf[l.curEnd]
 		l.curEnd++
@@ -221,17 +568,63 @@ func (l *lines) Dec() int {
 	return count
 }
 
-// New adds a new line to the buffer with the capacity being (current line size) - splitSize.
-// The current line size is updated to splitSize.
-func (l *lines) New(splitSize int) bool {
-	if l.Capacity() == l.Used() {
+// Join merges the next line into the current one.
+// If there is no next line, returns false.
+func (l *lines) Join() bool {
+	if l.curEnd >= len(l.buf) {
+		return false
+	}
+
+	l.buf[l.cursor] += l.buf[l.curEnd]
+	l.curEnd++
+	return true
+}
+
+// SplitAt splits the current line after col characters, as typing a line break there does. The
+// characters after col go to a new line after it, which becomes the current one.
+// If the table is full, returns false.
+func (l *lines) SplitAt(col int) bool {
+	if l.Count() == l.Capacity() {
 		return false
 	}
+	l.grow(l.Count() + 1)
 
-	curSize := l.buf[l.cursor]
-	l.buf[l.cursor] = splitSize
+	size := l.buf[l.cursor]
+	col = min(max(col, 0), size)
+	l.buf[l.cursor] = col
 	l.cursor++
-	l.buf[l.cursor] = curSize - splitSize
+	l.buf[l.cursor] = size - col
 
 	return true
 }
+
+// InsertLine adds a line of size characters after the current one and makes it current.
+// If the table is full, returns false.
+func (l *lines) InsertLine(size int) bool {
+	if l.Count() == l.Capacity() {
+		return false
+	}
+	l.grow(l.Count() + 1)
+
+	l.cursor++
+	l.buf[l.cursor] = size
+
+	return true
+}
+
+// DeleteLine removes the current line and returns its character count. The next line becomes
+// the current one, or the previous one if it was the last. The only line left is emptied
+// instead.
+func (l *lines) DeleteLine() int {
+	size := l.buf[l.cursor]
+	switch {
+	case l.curEnd < len(l.buf):
+		l.buf[l.cursor] = l.buf[l.curEnd]
+		l.curEnd++
+	case l.cursor > 0:
+		l.cursor--
+	default:
+		l.buf[0] = 0
+	}
+	return size
+}
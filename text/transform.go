@@ -0,0 +1,160 @@
+package text
+
+import (
+	"cmp"
+	"math/rand/v2"
+	"slices"
+	"strconv"
+	"unicode"
+)
+
+// LineTransform rewrites a block of lines. Lines do not include their line breaks.
+type LineTransform func(lines [][]rune) [][]rune
+
+// TransformLines replaces the selected lines, or the whole buffer if there is no selection, with
+// the result of fn. It is a single undo step. The selection, if any, is extended to cover the
+// rewritten block. The empty line after a final line break is not one of the lines of the
+// buffer.
+func (b *Buffer) TransformLines(fn LineTransform) error {
+	first, last := 0, b.lines.Count()-1
+	if b.anchor != nil {
+		first, last = b.lineRange()
+	} else if used := b.chars.Used(); last > 0 && b.chars.slice(used-1, used)[0] == '\n' {
+		last--
+	}
+	return b.transformLines(first, last, fn)
+}
+
+// transformLines replaces lines first to last with the result of fn, as TransformLines does.
+func (b *Buffer) transformLines(first, last int, fn LineTransform) error {
+	start, end := b.lineStart(first), b.lineStart(last)+b.lines.Size(last)
+
+	// The block is copied once and split, as fetching each line on its own would walk the
+	// line table from the start for every line.
+	lines := make([][]rune, 0, last-first+1)
+	block := b.chars.slice(start, end)
+	for {
+		i := slices.Index(block, '\n')
+		if i < 0 {
+			lines = append(lines, block)
+			break
+		}
+		lines = append(lines, block[:i:i])
+		block = block[i+1:]
+	}
+
+	var text []rune
+	for i, line := range fn(lines) {
+		if i > 0 {
+			text = append(text, '\n')
+		}
+		text = append(text, line...)
+	}
+
+	cursor := b.chars.cursor
+	if err := b.replace(start, end-start, text); err != nil {
+		return err
+	}
+	if b.anchor != nil {
+		b.anchor.offset = start
+		return nil
+	}
+	b.Seek(cursor)
+	return nil
+}
+
+// SortLines sorts lines lexicographically, in descending order if desc is set.
+func (b *Buffer) SortLines(desc bool) error {
+	return b.TransformLines(func(lines [][]rune) [][]rune {
+		slices.SortStableFunc(lines, func(x, y []rune) int {
+			return order(slices.Compare(x, y), desc)
+		})
+		return lines
+	})
+}
+
+// SortLinesNumeric sorts lines by the number they start with, in descending order if desc is set.
+// Lines that do not start with a number sort before those that do, keeping their relative order.
+func (b *Buffer) SortLinesNumeric(desc bool) error {
+	return b.TransformLines(func(lines [][]rune) [][]rune {
+		slices.SortStableFunc(lines, func(x, y []rune) int {
+			nx, okx := leadingNumber(x)
+			ny, oky := leadingNumber(y)
+			if okx != oky {
+				if okx {
+					return 1
+				}
+				return -1
+			}
+			return order(cmp.Compare(nx, ny), desc)
+		})
+		return lines
+	})
+}
+
+// UniqueLines removes repeated lines, keeping the first occurrence of each.
+func (b *Buffer) UniqueLines() error {
+	return b.TransformLines(func(lines [][]rune) [][]rune {
+		seen := map[string]bool{}
+		return slices.DeleteFunc(lines, func(line []rune) bool {
+			key := string(line)
+			dup := seen[key]
+			seen[key] = true
+			return dup
+		})
+	})
+}
+
+// ReverseLines reverses the order of lines.
+func (b *Buffer) ReverseLines() error {
+	return b.TransformLines(func(lines [][]rune) [][]rune {
+		slices.Reverse(lines)
+		return lines
+	})
+}
+
+// ShuffleLines puts lines in a random order drawn from rng, or from the global source if rng is
+// nil.
+func (b *Buffer) ShuffleLines(rng *rand.Rand) error {
+	shuffle := rand.Shuffle
+	if rng != nil {
+		shuffle = rng.Shuffle
+	}
+
+	return b.TransformLines(func(lines [][]rune) [][]rune {
+		shuffle(len(lines), func(i, j int) {
+			lines[i], lines[j] = lines[j], lines[i]
+		})
+		return lines
+	})
+}
+
+func order(c int, desc bool) int {
+	if desc {
+		return -c
+	}
+	return c
+}
+
+// leadingNumber parses the number at the start of line, ignoring leading whitespace.
+func leadingNumber(line []rune) (float64, bool) {
+	i := 0
+	for i < len(line) && unicode.IsSpace(line[i]) {
+		i++
+	}
+
+	start, end := i, i
+	if end < len(line) && (line[end] == '-' || line[end] == '+') {
+		end++
+	}
+	for end < len(line) && (unicode.IsDigit(line[end]) || line[end] == '.') {
+		end++
+	}
+
+	for ; end > start; end-- {
+		if n, err := strconv.ParseFloat(string(line[start:end]), 64); err == nil {
+			return n, true
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,238 @@
+package text
+
+import "time"
+
+// group is a set of changes that are undone and redone as a single step. cursor is where the
+// first change starts and where Undo leaves the cursor.
+type group struct {
+	changes []Change
+	cursor  int
+}
+
+// node is a state in the undo tree, reached from its parent by applying its group. next is the
+// child Redo moves to, -1 if there is none.
+type node struct {
+	group
+	parent int
+	next   int
+	time   time.Time
+}
+
+// history is the undo tree of a buffer. Editing after an undo starts a new branch instead of
+// discarding the undone changes, so every state the buffer went through stays reachable. Nodes
+// are numbered in creation order; nodes[0] is the state the history starts from.
+type history struct {
+	nodes   []node
+	cur     int
+	depth   int
+	pending group
+	replay  bool
+}
+
+// UndoState describes a state of the undo tree. States are numbered in the order they were
+// created, starting from 0 for the state the history starts from.
+type UndoState struct {
+	Seq     int
+	Parent  int
+	Time    time.Time
+	Current bool
+}
+
+func (h *history) root() {
+	if len(h.nodes) == 0 {
+		h.nodes = []node{{parent: -1, next: -1, time: time.Now()}}
+	}
+}
+
+// record adds c to the pending group, sealing it unless a transaction is open.
+func (h *history) record(c Change) {
+	if h.replay {
+		return
+	}
+
+	if len(h.pending.changes) == 0 {
+		h.pending.cursor = c.Offset
+	}
+	h.pending.changes = append(h.pending.changes, c)
+	if h.depth == 0 {
+		h.seal()
+	}
+}
+
+// seal turns the pending group into a new state, child of the current one.
+func (h *history) seal() {
+	if len(h.pending.changes) > 0 {
+		h.root()
+		h.nodes = append(h.nodes, node{group: h.pending, parent: h.cur, next: -1, time: time.Now()})
+		h.cur = len(h.nodes) - 1
+		h.nodes[h.nodes[h.cur].parent].next = h.cur
+	}
+	h.pending = group{}
+}
+
+// drop removes the newest state, which must be a leaf that is not current.
+func (h *history) drop() {
+	last := len(h.nodes) - 1
+	parent := h.nodes[last].parent
+	h.nodes = h.nodes[:last]
+
+	h.nodes[parent].next = -1
+	for i := last - 1; i > parent; i-- {
+		if h.nodes[i].parent == parent {
+			h.nodes[parent].next = i
+			break
+		}
+	}
+}
+
+// Begin opens a transaction: all edits until the matching End are undone as a single step.
+// Transactions may be nested.
+func (b *Buffer) Begin() {
+	b.history.depth++
+}
+
+// End closes a transaction opened by Begin.
+func (b *Buffer) End() {
+	if b.history.depth == 0 {
+		return
+	}
+
+	b.history.depth--
+	if b.history.depth == 0 {
+		b.history.seal()
+	}
+}
+
+// Transaction runs fn inside Begin/End. If fn fails, its edits are reverted and dropped from the
+// history.
+func (b *Buffer) Transaction(fn func() error) error {
+	before := len(b.history.nodes)
+	b.Begin()
+	err := fn()
+	b.End()
+
+	if err != nil && b.history.depth == 0 && len(b.history.nodes) > max(before, 1) {
+		b.Undo()
+		b.history.drop()
+	}
+	return err
+}
+
+// Undo reverts the last undo step. Returns false if there is nothing to undo.
+func (b *Buffer) Undo() bool {
+	h := &b.history
+	if h.cur == 0 || b.readOnly {
+		return false
+	}
+
+	n := h.nodes[h.cur]
+	h.replay = true
+	for i := len(n.changes) - 1; i >= 0; i-- {
+		c := n.changes[i]
+		b.replace(c.Offset, len(c.Inserted), c.Removed)
+	}
+	h.replay = false
+
+	h.nodes[n.parent].next = h.cur
+	h.cur = n.parent
+	b.Seek(n.cursor)
+	return true
+}
+
+// Redo reapplies the last undone step of the current branch. Returns false if there is nothing
+// to redo.
+func (b *Buffer) Redo() bool {
+	h := &b.history
+	if len(h.nodes) == 0 || h.nodes[h.cur].next < 0 || b.readOnly {
+		return false
+	}
+
+	h.cur = h.nodes[h.cur].next
+	h.replay = true
+	for _, c := range h.nodes[h.cur].changes {
+		b.replace(c.Offset, len(c.Removed), c.Inserted)
+	}
+	h.replay = false
+	return true
+}
+
+// Earlier moves count states back in the order they were created, crossing branches if needed,
+// as vim's g- does. Returns false if already at the oldest state.
+func (b *Buffer) Earlier(count int) bool {
+	return b.UndoTo(max(b.history.cur-count, 0))
+}
+
+// Later moves count states forward in the order they were created, crossing branches if
+// needed, as vim's g+ does. Returns false if already at the newest state.
+func (b *Buffer) Later(count int) bool {
+	return b.UndoTo(min(b.history.cur+count, max(len(b.history.nodes)-1, 0)))
+}
+
+// UndoTo moves the buffer to undo state seq, undoing back to the closest common ancestor and
+// redoing down to it. Returns false if seq is the current state or does not exist.
+func (b *Buffer) UndoTo(seq int) bool {
+	h := &b.history
+	if seq == h.cur || seq < 0 || seq >= len(h.nodes) || b.readOnly {
+		return false
+	}
+
+	var path []int
+	onPath := map[int]bool{}
+	for n := seq; n >= 0; n = h.nodes[n].parent {
+		path = append(path, n)
+		onPath[n] = true
+	}
+
+	for !onPath[h.cur] {
+		b.Undo()
+	}
+	for i := len(path) - 1; i >= 0; i-- {
+		if n := path[i]; h.nodes[n].parent == h.cur {
+			h.nodes[h.cur].next = n
+			b.Redo()
+		}
+	}
+	return true
+}
+
+// UndoTree returns every state of the undo tree, in creation order.
+func (b *Buffer) UndoTree() []UndoState {
+	h := &b.history
+	h.root()
+
+	states := make([]UndoState, len(h.nodes))
+	for i, n := range h.nodes {
+		states[i] = UndoState{Seq: i, Parent: n.parent, Time: n.time, Current: i == h.cur}
+	}
+	return states
+}
+
+// EarlierBy moves the buffer to the state it was in d ago: the newest state created at or before
+// that time, as vim's ":earlier 5m" does. Returns false if the buffer is already there.
+func (b *Buffer) EarlierBy(d time.Duration) bool {
+	return b.UndoTo(b.stateAt(time.Now().Add(-d)))
+}
+
+// LaterBy moves the buffer forward to the newest state created at most d after the current one,
+// as vim's ":later 30s" does. Returns false if the buffer is already there.
+func (b *Buffer) LaterBy(d time.Duration) bool {
+	h := &b.history
+	if len(h.nodes) == 0 {
+		return false
+	}
+	return b.UndoTo(b.stateAt(h.nodes[h.cur].time.Add(d)))
+}
+
+// stateAt returns the newest state created at or before t, or the root if there is none.
+func (b *Buffer) stateAt(t time.Time) int {
+	h := &b.history
+	h.root()
+
+	seq := 0
+	for i, n := range h.nodes {
+		if i > 0 && !n.time.After(t) {
+			seq = i
+		}
+	}
+	return seq
+}
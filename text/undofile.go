@@ -0,0 +1,147 @@
+package text
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// undoVersion is the format version of undo files.
+const undoVersion = 2
+
+// ErrUndoMismatch is returned when an undo file does not belong to the current buffer contents,
+// usually because the file was changed by another program.
+var ErrUndoMismatch = errors.New("text: undo file does not match buffer contents")
+
+type undoFile struct {
+	Version int        `json:"version"`
+	Hash    string     `json:"hash"`
+	Current int        `json:"current"`
+	States  []undoNode `json:"states"`
+}
+
+type undoNode struct {
+	Parent  int          `json:"parent"`
+	Next    int          `json:"next"`
+	Time    time.Time    `json:"time"`
+	Cursor  int          `json:"cursor"`
+	Changes []undoChange `json:"changes,omitempty"`
+}
+
+type undoChange struct {
+	Offset   int    `json:"offset"`
+	Removed  string `json:"removed,omitempty"`
+	Inserted string `json:"inserted,omitempty"`
+}
+
+// UndoPath returns where the undo history of the file at path is kept: a hidden file next to it,
+// as vim does.
+func UndoPath(path string) string {
+	return filepath.Join(filepath.Dir(path), "."+filepath.Base(path)+".un~")
+}
+
+// ContentHash returns the SHA-256 hash of the UTF-8 encoded contents of the buffer.
+func (b *Buffer) ContentHash() string {
+	h := sha256.New()
+	io.WriteString(h, string(b.chars.text()))
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// WriteUndo writes the undo history to w, tagged with the hash of the current contents so it is
+// only restored onto the same text.
+func (b *Buffer) WriteUndo(w io.Writer) error {
+	if b.history.depth == 0 {
+		b.history.seal()
+	}
+
+	b.history.root()
+	f := undoFile{Version: undoVersion, Hash: b.ContentHash(), Current: b.history.cur}
+	for _, n := range b.history.nodes {
+		u := undoNode{Parent: n.parent, Next: n.next, Time: n.time, Cursor: n.cursor}
+		for _, c := range n.changes {
+			u.Changes = append(u.Changes, undoChange{c.Offset, string(c.Removed), string(c.Inserted)})
+		}
+		f.States = append(f.States, u)
+	}
+	return json.NewEncoder(w).Encode(f)
+}
+
+// ReadUndo replaces the undo history with the one read from r. It fails with ErrUndoMismatch,
+// leaving the history alone, if the history was written for different contents.
+func (b *Buffer) ReadUndo(r io.Reader) error {
+	var f undoFile
+	if err := json.NewDecoder(r).Decode(&f); err != nil {
+		return fmt.Errorf("text: bad undo file: %w", err)
+	}
+	if f.Version != undoVersion {
+		return fmt.Errorf("text: unsupported undo file version %d", f.Version)
+	}
+	if f.Hash != b.ContentHash() {
+		return ErrUndoMismatch
+	}
+	if len(f.States) == 0 || f.Current < 0 || f.Current >= len(f.States) {
+		return fmt.Errorf("text: bad undo file: no current state")
+	}
+
+	// Every state but the root comes after its parent, so the tree has no cycles, and redoes
+	// to one of its children, if any.
+	h := history{cur: f.Current}
+	for i, u := range f.States {
+		root := i == 0 && u.Parent == -1
+		if !root && (u.Parent < 0 || u.Parent >= i) {
+			return fmt.Errorf("text: bad undo file: state %d is out of order", i)
+		}
+		if u.Next != -1 && (u.Next <= i || u.Next >= len(f.States) || f.States[u.Next].Parent != i) {
+			return fmt.Errorf("text: bad undo file: state %d redoes to state %d", i, u.Next)
+		}
+
+		n := node{group: group{cursor: u.Cursor}, parent: u.Parent, next: u.Next, time: u.Time}
+		for _, c := range u.Changes {
+			n.changes = append(n.changes, Change{c.Offset, []rune(c.Removed), []rune(c.Inserted)})
+		}
+		h.nodes = append(h.nodes, n)
+	}
+	b.history = h
+	return nil
+}
+
+// SaveUndoFile writes the undo history to the undo file of the buffer's file.
+func (b *Buffer) SaveUndoFile() error {
+	if b.path == "" {
+		return nil
+	}
+
+	f, err := os.Create(UndoPath(b.path))
+	if err != nil {
+		return err
+	}
+	if err := b.WriteUndo(f); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// LoadUndoFile restores the undo history from the undo file of the buffer's file. A missing
+// undo file is not an error.
+func (b *Buffer) LoadUndoFile() error {
+	if b.path == "" {
+		return nil
+	}
+
+	f, err := os.Open(UndoPath(b.path))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	return b.ReadUndo(f)
+}
@@ -0,0 +1,124 @@
+package theme
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+)
+
+// ErrUnknown is returned for themes that are neither built in nor in the theme directory.
+var ErrUnknown = errors.New("theme: unknown theme")
+
+// Dir returns the directory user themes are read from, one name.json file each.
+func Dir() (string, error) {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "goted", "themes"), nil
+}
+
+// Named returns the theme called name: the built-in dark or light theme, or the user theme of
+// that name in Dir.
+func Named(name string) (Theme, error) {
+	switch name {
+	case "dark", "default":
+		return Dark(), nil
+	case "light":
+		return Light(), nil
+	}
+	dir, err := Dir()
+	if err != nil {
+		return Theme{}, err
+	}
+	t, err := Load(filepath.Join(dir, name+".json"))
+	if errors.Is(err, os.ErrNotExist) {
+		return Theme{}, fmt.Errorf("%w %q", ErrUnknown, name)
+	}
+	return t, err
+}
+
+// Load reads the theme in the JSON file at path. It is laid over the built-in theme of its
+// variant, dark unless it says light, so it only needs the styles it changes.
+func Load(path string) (Theme, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return Theme{}, err
+	}
+	var t Theme
+	if err := json.Unmarshal(data, &t); err != nil {
+		return Theme{}, fmt.Errorf("theme: %s: %w", path, err)
+	}
+
+	base := Dark()
+	if t.Variant == "light" {
+		base = Light()
+	}
+	base.Variant = t.Variant
+	if base.Variant == "" {
+		base.Variant = "dark"
+	}
+	base.Name = t.Name
+	if base.Name == "" {
+		base.Name = strings.TrimSuffix(filepath.Base(path), ".json")
+	}
+	if t.Foreground != "" {
+		base.Foreground = t.Foreground
+	}
+	if t.Background != "" {
+		base.Background = t.Background
+	}
+	maps.Copy(base.Scopes, t.Scopes)
+	maps.Copy(base.UI, t.UI)
+	return base, nil
+}
+
+// SystemDark reports whether the desktop asks for dark themes, as macOS appearance, the
+// Windows app mode and the GNOME color scheme setting do. Returns false if it cannot tell, as on other systems or over
+// ssh.
+func SystemDark() (dark, ok bool) {
+	if v := os.Getenv("GTK_THEME"); v != "" {
+		return strings.HasSuffix(strings.ToLower(v), ":dark"), true
+	}
+	switch runtime.GOOS {
+	case "darwin":
+		out, err := exec.Command("defaults", "read", "-g", "AppleInterfaceStyle").Output()
+		if err != nil {
+			// The key is only set in dark mode.
+			var exit *exec.ExitError
+			return false, errors.As(err, &exit)
+		}
+		return strings.TrimSpace(string(out)) == "Dark", true
+	case "windows":
+		out, err := exec.Command("reg", "query", `HKCU\Software\Microsoft\Windows\CurrentVersion\Themes\Personalize`, "/v", "AppsUseLightTheme").Output()
+		if err != nil {
+			return false, false
+		}
+		fields := strings.Fields(string(out))
+		if len(fields) == 0 {
+			return false, false
+		}
+		return fields[len(fields)-1] == "0x0", true
+	case "linux", "freebsd", "openbsd", "netbsd":
+		if os.Getenv("DISPLAY") == "" && os.Getenv("WAYLAND_DISPLAY") == "" {
+			return false, false
+		}
+		out, err := exec.Command("gsettings", "get", "org.gnome.desktop.interface", "color-scheme").Output()
+		if err != nil {
+			return false, false
+		}
+		switch strings.Trim(strings.TrimSpace(string(out)), "'") {
+		case "prefer-dark":
+			return true, true
+		case "prefer-light":
+			return false, true
+		}
+	}
+	return false, false
+}
@@ -0,0 +1,244 @@
+// Package theme maps highlight scopes to display styles.
+package theme
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Style is how a span of text is drawn. Colors are "#rrggbb" strings; an empty color uses the
+// theme default.
+type Style struct {
+	FG        string `json:"fg,omitempty"`
+	BG        string `json:"bg,omitempty"`
+	Bold      bool   `json:"bold,omitempty"`
+	Italic    bool   `json:"italic,omitempty"`
+	Underline bool   `json:"underline,omitempty"`
+}
+
+// Theme is a named set of styles. Scopes style the text by what it is, as highlighting names it,
+// and UI styles the parts of the editor around the text, such as the status line, by element:
+//
+//	status          the status line
+//	border          borders between panes
+//	gutter          the gutter, and gutter.current its line of the cursor
+//	selection       the selected text
+//	search          matches of a search, and search.current the one at the cursor
+//	guide           guide columns
+//	popup           popups, popup.thumb their scroll thumb and popup.active the highlighted part
+//	outline.current the symbol of the outline the cursor is in
+//	bookmark        bookmarked lines
+//	redacted        text masked as a likely secret
+//
+// Variant is "dark" or "light", for the background the theme is made for.
+type Theme struct {
+	Name       string           `json:"name"`
+	Variant    string           `json:"variant"`
+	Foreground string           `json:"foreground"`
+	Background string           `json:"background"`
+	Scopes     map[string]Style `json:"scopes"`
+	UI         map[string]Style `json:"ui"`
+}
+
+// Default returns the built-in dark theme.
+func Default() Theme {
+	return Dark()
+}
+
+// Dark returns the built-in theme for dark backgrounds.
+func Dark() Theme {
+	return Theme{
+		Name:       "dark",
+		Variant:    "dark",
+		Foreground: "#d0d0d0",
+		Background: "#1c1c1c",
+		Scopes: map[string]Style{
+			"comment":  {FG: "#808080", Italic: true},
+			"string":   {FG: "#87af5f"},
+			"keyword":  {FG: "#d787af", Bold: true},
+			"constant": {FG: "#d7875f"},
+			"number":   {FG: "#d7875f"},
+			"type":     {FG: "#5fafd7"},
+
+			"diff.added":        {FG: "#87af5f"},
+			"diff.removed":      {FG: "#d75f5f"},
+			"diff.header":       {Bold: true},
+			"diff.added.word":   {FG: "#afd787", BG: "#2f4f1f", Bold: true},
+			"diff.removed.word": {FG: "#ff8787", BG: "#5f1f1f", Bold: true},
+
+			"log.time":  {FG: "#808080"},
+			"log.error": {FG: "#d75f5f", Bold: true},
+			"log.warn":  {FG: "#d7af5f", Bold: true},
+			"log.info":  {FG: "#5fafd7"},
+			"log.debug": {FG: "#808080"},
+
+			"conflict.marker": {BG: "#5f5f87", Bold: true},
+			"conflict.ours":   {BG: "#1c3a1c"},
+			"conflict.base":   {BG: "#3a3a1c"},
+			"conflict.theirs": {BG: "#1c2a3a"},
+
+			"markup.heading":  {Bold: true},
+			"markup.link":     {FG: "#5fafd7", Underline: true},
+			"markup.code":     {FG: "#d7af87"},
+			"markup.strong":   {Bold: true},
+			"markup.emphasis": {Italic: true},
+
+			"tag":       {FG: "#5fafd7"},
+			"attribute": {FG: "#d7af5f"},
+			"template":  {FG: "#d7875f", Bold: true},
+
+			"csv.column0": {FG: "#d0d0d0"},
+			"csv.column1": {FG: "#87af5f"},
+			"csv.column2": {FG: "#d787af"},
+			"csv.column3": {FG: "#d7875f"},
+			"csv.column4": {FG: "#5fafd7"},
+			"csv.column5": {FG: "#d7af5f"},
+		},
+		UI: map[string]Style{
+			"status":         {FG: "#1c1c1c", BG: "#d0d0d0"},
+			"border":         {FG: "#303030"},
+			"gutter":         {FG: "#585858"},
+			"gutter.current": {FG: "#d0d0d0"},
+			"selection":      {BG: "#264f78"},
+			"search":         {BG: "#5f5f00"},
+			"search.current": {FG: "#1c1c1c", BG: "#ffd75f"},
+			"guide":          {BG: "#303030"},
+
+			"scrollbar":            {BG: "#262626"},
+			"scrollbar.thumb":      {BG: "#585858"},
+			"scrollbar.search":     {FG: "#ffd75f"},
+			"scrollbar.hunk":       {FG: "#5fafd7"},
+			"scrollbar.diagnostic": {FG: "#d75f5f"},
+
+			"popup":        {BG: "#303030"},
+			"popup.thumb":  {BG: "#585858"},
+			"popup.active": {FG: "#ffd75f", Bold: true},
+
+			"outline.current": {BG: "#303030"},
+			"bookmark":        {BG: "#2a2a3a"},
+			"redacted":        {FG: "#af5f5f"},
+		},
+	}
+}
+
+// Light returns the built-in theme for light backgrounds.
+func Light() Theme {
+	return Theme{
+		Name:       "light",
+		Variant:    "light",
+		Foreground: "#303030",
+		Background: "#fafafa",
+		Scopes: map[string]Style{
+			"comment":  {FG: "#8a8a8a", Italic: true},
+			"string":   {FG: "#5f8700"},
+			"keyword":  {FG: "#af005f", Bold: true},
+			"constant": {FG: "#af5f00"},
+			"number":   {FG: "#af5f00"},
+			"type":     {FG: "#005f87"},
+
+			"diff.added":        {FG: "#5f8700"},
+			"diff.removed":      {FG: "#af0000"},
+			"diff.header":       {Bold: true},
+			"diff.added.word":   {FG: "#005f00", BG: "#d7ffaf", Bold: true},
+			"diff.removed.word": {FG: "#870000", BG: "#ffd7d7", Bold: true},
+
+			"log.time":  {FG: "#8a8a8a"},
+			"log.error": {FG: "#af0000", Bold: true},
+			"log.warn":  {FG: "#af5f00", Bold: true},
+			"log.info":  {FG: "#005f87"},
+			"log.debug": {FG: "#8a8a8a"},
+
+			"conflict.marker": {BG: "#d7d7ff", Bold: true},
+			"conflict.ours":   {BG: "#e4f4e4"},
+			"conflict.base":   {BG: "#f4f4e0"},
+			"conflict.theirs": {BG: "#e4ecf8"},
+
+			"markup.heading":  {Bold: true},
+			"markup.link":     {FG: "#005f87", Underline: true},
+			"markup.code":     {FG: "#875f00"},
+			"markup.strong":   {Bold: true},
+			"markup.emphasis": {Italic: true},
+
+			"tag":       {FG: "#005f87"},
+			"attribute": {FG: "#875f00"},
+			"template":  {FG: "#af5f00", Bold: true},
+
+			"csv.column0": {FG: "#303030"},
+			"csv.column1": {FG: "#5f8700"},
+			"csv.column2": {FG: "#af005f"},
+			"csv.column3": {FG: "#af5f00"},
+			"csv.column4": {FG: "#005f87"},
+			"csv.column5": {FG: "#875f00"},
+		},
+		UI: map[string]Style{
+			"status":         {FG: "#fafafa", BG: "#303030"},
+			"border":         {FG: "#d0d0d0"},
+			"gutter":         {FG: "#a8a8a8"},
+			"gutter.current": {FG: "#303030"},
+			"selection":      {BG: "#c6ddf5"},
+			"search":         {BG: "#ffffaf"},
+			"search.current": {BG: "#ffd75f"},
+			"guide":          {BG: "#ececec"},
+
+			"scrollbar":            {BG: "#eeeeee"},
+			"scrollbar.thumb":      {BG: "#bcbcbc"},
+			"scrollbar.search":     {FG: "#af8700"},
+			"scrollbar.hunk":       {FG: "#005f87"},
+			"scrollbar.diagnostic": {FG: "#af0000"},
+
+			"popup":        {BG: "#e4e4e4"},
+			"popup.thumb":  {BG: "#bcbcbc"},
+			"popup.active": {FG: "#af005f", Bold: true},
+
+			"outline.current": {BG: "#e4e4e4"},
+			"bookmark":        {BG: "#e8e8f8"},
+			"redacted":        {FG: "#af5f5f"},
+		},
+	}
+}
+
+// Style returns the style for scope. A scope with no style of its own uses the style of its
+// closest parent, so "comment.doc" falls back to "comment". Scopes starting with "ui." name UI
+// elements, so "ui.popup.thumb" is the popup.thumb style of UI.
+func (t Theme) Style(scope string) Style {
+	styles := t.Scopes
+	if element, ok := strings.CutPrefix(scope, "ui."); ok {
+		styles, scope = t.UI, element
+	}
+	for scope != "" {
+		if s, ok := styles[scope]; ok {
+			return s
+		}
+
+		i := strings.LastIndexByte(scope, '.')
+		if i < 0 {
+			break
+		}
+		scope = scope[:i]
+	}
+	return Style{}
+}
+
+// RGB parses a "#rrggbb" color. Returns false if color is not in that form.
+func RGB(color string) (r, g, b uint8, ok bool) {
+	hex, found := strings.CutPrefix(color, "#")
+	if !found || len(hex) != 6 {
+		return 0, 0, 0, false
+	}
+
+	n, err := strconv.ParseUint(hex, 16, 32)
+	if err != nil {
+		return 0, 0, 0, false
+	}
+	return uint8(n >> 16), uint8(n >> 8), uint8(n), true
+}
+
+// IsDark reports whether the "#rrggbb" color is dark, by its relative luminance, as a
+// background a dark theme suits. Returns false if color is not in that form.
+func IsDark(color string) (dark, ok bool) {
+	r, g, b, ok := RGB(color)
+	if !ok {
+		return false, false
+	}
+	return 0.2126*float64(r)+0.7152*float64(g)+0.0722*float64(b) < 128, true
+}
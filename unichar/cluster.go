@@ -0,0 +1,67 @@
+package unichar
+
+import "unicode"
+
+const (
+	zwj         = 0x200D
+	regionalMin = 0x1F1E6
+	regionalMax = 0x1F1FF
+)
+
+// ClusterEnd returns the end of the grapheme cluster starting at text[i]. It approximates the
+// Unicode rules well enough for cursor movement and display: combining marks, variation
+// selectors, emoji modifiers and tags extend the previous character, zero width joiners glue two
+// characters together, regional indicators pair into flags and CR LF stays together.
+func ClusterEnd(text []rune, i int) int {
+	if i >= len(text) {
+		return len(text)
+	}
+
+	r := text[i]
+	i++
+	switch {
+	case r == '\r' && i < len(text) && text[i] == '\n':
+		return i + 1
+	case isRegional(r) && i < len(text) && isRegional(text[i]):
+		i++
+	}
+
+	for i < len(text) {
+		switch next := text[i]; {
+		case Extends(next):
+			i++
+		case next == zwj:
+			i++
+			if i < len(text) {
+				i++
+			}
+		default:
+			return i
+		}
+	}
+	return i
+}
+
+// ClusterAt returns the bounds of the grapheme cluster of text holding text[i].
+func ClusterAt(text []rune, i int) (start, end int) {
+	for start < len(text) {
+		end = ClusterEnd(text, start)
+		if end > i {
+			return start, end
+		}
+		start = end
+	}
+	return len(text), len(text)
+}
+
+// Extends reports whether r attaches to the character before it instead of starting a cluster.
+func Extends(r rune) bool {
+	return unicode.In(r, unicode.Mn, unicode.Me, unicode.Mc) ||
+		r >= 0xFE00 && r <= 0xFE0F ||
+		r >= 0x1F3FB && r <= 0x1F3FF ||
+		r >= 0xE0020 && r <= 0xE007F
+}
+
+func isRegional(r rune) bool {
+	return r >= regionalMin && r <= regionalMax
+}
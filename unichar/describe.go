@@ -0,0 +1,63 @@
+package unichar
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"unicode"
+)
+
+var categories = func() []string {
+	var names []string
+	for name := range unicode.Categories {
+		if len(name) == 2 && name != "LC" {
+			names = append(names, name)
+		}
+	}
+	slices.Sort(names)
+	return names
+}()
+
+// Category returns the two letter general category of r, such as "Lu" or "Mn".
+func Category(r rune) string {
+	for _, name := range categories {
+		if unicode.Is(unicode.Categories[name], r) {
+			return name
+		}
+	}
+	return "Cn"
+}
+
+// Describe returns a one line description of r: its code point, name, category and UTF-8 bytes.
+func Describe(r rune) string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "U+%04X", r)
+	if name := Name(r); name != "" {
+		sb.WriteString(" " + name)
+	}
+	fmt.Fprintf(&sb, " (%s) UTF-8:", Category(r))
+	for _, c := range []byte(string(r)) {
+		fmt.Fprintf(&sb, " %02x", c)
+	}
+	return sb.String()
+}
+
+// DescribeCluster describes the grapheme cluster of text holding text[i]: the character at i
+// followed, for clusters of more than one character, by the code points making it up.
+func DescribeCluster(text []rune, i int) string {
+	if i < 0 || i >= len(text) {
+		return ""
+	}
+
+	desc := Describe(text[i])
+	start, end := ClusterAt(text, i)
+	if end-start <= 1 {
+		return desc
+	}
+
+	parts := make([]string, 0, end-start)
+	for _, r := range text[start:end] {
+		parts = append(parts, fmt.Sprintf("U+%04X", r))
+	}
+	return desc + " cluster: " + strings.Join(parts, " ")
+}
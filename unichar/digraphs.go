@@ -0,0 +1,527 @@
+// Digraphs taken from the mnemonics of RFC 1345.
+
+package unichar
+
+// digraphs maps two key mnemonics to the character they insert.
+var digraphs = map[string]rune{
+	"!2":  0x2016, // ‖
+	"!=":  0x2260, // ≠
+	"!I":  0x00A1, // ¡
+	"\"6": 0x201C, // “
+	"\"9": 0x201D, // ”
+	"%\"": 0x044C, // ь
+	"%0":  0x2030, // ‰
+	"'6":  0x2018, // ‘
+	"'9":  0x2019, // ’
+	"'m":  0x00AF, // ¯
+	"(-":  0x2208, // ∈
+	"(C":  0x2282, // ⊂
+	"(U":  0x2229, // ∩
+	"(_":  0x2286, // ⊆
+	")C":  0x2283, // ⊃
+	")U":  0x222A, // ∪
+	")_":  0x2287, // ⊇
+	"*-":  0x2217, // ∗
+	"*1":  0x2606, // ☆
+	"*2":  0x2605, // ★
+	"*>":  0x226B, // ≫
+	"*P":  0x220F, // ∏
+	"*X":  0x00D7, // ×
+	"*s":  0x03C2, // ς
+	"+-":  0x00B1, // ±
+	"+Z":  0x2211, // ∑
+	",.":  0x2026, // …
+	"-!":  0x2191, // ↑
+	"-)":  0x220B, // ∋
+	"-+":  0x2213, // ∓
+	"--":  0x00AD, // SOFT HYPHEN
+	"-2":  0x2212, // −
+	"-3":  0x2015, // ―
+	"-:":  0x00F7, // ÷
+	"->":  0x2192, // →
+	"-L":  0x221F, // ∟
+	"-M":  0x2014, // —
+	"-N":  0x2013, // –
+	"-T":  0x22A5, // ⊥
+	"-V":  0x2220, // ∠
+	"-X":  0x2720, // ✠
+	"-a":  0x00AA, // ª
+	"-o":  0x00BA, // º
+	"-v":  0x2193, // ↓
+	"..":  0x2025, // ‥
+	".9":  0x201A, // ‚
+	".:":  0x2234, // ∴
+	".M":  0x00B7, // ·
+	".P":  0x22C5, // ⋅
+	"/-":  0x2020, // †
+	"/0":  0x2205, // ∅
+	"/=":  0x2021, // ‡
+	"0(":  0x221D, // ∝
+	"0.":  0x2299, // ⊙
+	"00":  0x221E, // ∞
+	"0M":  0x25CF, // ●
+	"0m":  0x25CB, // ○
+	"1'":  0x2032, // ′
+	"12":  0x00BD, // ½
+	"14":  0x00BC, // ¼
+	"1S":  0x00B9, // ¹
+	"2'":  0x2033, // ″
+	"2S":  0x00B2, // ²
+	"3-":  0x2504, // ┄
+	"34":  0x00BE, // ¾
+	"3S":  0x00B3, // ³
+	":.":  0x2235, // ∵
+	":9":  0x201E, // „
+	"<*":  0x226A, // ≪
+	"<-":  0x2190, // ←
+	"<1":  0x2039, // ‹
+	"<<":  0x00AB, // «
+	"<=":  0x21D0, // ⇐
+	"<>":  0x2194, // ↔
+	"=\"": 0x044A, // ъ
+	"=3":  0x2261, // ≡
+	"=<":  0x2264, // ≤
+	"==":  0x21D4, // ⇔
+	"=>":  0x21D2, // ⇒
+	"=?":  0x224C, // ≌
+	"=R":  0x20BD, // ₽
+	"=e":  0x20AC, // €
+	">1":  0x203A, // ›
+	">=":  0x2265, // ≥
+	">>":  0x00BB, // »
+	"?1":  0x223C, // ∼
+	"?2":  0x2248, // ≈
+	"?=":  0x2245, // ≅
+	"?I":  0x00BF, // ¿
+	"A!":  0x00C0, // À
+	"A'":  0x00C1, // Á
+	"A(":  0x0102, // Ă
+	"A*":  0x0391, // Α
+	"A-":  0x0100, // Ā
+	"A.":  0x0226, // Ȧ
+	"A0":  0x00C5, // Å
+	"A:":  0x00C4, // Ä
+	"A;":  0x0104, // Ą
+	"A<":  0x01CD, // Ǎ
+	"A=":  0x0410, // А
+	"A>":  0x00C2, // Â
+	"A?":  0x00C3, // Ã
+	"AE":  0x00C6, // Æ
+	"AN":  0x2227, // ∧
+	"B*":  0x0392, // Β
+	"B.":  0x1E02, // Ḃ
+	"B=":  0x0411, // Б
+	"BB":  0x00A6, // ¦
+	"C%":  0x0427, // Ч
+	"C'":  0x0106, // Ć
+	"C*":  0x039E, // Ξ
+	"C,":  0x00C7, // Ç
+	"C.":  0x010A, // Ċ
+	"C<":  0x010C, // Č
+	"C=":  0x0426, // Ц
+	"C>":  0x0108, // Ĉ
+	"Co":  0x00A9, // ©
+	"Ct":  0x00A2, // ¢
+	"Cu":  0x00A4, // ¤
+	"D*":  0x0394, // Δ
+	"D,":  0x1E10, // Ḑ
+	"D.":  0x1E0A, // Ḋ
+	"D/":  0x0110, // Đ
+	"D<":  0x010E, // Ď
+	"D=":  0x0414, // Д
+	"DE":  0x2206, // ∆
+	"DG":  0x00B0, // °
+	"DH":  0x00D0, // Ð
+	"DI":  0x222C, // ∬
+	"Db":  0x25C6, // ◆
+	"E!":  0x00C8, // È
+	"E'":  0x00C9, // É
+	"E(":  0x0114, // Ĕ
+	"E*":  0x0395, // Ε
+	"E,":  0x0228, // Ȩ
+	"E-":  0x0112, // Ē
+	"E.":  0x0116, // Ė
+	"E:":  0x00CB, // Ë
+	"E;":  0x0118, // Ę
+	"E<":  0x011A, // Ě
+	"E=":  0x0415, // Е
+	"E>":  0x00CA, // Ê
+	"E?":  0x1EBC, // Ẽ
+	"Eh":  0x2302, // ⌂
+	"Eu":  0x20AC, // €
+	"F*":  0x03A6, // Φ
+	"F.":  0x1E1E, // Ḟ
+	"F=":  0x0424, // Ф
+	"FA":  0x2200, // ∀
+	"G'":  0x01F4, // Ǵ
+	"G(":  0x011E, // Ğ
+	"G*":  0x0393, // Γ
+	"G,":  0x0122, // Ģ
+	"G-":  0x1E20, // Ḡ
+	"G.":  0x0120, // Ġ
+	"G<":  0x01E6, // Ǧ
+	"G=":  0x0413, // Г
+	"G>":  0x011C, // Ĝ
+	"H*":  0x0398, // Θ
+	"H,":  0x1E28, // Ḩ
+	"H.":  0x1E22, // Ḣ
+	"H/":  0x0126, // Ħ
+	"H:":  0x1E26, // Ḧ
+	"H<":  0x021E, // Ȟ
+	"H=":  0x0425, // Х
+	"H>":  0x0124, // Ĥ
+	"I!":  0x00CC, // Ì
+	"I'":  0x00CD, // Í
+	"I(":  0x012C, // Ĭ
+	"I*":  0x0399, // Ι
+	"I-":  0x012A, // Ī
+	"I.":  0x0130, // İ
+	"I:":  0x00CF, // Ï
+	"I;":  0x012E, // Į
+	"I<":  0x01CF, // Ǐ
+	"I=":  0x0418, // И
+	"I>":  0x00CE, // Î
+	"I?":  0x0128, // Ĩ
+	"In":  0x222B, // ∫
+	"Io":  0x222E, // ∮
+	"J=":  0x0419, // Й
+	"J>":  0x0134, // Ĵ
+	"Ja":  0x042F, // Я
+	"Je":  0x042D, // Э
+	"Ju":  0x042E, // Ю
+	"K'":  0x1E30, // Ḱ
+	"K*":  0x039A, // Κ
+	"K,":  0x0136, // Ķ
+	"K<":  0x01E8, // Ǩ
+	"K=":  0x041A, // К
+	"L'":  0x0139, // Ĺ
+	"L*":  0x039B, // Λ
+	"L,":  0x013B, // Ļ
+	"L/":  0x0141, // Ł
+	"L<":  0x013D, // Ľ
+	"L=":  0x041B, // Л
+	"M'":  0x1E3E, // Ḿ
+	"M*":  0x039C, // Μ
+	"M.":  0x1E40, // Ṁ
+	"M2":  0x266B, // ♫
+	"M8":  0x266A, // ♪
+	"M=":  0x041C, // М
+	"Md":  0x2669, // ♩
+	"My":  0x00B5, // µ
+	"N!":  0x01F8, // Ǹ
+	"N'":  0x0143, // Ń
+	"N*":  0x039D, // Ν
+	"N,":  0x0145, // Ņ
+	"N.":  0x1E44, // Ṅ
+	"N0":  0x2116, // №
+	"N<":  0x0147, // Ň
+	"N=":  0x041D, // Н
+	"N?":  0x00D1, // Ñ
+	"NB":  0x2207, // ∇
+	"NO":  0x00AC, // ¬
+	"NS":  0x00A0, // NO-BREAK SPACE
+	"O!":  0x00D2, // Ò
+	"O\"": 0x0150, // Ő
+	"O'":  0x00D3, // Ó
+	"O(":  0x014E, // Ŏ
+	"O*":  0x039F, // Ο
+	"O-":  0x014C, // Ō
+	"O.":  0x022E, // Ȯ
+	"O/":  0x00D8, // Ø
+	"O:":  0x00D6, // Ö
+	"O;":  0x01EA, // Ǫ
+	"O<":  0x01D1, // Ǒ
+	"O=":  0x041E, // О
+	"O>":  0x00D4, // Ô
+	"O?":  0x00D5, // Õ
+	"OE":  0x0152, // Œ
+	"OK":  0x2713, // ✓
+	"OR":  0x2228, // ∨
+	"OS":  0x25AA, // ▪
+	"Ob":  0x2218, // ∘
+	"Om":  0x03A9, // Ω
+	"P'":  0x1E54, // Ṕ
+	"P*":  0x03A0, // Π
+	"P.":  0x1E56, // Ṗ
+	"P=":  0x041F, // П
+	"PI":  0x00B6, // ¶
+	"PP":  0x2225, // ∥
+	"Pd":  0x00A3, // £
+	"Q*":  0x03A8, // Ψ
+	"R'":  0x0154, // Ŕ
+	"R*":  0x03A1, // Ρ
+	"R,":  0x0156, // Ŗ
+	"R.":  0x1E58, // Ṙ
+	"R<":  0x0158, // Ř
+	"R=":  0x0420, // Р
+	"RT":  0x221A, // √
+	"Rg":  0x00AE, // ®
+	"S%":  0x0428, // Ш
+	"S'":  0x015A, // Ś
+	"S*":  0x03A3, // Σ
+	"S,":  0x015E, // Ş
+	"S.":  0x1E60, // Ṡ
+	"S<":  0x0160, // Š
+	"S=":  0x0421, // С
+	"S>":  0x015C, // Ŝ
+	"SE":  0x00A7, // §
+	"SP":  0x0020, // SPACE
+	"Sb":  0x2219, // ∙
+	"Sc":  0x0429, // Щ
+	"T*":  0x03A4, // Τ
+	"T,":  0x0162, // Ţ
+	"T.":  0x1E6A, // Ṫ
+	"T/":  0x0166, // Ŧ
+	"T<":  0x0164, // Ť
+	"T=":  0x0422, // Т
+	"TE":  0x2203, // ∃
+	"TH":  0x00DE, // Þ
+	"TM":  0x2122, // ™
+	"U!":  0x00D9, // Ù
+	"U\"": 0x0170, // Ű
+	"U'":  0x00DA, // Ú
+	"U(":  0x016C, // Ŭ
+	"U*":  0x03A5, // Υ
+	"U-":  0x016A, // Ū
+	"U0":  0x016E, // Ů
+	"U:":  0x00DC, // Ü
+	"U;":  0x0172, // Ų
+	"U<":  0x01D3, // Ǔ
+	"U=":  0x0423, // У
+	"U>":  0x00DB, // Û
+	"U?":  0x0168, // Ũ
+	"UD":  0x2195, // ↕
+	"V=":  0x0412, // В
+	"V?":  0x1E7C, // Ṽ
+	"W!":  0x1E80, // Ẁ
+	"W'":  0x1E82, // Ẃ
+	"W*":  0x03A9, // Ω
+	"W.":  0x1E86, // Ẇ
+	"W:":  0x1E84, // Ẅ
+	"W>":  0x0174, // Ŵ
+	"X*":  0x03A7, // Χ
+	"X.":  0x1E8A, // Ẋ
+	"X:":  0x1E8C, // Ẍ
+	"XX":  0x2717, // ✗
+	"Y!":  0x1EF2, // Ỳ
+	"Y'":  0x00DD, // Ý
+	"Y*":  0x0397, // Η
+	"Y-":  0x0232, // Ȳ
+	"Y.":  0x1E8E, // Ẏ
+	"Y:":  0x0178, // Ÿ
+	"Y=":  0x042B, // Ы
+	"Y>":  0x0176, // Ŷ
+	"Y?":  0x1EF8, // Ỹ
+	"Ye":  0x00A5, // ¥
+	"Z%":  0x0416, // Ж
+	"Z'":  0x0179, // Ź
+	"Z*":  0x0396, // Ζ
+	"Z.":  0x017B, // Ż
+	"Z<":  0x017D, // Ž
+	"Z=":  0x0417, // З
+	"Z>":  0x1E90, // Ẑ
+	"a!":  0x00E0, // à
+	"a'":  0x00E1, // á
+	"a(":  0x0103, // ă
+	"a*":  0x03B1, // α
+	"a-":  0x0101, // ā
+	"a.":  0x0227, // ȧ
+	"a0":  0x00E5, // å
+	"a:":  0x00E4, // ä
+	"a;":  0x0105, // ą
+	"a<":  0x01CE, // ǎ
+	"a=":  0x0430, // а
+	"a>":  0x00E2, // â
+	"a?":  0x00E3, // ã
+	"ae":  0x00E6, // æ
+	"b*":  0x03B2, // β
+	"b.":  0x1E03, // ḃ
+	"b/":  0x0180, // ƀ
+	"b=":  0x0431, // б
+	"c%":  0x0447, // ч
+	"c'":  0x0107, // ć
+	"c*":  0x03BE, // ξ
+	"c,":  0x00E7, // ç
+	"c.":  0x010B, // ċ
+	"c<":  0x010D, // č
+	"c=":  0x0446, // ц
+	"c>":  0x0109, // ĉ
+	"cC":  0x2663, // ♣
+	"cD":  0x2662, // ♢
+	"cH":  0x2661, // ♡
+	"cS":  0x2660, // ♠
+	"co":  0x2105, // ℅
+	"d*":  0x03B4, // δ
+	"d,":  0x1E11, // ḑ
+	"d.":  0x1E0B, // ḋ
+	"d/":  0x0111, // đ
+	"d<":  0x010F, // ď
+	"d=":  0x0434, // д
+	"dP":  0x2202, // ∂
+	"dh":  0x00F0, // ð
+	"e!":  0x00E8, // è
+	"e'":  0x00E9, // é
+	"e(":  0x0115, // ĕ
+	"e*":  0x03B5, // ε
+	"e,":  0x0229, // ȩ
+	"e-":  0x0113, // ē
+	"e.":  0x0117, // ė
+	"e:":  0x00EB, // ë
+	"e;":  0x0119, // ę
+	"e<":  0x011B, // ě
+	"e=":  0x0435, // е
+	"e>":  0x00EA, // ê
+	"e?":  0x1EBD, // ẽ
+	"f*":  0x03C6, // φ
+	"f.":  0x1E1F, // ḟ
+	"f=":  0x0444, // ф
+	"g'":  0x01F5, // ǵ
+	"g(":  0x011F, // ğ
+	"g*":  0x03B3, // γ
+	"g,":  0x0123, // ģ
+	"g-":  0x1E21, // ḡ
+	"g.":  0x0121, // ġ
+	"g<":  0x01E7, // ǧ
+	"g=":  0x0433, // г
+	"g>":  0x011D, // ĝ
+	"h*":  0x03B8, // θ
+	"h,":  0x1E29, // ḩ
+	"h.":  0x1E23, // ḣ
+	"h/":  0x0127, // ħ
+	"h:":  0x1E27, // ḧ
+	"h<":  0x021F, // ȟ
+	"h=":  0x0445, // х
+	"h>":  0x0125, // ĥ
+	"hh":  0x2500, // ─
+	"i!":  0x00EC, // ì
+	"i'":  0x00ED, // í
+	"i(":  0x012D, // ĭ
+	"i*":  0x03B9, // ι
+	"i-":  0x012B, // ī
+	"i.":  0x0131, // ı
+	"i:":  0x00EF, // ï
+	"i;":  0x012F, // į
+	"i<":  0x01D0, // ǐ
+	"i=":  0x0438, // и
+	"i>":  0x00EE, // î
+	"i?":  0x0129, // ĩ
+	"io":  0x0451, // ё
+	"j<":  0x01F0, // ǰ
+	"j=":  0x0439, // й
+	"j>":  0x0135, // ĵ
+	"ja":  0x044F, // я
+	"je":  0x044D, // э
+	"ju":  0x044E, // ю
+	"k'":  0x1E31, // ḱ
+	"k*":  0x03BA, // κ
+	"k,":  0x0137, // ķ
+	"k<":  0x01E9, // ǩ
+	"k=":  0x043A, // к
+	"l'":  0x013A, // ĺ
+	"l*":  0x03BB, // λ
+	"l,":  0x013C, // ļ
+	"l/":  0x0142, // ł
+	"l<":  0x013E, // ľ
+	"l=":  0x043B, // л
+	"m'":  0x1E3F, // ḿ
+	"m*":  0x03BC, // μ
+	"m.":  0x1E41, // ṁ
+	"m=":  0x043C, // м
+	"n!":  0x01F9, // ǹ
+	"n'":  0x0144, // ń
+	"n*":  0x03BD, // ν
+	"n,":  0x0146, // ņ
+	"n.":  0x1E45, // ṅ
+	"n<":  0x0148, // ň
+	"n=":  0x043D, // н
+	"n?":  0x00F1, // ñ
+	"o!":  0x00F2, // ò
+	"o\"": 0x0151, // ő
+	"o'":  0x00F3, // ó
+	"o(":  0x014F, // ŏ
+	"o*":  0x03BF, // ο
+	"o-":  0x014D, // ō
+	"o.":  0x022F, // ȯ
+	"o/":  0x00F8, // ø
+	"o:":  0x00F6, // ö
+	"o;":  0x01EB, // ǫ
+	"o<":  0x01D2, // ǒ
+	"o=":  0x043E, // о
+	"o>":  0x00F4, // ô
+	"o?":  0x00F5, // õ
+	"oC":  0x2103, // ℃
+	"oF":  0x2109, // ℉
+	"oe":  0x0153, // œ
+	"p'":  0x1E55, // ṕ
+	"p*":  0x03C0, // π
+	"p.":  0x1E57, // ṗ
+	"p=":  0x043F, // п
+	"q*":  0x03C8, // ψ
+	"r'":  0x0155, // ŕ
+	"r*":  0x03C1, // ρ
+	"r,":  0x0157, // ŗ
+	"r.":  0x1E59, // ṙ
+	"r<":  0x0159, // ř
+	"r=":  0x0440, // р
+	"s%":  0x0448, // ш
+	"s'":  0x015B, // ś
+	"s*":  0x03C3, // σ
+	"s,":  0x015F, // ş
+	"s.":  0x1E61, // ṡ
+	"s<":  0x0161, // š
+	"s=":  0x0441, // с
+	"s>":  0x015D, // ŝ
+	"sc":  0x0449, // щ
+	"ss":  0x00DF, // ß
+	"t*":  0x03C4, // τ
+	"t,":  0x0163, // ţ
+	"t.":  0x1E6B, // ṫ
+	"t/":  0x0167, // ŧ
+	"t:":  0x1E97, // ẗ
+	"t<":  0x0165, // ť
+	"t=":  0x0442, // т
+	"th":  0x00FE, // þ
+	"u!":  0x00F9, // ù
+	"u\"": 0x0171, // ű
+	"u'":  0x00FA, // ú
+	"u(":  0x016D, // ŭ
+	"u*":  0x03C5, // υ
+	"u-":  0x016B, // ū
+	"u0":  0x016F, // ů
+	"u:":  0x00FC, // ü
+	"u;":  0x0173, // ų
+	"u<":  0x01D4, // ǔ
+	"u=":  0x0443, // у
+	"u>":  0x00FB, // û
+	"u?":  0x0169, // ũ
+	"v=":  0x0432, // в
+	"v?":  0x1E7D, // ṽ
+	"vv":  0x2502, // │
+	"w!":  0x1E81, // ẁ
+	"w'":  0x1E83, // ẃ
+	"w*":  0x03C9, // ω
+	"w.":  0x1E87, // ẇ
+	"w0":  0x1E98, // ẘ
+	"w:":  0x1E85, // ẅ
+	"w>":  0x0175, // ŵ
+	"x*":  0x03C7, // χ
+	"x.":  0x1E8B, // ẋ
+	"x:":  0x1E8D, // ẍ
+	"y!":  0x1EF3, // ỳ
+	"y'":  0x00FD, // ý
+	"y*":  0x03B7, // η
+	"y-":  0x0233, // ȳ
+	"y.":  0x1E8F, // ẏ
+	"y0":  0x1E99, // ẙ
+	"y:":  0x00FF, // ÿ
+	"y=":  0x044B, // ы
+	"y>":  0x0177, // ŷ
+	"y?":  0x1EF9, // ỹ
+	"z%":  0x0436, // ж
+	"z'":  0x017A, // ź
+	"z*":  0x03B6, // ζ
+	"z.":  0x017C, // ż
+	"z<":  0x017E, // ž
+	"z=":  0x0437, // з
+	"z>":  0x1E91, // ẑ
+}
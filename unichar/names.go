@@ -0,0 +1,5770 @@
+// Character names taken from the Unicode Character Database, kept in code point order.
+
+package unichar
+
+// nameData holds "HEX NAME" lines, sorted by code point, for the blocks covered by Name.
+const nameData = "" +
+	"0 NULL\n" +
+	"7 BELL\n" +
+	"8 BACKSPACE\n" +
+	"9 CHARACTER TABULATION\n" +
+	"A LINE FEED\n" +
+	"D CARRIAGE RETURN\n" +
+	"1B ESCAPE\n" +
+	"20 SPACE\n" +
+	"21 EXCLAMATION MARK\n" +
+	"22 QUOTATION MARK\n" +
+	"23 NUMBER SIGN\n" +
+	"24 DOLLAR SIGN\n" +
+	"25 PERCENT SIGN\n" +
+	"26 AMPERSAND\n" +
+	"27 APOSTROPHE\n" +
+	"28 LEFT PARENTHESIS\n" +
+	"29 RIGHT PARENTHESIS\n" +
+	"2A ASTERISK\n" +
+	"2B PLUS SIGN\n" +
+	"2C COMMA\n" +
+	"2D HYPHEN-MINUS\n" +
+	"2E FULL STOP\n" +
+	"2F SOLIDUS\n" +
+	"30 DIGIT ZERO\n" +
+	"31 DIGIT ONE\n" +
+	"32 DIGIT TWO\n" +
+	"33 DIGIT THREE\n" +
+	"34 DIGIT FOUR\n" +
+	"35 DIGIT FIVE\n" +
+	"36 DIGIT SIX\n" +
+	"37 DIGIT SEVEN\n" +
+	"38 DIGIT EIGHT\n" +
+	"39 DIGIT NINE\n" +
+	"3A COLON\n" +
+	"3B SEMICOLON\n" +
+	"3C LESS-THAN SIGN\n" +
+	"3D EQUALS SIGN\n" +
+	"3E GREATER-THAN SIGN\n" +
+	"3F QUESTION MARK\n" +
+	"40 COMMERCIAL AT\n" +
+	"41 LATIN CAPITAL LETTER A\n" +
+	"42 LATIN CAPITAL LETTER B\n" +
+	"43 LATIN CAPITAL LETTER C\n" +
+	"44 LATIN CAPITAL LETTER D\n" +
+	"45 LATIN CAPITAL LETTER E\n" +
+	"46 LATIN CAPITAL LETTER F\n" +
+	"47 LATIN CAPITAL LETTER G\n" +
+	"48 LATIN CAPITAL LETTER H\n" +
+	"49 LATIN CAPITAL LETTER I\n" +
+	"4A LATIN CAPITAL LETTER J\n" +
+	"4B LATIN CAPITAL LETTER K\n" +
+	"4C LATIN CAPITAL LETTER L\n" +
+	"4D LATIN CAPITAL LETTER M\n" +
+	"4E LATIN CAPITAL LETTER N\n" +
+	"4F LATIN CAPITAL LETTER O\n" +
+	"50 LATIN CAPITAL LETTER P\n" +
+	"51 LATIN CAPITAL LETTER Q\n" +
+	"52 LATIN CAPITAL LETTER R\n" +
+	"53 LATIN CAPITAL LETTER S\n" +
+	"54 LATIN CAPITAL LETTER T\n" +
+	"55 LATIN CAPITAL LETTER U\n" +
+	"56 LATIN CAPITAL LETTER V\n" +
+	"57 LATIN CAPITAL LETTER W\n" +
+	"58 LATIN CAPITAL LETTER X\n" +
+	"59 LATIN CAPITAL LETTER Y\n" +
+	"5A LATIN CAPITAL LETTER Z\n" +
+	"5B LEFT SQUARE BRACKET\n" +
+	"5C REVERSE SOLIDUS\n" +
+	"5D RIGHT SQUARE BRACKET\n" +
+	"5E CIRCUMFLEX ACCENT\n" +
+	"5F LOW LINE\n" +
+	"60 GRAVE ACCENT\n" +
+	"61 LATIN SMALL LETTER A\n" +
+	"62 LATIN SMALL LETTER B\n" +
+	"63 LATIN SMALL LETTER C\n" +
+	"64 LATIN SMALL LETTER D\n" +
+	"65 LATIN SMALL LETTER E\n" +
+	"66 LATIN SMALL LETTER F\n" +
+	"67 LATIN SMALL LETTER G\n" +
+	"68 LATIN SMALL LETTER H\n" +
+	"69 LATIN SMALL LETTER I\n" +
+	"6A LATIN SMALL LETTER J\n" +
+	"6B LATIN SMALL LETTER K\n" +
+	"6C LATIN SMALL LETTER L\n" +
+	"6D LATIN SMALL LETTER M\n" +
+	"6E LATIN SMALL LETTER N\n" +
+	"6F LATIN SMALL LETTER O\n" +
+	"70 LATIN SMALL LETTER P\n" +
+	"71 LATIN SMALL LETTER Q\n" +
+	"72 LATIN SMALL LETTER R\n" +
+	"73 LATIN SMALL LETTER S\n" +
+	"74 LATIN SMALL LETTER T\n" +
+	"75 LATIN SMALL LETTER U\n" +
+	"76 LATIN SMALL LETTER V\n" +
+	"77 LATIN SMALL LETTER W\n" +
+	"78 LATIN SMALL LETTER X\n" +
+	"79 LATIN SMALL LETTER Y\n" +
+	"7A LATIN SMALL LETTER Z\n" +
+	"7B LEFT CURLY BRACKET\n" +
+	"7C VERTICAL LINE\n" +
+	"7D RIGHT CURLY BRACKET\n" +
+	"7E TILDE\n" +
+	"7F DELETE\n" +
+	"A0 NO-BREAK SPACE\n" +
+	"A1 INVERTED EXCLAMATION MARK\n" +
+	"A2 CENT SIGN\n" +
+	"A3 POUND SIGN\n" +
+	"A4 CURRENCY SIGN\n" +
+	"A5 YEN SIGN\n" +
+	"A6 BROKEN BAR\n" +
+	"A7 SECTION SIGN\n" +
+	"A8 DIAERESIS\n" +
+	"A9 COPYRIGHT SIGN\n" +
+	"AA FEMININE ORDINAL INDICATOR\n" +
+	"AB LEFT-POINTING DOUBLE ANGLE QUOTATION MARK\n" +
+	"AC NOT SIGN\n" +
+	"AD SOFT HYPHEN\n" +
+	"AE REGISTERED SIGN\n" +
+	"AF MACRON\n" +
+	"B0 DEGREE SIGN\n" +
+	"B1 PLUS-MINUS SIGN\n" +
+	"B2 SUPERSCRIPT TWO\n" +
+	"B3 SUPERSCRIPT THREE\n" +
+	"B4 ACUTE ACCENT\n" +
+	"B5 MICRO SIGN\n" +
+	"B6 PILCROW SIGN\n" +
+	"B7 MIDDLE DOT\n" +
+	"B8 CEDILLA\n" +
+	"B9 SUPERSCRIPT ONE\n" +
+	"BA MASCULINE ORDINAL INDICATOR\n" +
+	"BB RIGHT-POINTING DOUBLE ANGLE QUOTATION MARK\n" +
+	"BC VULGAR FRACTION ONE QUARTER\n" +
+	"BD VULGAR FRACTION ONE HALF\n" +
+	"BE VULGAR FRACTION THREE QUARTERS\n" +
+	"BF INVERTED QUESTION MARK\n" +
+	"C0 LATIN CAPITAL LETTER A WITH GRAVE\n" +
+	"C1 LATIN CAPITAL LETTER A WITH ACUTE\n" +
+	"C2 LATIN CAPITAL LETTER A WITH CIRCUMFLEX\n" +
+	"C3 LATIN CAPITAL LETTER A WITH TILDE\n" +
+	"C4 LATIN CAPITAL LETTER A WITH DIAERESIS\n" +
+	"C5 LATIN CAPITAL LETTER A WITH RING ABOVE\n" +
+	"C6 LATIN CAPITAL LETTER AE\n" +
+	"C7 LATIN CAPITAL LETTER C WITH CEDILLA\n" +
+	"C8 LATIN CAPITAL LETTER E WITH GRAVE\n" +
+	"C9 LATIN CAPITAL LETTER E WITH ACUTE\n" +
+	"CA LATIN CAPITAL LETTER E WITH CIRCUMFLEX\n" +
+	"CB LATIN CAPITAL LETTER E WITH DIAERESIS\n" +
+	"CC LATIN CAPITAL LETTER I WITH GRAVE\n" +
+	"CD LATIN CAPITAL LETTER I WITH ACUTE\n" +
+	"CE LATIN CAPITAL LETTER I WITH CIRCUMFLEX\n" +
+	"CF LATIN CAPITAL LETTER I WITH DIAERESIS\n" +
+	"D0 LATIN CAPITAL LETTER ETH\n" +
+	"D1 LATIN CAPITAL LETTER N WITH TILDE\n" +
+	"D2 LATIN CAPITAL LETTER O WITH GRAVE\n" +
+	"D3 LATIN CAPITAL LETTER O WITH ACUTE\n" +
+	"D4 LATIN CAPITAL LETTER O WITH CIRCUMFLEX\n" +
+	"D5 LATIN CAPITAL LETTER O WITH TILDE\n" +
+	"D6 LATIN CAPITAL LETTER O WITH DIAERESIS\n" +
+	"D7 MULTIPLICATION SIGN\n" +
+	"D8 LATIN CAPITAL LETTER O WITH STROKE\n" +
+	"D9 LATIN CAPITAL LETTER U WITH GRAVE\n" +
+	"DA LATIN CAPITAL LETTER U WITH ACUTE\n" +
+	"DB LATIN CAPITAL LETTER U WITH CIRCUMFLEX\n" +
+	"DC LATIN CAPITAL LETTER U WITH DIAERESIS\n" +
+	"DD LATIN CAPITAL LETTER Y WITH ACUTE\n" +
+	"DE LATIN CAPITAL LETTER THORN\n" +
+	"DF LATIN SMALL LETTER SHARP S\n" +
+	"E0 LATIN SMALL LETTER A WITH GRAVE\n" +
+	"E1 LATIN SMALL LETTER A WITH ACUTE\n" +
+	"E2 LATIN SMALL LETTER A WITH CIRCUMFLEX\n" +
+	"E3 LATIN SMALL LETTER A WITH TILDE\n" +
+	"E4 LATIN SMALL LETTER A WITH DIAERESIS\n" +
+	"E5 LATIN SMALL LETTER A WITH RING ABOVE\n" +
+	"E6 LATIN SMALL LETTER AE\n" +
+	"E7 LATIN SMALL LETTER C WITH CEDILLA\n" +
+	"E8 LATIN SMALL LETTER E WITH GRAVE\n" +
+	"E9 LATIN SMALL LETTER E WITH ACUTE\n" +
+	"EA LATIN SMALL LETTER E WITH CIRCUMFLEX\n" +
+	"EB LATIN SMALL LETTER E WITH DIAERESIS\n" +
+	"EC LATIN SMALL LETTER I WITH GRAVE\n" +
+	"ED LATIN SMALL LETTER I WITH ACUTE\n" +
+	"EE LATIN SMALL LETTER I WITH CIRCUMFLEX\n" +
+	"EF LATIN SMALL LETTER I WITH DIAERESIS\n" +
+	"F0 LATIN SMALL LETTER ETH\n" +
+	"F1 LATIN SMALL LETTER N WITH TILDE\n" +
+	"F2 LATIN SMALL LETTER O WITH GRAVE\n" +
+	"F3 LATIN SMALL LETTER O WITH ACUTE\n" +
+	"F4 LATIN SMALL LETTER O WITH CIRCUMFLEX\n" +
+	"F5 LATIN SMALL LETTER O WITH TILDE\n" +
+	"F6 LATIN SMALL LETTER O WITH DIAERESIS\n" +
+	"F7 DIVISION SIGN\n" +
+	"F8 LATIN SMALL LETTER O WITH STROKE\n" +
+	"F9 LATIN SMALL LETTER U WITH GRAVE\n" +
+	"FA LATIN SMALL LETTER U WITH ACUTE\n" +
+	"FB LATIN SMALL LETTER U WITH CIRCUMFLEX\n" +
+	"FC LATIN SMALL LETTER U WITH DIAERESIS\n" +
+	"FD LATIN SMALL LETTER Y WITH ACUTE\n" +
+	"FE LATIN SMALL LETTER THORN\n" +
+	"FF LATIN SMALL LETTER Y WITH DIAERESIS\n" +
+	"100 LATIN CAPITAL LETTER A WITH MACRON\n" +
+	"101 LATIN SMALL LETTER A WITH MACRON\n" +
+	"102 LATIN CAPITAL LETTER A WITH BREVE\n" +
+	"103 LATIN SMALL LETTER A WITH BREVE\n" +
+	"104 LATIN CAPITAL LETTER A WITH OGONEK\n" +
+	"105 LATIN SMALL LETTER A WITH OGONEK\n" +
+	"106 LATIN CAPITAL LETTER C WITH ACUTE\n" +
+	"107 LATIN SMALL LETTER C WITH ACUTE\n" +
+	"108 LATIN CAPITAL LETTER C WITH CIRCUMFLEX\n" +
+	"109 LATIN SMALL LETTER C WITH CIRCUMFLEX\n" +
+	"10A LATIN CAPITAL LETTER C WITH DOT ABOVE\n" +
+	"10B LATIN SMALL LETTER C WITH DOT ABOVE\n" +
+	"10C LATIN CAPITAL LETTER C WITH CARON\n" +
+	"10D LATIN SMALL LETTER C WITH CARON\n" +
+	"10E LATIN CAPITAL LETTER D WITH CARON\n" +
+	"10F LATIN SMALL LETTER D WITH CARON\n" +
+	"110 LATIN CAPITAL LETTER D WITH STROKE\n" +
+	"111 LATIN SMALL LETTER D WITH STROKE\n" +
+	"112 LATIN CAPITAL LETTER E WITH MACRON\n" +
+	"113 LATIN SMALL LETTER E WITH MACRON\n" +
+	"114 LATIN CAPITAL LETTER E WITH BREVE\n" +
+	"115 LATIN SMALL LETTER E WITH BREVE\n" +
+	"116 LATIN CAPITAL LETTER E WITH DOT ABOVE\n" +
+	"117 LATIN SMALL LETTER E WITH DOT ABOVE\n" +
+	"118 LATIN CAPITAL LETTER E WITH OGONEK\n" +
+	"119 LATIN SMALL LETTER E WITH OGONEK\n" +
+	"11A LATIN CAPITAL LETTER E WITH CARON\n" +
+	"11B LATIN SMALL LETTER E WITH CARON\n" +
+	"11C LATIN CAPITAL LETTER G WITH CIRCUMFLEX\n" +
+	"11D LATIN SMALL LETTER G WITH CIRCUMFLEX\n" +
+	"11E LATIN CAPITAL LETTER G WITH BREVE\n" +
+	"11F LATIN SMALL LETTER G WITH BREVE\n" +
+	"120 LATIN CAPITAL LETTER G WITH DOT ABOVE\n" +
+	"121 LATIN SMALL LETTER G WITH DOT ABOVE\n" +
+	"122 LATIN CAPITAL LETTER G WITH CEDILLA\n" +
+	"123 LATIN SMALL LETTER G WITH CEDILLA\n" +
+	"124 LATIN CAPITAL LETTER H WITH CIRCUMFLEX\n" +
+	"125 LATIN SMALL LETTER H WITH CIRCUMFLEX\n" +
+	"126 LATIN CAPITAL LETTER H WITH STROKE\n" +
+	"127 LATIN SMALL LETTER H WITH STROKE\n" +
+	"128 LATIN CAPITAL LETTER I WITH TILDE\n" +
+	"129 LATIN SMALL LETTER I WITH TILDE\n" +
+	"12A LATIN CAPITAL LETTER I WITH MACRON\n" +
+	"12B LATIN SMALL LETTER I WITH MACRON\n" +
+	"12C LATIN CAPITAL LETTER I WITH BREVE\n" +
+	"12D LATIN SMALL LETTER I WITH BREVE\n" +
+	"12E LATIN CAPITAL LETTER I WITH OGONEK\n" +
+	"12F LATIN SMALL LETTER I WITH OGONEK\n" +
+	"130 LATIN CAPITAL LETTER I WITH DOT ABOVE\n" +
+	"131 LATIN SMALL LETTER DOTLESS I\n" +
+	"132 LATIN CAPITAL LIGATURE IJ\n" +
+	"133 LATIN SMALL LIGATURE IJ\n" +
+	"134 LATIN CAPITAL LETTER J WITH CIRCUMFLEX\n" +
+	"135 LATIN SMALL LETTER J WITH CIRCUMFLEX\n" +
+	"136 LATIN CAPITAL LETTER K WITH CEDILLA\n" +
+	"137 LATIN SMALL LETTER K WITH CEDILLA\n" +
+	"138 LATIN SMALL LETTER KRA\n" +
+	"139 LATIN CAPITAL LETTER L WITH ACUTE\n" +
+	"13A LATIN SMALL LETTER L WITH ACUTE\n" +
+	"13B LATIN CAPITAL LETTER L WITH CEDILLA\n" +
+	"13C LATIN SMALL LETTER L WITH CEDILLA\n" +
+	"13D LATIN CAPITAL LETTER L WITH CARON\n" +
+	"13E LATIN SMALL LETTER L WITH CARON\n" +
+	"13F LATIN CAPITAL LETTER L WITH MIDDLE DOT\n" +
+	"140 LATIN SMALL LETTER L WITH MIDDLE DOT\n" +
+	"141 LATIN CAPITAL LETTER L WITH STROKE\n" +
+	"142 LATIN SMALL LETTER L WITH STROKE\n" +
+	"143 LATIN CAPITAL LETTER N WITH ACUTE\n" +
+	"144 LATIN SMALL LETTER N WITH ACUTE\n" +
+	"145 LATIN CAPITAL LETTER N WITH CEDILLA\n" +
+	"146 LATIN SMALL LETTER N WITH CEDILLA\n" +
+	"147 LATIN CAPITAL LETTER N WITH CARON\n" +
+	"148 LATIN SMALL LETTER N WITH CARON\n" +
+	"149 LATIN SMALL LETTER N PRECEDED BY APOSTROPHE\n" +
+	"14A LATIN CAPITAL LETTER ENG\n" +
+	"14B LATIN SMALL LETTER ENG\n" +
+	"14C LATIN CAPITAL LETTER O WITH MACRON\n" +
+	"14D LATIN SMALL LETTER O WITH MACRON\n" +
+	"14E LATIN CAPITAL LETTER O WITH BREVE\n" +
+	"14F LATIN SMALL LETTER O WITH BREVE\n" +
+	"150 LATIN CAPITAL LETTER O WITH DOUBLE ACUTE\n" +
+	"151 LATIN SMALL LETTER O WITH DOUBLE ACUTE\n" +
+	"152 LATIN CAPITAL LIGATURE OE\n" +
+	"153 LATIN SMALL LIGATURE OE\n" +
+	"154 LATIN CAPITAL LETTER R WITH ACUTE\n" +
+	"155 LATIN SMALL LETTER R WITH ACUTE\n" +
+	"156 LATIN CAPITAL LETTER R WITH CEDILLA\n" +
+	"157 LATIN SMALL LETTER R WITH CEDILLA\n" +
+	"158 LATIN CAPITAL LETTER R WITH CARON\n" +
+	"159 LATIN SMALL LETTER R WITH CARON\n" +
+	"15A LATIN CAPITAL LETTER S WITH ACUTE\n" +
+	"15B LATIN SMALL LETTER S WITH ACUTE\n" +
+	"15C LATIN CAPITAL LETTER S WITH CIRCUMFLEX\n" +
+	"15D LATIN SMALL LETTER S WITH CIRCUMFLEX\n" +
+	"15E LATIN CAPITAL LETTER S WITH CEDILLA\n" +
+	"15F LATIN SMALL LETTER S WITH CEDILLA\n" +
+	"160 LATIN CAPITAL LETTER S WITH CARON\n" +
+	"161 LATIN SMALL LETTER S WITH CARON\n" +
+	"162 LATIN CAPITAL LETTER T WITH CEDILLA\n" +
+	"163 LATIN SMALL LETTER T WITH CEDILLA\n" +
+	"164 LATIN CAPITAL LETTER T WITH CARON\n" +
+	"165 LATIN SMALL LETTER T WITH CARON\n" +
+	"166 LATIN CAPITAL LETTER T WITH STROKE\n" +
+	"167 LATIN SMALL LETTER T WITH STROKE\n" +
+	"168 LATIN CAPITAL LETTER U WITH TILDE\n" +
+	"169 LATIN SMALL LETTER U WITH TILDE\n" +
+	"16A LATIN CAPITAL LETTER U WITH MACRON\n" +
+	"16B LATIN SMALL LETTER U WITH MACRON\n" +
+	"16C LATIN CAPITAL LETTER U WITH BREVE\n" +
+	"16D LATIN SMALL LETTER U WITH BREVE\n" +
+	"16E LATIN CAPITAL LETTER U WITH RING ABOVE\n" +
+	"16F LATIN SMALL LETTER U WITH RING ABOVE\n" +
+	"170 LATIN CAPITAL LETTER U WITH DOUBLE ACUTE\n" +
+	"171 LATIN SMALL LETTER U WITH DOUBLE ACUTE\n" +
+	"172 LATIN CAPITAL LETTER U WITH OGONEK\n" +
+	"173 LATIN SMALL LETTER U WITH OGONEK\n" +
+	"174 LATIN CAPITAL LETTER W WITH CIRCUMFLEX\n" +
+	"175 LATIN SMALL LETTER W WITH CIRCUMFLEX\n" +
+	"176 LATIN CAPITAL LETTER Y WITH CIRCUMFLEX\n" +
+	"177 LATIN SMALL LETTER Y WITH CIRCUMFLEX\n" +
+	"178 LATIN CAPITAL LETTER Y WITH DIAERESIS\n" +
+	"179 LATIN CAPITAL LETTER Z WITH ACUTE\n" +
+	"17A LATIN SMALL LETTER Z WITH ACUTE\n" +
+	"17B LATIN CAPITAL LETTER Z WITH DOT ABOVE\n" +
+	"17C LATIN SMALL LETTER Z WITH DOT ABOVE\n" +
+	"17D LATIN CAPITAL LETTER Z WITH CARON\n" +
+	"17E LATIN SMALL LETTER Z WITH CARON\n" +
+	"17F LATIN SMALL LETTER LONG S\n" +
+	"180 LATIN SMALL LETTER B WITH STROKE\n" +
+	"181 LATIN CAPITAL LETTER B WITH HOOK\n" +
+	"182 LATIN CAPITAL LETTER B WITH TOPBAR\n" +
+	"183 LATIN SMALL LETTER B WITH TOPBAR\n" +
+	"184 LATIN CAPITAL LETTER TONE SIX\n" +
+	"185 LATIN SMALL LETTER TONE SIX\n" +
+	"186 LATIN CAPITAL LETTER OPEN O\n" +
+	"187 LATIN CAPITAL LETTER C WITH HOOK\n" +
+	"188 LATIN SMALL LETTER C WITH HOOK\n" +
+	"189 LATIN CAPITAL LETTER AFRICAN D\n" +
+	"18A LATIN CAPITAL LETTER D WITH HOOK\n" +
+	"18B LATIN CAPITAL LETTER D WITH TOPBAR\n" +
+	"18C LATIN SMALL LETTER D WITH TOPBAR\n" +
+	"18D LATIN SMALL LETTER TURNED DELTA\n" +
+	"18E LATIN CAPITAL LETTER REVERSED E\n" +
+	"18F LATIN CAPITAL LETTER SCHWA\n" +
+	"190 LATIN CAPITAL LETTER OPEN E\n" +
+	"191 LATIN CAPITAL LETTER F WITH HOOK\n" +
+	"192 LATIN SMALL LETTER F WITH HOOK\n" +
+	"193 LATIN CAPITAL LETTER G WITH HOOK\n" +
+	"194 LATIN CAPITAL LETTER GAMMA\n" +
+	"195 LATIN SMALL LETTER HV\n" +
+	"196 LATIN CAPITAL LETTER IOTA\n" +
+	"197 LATIN CAPITAL LETTER I WITH STROKE\n" +
+	"198 LATIN CAPITAL LETTER K WITH HOOK\n" +
+	"199 LATIN SMALL LETTER K WITH HOOK\n" +
+	"19A LATIN SMALL LETTER L WITH BAR\n" +
+	"19B LATIN SMALL LETTER LAMBDA WITH STROKE\n" +
+	"19C LATIN CAPITAL LETTER TURNED M\n" +
+	"19D LATIN CAPITAL LETTER N WITH LEFT HOOK\n" +
+	"19E LATIN SMALL LETTER N WITH LONG RIGHT LEG\n" +
+	"19F LATIN CAPITAL LETTER O WITH MIDDLE TILDE\n" +
+	"1A0 LATIN CAPITAL LETTER O WITH HORN\n" +
+	"1A1 LATIN SMALL LETTER O WITH HORN\n" +
+	"1A2 LATIN CAPITAL LETTER OI\n" +
+	"1A3 LATIN SMALL LETTER OI\n" +
+	"1A4 LATIN CAPITAL LETTER P WITH HOOK\n" +
+	"1A5 LATIN SMALL LETTER P WITH HOOK\n" +
+	"1A6 LATIN LETTER YR\n" +
+	"1A7 LATIN CAPITAL LETTER TONE TWO\n" +
+	"1A8 LATIN SMALL LETTER TONE TWO\n" +
+	"1A9 LATIN CAPITAL LETTER ESH\n" +
+	"1AA LATIN LETTER REVERSED ESH LOOP\n" +
+	"1AB LATIN SMALL LETTER T WITH PALATAL HOOK\n" +
+	"1AC LATIN CAPITAL LETTER T WITH HOOK\n" +
+	"1AD LATIN SMALL LETTER T WITH HOOK\n" +
+	"1AE LATIN CAPITAL LETTER T WITH RETROFLEX HOOK\n" +
+	"1AF LATIN CAPITAL LETTER U WITH HORN\n" +
+	"1B0 LATIN SMALL LETTER U WITH HORN\n" +
+	"1B1 LATIN CAPITAL LETTER UPSILON\n" +
+	"1B2 LATIN CAPITAL LETTER V WITH HOOK\n" +
+	"1B3 LATIN CAPITAL LETTER Y WITH HOOK\n" +
+	"1B4 LATIN SMALL LETTER Y WITH HOOK\n" +
+	"1B5 LATIN CAPITAL LETTER Z WITH STROKE\n" +
+	"1B6 LATIN SMALL LETTER Z WITH STROKE\n" +
+	"1B7 LATIN CAPITAL LETTER EZH\n" +
+	"1B8 LATIN CAPITAL LETTER EZH REVERSED\n" +
+	"1B9 LATIN SMALL LETTER EZH REVERSED\n" +
+	"1BA LATIN SMALL LETTER EZH WITH TAIL\n" +
+	"1BB LATIN LETTER TWO WITH STROKE\n" +
+	"1BC LATIN CAPITAL LETTER TONE FIVE\n" +
+	"1BD LATIN SMALL LETTER TONE FIVE\n" +
+	"1BE LATIN LETTER INVERTED GLOTTAL STOP WITH STROKE\n" +
+	"1BF LATIN LETTER WYNN\n" +
+	"1C0 LATIN LETTER DENTAL CLICK\n" +
+	"1C1 LATIN LETTER LATERAL CLICK\n" +
+	"1C2 LATIN LETTER ALVEOLAR CLICK\n" +
+	"1C3 LATIN LETTER RETROFLEX CLICK\n" +
+	"1C4 LATIN CAPITAL LETTER DZ WITH CARON\n" +
+	"1C5 LATIN CAPITAL LETTER D WITH SMALL LETTER Z WITH CARON\n" +
+	"1C6 LATIN SMALL LETTER DZ WITH CARON\n" +
+	"1C7 LATIN CAPITAL LETTER LJ\n" +
+	"1C8 LATIN CAPITAL LETTER L WITH SMALL LETTER J\n" +
+	"1C9 LATIN SMALL LETTER LJ\n" +
+	"1CA LATIN CAPITAL LETTER NJ\n" +
+	"1CB LATIN CAPITAL LETTER N WITH SMALL LETTER J\n" +
+	"1CC LATIN SMALL LETTER NJ\n" +
+	"1CD LATIN CAPITAL LETTER A WITH CARON\n" +
+	"1CE LATIN SMALL LETTER A WITH CARON\n" +
+	"1CF LATIN CAPITAL LETTER I WITH CARON\n" +
+	"1D0 LATIN SMALL LETTER I WITH CARON\n" +
+	"1D1 LATIN CAPITAL LETTER O WITH CARON\n" +
+	"1D2 LATIN SMALL LETTER O WITH CARON\n" +
+	"1D3 LATIN CAPITAL LETTER U WITH CARON\n" +
+	"1D4 LATIN SMALL LETTER U WITH CARON\n" +
+	"1D5 LATIN CAPITAL LETTER U WITH DIAERESIS AND MACRON\n" +
+	"1D6 LATIN SMALL LETTER U WITH DIAERESIS AND MACRON\n" +
+	"1D7 LATIN CAPITAL LETTER U WITH DIAERESIS AND ACUTE\n" +
+	"1D8 LATIN SMALL LETTER U WITH DIAERESIS AND ACUTE\n" +
+	"1D9 LATIN CAPITAL LETTER U WITH DIAERESIS AND CARON\n" +
+	"1DA LATIN SMALL LETTER U WITH DIAERESIS AND CARON\n" +
+	"1DB LATIN CAPITAL LETTER U WITH DIAERESIS AND GRAVE\n" +
+	"1DC LATIN SMALL LETTER U WITH DIAERESIS AND GRAVE\n" +
+	"1DD LATIN SMALL LETTER TURNED E\n" +
+	"1DE LATIN CAPITAL LETTER A WITH DIAERESIS AND MACRON\n" +
+	"1DF LATIN SMALL LETTER A WITH DIAERESIS AND MACRON\n" +
+	"1E0 LATIN CAPITAL LETTER A WITH DOT ABOVE AND MACRON\n" +
+	"1E1 LATIN SMALL LETTER A WITH DOT ABOVE AND MACRON\n" +
+	"1E2 LATIN CAPITAL LETTER AE WITH MACRON\n" +
+	"1E3 LATIN SMALL LETTER AE WITH MACRON\n" +
+	"1E4 LATIN CAPITAL LETTER G WITH STROKE\n" +
+	"1E5 LATIN SMALL LETTER G WITH STROKE\n" +
+	"1E6 LATIN CAPITAL LETTER G WITH CARON\n" +
+	"1E7 LATIN SMALL LETTER G WITH CARON\n" +
+	"1E8 LATIN CAPITAL LETTER K WITH CARON\n" +
+	"1E9 LATIN SMALL LETTER K WITH CARON\n" +
+	"1EA LATIN CAPITAL LETTER O WITH OGONEK\n" +
+	"1EB LATIN SMALL LETTER O WITH OGONEK\n" +
+	"1EC LATIN CAPITAL LETTER O WITH OGONEK AND MACRON\n" +
+	"1ED LATIN SMALL LETTER O WITH OGONEK AND MACRON\n" +
+	"1EE LATIN CAPITAL LETTER EZH WITH CARON\n" +
+	"1EF LATIN SMALL LETTER EZH WITH CARON\n" +
+	"1F0 LATIN SMALL LETTER J WITH CARON\n" +
+	"1F1 LATIN CAPITAL LETTER DZ\n" +
+	"1F2 LATIN CAPITAL LETTER D WITH SMALL LETTER Z\n" +
+	"1F3 LATIN SMALL LETTER DZ\n" +
+	"1F4 LATIN CAPITAL LETTER G WITH ACUTE\n" +
+	"1F5 LATIN SMALL LETTER G WITH ACUTE\n" +
+	"1F6 LATIN CAPITAL LETTER HWAIR\n" +
+	"1F7 LATIN CAPITAL LETTER WYNN\n" +
+	"1F8 LATIN CAPITAL LETTER N WITH GRAVE\n" +
+	"1F9 LATIN SMALL LETTER N WITH GRAVE\n" +
+	"1FA LATIN CAPITAL LETTER A WITH RING ABOVE AND ACUTE\n" +
+	"1FB LATIN SMALL LETTER A WITH RING ABOVE AND ACUTE\n" +
+	"1FC LATIN CAPITAL LETTER AE WITH ACUTE\n" +
+	"1FD LATIN SMALL LETTER AE WITH ACUTE\n" +
+	"1FE LATIN CAPITAL LETTER O WITH STROKE AND ACUTE\n" +
+	"1FF LATIN SMALL LETTER O WITH STROKE AND ACUTE\n" +
+	"200 LATIN CAPITAL LETTER A WITH DOUBLE GRAVE\n" +
+	"201 LATIN SMALL LETTER A WITH DOUBLE GRAVE\n" +
+	"202 LATIN CAPITAL LETTER A WITH INVERTED BREVE\n" +
+	"203 LATIN SMALL LETTER A WITH INVERTED BREVE\n" +
+	"204 LATIN CAPITAL LETTER E WITH DOUBLE GRAVE\n" +
+	"205 LATIN SMALL LETTER E WITH DOUBLE GRAVE\n" +
+	"206 LATIN CAPITAL LETTER E WITH INVERTED BREVE\n" +
+	"207 LATIN SMALL LETTER E WITH INVERTED BREVE\n" +
+	"208 LATIN CAPITAL LETTER I WITH DOUBLE GRAVE\n" +
+	"209 LATIN SMALL LETTER I WITH DOUBLE GRAVE\n" +
+	"20A LATIN CAPITAL LETTER I WITH INVERTED BREVE\n" +
+	"20B LATIN SMALL LETTER I WITH INVERTED BREVE\n" +
+	"20C LATIN CAPITAL LETTER O WITH DOUBLE GRAVE\n" +
+	"20D LATIN SMALL LETTER O WITH DOUBLE GRAVE\n" +
+	"20E LATIN CAPITAL LETTER O WITH INVERTED BREVE\n" +
+	"20F LATIN SMALL LETTER O WITH INVERTED BREVE\n" +
+	"210 LATIN CAPITAL LETTER R WITH DOUBLE GRAVE\n" +
+	"211 LATIN SMALL LETTER R WITH DOUBLE GRAVE\n" +
+	"212 LATIN CAPITAL LETTER R WITH INVERTED BREVE\n" +
+	"213 LATIN SMALL LETTER R WITH INVERTED BREVE\n" +
+	"214 LATIN CAPITAL LETTER U WITH DOUBLE GRAVE\n" +
+	"215 LATIN SMALL LETTER U WITH DOUBLE GRAVE\n" +
+	"216 LATIN CAPITAL LETTER U WITH INVERTED BREVE\n" +
+	"217 LATIN SMALL LETTER U WITH INVERTED BREVE\n" +
+	"218 LATIN CAPITAL LETTER S WITH COMMA BELOW\n" +
+	"219 LATIN SMALL LETTER S WITH COMMA BELOW\n" +
+	"21A LATIN CAPITAL LETTER T WITH COMMA BELOW\n" +
+	"21B LATIN SMALL LETTER T WITH COMMA BELOW\n" +
+	"21C LATIN CAPITAL LETTER YOGH\n" +
+	"21D LATIN SMALL LETTER YOGH\n" +
+	"21E LATIN CAPITAL LETTER H WITH CARON\n" +
+	"21F LATIN SMALL LETTER H WITH CARON\n" +
+	"220 LATIN CAPITAL LETTER N WITH LONG RIGHT LEG\n" +
+	"221 LATIN SMALL LETTER D WITH CURL\n" +
+	"222 LATIN CAPITAL LETTER OU\n" +
+	"223 LATIN SMALL LETTER OU\n" +
+	"224 LATIN CAPITAL LETTER Z WITH HOOK\n" +
+	"225 LATIN SMALL LETTER Z WITH HOOK\n" +
+	"226 LATIN CAPITAL LETTER A WITH DOT ABOVE\n" +
+	"227 LATIN SMALL LETTER A WITH DOT ABOVE\n" +
+	"228 LATIN CAPITAL LETTER E WITH CEDILLA\n" +
+	"229 LATIN SMALL LETTER E WITH CEDILLA\n" +
+	"22A LATIN CAPITAL LETTER O WITH DIAERESIS AND MACRON\n" +
+	"22B LATIN SMALL LETTER O WITH DIAERESIS AND MACRON\n" +
+	"22C LATIN CAPITAL LETTER O WITH TILDE AND MACRON\n" +
+	"22D LATIN SMALL LETTER O WITH TILDE AND MACRON\n" +
+	"22E LATIN CAPITAL LETTER O WITH DOT ABOVE\n" +
+	"22F LATIN SMALL LETTER O WITH DOT ABOVE\n" +
+	"230 LATIN CAPITAL LETTER O WITH DOT ABOVE AND MACRON\n" +
+	"231 LATIN SMALL LETTER O WITH DOT ABOVE AND MACRON\n" +
+	"232 LATIN CAPITAL LETTER Y WITH MACRON\n" +
+	"233 LATIN SMALL LETTER Y WITH MACRON\n" +
+	"234 LATIN SMALL LETTER L WITH CURL\n" +
+	"235 LATIN SMALL LETTER N WITH CURL\n" +
+	"236 LATIN SMALL LETTER T WITH CURL\n" +
+	"237 LATIN SMALL LETTER DOTLESS J\n" +
+	"238 LATIN SMALL LETTER DB DIGRAPH\n" +
+	"239 LATIN SMALL LETTER QP DIGRAPH\n" +
+	"23A LATIN CAPITAL LETTER A WITH STROKE\n" +
+	"23B LATIN CAPITAL LETTER C WITH STROKE\n" +
+	"23C LATIN SMALL LETTER C WITH STROKE\n" +
+	"23D LATIN CAPITAL LETTER L WITH BAR\n" +
+	"23E LATIN CAPITAL LETTER T WITH DIAGONAL STROKE\n" +
+	"23F LATIN SMALL LETTER S WITH SWASH TAIL\n" +
+	"240 LATIN SMALL LETTER Z WITH SWASH TAIL\n" +
+	"241 LATIN CAPITAL LETTER GLOTTAL STOP\n" +
+	"242 LATIN SMALL LETTER GLOTTAL STOP\n" +
+	"243 LATIN CAPITAL LETTER B WITH STROKE\n" +
+	"244 LATIN CAPITAL LETTER U BAR\n" +
+	"245 LATIN CAPITAL LETTER TURNED V\n" +
+	"246 LATIN CAPITAL LETTER E WITH STROKE\n" +
+	"247 LATIN SMALL LETTER E WITH STROKE\n" +
+	"248 LATIN CAPITAL LETTER J WITH STROKE\n" +
+	"249 LATIN SMALL LETTER J WITH STROKE\n" +
+	"24A LATIN CAPITAL LETTER SMALL Q WITH HOOK TAIL\n" +
+	"24B LATIN SMALL LETTER Q WITH HOOK TAIL\n" +
+	"24C LATIN CAPITAL LETTER R WITH STROKE\n" +
+	"24D LATIN SMALL LETTER R WITH STROKE\n" +
+	"24E LATIN CAPITAL LETTER Y WITH STROKE\n" +
+	"24F LATIN SMALL LETTER Y WITH STROKE\n" +
+	"250 LATIN SMALL LETTER TURNED A\n" +
+	"251 LATIN SMALL LETTER ALPHA\n" +
+	"252 LATIN SMALL LETTER TURNED ALPHA\n" +
+	"253 LATIN SMALL LETTER B WITH HOOK\n" +
+	"254 LATIN SMALL LETTER OPEN O\n" +
+	"255 LATIN SMALL LETTER C WITH CURL\n" +
+	"256 LATIN SMALL LETTER D WITH TAIL\n" +
+	"257 LATIN SMALL LETTER D WITH HOOK\n" +
+	"258 LATIN SMALL LETTER REVERSED E\n" +
+	"259 LATIN SMALL LETTER SCHWA\n" +
+	"25A LATIN SMALL LETTER SCHWA WITH HOOK\n" +
+	"25B LATIN SMALL LETTER OPEN E\n" +
+	"25C LATIN SMALL LETTER REVERSED OPEN E\n" +
+	"25D LATIN SMALL LETTER REVERSED OPEN E WITH HOOK\n" +
+	"25E LATIN SMALL LETTER CLOSED REVERSED OPEN E\n" +
+	"25F LATIN SMALL LETTER DOTLESS J WITH STROKE\n" +
+	"260 LATIN SMALL LETTER G WITH HOOK\n" +
+	"261 LATIN SMALL LETTER SCRIPT G\n" +
+	"262 LATIN LETTER SMALL CAPITAL G\n" +
+	"263 LATIN SMALL LETTER GAMMA\n" +
+	"264 LATIN SMALL LETTER RAMS HORN\n" +
+	"265 LATIN SMALL LETTER TURNED H\n" +
+	"266 LATIN SMALL LETTER H WITH HOOK\n" +
+	"267 LATIN SMALL LETTER HENG WITH HOOK\n" +
+	"268 LATIN SMALL LETTER I WITH STROKE\n" +
+	"269 LATIN SMALL LETTER IOTA\n" +
+	"26A LATIN LETTER SMALL CAPITAL I\n" +
+	"26B LATIN SMALL LETTER L WITH MIDDLE TILDE\n" +
+	"26C LATIN SMALL LETTER L WITH BELT\n" +
+	"26D LATIN SMALL LETTER L WITH RETROFLEX HOOK\n" +
+	"26E LATIN SMALL LETTER LEZH\n" +
+	"26F LATIN SMALL LETTER TURNED M\n" +
+	"270 LATIN SMALL LETTER TURNED M WITH LONG LEG\n" +
+	"271 LATIN SMALL LETTER M WITH HOOK\n" +
+	"272 LATIN SMALL LETTER N WITH LEFT HOOK\n" +
+	"273 LATIN SMALL LETTER N WITH RETROFLEX HOOK\n" +
+	"274 LATIN LETTER SMALL CAPITAL N\n" +
+	"275 LATIN SMALL LETTER BARRED O\n" +
+	"276 LATIN LETTER SMALL CAPITAL OE\n" +
+	"277 LATIN SMALL LETTER CLOSED OMEGA\n" +
+	"278 LATIN SMALL LETTER PHI\n" +
+	"279 LATIN SMALL LETTER TURNED R\n" +
+	"27A LATIN SMALL LETTER TURNED R WITH LONG LEG\n" +
+	"27B LATIN SMALL LETTER TURNED R WITH HOOK\n" +
+	"27C LATIN SMALL LETTER R WITH LONG LEG\n" +
+	"27D LATIN SMALL LETTER R WITH TAIL\n" +
+	"27E LATIN SMALL LETTER R WITH FISHHOOK\n" +
+	"27F LATIN SMALL LETTER REVERSED R WITH FISHHOOK\n" +
+	"280 LATIN LETTER SMALL CAPITAL R\n" +
+	"281 LATIN LETTER SMALL CAPITAL INVERTED R\n" +
+	"282 LATIN SMALL LETTER S WITH HOOK\n" +
+	"283 LATIN SMALL LETTER ESH\n" +
+	"284 LATIN SMALL LETTER DOTLESS J WITH STROKE AND HOOK\n" +
+	"285 LATIN SMALL LETTER SQUAT REVERSED ESH\n" +
+	"286 LATIN SMALL LETTER ESH WITH CURL\n" +
+	"287 LATIN SMALL LETTER TURNED T\n" +
+	"288 LATIN SMALL LETTER T WITH RETROFLEX HOOK\n" +
+	"289 LATIN SMALL LETTER U BAR\n" +
+	"28A LATIN SMALL LETTER UPSILON\n" +
+	"28B LATIN SMALL LETTER V WITH HOOK\n" +
+	"28C LATIN SMALL LETTER TURNED V\n" +
+	"28D LATIN SMALL LETTER TURNED W\n" +
+	"28E LATIN SMALL LETTER TURNED Y\n" +
+	"28F LATIN LETTER SMALL CAPITAL Y\n" +
+	"290 LATIN SMALL LETTER Z WITH RETROFLEX HOOK\n" +
+	"291 LATIN SMALL LETTER Z WITH CURL\n" +
+	"292 LATIN SMALL LETTER EZH\n" +
+	"293 LATIN SMALL LETTER EZH WITH CURL\n" +
+	"294 LATIN LETTER GLOTTAL STOP\n" +
+	"295 LATIN LETTER PHARYNGEAL VOICED FRICATIVE\n" +
+	"296 LATIN LETTER INVERTED GLOTTAL STOP\n" +
+	"297 LATIN LETTER STRETCHED C\n" +
+	"298 LATIN LETTER BILABIAL CLICK\n" +
+	"299 LATIN LETTER SMALL CAPITAL B\n" +
+	"29A LATIN SMALL LETTER CLOSED OPEN E\n" +
+	"29B LATIN LETTER SMALL CAPITAL G WITH HOOK\n" +
+	"29C LATIN LETTER SMALL CAPITAL H\n" +
+	"29D LATIN SMALL LETTER J WITH CROSSED-TAIL\n" +
+	"29E LATIN SMALL LETTER TURNED K\n" +
+	"29F LATIN LETTER SMALL CAPITAL L\n" +
+	"2A0 LATIN SMALL LETTER Q WITH HOOK\n" +
+	"2A1 LATIN LETTER GLOTTAL STOP WITH STROKE\n" +
+	"2A2 LATIN LETTER REVERSED GLOTTAL STOP WITH STROKE\n" +
+	"2A3 LATIN SMALL LETTER DZ DIGRAPH\n" +
+	"2A4 LATIN SMALL LETTER DEZH DIGRAPH\n" +
+	"2A5 LATIN SMALL LETTER DZ DIGRAPH WITH CURL\n" +
+	"2A6 LATIN SMALL LETTER TS DIGRAPH\n" +
+	"2A7 LATIN SMALL LETTER TESH DIGRAPH\n" +
+	"2A8 LATIN SMALL LETTER TC DIGRAPH WITH CURL\n" +
+	"2A9 LATIN SMALL LETTER FENG DIGRAPH\n" +
+	"2AA LATIN SMALL LETTER LS DIGRAPH\n" +
+	"2AB LATIN SMALL LETTER LZ DIGRAPH\n" +
+	"2AC LATIN LETTER BILABIAL PERCUSSIVE\n" +
+	"2AD LATIN LETTER BIDENTAL PERCUSSIVE\n" +
+	"2AE LATIN SMALL LETTER TURNED H WITH FISHHOOK\n" +
+	"2AF LATIN SMALL LETTER TURNED H WITH FISHHOOK AND TAIL\n" +
+	"2B0 MODIFIER LETTER SMALL H\n" +
+	"2B1 MODIFIER LETTER SMALL H WITH HOOK\n" +
+	"2B2 MODIFIER LETTER SMALL J\n" +
+	"2B3 MODIFIER LETTER SMALL R\n" +
+	"2B4 MODIFIER LETTER SMALL TURNED R\n" +
+	"2B5 MODIFIER LETTER SMALL TURNED R WITH HOOK\n" +
+	"2B6 MODIFIER LETTER SMALL CAPITAL INVERTED R\n" +
+	"2B7 MODIFIER LETTER SMALL W\n" +
+	"2B8 MODIFIER LETTER SMALL Y\n" +
+	"2B9 MODIFIER LETTER PRIME\n" +
+	"2BA MODIFIER LETTER DOUBLE PRIME\n" +
+	"2BB MODIFIER LETTER TURNED COMMA\n" +
+	"2BC MODIFIER LETTER APOSTROPHE\n" +
+	"2BD MODIFIER LETTER REVERSED COMMA\n" +
+	"2BE MODIFIER LETTER RIGHT HALF RING\n" +
+	"2BF MODIFIER LETTER LEFT HALF RING\n" +
+	"2C0 MODIFIER LETTER GLOTTAL STOP\n" +
+	"2C1 MODIFIER LETTER REVERSED GLOTTAL STOP\n" +
+	"2C2 MODIFIER LETTER LEFT ARROWHEAD\n" +
+	"2C3 MODIFIER LETTER RIGHT ARROWHEAD\n" +
+	"2C4 MODIFIER LETTER UP ARROWHEAD\n" +
+	"2C5 MODIFIER LETTER DOWN ARROWHEAD\n" +
+	"2C6 MODIFIER LETTER CIRCUMFLEX ACCENT\n" +
+	"2C7 CARON\n" +
+	"2C8 MODIFIER LETTER VERTICAL LINE\n" +
+	"2C9 MODIFIER LETTER MACRON\n" +
+	"2CA MODIFIER LETTER ACUTE ACCENT\n" +
+	"2CB MODIFIER LETTER GRAVE ACCENT\n" +
+	"2CC MODIFIER LETTER LOW VERTICAL LINE\n" +
+	"2CD MODIFIER LETTER LOW MACRON\n" +
+	"2CE MODIFIER LETTER LOW GRAVE ACCENT\n" +
+	"2CF MODIFIER LETTER LOW ACUTE ACCENT\n" +
+	"2D0 MODIFIER LETTER TRIANGULAR COLON\n" +
+	"2D1 MODIFIER LETTER HALF TRIANGULAR COLON\n" +
+	"2D2 MODIFIER LETTER CENTRED RIGHT HALF RING\n" +
+	"2D3 MODIFIER LETTER CENTRED LEFT HALF RING\n" +
+	"2D4 MODIFIER LETTER UP TACK\n" +
+	"2D5 MODIFIER LETTER DOWN TACK\n" +
+	"2D6 MODIFIER LETTER PLUS SIGN\n" +
+	"2D7 MODIFIER LETTER MINUS SIGN\n" +
+	"2D8 BREVE\n" +
+	"2D9 DOT ABOVE\n" +
+	"2DA RING ABOVE\n" +
+	"2DB OGONEK\n" +
+	"2DC SMALL TILDE\n" +
+	"2DD DOUBLE ACUTE ACCENT\n" +
+	"2DE MODIFIER LETTER RHOTIC HOOK\n" +
+	"2DF MODIFIER LETTER CROSS ACCENT\n" +
+	"2E0 MODIFIER LETTER SMALL GAMMA\n" +
+	"2E1 MODIFIER LETTER SMALL L\n" +
+	"2E2 MODIFIER LETTER SMALL S\n" +
+	"2E3 MODIFIER LETTER SMALL X\n" +
+	"2E4 MODIFIER LETTER SMALL REVERSED GLOTTAL STOP\n" +
+	"2E5 MODIFIER LETTER EXTRA-HIGH TONE BAR\n" +
+	"2E6 MODIFIER LETTER HIGH TONE BAR\n" +
+	"2E7 MODIFIER LETTER MID TONE BAR\n" +
+	"2E8 MODIFIER LETTER LOW TONE BAR\n" +
+	"2E9 MODIFIER LETTER EXTRA-LOW TONE BAR\n" +
+	"2EA MODIFIER LETTER YIN DEPARTING TONE MARK\n" +
+	"2EB MODIFIER LETTER YANG DEPARTING TONE MARK\n" +
+	"2EC MODIFIER LETTER VOICING\n" +
+	"2ED MODIFIER LETTER UNASPIRATED\n" +
+	"2EE MODIFIER LETTER DOUBLE APOSTROPHE\n" +
+	"2EF MODIFIER LETTER LOW DOWN ARROWHEAD\n" +
+	"2F0 MODIFIER LETTER LOW UP ARROWHEAD\n" +
+	"2F1 MODIFIER LETTER LOW LEFT ARROWHEAD\n" +
+	"2F2 MODIFIER LETTER LOW RIGHT ARROWHEAD\n" +
+	"2F3 MODIFIER LETTER LOW RING\n" +
+	"2F4 MODIFIER LETTER MIDDLE GRAVE ACCENT\n" +
+	"2F5 MODIFIER LETTER MIDDLE DOUBLE GRAVE ACCENT\n" +
+	"2F6 MODIFIER LETTER MIDDLE DOUBLE ACUTE ACCENT\n" +
+	"2F7 MODIFIER LETTER LOW TILDE\n" +
+	"2F8 MODIFIER LETTER RAISED COLON\n" +
+	"2F9 MODIFIER LETTER BEGIN HIGH TONE\n" +
+	"2FA MODIFIER LETTER END HIGH TONE\n" +
+	"2FB MODIFIER LETTER BEGIN LOW TONE\n" +
+	"2FC MODIFIER LETTER END LOW TONE\n" +
+	"2FD MODIFIER LETTER SHELF\n" +
+	"2FE MODIFIER LETTER OPEN SHELF\n" +
+	"2FF MODIFIER LETTER LOW LEFT ARROW\n" +
+	"300 COMBINING GRAVE ACCENT\n" +
+	"301 COMBINING ACUTE ACCENT\n" +
+	"302 COMBINING CIRCUMFLEX ACCENT\n" +
+	"303 COMBINING TILDE\n" +
+	"304 COMBINING MACRON\n" +
+	"305 COMBINING OVERLINE\n" +
+	"306 COMBINING BREVE\n" +
+	"307 COMBINING DOT ABOVE\n" +
+	"308 COMBINING DIAERESIS\n" +
+	"309 COMBINING HOOK ABOVE\n" +
+	"30A COMBINING RING ABOVE\n" +
+	"30B COMBINING DOUBLE ACUTE ACCENT\n" +
+	"30C COMBINING CARON\n" +
+	"30D COMBINING VERTICAL LINE ABOVE\n" +
+	"30E COMBINING DOUBLE VERTICAL LINE ABOVE\n" +
+	"30F COMBINING DOUBLE GRAVE ACCENT\n" +
+	"310 COMBINING CANDRABINDU\n" +
+	"311 COMBINING INVERTED BREVE\n" +
+	"312 COMBINING TURNED COMMA ABOVE\n" +
+	"313 COMBINING COMMA ABOVE\n" +
+	"314 COMBINING REVERSED COMMA ABOVE\n" +
+	"315 COMBINING COMMA ABOVE RIGHT\n" +
+	"316 COMBINING GRAVE ACCENT BELOW\n" +
+	"317 COMBINING ACUTE ACCENT BELOW\n" +
+	"318 COMBINING LEFT TACK BELOW\n" +
+	"319 COMBINING RIGHT TACK BELOW\n" +
+	"31A COMBINING LEFT ANGLE ABOVE\n" +
+	"31B COMBINING HORN\n" +
+	"31C COMBINING LEFT HALF RING BELOW\n" +
+	"31D COMBINING UP TACK BELOW\n" +
+	"31E COMBINING DOWN TACK BELOW\n" +
+	"31F COMBINING PLUS SIGN BELOW\n" +
+	"320 COMBINING MINUS SIGN BELOW\n" +
+	"321 COMBINING PALATALIZED HOOK BELOW\n" +
+	"322 COMBINING RETROFLEX HOOK BELOW\n" +
+	"323 COMBINING DOT BELOW\n" +
+	"324 COMBINING DIAERESIS BELOW\n" +
+	"325 COMBINING RING BELOW\n" +
+	"326 COMBINING COMMA BELOW\n" +
+	"327 COMBINING CEDILLA\n" +
+	"328 COMBINING OGONEK\n" +
+	"329 COMBINING VERTICAL LINE BELOW\n" +
+	"32A COMBINING BRIDGE BELOW\n" +
+	"32B COMBINING INVERTED DOUBLE ARCH BELOW\n" +
+	"32C COMBINING CARON BELOW\n" +
+	"32D COMBINING CIRCUMFLEX ACCENT BELOW\n" +
+	"32E COMBINING BREVE BELOW\n" +
+	"32F COMBINING INVERTED BREVE BELOW\n" +
+	"330 COMBINING TILDE BELOW\n" +
+	"331 COMBINING MACRON BELOW\n" +
+	"332 COMBINING LOW LINE\n" +
+	"333 COMBINING DOUBLE LOW LINE\n" +
+	"334 COMBINING TILDE OVERLAY\n" +
+	"335 COMBINING SHORT STROKE OVERLAY\n" +
+	"336 COMBINING LONG STROKE OVERLAY\n" +
+	"337 COMBINING SHORT SOLIDUS OVERLAY\n" +
+	"338 COMBINING LONG SOLIDUS OVERLAY\n" +
+	"339 COMBINING RIGHT HALF RING BELOW\n" +
+	"33A COMBINING INVERTED BRIDGE BELOW\n" +
+	"33B COMBINING SQUARE BELOW\n" +
+	"33C COMBINING SEAGULL BELOW\n" +
+	"33D COMBINING X ABOVE\n" +
+	"33E COMBINING VERTICAL TILDE\n" +
+	"33F COMBINING DOUBLE OVERLINE\n" +
+	"340 COMBINING GRAVE TONE MARK\n" +
+	"341 COMBINING ACUTE TONE MARK\n" +
+	"342 COMBINING GREEK PERISPOMENI\n" +
+	"343 COMBINING GREEK KORONIS\n" +
+	"344 COMBINING GREEK DIALYTIKA TONOS\n" +
+	"345 COMBINING GREEK YPOGEGRAMMENI\n" +
+	"346 COMBINING BRIDGE ABOVE\n" +
+	"347 COMBINING EQUALS SIGN BELOW\n" +
+	"348 COMBINING DOUBLE VERTICAL LINE BELOW\n" +
+	"349 COMBINING LEFT ANGLE BELOW\n" +
+	"34A COMBINING NOT TILDE ABOVE\n" +
+	"34B COMBINING HOMOTHETIC ABOVE\n" +
+	"34C COMBINING ALMOST EQUAL TO ABOVE\n" +
+	"34D COMBINING LEFT RIGHT ARROW BELOW\n" +
+	"34E COMBINING UPWARDS ARROW BELOW\n" +
+	"34F COMBINING GRAPHEME JOINER\n" +
+	"350 COMBINING RIGHT ARROWHEAD ABOVE\n" +
+	"351 COMBINING LEFT HALF RING ABOVE\n" +
+	"352 COMBINING FERMATA\n" +
+	"353 COMBINING X BELOW\n" +
+	"354 COMBINING LEFT ARROWHEAD BELOW\n" +
+	"355 COMBINING RIGHT ARROWHEAD BELOW\n" +
+	"356 COMBINING RIGHT ARROWHEAD AND UP ARROWHEAD BELOW\n" +
+	"357 COMBINING RIGHT HALF RING ABOVE\n" +
+	"358 COMBINING DOT ABOVE RIGHT\n" +
+	"359 COMBINING ASTERISK BELOW\n" +
+	"35A COMBINING DOUBLE RING BELOW\n" +
+	"35B COMBINING ZIGZAG ABOVE\n" +
+	"35C COMBINING DOUBLE BREVE BELOW\n" +
+	"35D COMBINING DOUBLE BREVE\n" +
+	"35E COMBINING DOUBLE MACRON\n" +
+	"35F COMBINING DOUBLE MACRON BELOW\n" +
+	"360 COMBINING DOUBLE TILDE\n" +
+	"361 COMBINING DOUBLE INVERTED BREVE\n" +
+	"362 COMBINING DOUBLE RIGHTWARDS ARROW BELOW\n" +
+	"363 COMBINING LATIN SMALL LETTER A\n" +
+	"364 COMBINING LATIN SMALL LETTER E\n" +
+	"365 COMBINING LATIN SMALL LETTER I\n" +
+	"366 COMBINING LATIN SMALL LETTER O\n" +
+	"367 COMBINING LATIN SMALL LETTER U\n" +
+	"368 COMBINING LATIN SMALL LETTER C\n" +
+	"369 COMBINING LATIN SMALL LETTER D\n" +
+	"36A COMBINING LATIN SMALL LETTER H\n" +
+	"36B COMBINING LATIN SMALL LETTER M\n" +
+	"36C COMBINING LATIN SMALL LETTER R\n" +
+	"36D COMBINING LATIN SMALL LETTER T\n" +
+	"36E COMBINING LATIN SMALL LETTER V\n" +
+	"36F COMBINING LATIN SMALL LETTER X\n" +
+	"370 GREEK CAPITAL LETTER HETA\n" +
+	"371 GREEK SMALL LETTER HETA\n" +
+	"372 GREEK CAPITAL LETTER ARCHAIC SAMPI\n" +
+	"373 GREEK SMALL LETTER ARCHAIC SAMPI\n" +
+	"374 GREEK NUMERAL SIGN\n" +
+	"375 GREEK LOWER NUMERAL SIGN\n" +
+	"376 GREEK CAPITAL LETTER PAMPHYLIAN DIGAMMA\n" +
+	"377 GREEK SMALL LETTER PAMPHYLIAN DIGAMMA\n" +
+	"37A GREEK YPOGEGRAMMENI\n" +
+	"37B GREEK SMALL REVERSED LUNATE SIGMA SYMBOL\n" +
+	"37C GREEK SMALL DOTTED LUNATE SIGMA SYMBOL\n" +
+	"37D GREEK SMALL REVERSED DOTTED LUNATE SIGMA SYMBOL\n" +
+	"37E GREEK QUESTION MARK\n" +
+	"37F GREEK CAPITAL LETTER YOT\n" +
+	"384 GREEK TONOS\n" +
+	"385 GREEK DIALYTIKA TONOS\n" +
+	"386 GREEK CAPITAL LETTER ALPHA WITH TONOS\n" +
+	"387 GREEK ANO TELEIA\n" +
+	"388 GREEK CAPITAL LETTER EPSILON WITH TONOS\n" +
+	"389 GREEK CAPITAL LETTER ETA WITH TONOS\n" +
+	"38A GREEK CAPITAL LETTER IOTA WITH TONOS\n" +
+	"38C GREEK CAPITAL LETTER OMICRON WITH TONOS\n" +
+	"38E GREEK CAPITAL LETTER UPSILON WITH TONOS\n" +
+	"38F GREEK CAPITAL LETTER OMEGA WITH TONOS\n" +
+	"390 GREEK SMALL LETTER IOTA WITH DIALYTIKA AND TONOS\n" +
+	"391 GREEK CAPITAL LETTER ALPHA\n" +
+	"392 GREEK CAPITAL LETTER BETA\n" +
+	"393 GREEK CAPITAL LETTER GAMMA\n" +
+	"394 GREEK CAPITAL LETTER DELTA\n" +
+	"395 GREEK CAPITAL LETTER EPSILON\n" +
+	"396 GREEK CAPITAL LETTER ZETA\n" +
+	"397 GREEK CAPITAL LETTER ETA\n" +
+	"398 GREEK CAPITAL LETTER THETA\n" +
+	"399 GREEK CAPITAL LETTER IOTA\n" +
+	"39A GREEK CAPITAL LETTER KAPPA\n" +
+	"39B GREEK CAPITAL LETTER LAMDA\n" +
+	"39C GREEK CAPITAL LETTER MU\n" +
+	"39D GREEK CAPITAL LETTER NU\n" +
+	"39E GREEK CAPITAL LETTER XI\n" +
+	"39F GREEK CAPITAL LETTER OMICRON\n" +
+	"3A0 GREEK CAPITAL LETTER PI\n" +
+	"3A1 GREEK CAPITAL LETTER RHO\n" +
+	"3A3 GREEK CAPITAL LETTER SIGMA\n" +
+	"3A4 GREEK CAPITAL LETTER TAU\n" +
+	"3A5 GREEK CAPITAL LETTER UPSILON\n" +
+	"3A6 GREEK CAPITAL LETTER PHI\n" +
+	"3A7 GREEK CAPITAL LETTER CHI\n" +
+	"3A8 GREEK CAPITAL LETTER PSI\n" +
+	"3A9 GREEK CAPITAL LETTER OMEGA\n" +
+	"3AA GREEK CAPITAL LETTER IOTA WITH DIALYTIKA\n" +
+	"3AB GREEK CAPITAL LETTER UPSILON WITH DIALYTIKA\n" +
+	"3AC GREEK SMALL LETTER ALPHA WITH TONOS\n" +
+	"3AD GREEK SMALL LETTER EPSILON WITH TONOS\n" +
+	"3AE GREEK SMALL LETTER ETA WITH TONOS\n" +
+	"3AF GREEK SMALL LETTER IOTA WITH TONOS\n" +
+	"3B0 GREEK SMALL LETTER UPSILON WITH DIALYTIKA AND TONOS\n" +
+	"3B1 GREEK SMALL LETTER ALPHA\n" +
+	"3B2 GREEK SMALL LETTER BETA\n" +
+	"3B3 GREEK SMALL LETTER GAMMA\n" +
+	"3B4 GREEK SMALL LETTER DELTA\n" +
+	"3B5 GREEK SMALL LETTER EPSILON\n" +
+	"3B6 GREEK SMALL LETTER ZETA\n" +
+	"3B7 GREEK SMALL LETTER ETA\n" +
+	"3B8 GREEK SMALL LETTER THETA\n" +
+	"3B9 GREEK SMALL LETTER IOTA\n" +
+	"3BA GREEK SMALL LETTER KAPPA\n" +
+	"3BB GREEK SMALL LETTER LAMDA\n" +
+	"3BC GREEK SMALL LETTER MU\n" +
+	"3BD GREEK SMALL LETTER NU\n" +
+	"3BE GREEK SMALL LETTER XI\n" +
+	"3BF GREEK SMALL LETTER OMICRON\n" +
+	"3C0 GREEK SMALL LETTER PI\n" +
+	"3C1 GREEK SMALL LETTER RHO\n" +
+	"3C2 GREEK SMALL LETTER FINAL SIGMA\n" +
+	"3C3 GREEK SMALL LETTER SIGMA\n" +
+	"3C4 GREEK SMALL LETTER TAU\n" +
+	"3C5 GREEK SMALL LETTER UPSILON\n" +
+	"3C6 GREEK SMALL LETTER PHI\n" +
+	"3C7 GREEK SMALL LETTER CHI\n" +
+	"3C8 GREEK SMALL LETTER PSI\n" +
+	"3C9 GREEK SMALL LETTER OMEGA\n" +
+	"3CA GREEK SMALL LETTER IOTA WITH DIALYTIKA\n" +
+	"3CB GREEK SMALL LETTER UPSILON WITH DIALYTIKA\n" +
+	"3CC GREEK SMALL LETTER OMICRON WITH TONOS\n" +
+	"3CD GREEK SMALL LETTER UPSILON WITH TONOS\n" +
+	"3CE GREEK SMALL LETTER OMEGA WITH TONOS\n" +
+	"3CF GREEK CAPITAL KAI SYMBOL\n" +
+	"3D0 GREEK BETA SYMBOL\n" +
+	"3D1 GREEK THETA SYMBOL\n" +
+	"3D2 GREEK UPSILON WITH HOOK SYMBOL\n" +
+	"3D3 GREEK UPSILON WITH ACUTE AND HOOK SYMBOL\n" +
+	"3D4 GREEK UPSILON WITH DIAERESIS AND HOOK SYMBOL\n" +
+	"3D5 GREEK PHI SYMBOL\n" +
+	"3D6 GREEK PI SYMBOL\n" +
+	"3D7 GREEK KAI SYMBOL\n" +
+	"3D8 GREEK LETTER ARCHAIC KOPPA\n" +
+	"3D9 GREEK SMALL LETTER ARCHAIC KOPPA\n" +
+	"3DA GREEK LETTER STIGMA\n" +
+	"3DB GREEK SMALL LETTER STIGMA\n" +
+	"3DC GREEK LETTER DIGAMMA\n" +
+	"3DD GREEK SMALL LETTER DIGAMMA\n" +
+	"3DE GREEK LETTER KOPPA\n" +
+	"3DF GREEK SMALL LETTER KOPPA\n" +
+	"3E0 GREEK LETTER SAMPI\n" +
+	"3E1 GREEK SMALL LETTER SAMPI\n" +
+	"3E2 COPTIC CAPITAL LETTER SHEI\n" +
+	"3E3 COPTIC SMALL LETTER SHEI\n" +
+	"3E4 COPTIC CAPITAL LETTER FEI\n" +
+	"3E5 COPTIC SMALL LETTER FEI\n" +
+	"3E6 COPTIC CAPITAL LETTER KHEI\n" +
+	"3E7 COPTIC SMALL LETTER KHEI\n" +
+	"3E8 COPTIC CAPITAL LETTER HORI\n" +
+	"3E9 COPTIC SMALL LETTER HORI\n" +
+	"3EA COPTIC CAPITAL LETTER GANGIA\n" +
+	"3EB COPTIC SMALL LETTER GANGIA\n" +
+	"3EC COPTIC CAPITAL LETTER SHIMA\n" +
+	"3ED COPTIC SMALL LETTER SHIMA\n" +
+	"3EE COPTIC CAPITAL LETTER DEI\n" +
+	"3EF COPTIC SMALL LETTER DEI\n" +
+	"3F0 GREEK KAPPA SYMBOL\n" +
+	"3F1 GREEK RHO SYMBOL\n" +
+	"3F2 GREEK LUNATE SIGMA SYMBOL\n" +
+	"3F3 GREEK LETTER YOT\n" +
+	"3F4 GREEK CAPITAL THETA SYMBOL\n" +
+	"3F5 GREEK LUNATE EPSILON SYMBOL\n" +
+	"3F6 GREEK REVERSED LUNATE EPSILON SYMBOL\n" +
+	"3F7 GREEK CAPITAL LETTER SHO\n" +
+	"3F8 GREEK SMALL LETTER SHO\n" +
+	"3F9 GREEK CAPITAL LUNATE SIGMA SYMBOL\n" +
+	"3FA GREEK CAPITAL LETTER SAN\n" +
+	"3FB GREEK SMALL LETTER SAN\n" +
+	"3FC GREEK RHO WITH STROKE SYMBOL\n" +
+	"3FD GREEK CAPITAL REVERSED LUNATE SIGMA SYMBOL\n" +
+	"3FE GREEK CAPITAL DOTTED LUNATE SIGMA SYMBOL\n" +
+	"3FF GREEK CAPITAL REVERSED DOTTED LUNATE SIGMA SYMBOL\n" +
+	"400 CYRILLIC CAPITAL LETTER IE WITH GRAVE\n" +
+	"401 CYRILLIC CAPITAL LETTER IO\n" +
+	"402 CYRILLIC CAPITAL LETTER DJE\n" +
+	"403 CYRILLIC CAPITAL LETTER GJE\n" +
+	"404 CYRILLIC CAPITAL LETTER UKRAINIAN IE\n" +
+	"405 CYRILLIC CAPITAL LETTER DZE\n" +
+	"406 CYRILLIC CAPITAL LETTER BYELORUSSIAN-UKRAINIAN I\n" +
+	"407 CYRILLIC CAPITAL LETTER YI\n" +
+	"408 CYRILLIC CAPITAL LETTER JE\n" +
+	"409 CYRILLIC CAPITAL LETTER LJE\n" +
+	"40A CYRILLIC CAPITAL LETTER NJE\n" +
+	"40B CYRILLIC CAPITAL LETTER TSHE\n" +
+	"40C CYRILLIC CAPITAL LETTER KJE\n" +
+	"40D CYRILLIC CAPITAL LETTER I WITH GRAVE\n" +
+	"40E CYRILLIC CAPITAL LETTER SHORT U\n" +
+	"40F CYRILLIC CAPITAL LETTER DZHE\n" +
+	"410 CYRILLIC CAPITAL LETTER A\n" +
+	"411 CYRILLIC CAPITAL LETTER BE\n" +
+	"412 CYRILLIC CAPITAL LETTER VE\n" +
+	"413 CYRILLIC CAPITAL LETTER GHE\n" +
+	"414 CYRILLIC CAPITAL LETTER DE\n" +
+	"415 CYRILLIC CAPITAL LETTER IE\n" +
+	"416 CYRILLIC CAPITAL LETTER ZHE\n" +
+	"417 CYRILLIC CAPITAL LETTER ZE\n" +
+	"418 CYRILLIC CAPITAL LETTER I\n" +
+	"419 CYRILLIC CAPITAL LETTER SHORT I\n" +
+	"41A CYRILLIC CAPITAL LETTER KA\n" +
+	"41B CYRILLIC CAPITAL LETTER EL\n" +
+	"41C CYRILLIC CAPITAL LETTER EM\n" +
+	"41D CYRILLIC CAPITAL LETTER EN\n" +
+	"41E CYRILLIC CAPITAL LETTER O\n" +
+	"41F CYRILLIC CAPITAL LETTER PE\n" +
+	"420 CYRILLIC CAPITAL LETTER ER\n" +
+	"421 CYRILLIC CAPITAL LETTER ES\n" +
+	"422 CYRILLIC CAPITAL LETTER TE\n" +
+	"423 CYRILLIC CAPITAL LETTER U\n" +
+	"424 CYRILLIC CAPITAL LETTER EF\n" +
+	"425 CYRILLIC CAPITAL LETTER HA\n" +
+	"426 CYRILLIC CAPITAL LETTER TSE\n" +
+	"427 CYRILLIC CAPITAL LETTER CHE\n" +
+	"428 CYRILLIC CAPITAL LETTER SHA\n" +
+	"429 CYRILLIC CAPITAL LETTER SHCHA\n" +
+	"42A CYRILLIC CAPITAL LETTER HARD SIGN\n" +
+	"42B CYRILLIC CAPITAL LETTER YERU\n" +
+	"42C CYRILLIC CAPITAL LETTER SOFT SIGN\n" +
+	"42D CYRILLIC CAPITAL LETTER E\n" +
+	"42E CYRILLIC CAPITAL LETTER YU\n" +
+	"42F CYRILLIC CAPITAL LETTER YA\n" +
+	"430 CYRILLIC SMALL LETTER A\n" +
+	"431 CYRILLIC SMALL LETTER BE\n" +
+	"432 CYRILLIC SMALL LETTER VE\n" +
+	"433 CYRILLIC SMALL LETTER GHE\n" +
+	"434 CYRILLIC SMALL LETTER DE\n" +
+	"435 CYRILLIC SMALL LETTER IE\n" +
+	"436 CYRILLIC SMALL LETTER ZHE\n" +
+	"437 CYRILLIC SMALL LETTER ZE\n" +
+	"438 CYRILLIC SMALL LETTER I\n" +
+	"439 CYRILLIC SMALL LETTER SHORT I\n" +
+	"43A CYRILLIC SMALL LETTER KA\n" +
+	"43B CYRILLIC SMALL LETTER EL\n" +
+	"43C CYRILLIC SMALL LETTER EM\n" +
+	"43D CYRILLIC SMALL LETTER EN\n" +
+	"43E CYRILLIC SMALL LETTER O\n" +
+	"43F CYRILLIC SMALL LETTER PE\n" +
+	"440 CYRILLIC SMALL LETTER ER\n" +
+	"441 CYRILLIC SMALL LETTER ES\n" +
+	"442 CYRILLIC SMALL LETTER TE\n" +
+	"443 CYRILLIC SMALL LETTER U\n" +
+	"444 CYRILLIC SMALL LETTER EF\n" +
+	"445 CYRILLIC SMALL LETTER HA\n" +
+	"446 CYRILLIC SMALL LETTER TSE\n" +
+	"447 CYRILLIC SMALL LETTER CHE\n" +
+	"448 CYRILLIC SMALL LETTER SHA\n" +
+	"449 CYRILLIC SMALL LETTER SHCHA\n" +
+	"44A CYRILLIC SMALL LETTER HARD SIGN\n" +
+	"44B CYRILLIC SMALL LETTER YERU\n" +
+	"44C CYRILLIC SMALL LETTER SOFT SIGN\n" +
+	"44D CYRILLIC SMALL LETTER E\n" +
+	"44E CYRILLIC SMALL LETTER YU\n" +
+	"44F CYRILLIC SMALL LETTER YA\n" +
+	"450 CYRILLIC SMALL LETTER IE WITH GRAVE\n" +
+	"451 CYRILLIC SMALL LETTER IO\n" +
+	"452 CYRILLIC SMALL LETTER DJE\n" +
+	"453 CYRILLIC SMALL LETTER GJE\n" +
+	"454 CYRILLIC SMALL LETTER UKRAINIAN IE\n" +
+	"455 CYRILLIC SMALL LETTER DZE\n" +
+	"456 CYRILLIC SMALL LETTER BYELORUSSIAN-UKRAINIAN I\n" +
+	"457 CYRILLIC SMALL LETTER YI\n" +
+	"458 CYRILLIC SMALL LETTER JE\n" +
+	"459 CYRILLIC SMALL LETTER LJE\n" +
+	"45A CYRILLIC SMALL LETTER NJE\n" +
+	"45B CYRILLIC SMALL LETTER TSHE\n" +
+	"45C CYRILLIC SMALL LETTER KJE\n" +
+	"45D CYRILLIC SMALL LETTER I WITH GRAVE\n" +
+	"45E CYRILLIC SMALL LETTER SHORT U\n" +
+	"45F CYRILLIC SMALL LETTER DZHE\n" +
+	"460 CYRILLIC CAPITAL LETTER OMEGA\n" +
+	"461 CYRILLIC SMALL LETTER OMEGA\n" +
+	"462 CYRILLIC CAPITAL LETTER YAT\n" +
+	"463 CYRILLIC SMALL LETTER YAT\n" +
+	"464 CYRILLIC CAPITAL LETTER IOTIFIED E\n" +
+	"465 CYRILLIC SMALL LETTER IOTIFIED E\n" +
+	"466 CYRILLIC CAPITAL LETTER LITTLE YUS\n" +
+	"467 CYRILLIC SMALL LETTER LITTLE YUS\n" +
+	"468 CYRILLIC CAPITAL LETTER IOTIFIED LITTLE YUS\n" +
+	"469 CYRILLIC SMALL LETTER IOTIFIED LITTLE YUS\n" +
+	"46A CYRILLIC CAPITAL LETTER BIG YUS\n" +
+	"46B CYRILLIC SMALL LETTER BIG YUS\n" +
+	"46C CYRILLIC CAPITAL LETTER IOTIFIED BIG YUS\n" +
+	"46D CYRILLIC SMALL LETTER IOTIFIED BIG YUS\n" +
+	"46E CYRILLIC CAPITAL LETTER KSI\n" +
+	"46F CYRILLIC SMALL LETTER KSI\n" +
+	"470 CYRILLIC CAPITAL LETTER PSI\n" +
+	"471 CYRILLIC SMALL LETTER PSI\n" +
+	"472 CYRILLIC CAPITAL LETTER FITA\n" +
+	"473 CYRILLIC SMALL LETTER FITA\n" +
+	"474 CYRILLIC CAPITAL LETTER IZHITSA\n" +
+	"475 CYRILLIC SMALL LETTER IZHITSA\n" +
+	"476 CYRILLIC CAPITAL LETTER IZHITSA WITH DOUBLE GRAVE ACCENT\n" +
+	"477 CYRILLIC SMALL LETTER IZHITSA WITH DOUBLE GRAVE ACCENT\n" +
+	"478 CYRILLIC CAPITAL LETTER UK\n" +
+	"479 CYRILLIC SMALL LETTER UK\n" +
+	"47A CYRILLIC CAPITAL LETTER ROUND OMEGA\n" +
+	"47B CYRILLIC SMALL LETTER ROUND OMEGA\n" +
+	"47C CYRILLIC CAPITAL LETTER OMEGA WITH TITLO\n" +
+	"47D CYRILLIC SMALL LETTER OMEGA WITH TITLO\n" +
+	"47E CYRILLIC CAPITAL LETTER OT\n" +
+	"47F CYRILLIC SMALL LETTER OT\n" +
+	"480 CYRILLIC CAPITAL LETTER KOPPA\n" +
+	"481 CYRILLIC SMALL LETTER KOPPA\n" +
+	"482 CYRILLIC THOUSANDS SIGN\n" +
+	"483 COMBINING CYRILLIC TITLO\n" +
+	"484 COMBINING CYRILLIC PALATALIZATION\n" +
+	"485 COMBINING CYRILLIC DASIA PNEUMATA\n" +
+	"486 COMBINING CYRILLIC PSILI PNEUMATA\n" +
+	"487 COMBINING CYRILLIC POKRYTIE\n" +
+	"488 COMBINING CYRILLIC HUNDRED THOUSANDS SIGN\n" +
+	"489 COMBINING CYRILLIC MILLIONS SIGN\n" +
+	"48A CYRILLIC CAPITAL LETTER SHORT I WITH TAIL\n" +
+	"48B CYRILLIC SMALL LETTER SHORT I WITH TAIL\n" +
+	"48C CYRILLIC CAPITAL LETTER SEMISOFT SIGN\n" +
+	"48D CYRILLIC SMALL LETTER SEMISOFT SIGN\n" +
+	"48E CYRILLIC CAPITAL LETTER ER WITH TICK\n" +
+	"48F CYRILLIC SMALL LETTER ER WITH TICK\n" +
+	"490 CYRILLIC CAPITAL LETTER GHE WITH UPTURN\n" +
+	"491 CYRILLIC SMALL LETTER GHE WITH UPTURN\n" +
+	"492 CYRILLIC CAPITAL LETTER GHE WITH STROKE\n" +
+	"493 CYRILLIC SMALL LETTER GHE WITH STROKE\n" +
+	"494 CYRILLIC CAPITAL LETTER GHE WITH MIDDLE HOOK\n" +
+	"495 CYRILLIC SMALL LETTER GHE WITH MIDDLE HOOK\n" +
+	"496 CYRILLIC CAPITAL LETTER ZHE WITH DESCENDER\n" +
+	"497 CYRILLIC SMALL LETTER ZHE WITH DESCENDER\n" +
+	"498 CYRILLIC CAPITAL LETTER ZE WITH DESCENDER\n" +
+	"499 CYRILLIC SMALL LETTER ZE WITH DESCENDER\n" +
+	"49A CYRILLIC CAPITAL LETTER KA WITH DESCENDER\n" +
+	"49B CYRILLIC SMALL LETTER KA WITH DESCENDER\n" +
+	"49C CYRILLIC CAPITAL LETTER KA WITH VERTICAL STROKE\n" +
+	"49D CYRILLIC SMALL LETTER KA WITH VERTICAL STROKE\n" +
+	"49E CYRILLIC CAPITAL LETTER KA WITH STROKE\n" +
+	"49F CYRILLIC SMALL LETTER KA WITH STROKE\n" +
+	"4A0 CYRILLIC CAPITAL LETTER BASHKIR KA\n" +
+	"4A1 CYRILLIC SMALL LETTER BASHKIR KA\n" +
+	"4A2 CYRILLIC CAPITAL LETTER EN WITH DESCENDER\n" +
+	"4A3 CYRILLIC SMALL LETTER EN WITH DESCENDER\n" +
+	"4A4 CYRILLIC CAPITAL LIGATURE EN GHE\n" +
+	"4A5 CYRILLIC SMALL LIGATURE EN GHE\n" +
+	"4A6 CYRILLIC CAPITAL LETTER PE WITH MIDDLE HOOK\n" +
+	"4A7 CYRILLIC SMALL LETTER PE WITH MIDDLE HOOK\n" +
+	"4A8 CYRILLIC CAPITAL LETTER ABKHASIAN HA\n" +
+	"4A9 CYRILLIC SMALL LETTER ABKHASIAN HA\n" +
+	"4AA CYRILLIC CAPITAL LETTER ES WITH DESCENDER\n" +
+	"4AB CYRILLIC SMALL LETTER ES WITH DESCENDER\n" +
+	"4AC CYRILLIC CAPITAL LETTER TE WITH DESCENDER\n" +
+	"4AD CYRILLIC SMALL LETTER TE WITH DESCENDER\n" +
+	"4AE CYRILLIC CAPITAL LETTER STRAIGHT U\n" +
+	"4AF CYRILLIC SMALL LETTER STRAIGHT U\n" +
+	"4B0 CYRILLIC CAPITAL LETTER STRAIGHT U WITH STROKE\n" +
+	"4B1 CYRILLIC SMALL LETTER STRAIGHT U WITH STROKE\n" +
+	"4B2 CYRILLIC CAPITAL LETTER HA WITH DESCENDER\n" +
+	"4B3 CYRILLIC SMALL LETTER HA WITH DESCENDER\n" +
+	"4B4 CYRILLIC CAPITAL LIGATURE TE TSE\n" +
+	"4B5 CYRILLIC SMALL LIGATURE TE TSE\n" +
+	"4B6 CYRILLIC CAPITAL LETTER CHE WITH DESCENDER\n" +
+	"4B7 CYRILLIC SMALL LETTER CHE WITH DESCENDER\n" +
+	"4B8 CYRILLIC CAPITAL LETTER CHE WITH VERTICAL STROKE\n" +
+	"4B9 CYRILLIC SMALL LETTER CHE WITH VERTICAL STROKE\n" +
+	"4BA CYRILLIC CAPITAL LETTER SHHA\n" +
+	"4BB CYRILLIC SMALL LETTER SHHA\n" +
+	"4BC CYRILLIC CAPITAL LETTER ABKHASIAN CHE\n" +
+	"4BD CYRILLIC SMALL LETTER ABKHASIAN CHE\n" +
+	"4BE CYRILLIC CAPITAL LETTER ABKHASIAN CHE WITH DESCENDER\n" +
+	"4BF CYRILLIC SMALL LETTER ABKHASIAN CHE WITH DESCENDER\n" +
+	"4C0 CYRILLIC LETTER PALOCHKA\n" +
+	"4C1 CYRILLIC CAPITAL LETTER ZHE WITH BREVE\n" +
+	"4C2 CYRILLIC SMALL LETTER ZHE WITH BREVE\n" +
+	"4C3 CYRILLIC CAPITAL LETTER KA WITH HOOK\n" +
+	"4C4 CYRILLIC SMALL LETTER KA WITH HOOK\n" +
+	"4C5 CYRILLIC CAPITAL LETTER EL WITH TAIL\n" +
+	"4C6 CYRILLIC SMALL LETTER EL WITH TAIL\n" +
+	"4C7 CYRILLIC CAPITAL LETTER EN WITH HOOK\n" +
+	"4C8 CYRILLIC SMALL LETTER EN WITH HOOK\n" +
+	"4C9 CYRILLIC CAPITAL LETTER EN WITH TAIL\n" +
+	"4CA CYRILLIC SMALL LETTER EN WITH TAIL\n" +
+	"4CB CYRILLIC CAPITAL LETTER KHAKASSIAN CHE\n" +
+	"4CC CYRILLIC SMALL LETTER KHAKASSIAN CHE\n" +
+	"4CD CYRILLIC CAPITAL LETTER EM WITH TAIL\n" +
+	"4CE CYRILLIC SMALL LETTER EM WITH TAIL\n" +
+	"4CF CYRILLIC SMALL LETTER PALOCHKA\n" +
+	"4D0 CYRILLIC CAPITAL LETTER A WITH BREVE\n" +
+	"4D1 CYRILLIC SMALL LETTER A WITH BREVE\n" +
+	"4D2 CYRILLIC CAPITAL LETTER A WITH DIAERESIS\n" +
+	"4D3 CYRILLIC SMALL LETTER A WITH DIAERESIS\n" +
+	"4D4 CYRILLIC CAPITAL LIGATURE A IE\n" +
+	"4D5 CYRILLIC SMALL LIGATURE A IE\n" +
+	"4D6 CYRILLIC CAPITAL LETTER IE WITH BREVE\n" +
+	"4D7 CYRILLIC SMALL LETTER IE WITH BREVE\n" +
+	"4D8 CYRILLIC CAPITAL LETTER SCHWA\n" +
+	"4D9 CYRILLIC SMALL LETTER SCHWA\n" +
+	"4DA CYRILLIC CAPITAL LETTER SCHWA WITH DIAERESIS\n" +
+	"4DB CYRILLIC SMALL LETTER SCHWA WITH DIAERESIS\n" +
+	"4DC CYRILLIC CAPITAL LETTER ZHE WITH DIAERESIS\n" +
+	"4DD CYRILLIC SMALL LETTER ZHE WITH DIAERESIS\n" +
+	"4DE CYRILLIC CAPITAL LETTER ZE WITH DIAERESIS\n" +
+	"4DF CYRILLIC SMALL LETTER ZE WITH DIAERESIS\n" +
+	"4E0 CYRILLIC CAPITAL LETTER ABKHASIAN DZE\n" +
+	"4E1 CYRILLIC SMALL LETTER ABKHASIAN DZE\n" +
+	"4E2 CYRILLIC CAPITAL LETTER I WITH MACRON\n" +
+	"4E3 CYRILLIC SMALL LETTER I WITH MACRON\n" +
+	"4E4 CYRILLIC CAPITAL LETTER I WITH DIAERESIS\n" +
+	"4E5 CYRILLIC SMALL LETTER I WITH DIAERESIS\n" +
+	"4E6 CYRILLIC CAPITAL LETTER O WITH DIAERESIS\n" +
+	"4E7 CYRILLIC SMALL LETTER O WITH DIAERESIS\n" +
+	"4E8 CYRILLIC CAPITAL LETTER BARRED O\n" +
+	"4E9 CYRILLIC SMALL LETTER BARRED O\n" +
+	"4EA CYRILLIC CAPITAL LETTER BARRED O WITH DIAERESIS\n" +
+	"4EB CYRILLIC SMALL LETTER BARRED O WITH DIAERESIS\n" +
+	"4EC CYRILLIC CAPITAL LETTER E WITH DIAERESIS\n" +
+	"4ED CYRILLIC SMALL LETTER E WITH DIAERESIS\n" +
+	"4EE CYRILLIC CAPITAL LETTER U WITH MACRON\n" +
+	"4EF CYRILLIC SMALL LETTER U WITH MACRON\n" +
+	"4F0 CYRILLIC CAPITAL LETTER U WITH DIAERESIS\n" +
+	"4F1 CYRILLIC SMALL LETTER U WITH DIAERESIS\n" +
+	"4F2 CYRILLIC CAPITAL LETTER U WITH DOUBLE ACUTE\n" +
+	"4F3 CYRILLIC SMALL LETTER U WITH DOUBLE ACUTE\n" +
+	"4F4 CYRILLIC CAPITAL LETTER CHE WITH DIAERESIS\n" +
+	"4F5 CYRILLIC SMALL LETTER CHE WITH DIAERESIS\n" +
+	"4F6 CYRILLIC CAPITAL LETTER GHE WITH DESCENDER\n" +
+	"4F7 CYRILLIC SMALL LETTER GHE WITH DESCENDER\n" +
+	"4F8 CYRILLIC CAPITAL LETTER YERU WITH DIAERESIS\n" +
+	"4F9 CYRILLIC SMALL LETTER YERU WITH DIAERESIS\n" +
+	"4FA CYRILLIC CAPITAL LETTER GHE WITH STROKE AND HOOK\n" +
+	"4FB CYRILLIC SMALL LETTER GHE WITH STROKE AND HOOK\n" +
+	"4FC CYRILLIC CAPITAL LETTER HA WITH HOOK\n" +
+	"4FD CYRILLIC SMALL LETTER HA WITH HOOK\n" +
+	"4FE CYRILLIC CAPITAL LETTER HA WITH STROKE\n" +
+	"4FF CYRILLIC SMALL LETTER HA WITH STROKE\n" +
+	"591 HEBREW ACCENT ETNAHTA\n" +
+	"592 HEBREW ACCENT SEGOL\n" +
+	"593 HEBREW ACCENT SHALSHELET\n" +
+	"594 HEBREW ACCENT ZAQEF QATAN\n" +
+	"595 HEBREW ACCENT ZAQEF GADOL\n" +
+	"596 HEBREW ACCENT TIPEHA\n" +
+	"597 HEBREW ACCENT REVIA\n" +
+	"598 HEBREW ACCENT ZARQA\n" +
+	"599 HEBREW ACCENT PASHTA\n" +
+	"59A HEBREW ACCENT YETIV\n" +
+	"59B HEBREW ACCENT TEVIR\n" +
+	"59C HEBREW ACCENT GERESH\n" +
+	"59D HEBREW ACCENT GERESH MUQDAM\n" +
+	"59E HEBREW ACCENT GERSHAYIM\n" +
+	"59F HEBREW ACCENT QARNEY PARA\n" +
+	"5A0 HEBREW ACCENT TELISHA GEDOLA\n" +
+	"5A1 HEBREW ACCENT PAZER\n" +
+	"5A2 HEBREW ACCENT ATNAH HAFUKH\n" +
+	"5A3 HEBREW ACCENT MUNAH\n" +
+	"5A4 HEBREW ACCENT MAHAPAKH\n" +
+	"5A5 HEBREW ACCENT MERKHA\n" +
+	"5A6 HEBREW ACCENT MERKHA KEFULA\n" +
+	"5A7 HEBREW ACCENT DARGA\n" +
+	"5A8 HEBREW ACCENT QADMA\n" +
+	"5A9 HEBREW ACCENT TELISHA QETANA\n" +
+	"5AA HEBREW ACCENT YERAH BEN YOMO\n" +
+	"5AB HEBREW ACCENT OLE\n" +
+	"5AC HEBREW ACCENT ILUY\n" +
+	"5AD HEBREW ACCENT DEHI\n" +
+	"5AE HEBREW ACCENT ZINOR\n" +
+	"5AF HEBREW MARK MASORA CIRCLE\n" +
+	"5B0 HEBREW POINT SHEVA\n" +
+	"5B1 HEBREW POINT HATAF SEGOL\n" +
+	"5B2 HEBREW POINT HATAF PATAH\n" +
+	"5B3 HEBREW POINT HATAF QAMATS\n" +
+	"5B4 HEBREW POINT HIRIQ\n" +
+	"5B5 HEBREW POINT TSERE\n" +
+	"5B6 HEBREW POINT SEGOL\n" +
+	"5B7 HEBREW POINT PATAH\n" +
+	"5B8 HEBREW POINT QAMATS\n" +
+	"5B9 HEBREW POINT HOLAM\n" +
+	"5BA HEBREW POINT HOLAM HASER FOR VAV\n" +
+	"5BB HEBREW POINT QUBUTS\n" +
+	"5BC HEBREW POINT DAGESH OR MAPIQ\n" +
+	"5BD HEBREW POINT METEG\n" +
+	"5BE HEBREW PUNCTUATION MAQAF\n" +
+	"5BF HEBREW POINT RAFE\n" +
+	"5C0 HEBREW PUNCTUATION PASEQ\n" +
+	"5C1 HEBREW POINT SHIN DOT\n" +
+	"5C2 HEBREW POINT SIN DOT\n" +
+	"5C3 HEBREW PUNCTUATION SOF PASUQ\n" +
+	"5C4 HEBREW MARK UPPER DOT\n" +
+	"5C5 HEBREW MARK LOWER DOT\n" +
+	"5C6 HEBREW PUNCTUATION NUN HAFUKHA\n" +
+	"5C7 HEBREW POINT QAMATS QATAN\n" +
+	"5D0 HEBREW LETTER ALEF\n" +
+	"5D1 HEBREW LETTER BET\n" +
+	"5D2 HEBREW LETTER GIMEL\n" +
+	"5D3 HEBREW LETTER DALET\n" +
+	"5D4 HEBREW LETTER HE\n" +
+	"5D5 HEBREW LETTER VAV\n" +
+	"5D6 HEBREW LETTER ZAYIN\n" +
+	"5D7 HEBREW LETTER HET\n" +
+	"5D8 HEBREW LETTER TET\n" +
+	"5D9 HEBREW LETTER YOD\n" +
+	"5DA HEBREW LETTER FINAL KAF\n" +
+	"5DB HEBREW LETTER KAF\n" +
+	"5DC HEBREW LETTER LAMED\n" +
+	"5DD HEBREW LETTER FINAL MEM\n" +
+	"5DE HEBREW LETTER MEM\n" +
+	"5DF HEBREW LETTER FINAL NUN\n" +
+	"5E0 HEBREW LETTER NUN\n" +
+	"5E1 HEBREW LETTER SAMEKH\n" +
+	"5E2 HEBREW LETTER AYIN\n" +
+	"5E3 HEBREW LETTER FINAL PE\n" +
+	"5E4 HEBREW LETTER PE\n" +
+	"5E5 HEBREW LETTER FINAL TSADI\n" +
+	"5E6 HEBREW LETTER TSADI\n" +
+	"5E7 HEBREW LETTER QOF\n" +
+	"5E8 HEBREW LETTER RESH\n" +
+	"5E9 HEBREW LETTER SHIN\n" +
+	"5EA HEBREW LETTER TAV\n" +
+	"5EF HEBREW YOD TRIANGLE\n" +
+	"5F0 HEBREW LIGATURE YIDDISH DOUBLE VAV\n" +
+	"5F1 HEBREW LIGATURE YIDDISH VAV YOD\n" +
+	"5F2 HEBREW LIGATURE YIDDISH DOUBLE YOD\n" +
+	"5F3 HEBREW PUNCTUATION GERESH\n" +
+	"5F4 HEBREW PUNCTUATION GERSHAYIM\n" +
+	"600 ARABIC NUMBER SIGN\n" +
+	"601 ARABIC SIGN SANAH\n" +
+	"602 ARABIC FOOTNOTE MARKER\n" +
+	"603 ARABIC SIGN SAFHA\n" +
+	"604 ARABIC SIGN SAMVAT\n" +
+	"605 ARABIC NUMBER MARK ABOVE\n" +
+	"606 ARABIC-INDIC CUBE ROOT\n" +
+	"607 ARABIC-INDIC FOURTH ROOT\n" +
+	"608 ARABIC RAY\n" +
+	"609 ARABIC-INDIC PER MILLE SIGN\n" +
+	"60A ARABIC-INDIC PER TEN THOUSAND SIGN\n" +
+	"60B AFGHANI SIGN\n" +
+	"60C ARABIC COMMA\n" +
+	"60D ARABIC DATE SEPARATOR\n" +
+	"60E ARABIC POETIC VERSE SIGN\n" +
+	"60F ARABIC SIGN MISRA\n" +
+	"610 ARABIC SIGN SALLALLAHOU ALAYHE WASSALLAM\n" +
+	"611 ARABIC SIGN ALAYHE ASSALLAM\n" +
+	"612 ARABIC SIGN RAHMATULLAH ALAYHE\n" +
+	"613 ARABIC SIGN RADI ALLAHOU ANHU\n" +
+	"614 ARABIC SIGN TAKHALLUS\n" +
+	"615 ARABIC SMALL HIGH TAH\n" +
+	"616 ARABIC SMALL HIGH LIGATURE ALEF WITH LAM WITH YEH\n" +
+	"617 ARABIC SMALL HIGH ZAIN\n" +
+	"618 ARABIC SMALL FATHA\n" +
+	"619 ARABIC SMALL DAMMA\n" +
+	"61A ARABIC SMALL KASRA\n" +
+	"61B ARABIC SEMICOLON\n" +
+	"61C ARABIC LETTER MARK\n" +
+	"61D ARABIC END OF TEXT MARK\n" +
+	"61E ARABIC TRIPLE DOT PUNCTUATION MARK\n" +
+	"61F ARABIC QUESTION MARK\n" +
+	"620 ARABIC LETTER KASHMIRI YEH\n" +
+	"621 ARABIC LETTER HAMZA\n" +
+	"622 ARABIC LETTER ALEF WITH MADDA ABOVE\n" +
+	"623 ARABIC LETTER ALEF WITH HAMZA ABOVE\n" +
+	"624 ARABIC LETTER WAW WITH HAMZA ABOVE\n" +
+	"625 ARABIC LETTER ALEF WITH HAMZA BELOW\n" +
+	"626 ARABIC LETTER YEH WITH HAMZA ABOVE\n" +
+	"627 ARABIC LETTER ALEF\n" +
+	"628 ARABIC LETTER BEH\n" +
+	"629 ARABIC LETTER TEH MARBUTA\n" +
+	"62A ARABIC LETTER TEH\n" +
+	"62B ARABIC LETTER THEH\n" +
+	"62C ARABIC LETTER JEEM\n" +
+	"62D ARABIC LETTER HAH\n" +
+	"62E ARABIC LETTER KHAH\n" +
+	"62F ARABIC LETTER DAL\n" +
+	"630 ARABIC LETTER THAL\n" +
+	"631 ARABIC LETTER REH\n" +
+	"632 ARABIC LETTER ZAIN\n" +
+	"633 ARABIC LETTER SEEN\n" +
+	"634 ARABIC LETTER SHEEN\n" +
+	"635 ARABIC LETTER SAD\n" +
+	"636 ARABIC LETTER DAD\n" +
+	"637 ARABIC LETTER TAH\n" +
+	"638 ARABIC LETTER ZAH\n" +
+	"639 ARABIC LETTER AIN\n" +
+	"63A ARABIC LETTER GHAIN\n" +
+	"63B ARABIC LETTER KEHEH WITH TWO DOTS ABOVE\n" +
+	"63C ARABIC LETTER KEHEH WITH THREE DOTS BELOW\n" +
+	"63D ARABIC LETTER FARSI YEH WITH INVERTED V\n" +
+	"63E ARABIC LETTER FARSI YEH WITH TWO DOTS ABOVE\n" +
+	"63F ARABIC LETTER FARSI YEH WITH THREE DOTS ABOVE\n" +
+	"640 ARABIC TATWEEL\n" +
+	"641 ARABIC LETTER FEH\n" +
+	"642 ARABIC LETTER QAF\n" +
+	"643 ARABIC LETTER KAF\n" +
+	"644 ARABIC LETTER LAM\n" +
+	"645 ARABIC LETTER MEEM\n" +
+	"646 ARABIC LETTER NOON\n" +
+	"647 ARABIC LETTER HEH\n" +
+	"648 ARABIC LETTER WAW\n" +
+	"649 ARABIC LETTER ALEF MAKSURA\n" +
+	"64A ARABIC LETTER YEH\n" +
+	"64B ARABIC FATHATAN\n" +
+	"64C ARABIC DAMMATAN\n" +
+	"64D ARABIC KASRATAN\n" +
+	"64E ARABIC FATHA\n" +
+	"64F ARABIC DAMMA\n" +
+	"650 ARABIC KASRA\n" +
+	"651 ARABIC SHADDA\n" +
+	"652 ARABIC SUKUN\n" +
+	"653 ARABIC MADDAH ABOVE\n" +
+	"654 ARABIC HAMZA ABOVE\n" +
+	"655 ARABIC HAMZA BELOW\n" +
+	"656 ARABIC SUBSCRIPT ALEF\n" +
+	"657 ARABIC INVERTED DAMMA\n" +
+	"658 ARABIC MARK NOON GHUNNA\n" +
+	"659 ARABIC ZWARAKAY\n" +
+	"65A ARABIC VOWEL SIGN SMALL V ABOVE\n" +
+	"65B ARABIC VOWEL SIGN INVERTED SMALL V ABOVE\n" +
+	"65C ARABIC VOWEL SIGN DOT BELOW\n" +
+	"65D ARABIC REVERSED DAMMA\n" +
+	"65E ARABIC FATHA WITH TWO DOTS\n" +
+	"65F ARABIC WAVY HAMZA BELOW\n" +
+	"660 ARABIC-INDIC DIGIT ZERO\n" +
+	"661 ARABIC-INDIC DIGIT ONE\n" +
+	"662 ARABIC-INDIC DIGIT TWO\n" +
+	"663 ARABIC-INDIC DIGIT THREE\n" +
+	"664 ARABIC-INDIC DIGIT FOUR\n" +
+	"665 ARABIC-INDIC DIGIT FIVE\n" +
+	"666 ARABIC-INDIC DIGIT SIX\n" +
+	"667 ARABIC-INDIC DIGIT SEVEN\n" +
+	"668 ARABIC-INDIC DIGIT EIGHT\n" +
+	"669 ARABIC-INDIC DIGIT NINE\n" +
+	"66A ARABIC PERCENT SIGN\n" +
+	"66B ARABIC DECIMAL SEPARATOR\n" +
+	"66C ARABIC THOUSANDS SEPARATOR\n" +
+	"66D ARABIC FIVE POINTED STAR\n" +
+	"66E ARABIC LETTER DOTLESS BEH\n" +
+	"66F ARABIC LETTER DOTLESS QAF\n" +
+	"670 ARABIC LETTER SUPERSCRIPT ALEF\n" +
+	"671 ARABIC LETTER ALEF WASLA\n" +
+	"672 ARABIC LETTER ALEF WITH WAVY HAMZA ABOVE\n" +
+	"673 ARABIC LETTER ALEF WITH WAVY HAMZA BELOW\n" +
+	"674 ARABIC LETTER HIGH HAMZA\n" +
+	"675 ARABIC LETTER HIGH HAMZA ALEF\n" +
+	"676 ARABIC LETTER HIGH HAMZA WAW\n" +
+	"677 ARABIC LETTER U WITH HAMZA ABOVE\n" +
+	"678 ARABIC LETTER HIGH HAMZA YEH\n" +
+	"679 ARABIC LETTER TTEH\n" +
+	"67A ARABIC LETTER TTEHEH\n" +
+	"67B ARABIC LETTER BEEH\n" +
+	"67C ARABIC LETTER TEH WITH RING\n" +
+	"67D ARABIC LETTER TEH WITH THREE DOTS ABOVE DOWNWARDS\n" +
+	"67E ARABIC LETTER PEH\n" +
+	"67F ARABIC LETTER TEHEH\n" +
+	"680 ARABIC LETTER BEHEH\n" +
+	"681 ARABIC LETTER HAH WITH HAMZA ABOVE\n" +
+	"682 ARABIC LETTER HAH WITH TWO DOTS VERTICAL ABOVE\n" +
+	"683 ARABIC LETTER NYEH\n" +
+	"684 ARABIC LETTER DYEH\n" +
+	"685 ARABIC LETTER HAH WITH THREE DOTS ABOVE\n" +
+	"686 ARABIC LETTER TCHEH\n" +
+	"687 ARABIC LETTER TCHEHEH\n" +
+	"688 ARABIC LETTER DDAL\n" +
+	"689 ARABIC LETTER DAL WITH RING\n" +
+	"68A ARABIC LETTER DAL WITH DOT BELOW\n" +
+	"68B ARABIC LETTER DAL WITH DOT BELOW AND SMALL TAH\n" +
+	"68C ARABIC LETTER DAHAL\n" +
+	"68D ARABIC LETTER DDAHAL\n" +
+	"68E ARABIC LETTER DUL\n" +
+	"68F ARABIC LETTER DAL WITH THREE DOTS ABOVE DOWNWARDS\n" +
+	"690 ARABIC LETTER DAL WITH FOUR DOTS ABOVE\n" +
+	"691 ARABIC LETTER RREH\n" +
+	"692 ARABIC LETTER REH WITH SMALL V\n" +
+	"693 ARABIC LETTER REH WITH RING\n" +
+	"694 ARABIC LETTER REH WITH DOT BELOW\n" +
+	"695 ARABIC LETTER REH WITH SMALL V BELOW\n" +
+	"696 ARABIC LETTER REH WITH DOT BELOW AND DOT ABOVE\n" +
+	"697 ARABIC LETTER REH WITH TWO DOTS ABOVE\n" +
+	"698 ARABIC LETTER JEH\n" +
+	"699 ARABIC LETTER REH WITH FOUR DOTS ABOVE\n" +
+	"69A ARABIC LETTER SEEN WITH DOT BELOW AND DOT ABOVE\n" +
+	"69B ARABIC LETTER SEEN WITH THREE DOTS BELOW\n" +
+	"69C ARABIC LETTER SEEN WITH THREE DOTS BELOW AND THREE DOTS ABOVE\n" +
+	"69D ARABIC LETTER SAD WITH TWO DOTS BELOW\n" +
+	"69E ARABIC LETTER SAD WITH THREE DOTS ABOVE\n" +
+	"69F ARABIC LETTER TAH WITH THREE DOTS ABOVE\n" +
+	"6A0 ARABIC LETTER AIN WITH THREE DOTS ABOVE\n" +
+	"6A1 ARABIC LETTER DOTLESS FEH\n" +
+	"6A2 ARABIC LETTER FEH WITH DOT MOVED BELOW\n" +
+	"6A3 ARABIC LETTER FEH WITH DOT BELOW\n" +
+	"6A4 ARABIC LETTER VEH\n" +
+	"6A5 ARABIC LETTER FEH WITH THREE DOTS BELOW\n" +
+	"6A6 ARABIC LETTER PEHEH\n" +
+	"6A7 ARABIC LETTER QAF WITH DOT ABOVE\n" +
+	"6A8 ARABIC LETTER QAF WITH THREE DOTS ABOVE\n" +
+	"6A9 ARABIC LETTER KEHEH\n" +
+	"6AA ARABIC LETTER SWASH KAF\n" +
+	"6AB ARABIC LETTER KAF WITH RING\n" +
+	"6AC ARABIC LETTER KAF WITH DOT ABOVE\n" +
+	"6AD ARABIC LETTER NG\n" +
+	"6AE ARABIC LETTER KAF WITH THREE DOTS BELOW\n" +
+	"6AF ARABIC LETTER GAF\n" +
+	"6B0 ARABIC LETTER GAF WITH RING\n" +
+	"6B1 ARABIC LETTER NGOEH\n" +
+	"6B2 ARABIC LETTER GAF WITH TWO DOTS BELOW\n" +
+	"6B3 ARABIC LETTER GUEH\n" +
+	"6B4 ARABIC LETTER GAF WITH THREE DOTS ABOVE\n" +
+	"6B5 ARABIC LETTER LAM WITH SMALL V\n" +
+	"6B6 ARABIC LETTER LAM WITH DOT ABOVE\n" +
+	"6B7 ARABIC LETTER LAM WITH THREE DOTS ABOVE\n" +
+	"6B8 ARABIC LETTER LAM WITH THREE DOTS BELOW\n" +
+	"6B9 ARABIC LETTER NOON WITH DOT BELOW\n" +
+	"6BA ARABIC LETTER NOON GHUNNA\n" +
+	"6BB ARABIC LETTER RNOON\n" +
+	"6BC ARABIC LETTER NOON WITH RING\n" +
+	"6BD ARABIC LETTER NOON WITH THREE DOTS ABOVE\n" +
+	"6BE ARABIC LETTER HEH DOACHASHMEE\n" +
+	"6BF ARABIC LETTER TCHEH WITH DOT ABOVE\n" +
+	"6C0 ARABIC LETTER HEH WITH YEH ABOVE\n" +
+	"6C1 ARABIC LETTER HEH GOAL\n" +
+	"6C2 ARABIC LETTER HEH GOAL WITH HAMZA ABOVE\n" +
+	"6C3 ARABIC LETTER TEH MARBUTA GOAL\n" +
+	"6C4 ARABIC LETTER WAW WITH RING\n" +
+	"6C5 ARABIC LETTER KIRGHIZ OE\n" +
+	"6C6 ARABIC LETTER OE\n" +
+	"6C7 ARABIC LETTER U\n" +
+	"6C8 ARABIC LETTER YU\n" +
+	"6C9 ARABIC LETTER KIRGHIZ YU\n" +
+	"6CA ARABIC LETTER WAW WITH TWO DOTS ABOVE\n" +
+	"6CB ARABIC LETTER VE\n" +
+	"6CC ARABIC LETTER FARSI YEH\n" +
+	"6CD ARABIC LETTER YEH WITH TAIL\n" +
+	"6CE ARABIC LETTER YEH WITH SMALL V\n" +
+	"6CF ARABIC LETTER WAW WITH DOT ABOVE\n" +
+	"6D0 ARABIC LETTER E\n" +
+	"6D1 ARABIC LETTER YEH WITH THREE DOTS BELOW\n" +
+	"6D2 ARABIC LETTER YEH BARREE\n" +
+	"6D3 ARABIC LETTER YEH BARREE WITH HAMZA ABOVE\n" +
+	"6D4 ARABIC FULL STOP\n" +
+	"6D5 ARABIC LETTER AE\n" +
+	"6D6 ARABIC SMALL HIGH LIGATURE SAD WITH LAM WITH ALEF MAKSURA\n" +
+	"6D7 ARABIC SMALL HIGH LIGATURE QAF WITH LAM WITH ALEF MAKSURA\n" +
+	"6D8 ARABIC SMALL HIGH MEEM INITIAL FORM\n" +
+	"6D9 ARABIC SMALL HIGH LAM ALEF\n" +
+	"6DA ARABIC SMALL HIGH JEEM\n" +
+	"6DB ARABIC SMALL HIGH THREE DOTS\n" +
+	"6DC ARABIC SMALL HIGH SEEN\n" +
+	"6DD ARABIC END OF AYAH\n" +
+	"6DE ARABIC START OF RUB EL HIZB\n" +
+	"6DF ARABIC SMALL HIGH ROUNDED ZERO\n" +
+	"6E0 ARABIC SMALL HIGH UPRIGHT RECTANGULAR ZERO\n" +
+	"6E1 ARABIC SMALL HIGH DOTLESS HEAD OF KHAH\n" +
+	"6E2 ARABIC SMALL HIGH MEEM ISOLATED FORM\n" +
+	"6E3 ARABIC SMALL LOW SEEN\n" +
+	"6E4 ARABIC SMALL HIGH MADDA\n" +
+	"6E5 ARABIC SMALL WAW\n" +
+	"6E6 ARABIC SMALL YEH\n" +
+	"6E7 ARABIC SMALL HIGH YEH\n" +
+	"6E8 ARABIC SMALL HIGH NOON\n" +
+	"6E9 ARABIC PLACE OF SAJDAH\n" +
+	"6EA ARABIC EMPTY CENTRE LOW STOP\n" +
+	"6EB ARABIC EMPTY CENTRE HIGH STOP\n" +
+	"6EC ARABIC ROUNDED HIGH STOP WITH FILLED CENTRE\n" +
+	"6ED ARABIC SMALL LOW MEEM\n" +
+	"6EE ARABIC LETTER DAL WITH INVERTED V\n" +
+	"6EF ARABIC LETTER REH WITH INVERTED V\n" +
+	"6F0 EXTENDED ARABIC-INDIC DIGIT ZERO\n" +
+	"6F1 EXTENDED ARABIC-INDIC DIGIT ONE\n" +
+	"6F2 EXTENDED ARABIC-INDIC DIGIT TWO\n" +
+	"6F3 EXTENDED ARABIC-INDIC DIGIT THREE\n" +
+	"6F4 EXTENDED ARABIC-INDIC DIGIT FOUR\n" +
+	"6F5 EXTENDED ARABIC-INDIC DIGIT FIVE\n" +
+	"6F6 EXTENDED ARABIC-INDIC DIGIT SIX\n" +
+	"6F7 EXTENDED ARABIC-INDIC DIGIT SEVEN\n" +
+	"6F8 EXTENDED ARABIC-INDIC DIGIT EIGHT\n" +
+	"6F9 EXTENDED ARABIC-INDIC DIGIT NINE\n" +
+	"6FA ARABIC LETTER SHEEN WITH DOT BELOW\n" +
+	"6FB ARABIC LETTER DAD WITH DOT BELOW\n" +
+	"6FC ARABIC LETTER GHAIN WITH DOT BELOW\n" +
+	"6FD ARABIC SIGN SINDHI AMPERSAND\n" +
+	"6FE ARABIC SIGN SINDHI POSTPOSITION MEN\n" +
+	"6FF ARABIC LETTER HEH WITH INVERTED V\n" +
+	"1E00 LATIN CAPITAL LETTER A WITH RING BELOW\n" +
+	"1E01 LATIN SMALL LETTER A WITH RING BELOW\n" +
+	"1E02 LATIN CAPITAL LETTER B WITH DOT ABOVE\n" +
+	"1E03 LATIN SMALL LETTER B WITH DOT ABOVE\n" +
+	"1E04 LATIN CAPITAL LETTER B WITH DOT BELOW\n" +
+	"1E05 LATIN SMALL LETTER B WITH DOT BELOW\n" +
+	"1E06 LATIN CAPITAL LETTER B WITH LINE BELOW\n" +
+	"1E07 LATIN SMALL LETTER B WITH LINE BELOW\n" +
+	"1E08 LATIN CAPITAL LETTER C WITH CEDILLA AND ACUTE\n" +
+	"1E09 LATIN SMALL LETTER C WITH CEDILLA AND ACUTE\n" +
+	"1E0A LATIN CAPITAL LETTER D WITH DOT ABOVE\n" +
+	"1E0B LATIN SMALL LETTER D WITH DOT ABOVE\n" +
+	"1E0C LATIN CAPITAL LETTER D WITH DOT BELOW\n" +
+	"1E0D LATIN SMALL LETTER D WITH DOT BELOW\n" +
+	"1E0E LATIN CAPITAL LETTER D WITH LINE BELOW\n" +
+	"1E0F LATIN SMALL LETTER D WITH LINE BELOW\n" +
+	"1E10 LATIN CAPITAL LETTER D WITH CEDILLA\n" +
+	"1E11 LATIN SMALL LETTER D WITH CEDILLA\n" +
+	"1E12 LATIN CAPITAL LETTER D WITH CIRCUMFLEX BELOW\n" +
+	"1E13 LATIN SMALL LETTER D WITH CIRCUMFLEX BELOW\n" +
+	"1E14 LATIN CAPITAL LETTER E WITH MACRON AND GRAVE\n" +
+	"1E15 LATIN SMALL LETTER E WITH MACRON AND GRAVE\n" +
+	"1E16 LATIN CAPITAL LETTER E WITH MACRON AND ACUTE\n" +
+	"1E17 LATIN SMALL LETTER E WITH MACRON AND ACUTE\n" +
+	"1E18 LATIN CAPITAL LETTER E WITH CIRCUMFLEX BELOW\n" +
+	"1E19 LATIN SMALL LETTER E WITH CIRCUMFLEX BELOW\n" +
+	"1E1A LATIN CAPITAL LETTER E WITH TILDE BELOW\n" +
+	"1E1B LATIN SMALL LETTER E WITH TILDE BELOW\n" +
+	"1E1C LATIN CAPITAL LETTER E WITH CEDILLA AND BREVE\n" +
+	"1E1D LATIN SMALL LETTER E WITH CEDILLA AND BREVE\n" +
+	"1E1E LATIN CAPITAL LETTER F WITH DOT ABOVE\n" +
+	"1E1F LATIN SMALL LETTER F WITH DOT ABOVE\n" +
+	"1E20 LATIN CAPITAL LETTER G WITH MACRON\n" +
+	"1E21 LATIN SMALL LETTER G WITH MACRON\n" +
+	"1E22 LATIN CAPITAL LETTER H WITH DOT ABOVE\n" +
+	"1E23 LATIN SMALL LETTER H WITH DOT ABOVE\n" +
+	"1E24 LATIN CAPITAL LETTER H WITH DOT BELOW\n" +
+	"1E25 LATIN SMALL LETTER H WITH DOT BELOW\n" +
+	"1E26 LATIN CAPITAL LETTER H WITH DIAERESIS\n" +
+	"1E27 LATIN SMALL LETTER H WITH DIAERESIS\n" +
+	"1E28 LATIN CAPITAL LETTER H WITH CEDILLA\n" +
+	"1E29 LATIN SMALL LETTER H WITH CEDILLA\n" +
+	"1E2A LATIN CAPITAL LETTER H WITH BREVE BELOW\n" +
+	"1E2B LATIN SMALL LETTER H WITH BREVE BELOW\n" +
+	"1E2C LATIN CAPITAL LETTER I WITH TILDE BELOW\n" +
+	"1E2D LATIN SMALL LETTER I WITH TILDE BELOW\n" +
+	"1E2E LATIN CAPITAL LETTER I WITH DIAERESIS AND ACUTE\n" +
+	"1E2F LATIN SMALL LETTER I WITH DIAERESIS AND ACUTE\n" +
+	"1E30 LATIN CAPITAL LETTER K WITH ACUTE\n" +
+	"1E31 LATIN SMALL LETTER K WITH ACUTE\n" +
+	"1E32 LATIN CAPITAL LETTER K WITH DOT BELOW\n" +
+	"1E33 LATIN SMALL LETTER K WITH DOT BELOW\n" +
+	"1E34 LATIN CAPITAL LETTER K WITH LINE BELOW\n" +
+	"1E35 LATIN SMALL LETTER K WITH LINE BELOW\n" +
+	"1E36 LATIN CAPITAL LETTER L WITH DOT BELOW\n" +
+	"1E37 LATIN SMALL LETTER L WITH DOT BELOW\n" +
+	"1E38 LATIN CAPITAL LETTER L WITH DOT BELOW AND MACRON\n" +
+	"1E39 LATIN SMALL LETTER L WITH DOT BELOW AND MACRON\n" +
+	"1E3A LATIN CAPITAL LETTER L WITH LINE BELOW\n" +
+	"1E3B LATIN SMALL LETTER L WITH LINE BELOW\n" +
+	"1E3C LATIN CAPITAL LETTER L WITH CIRCUMFLEX BELOW\n" +
+	"1E3D LATIN SMALL LETTER L WITH CIRCUMFLEX BELOW\n" +
+	"1E3E LATIN CAPITAL LETTER M WITH ACUTE\n" +
+	"1E3F LATIN SMALL LETTER M WITH ACUTE\n" +
+	"1E40 LATIN CAPITAL LETTER M WITH DOT ABOVE\n" +
+	"1E41 LATIN SMALL LETTER M WITH DOT ABOVE\n" +
+	"1E42 LATIN CAPITAL LETTER M WITH DOT BELOW\n" +
+	"1E43 LATIN SMALL LETTER M WITH DOT BELOW\n" +
+	"1E44 LATIN CAPITAL LETTER N WITH DOT ABOVE\n" +
+	"1E45 LATIN SMALL LETTER N WITH DOT ABOVE\n" +
+	"1E46 LATIN CAPITAL LETTER N WITH DOT BELOW\n" +
+	"1E47 LATIN SMALL LETTER N WITH DOT BELOW\n" +
+	"1E48 LATIN CAPITAL LETTER N WITH LINE BELOW\n" +
+	"1E49 LATIN SMALL LETTER N WITH LINE BELOW\n" +
+	"1E4A LATIN CAPITAL LETTER N WITH CIRCUMFLEX BELOW\n" +
+	"1E4B LATIN SMALL LETTER N WITH CIRCUMFLEX BELOW\n" +
+	"1E4C LATIN CAPITAL LETTER O WITH TILDE AND ACUTE\n" +
+	"1E4D LATIN SMALL LETTER O WITH TILDE AND ACUTE\n" +
+	"1E4E LATIN CAPITAL LETTER O WITH TILDE AND DIAERESIS\n" +
+	"1E4F LATIN SMALL LETTER O WITH TILDE AND DIAERESIS\n" +
+	"1E50 LATIN CAPITAL LETTER O WITH MACRON AND GRAVE\n" +
+	"1E51 LATIN SMALL LETTER O WITH MACRON AND GRAVE\n" +
+	"1E52 LATIN CAPITAL LETTER O WITH MACRON AND ACUTE\n" +
+	"1E53 LATIN SMALL LETTER O WITH MACRON AND ACUTE\n" +
+	"1E54 LATIN CAPITAL LETTER P WITH ACUTE\n" +
+	"1E55 LATIN SMALL LETTER P WITH ACUTE\n" +
+	"1E56 LATIN CAPITAL LETTER P WITH DOT ABOVE\n" +
+	"1E57 LATIN SMALL LETTER P WITH DOT ABOVE\n" +
+	"1E58 LATIN CAPITAL LETTER R WITH DOT ABOVE\n" +
+	"1E59 LATIN SMALL LETTER R WITH DOT ABOVE\n" +
+	"1E5A LATIN CAPITAL LETTER R WITH DOT BELOW\n" +
+	"1E5B LATIN SMALL LETTER R WITH DOT BELOW\n" +
+	"1E5C LATIN CAPITAL LETTER R WITH DOT BELOW AND MACRON\n" +
+	"1E5D LATIN SMALL LETTER R WITH DOT BELOW AND MACRON\n" +
+	"1E5E LATIN CAPITAL LETTER R WITH LINE BELOW\n" +
+	"1E5F LATIN SMALL LETTER R WITH LINE BELOW\n" +
+	"1E60 LATIN CAPITAL LETTER S WITH DOT ABOVE\n" +
+	"1E61 LATIN SMALL LETTER S WITH DOT ABOVE\n" +
+	"1E62 LATIN CAPITAL LETTER S WITH DOT BELOW\n" +
+	"1E63 LATIN SMALL LETTER S WITH DOT BELOW\n" +
+	"1E64 LATIN CAPITAL LETTER S WITH ACUTE AND DOT ABOVE\n" +
+	"1E65 LATIN SMALL LETTER S WITH ACUTE AND DOT ABOVE\n" +
+	"1E66 LATIN CAPITAL LETTER S WITH CARON AND DOT ABOVE\n" +
+	"1E67 LATIN SMALL LETTER S WITH CARON AND DOT ABOVE\n" +
+	"1E68 LATIN CAPITAL LETTER S WITH DOT BELOW AND DOT ABOVE\n" +
+	"1E69 LATIN SMALL LETTER S WITH DOT BELOW AND DOT ABOVE\n" +
+	"1E6A LATIN CAPITAL LETTER T WITH DOT ABOVE\n" +
+	"1E6B LATIN SMALL LETTER T WITH DOT ABOVE\n" +
+	"1E6C LATIN CAPITAL LETTER T WITH DOT BELOW\n" +
+	"1E6D LATIN SMALL LETTER T WITH DOT BELOW\n" +
+	"1E6E LATIN CAPITAL LETTER T WITH LINE BELOW\n" +
+	"1E6F LATIN SMALL LETTER T WITH LINE BELOW\n" +
+	"1E70 LATIN CAPITAL LETTER T WITH CIRCUMFLEX BELOW\n" +
+	"1E71 LATIN SMALL LETTER T WITH CIRCUMFLEX BELOW\n" +
+	"1E72 LATIN CAPITAL LETTER U WITH DIAERESIS BELOW\n" +
+	"1E73 LATIN SMALL LETTER U WITH DIAERESIS BELOW\n" +
+	"1E74 LATIN CAPITAL LETTER U WITH TILDE BELOW\n" +
+	"1E75 LATIN SMALL LETTER U WITH TILDE BELOW\n" +
+	"1E76 LATIN CAPITAL LETTER U WITH CIRCUMFLEX BELOW\n" +
+	"1E77 LATIN SMALL LETTER U WITH CIRCUMFLEX BELOW\n" +
+	"1E78 LATIN CAPITAL LETTER U WITH TILDE AND ACUTE\n" +
+	"1E79 LATIN SMALL LETTER U WITH TILDE AND ACUTE\n" +
+	"1E7A LATIN CAPITAL LETTER U WITH MACRON AND DIAERESIS\n" +
+	"1E7B LATIN SMALL LETTER U WITH MACRON AND DIAERESIS\n" +
+	"1E7C LATIN CAPITAL LETTER V WITH TILDE\n" +
+	"1E7D LATIN SMALL LETTER V WITH TILDE\n" +
+	"1E7E LATIN CAPITAL LETTER V WITH DOT BELOW\n" +
+	"1E7F LATIN SMALL LETTER V WITH DOT BELOW\n" +
+	"1E80 LATIN CAPITAL LETTER W WITH GRAVE\n" +
+	"1E81 LATIN SMALL LETTER W WITH GRAVE\n" +
+	"1E82 LATIN CAPITAL LETTER W WITH ACUTE\n" +
+	"1E83 LATIN SMALL LETTER W WITH ACUTE\n" +
+	"1E84 LATIN CAPITAL LETTER W WITH DIAERESIS\n" +
+	"1E85 LATIN SMALL LETTER W WITH DIAERESIS\n" +
+	"1E86 LATIN CAPITAL LETTER W WITH DOT ABOVE\n" +
+	"1E87 LATIN SMALL LETTER W WITH DOT ABOVE\n" +
+	"1E88 LATIN CAPITAL LETTER W WITH DOT BELOW\n" +
+	"1E89 LATIN SMALL LETTER W WITH DOT BELOW\n" +
+	"1E8A LATIN CAPITAL LETTER X WITH DOT ABOVE\n" +
+	"1E8B LATIN SMALL LETTER X WITH DOT ABOVE\n" +
+	"1E8C LATIN CAPITAL LETTER X WITH DIAERESIS\n" +
+	"1E8D LATIN SMALL LETTER X WITH DIAERESIS\n" +
+	"1E8E LATIN CAPITAL LETTER Y WITH DOT ABOVE\n" +
+	"1E8F LATIN SMALL LETTER Y WITH DOT ABOVE\n" +
+	"1E90 LATIN CAPITAL LETTER Z WITH CIRCUMFLEX\n" +
+	"1E91 LATIN SMALL LETTER Z WITH CIRCUMFLEX\n" +
+	"1E92 LATIN CAPITAL LETTER Z WITH DOT BELOW\n" +
+	"1E93 LATIN SMALL LETTER Z WITH DOT BELOW\n" +
+	"1E94 LATIN CAPITAL LETTER Z WITH LINE BELOW\n" +
+	"1E95 LATIN SMALL LETTER Z WITH LINE BELOW\n" +
+	"1E96 LATIN SMALL LETTER H WITH LINE BELOW\n" +
+	"1E97 LATIN SMALL LETTER T WITH DIAERESIS\n" +
+	"1E98 LATIN SMALL LETTER W WITH RING ABOVE\n" +
+	"1E99 LATIN SMALL LETTER Y WITH RING ABOVE\n" +
+	"1E9A LATIN SMALL LETTER A WITH RIGHT HALF RING\n" +
+	"1E9B LATIN SMALL LETTER LONG S WITH DOT ABOVE\n" +
+	"1E9C LATIN SMALL LETTER LONG S WITH DIAGONAL STROKE\n" +
+	"1E9D LATIN SMALL LETTER LONG S WITH HIGH STROKE\n" +
+	"1E9E LATIN CAPITAL LETTER SHARP S\n" +
+	"1E9F LATIN SMALL LETTER DELTA\n" +
+	"1EA0 LATIN CAPITAL LETTER A WITH DOT BELOW\n" +
+	"1EA1 LATIN SMALL LETTER A WITH DOT BELOW\n" +
+	"1EA2 LATIN CAPITAL LETTER A WITH HOOK ABOVE\n" +
+	"1EA3 LATIN SMALL LETTER A WITH HOOK ABOVE\n" +
+	"1EA4 LATIN CAPITAL LETTER A WITH CIRCUMFLEX AND ACUTE\n" +
+	"1EA5 LATIN SMALL LETTER A WITH CIRCUMFLEX AND ACUTE\n" +
+	"1EA6 LATIN CAPITAL LETTER A WITH CIRCUMFLEX AND GRAVE\n" +
+	"1EA7 LATIN SMALL LETTER A WITH CIRCUMFLEX AND GRAVE\n" +
+	"1EA8 LATIN CAPITAL LETTER A WITH CIRCUMFLEX AND HOOK ABOVE\n" +
+	"1EA9 LATIN SMALL LETTER A WITH CIRCUMFLEX AND HOOK ABOVE\n" +
+	"1EAA LATIN CAPITAL LETTER A WITH CIRCUMFLEX AND TILDE\n" +
+	"1EAB LATIN SMALL LETTER A WITH CIRCUMFLEX AND TILDE\n" +
+	"1EAC LATIN CAPITAL LETTER A WITH CIRCUMFLEX AND DOT BELOW\n" +
+	"1EAD LATIN SMALL LETTER A WITH CIRCUMFLEX AND DOT BELOW\n" +
+	"1EAE LATIN CAPITAL LETTER A WITH BREVE AND ACUTE\n" +
+	"1EAF LATIN SMALL LETTER A WITH BREVE AND ACUTE\n" +
+	"1EB0 LATIN CAPITAL LETTER A WITH BREVE AND GRAVE\n" +
+	"1EB1 LATIN SMALL LETTER A WITH BREVE AND GRAVE\n" +
+	"1EB2 LATIN CAPITAL LETTER A WITH BREVE AND HOOK ABOVE\n" +
+	"1EB3 LATIN SMALL LETTER A WITH BREVE AND HOOK ABOVE\n" +
+	"1EB4 LATIN CAPITAL LETTER A WITH BREVE AND TILDE\n" +
+	"1EB5 LATIN SMALL LETTER A WITH BREVE AND TILDE\n" +
+	"1EB6 LATIN CAPITAL LETTER A WITH BREVE AND DOT BELOW\n" +
+	"1EB7 LATIN SMALL LETTER A WITH BREVE AND DOT BELOW\n" +
+	"1EB8 LATIN CAPITAL LETTER E WITH DOT BELOW\n" +
+	"1EB9 LATIN SMALL LETTER E WITH DOT BELOW\n" +
+	"1EBA LATIN CAPITAL LETTER E WITH HOOK ABOVE\n" +
+	"1EBB LATIN SMALL LETTER E WITH HOOK ABOVE\n" +
+	"1EBC LATIN CAPITAL LETTER E WITH TILDE\n" +
+	"1EBD LATIN SMALL LETTER E WITH TILDE\n" +
+	"1EBE LATIN CAPITAL LETTER E WITH CIRCUMFLEX AND ACUTE\n" +
+	"1EBF LATIN SMALL LETTER E WITH CIRCUMFLEX AND ACUTE\n" +
+	"1EC0 LATIN CAPITAL LETTER E WITH CIRCUMFLEX AND GRAVE\n" +
+	"1EC1 LATIN SMALL LETTER E WITH CIRCUMFLEX AND GRAVE\n" +
+	"1EC2 LATIN CAPITAL LETTER E WITH CIRCUMFLEX AND HOOK ABOVE\n" +
+	"1EC3 LATIN SMALL LETTER E WITH CIRCUMFLEX AND HOOK ABOVE\n" +
+	"1EC4 LATIN CAPITAL LETTER E WITH CIRCUMFLEX AND TILDE\n" +
+	"1EC5 LATIN SMALL LETTER E WITH CIRCUMFLEX AND TILDE\n" +
+	"1EC6 LATIN CAPITAL LETTER E WITH CIRCUMFLEX AND DOT BELOW\n" +
+	"1EC7 LATIN SMALL LETTER E WITH CIRCUMFLEX AND DOT BELOW\n" +
+	"1EC8 LATIN CAPITAL LETTER I WITH HOOK ABOVE\n" +
+	"1EC9 LATIN SMALL LETTER I WITH HOOK ABOVE\n" +
+	"1ECA LATIN CAPITAL LETTER I WITH DOT BELOW\n" +
+	"1ECB LATIN SMALL LETTER I WITH DOT BELOW\n" +
+	"1ECC LATIN CAPITAL LETTER O WITH DOT BELOW\n" +
+	"1ECD LATIN SMALL LETTER O WITH DOT BELOW\n" +
+	"1ECE LATIN CAPITAL LETTER O WITH HOOK ABOVE\n" +
+	"1ECF LATIN SMALL LETTER O WITH HOOK ABOVE\n" +
+	"1ED0 LATIN CAPITAL LETTER O WITH CIRCUMFLEX AND ACUTE\n" +
+	"1ED1 LATIN SMALL LETTER O WITH CIRCUMFLEX AND ACUTE\n" +
+	"1ED2 LATIN CAPITAL LETTER O WITH CIRCUMFLEX AND GRAVE\n" +
+	"1ED3 LATIN SMALL LETTER O WITH CIRCUMFLEX AND GRAVE\n" +
+	"1ED4 LATIN CAPITAL LETTER O WITH CIRCUMFLEX AND HOOK ABOVE\n" +
+	"1ED5 LATIN SMALL LETTER O WITH CIRCUMFLEX AND HOOK ABOVE\n" +
+	"1ED6 LATIN CAPITAL LETTER O WITH CIRCUMFLEX AND TILDE\n" +
+	"1ED7 LATIN SMALL LETTER O WITH CIRCUMFLEX AND TILDE\n" +
+	"1ED8 LATIN CAPITAL LETTER O WITH CIRCUMFLEX AND DOT BELOW\n" +
+	"1ED9 LATIN SMALL LETTER O WITH CIRCUMFLEX AND DOT BELOW\n" +
+	"1EDA LATIN CAPITAL LETTER O WITH HORN AND ACUTE\n" +
+	"1EDB LATIN SMALL LETTER O WITH HORN AND ACUTE\n" +
+	"1EDC LATIN CAPITAL LETTER O WITH HORN AND GRAVE\n" +
+	"1EDD LATIN SMALL LETTER O WITH HORN AND GRAVE\n" +
+	"1EDE LATIN CAPITAL LETTER O WITH HORN AND HOOK ABOVE\n" +
+	"1EDF LATIN SMALL LETTER O WITH HORN AND HOOK ABOVE\n" +
+	"1EE0 LATIN CAPITAL LETTER O WITH HORN AND TILDE\n" +
+	"1EE1 LATIN SMALL LETTER O WITH HORN AND TILDE\n" +
+	"1EE2 LATIN CAPITAL LETTER O WITH HORN AND DOT BELOW\n" +
+	"1EE3 LATIN SMALL LETTER O WITH HORN AND DOT BELOW\n" +
+	"1EE4 LATIN CAPITAL LETTER U WITH DOT BELOW\n" +
+	"1EE5 LATIN SMALL LETTER U WITH DOT BELOW\n" +
+	"1EE6 LATIN CAPITAL LETTER U WITH HOOK ABOVE\n" +
+	"1EE7 LATIN SMALL LETTER U WITH HOOK ABOVE\n" +
+	"1EE8 LATIN CAPITAL LETTER U WITH HORN AND ACUTE\n" +
+	"1EE9 LATIN SMALL LETTER U WITH HORN AND ACUTE\n" +
+	"1EEA LATIN CAPITAL LETTER U WITH HORN AND GRAVE\n" +
+	"1EEB LATIN SMALL LETTER U WITH HORN AND GRAVE\n" +
+	"1EEC LATIN CAPITAL LETTER U WITH HORN AND HOOK ABOVE\n" +
+	"1EED LATIN SMALL LETTER U WITH HORN AND HOOK ABOVE\n" +
+	"1EEE LATIN CAPITAL LETTER U WITH HORN AND TILDE\n" +
+	"1EEF LATIN SMALL LETTER U WITH HORN AND TILDE\n" +
+	"1EF0 LATIN CAPITAL LETTER U WITH HORN AND DOT BELOW\n" +
+	"1EF1 LATIN SMALL LETTER U WITH HORN AND DOT BELOW\n" +
+	"1EF2 LATIN CAPITAL LETTER Y WITH GRAVE\n" +
+	"1EF3 LATIN SMALL LETTER Y WITH GRAVE\n" +
+	"1EF4 LATIN CAPITAL LETTER Y WITH DOT BELOW\n" +
+	"1EF5 LATIN SMALL LETTER Y WITH DOT BELOW\n" +
+	"1EF6 LATIN CAPITAL LETTER Y WITH HOOK ABOVE\n" +
+	"1EF7 LATIN SMALL LETTER Y WITH HOOK ABOVE\n" +
+	"1EF8 LATIN CAPITAL LETTER Y WITH TILDE\n" +
+	"1EF9 LATIN SMALL LETTER Y WITH TILDE\n" +
+	"1EFA LATIN CAPITAL LETTER MIDDLE-WELSH LL\n" +
+	"1EFB LATIN SMALL LETTER MIDDLE-WELSH LL\n" +
+	"1EFC LATIN CAPITAL LETTER MIDDLE-WELSH V\n" +
+	"1EFD LATIN SMALL LETTER MIDDLE-WELSH V\n" +
+	"1EFE LATIN CAPITAL LETTER Y WITH LOOP\n" +
+	"1EFF LATIN SMALL LETTER Y WITH LOOP\n" +
+	"2000 EN QUAD\n" +
+	"2001 EM QUAD\n" +
+	"2002 EN SPACE\n" +
+	"2003 EM SPACE\n" +
+	"2004 THREE-PER-EM SPACE\n" +
+	"2005 FOUR-PER-EM SPACE\n" +
+	"2006 SIX-PER-EM SPACE\n" +
+	"2007 FIGURE SPACE\n" +
+	"2008 PUNCTUATION SPACE\n" +
+	"2009 THIN SPACE\n" +
+	"200A HAIR SPACE\n" +
+	"200B ZERO WIDTH SPACE\n" +
+	"200C ZERO WIDTH NON-JOINER\n" +
+	"200D ZERO WIDTH JOINER\n" +
+	"200E LEFT-TO-RIGHT MARK\n" +
+	"200F RIGHT-TO-LEFT MARK\n" +
+	"2010 HYPHEN\n" +
+	"2011 NON-BREAKING HYPHEN\n" +
+	"2012 FIGURE DASH\n" +
+	"2013 EN DASH\n" +
+	"2014 EM DASH\n" +
+	"2015 HORIZONTAL BAR\n" +
+	"2016 DOUBLE VERTICAL LINE\n" +
+	"2017 DOUBLE LOW LINE\n" +
+	"2018 LEFT SINGLE QUOTATION MARK\n" +
+	"2019 RIGHT SINGLE QUOTATION MARK\n" +
+	"201A SINGLE LOW-9 QUOTATION MARK\n" +
+	"201B SINGLE HIGH-REVERSED-9 QUOTATION MARK\n" +
+	"201C LEFT DOUBLE QUOTATION MARK\n" +
+	"201D RIGHT DOUBLE QUOTATION MARK\n" +
+	"201E DOUBLE LOW-9 QUOTATION MARK\n" +
+	"201F DOUBLE HIGH-REVERSED-9 QUOTATION MARK\n" +
+	"2020 DAGGER\n" +
+	"2021 DOUBLE DAGGER\n" +
+	"2022 BULLET\n" +
+	"2023 TRIANGULAR BULLET\n" +
+	"2024 ONE DOT LEADER\n" +
+	"2025 TWO DOT LEADER\n" +
+	"2026 HORIZONTAL ELLIPSIS\n" +
+	"2027 HYPHENATION POINT\n" +
+	"2028 LINE SEPARATOR\n" +
+	"2029 PARAGRAPH SEPARATOR\n" +
+	"202A LEFT-TO-RIGHT EMBEDDING\n" +
+	"202B RIGHT-TO-LEFT EMBEDDING\n" +
+	"202C POP DIRECTIONAL FORMATTING\n" +
+	"202D LEFT-TO-RIGHT OVERRIDE\n" +
+	"202E RIGHT-TO-LEFT OVERRIDE\n" +
+	"202F NARROW NO-BREAK SPACE\n" +
+	"2030 PER MILLE SIGN\n" +
+	"2031 PER TEN THOUSAND SIGN\n" +
+	"2032 PRIME\n" +
+	"2033 DOUBLE PRIME\n" +
+	"2034 TRIPLE PRIME\n" +
+	"2035 REVERSED PRIME\n" +
+	"2036 REVERSED DOUBLE PRIME\n" +
+	"2037 REVERSED TRIPLE PRIME\n" +
+	"2038 CARET\n" +
+	"2039 SINGLE LEFT-POINTING ANGLE QUOTATION MARK\n" +
+	"203A SINGLE RIGHT-POINTING ANGLE QUOTATION MARK\n" +
+	"203B REFERENCE MARK\n" +
+	"203C DOUBLE EXCLAMATION MARK\n" +
+	"203D INTERROBANG\n" +
+	"203E OVERLINE\n" +
+	"203F UNDERTIE\n" +
+	"2040 CHARACTER TIE\n" +
+	"2041 CARET INSERTION POINT\n" +
+	"2042 ASTERISM\n" +
+	"2043 HYPHEN BULLET\n" +
+	"2044 FRACTION SLASH\n" +
+	"2045 LEFT SQUARE BRACKET WITH QUILL\n" +
+	"2046 RIGHT SQUARE BRACKET WITH QUILL\n" +
+	"2047 DOUBLE QUESTION MARK\n" +
+	"2048 QUESTION EXCLAMATION MARK\n" +
+	"2049 EXCLAMATION QUESTION MARK\n" +
+	"204A TIRONIAN SIGN ET\n" +
+	"204B REVERSED PILCROW SIGN\n" +
+	"204C BLACK LEFTWARDS BULLET\n" +
+	"204D BLACK RIGHTWARDS BULLET\n" +
+	"204E LOW ASTERISK\n" +
+	"204F REVERSED SEMICOLON\n" +
+	"2050 CLOSE UP\n" +
+	"2051 TWO ASTERISKS ALIGNED VERTICALLY\n" +
+	"2052 COMMERCIAL MINUS SIGN\n" +
+	"2053 SWUNG DASH\n" +
+	"2054 INVERTED UNDERTIE\n" +
+	"2055 FLOWER PUNCTUATION MARK\n" +
+	"2056 THREE DOT PUNCTUATION\n" +
+	"2057 QUADRUPLE PRIME\n" +
+	"2058 FOUR DOT PUNCTUATION\n" +
+	"2059 FIVE DOT PUNCTUATION\n" +
+	"205A TWO DOT PUNCTUATION\n" +
+	"205B FOUR DOT MARK\n" +
+	"205C DOTTED CROSS\n" +
+	"205D TRICOLON\n" +
+	"205E VERTICAL FOUR DOTS\n" +
+	"205F MEDIUM MATHEMATICAL SPACE\n" +
+	"2060 WORD JOINER\n" +
+	"2061 FUNCTION APPLICATION\n" +
+	"2062 INVISIBLE TIMES\n" +
+	"2063 INVISIBLE SEPARATOR\n" +
+	"2064 INVISIBLE PLUS\n" +
+	"2066 LEFT-TO-RIGHT ISOLATE\n" +
+	"2067 RIGHT-TO-LEFT ISOLATE\n" +
+	"2068 FIRST STRONG ISOLATE\n" +
+	"2069 POP DIRECTIONAL ISOLATE\n" +
+	"206A INHIBIT SYMMETRIC SWAPPING\n" +
+	"206B ACTIVATE SYMMETRIC SWAPPING\n" +
+	"206C INHIBIT ARABIC FORM SHAPING\n" +
+	"206D ACTIVATE ARABIC FORM SHAPING\n" +
+	"206E NATIONAL DIGIT SHAPES\n" +
+	"206F NOMINAL DIGIT SHAPES\n" +
+	"2070 SUPERSCRIPT ZERO\n" +
+	"2071 SUPERSCRIPT LATIN SMALL LETTER I\n" +
+	"2074 SUPERSCRIPT FOUR\n" +
+	"2075 SUPERSCRIPT FIVE\n" +
+	"2076 SUPERSCRIPT SIX\n" +
+	"2077 SUPERSCRIPT SEVEN\n" +
+	"2078 SUPERSCRIPT EIGHT\n" +
+	"2079 SUPERSCRIPT NINE\n" +
+	"207A SUPERSCRIPT PLUS SIGN\n" +
+	"207B SUPERSCRIPT MINUS\n" +
+	"207C SUPERSCRIPT EQUALS SIGN\n" +
+	"207D SUPERSCRIPT LEFT PARENTHESIS\n" +
+	"207E SUPERSCRIPT RIGHT PARENTHESIS\n" +
+	"207F SUPERSCRIPT LATIN SMALL LETTER N\n" +
+	"2080 SUBSCRIPT ZERO\n" +
+	"2081 SUBSCRIPT ONE\n" +
+	"2082 SUBSCRIPT TWO\n" +
+	"2083 SUBSCRIPT THREE\n" +
+	"2084 SUBSCRIPT FOUR\n" +
+	"2085 SUBSCRIPT FIVE\n" +
+	"2086 SUBSCRIPT SIX\n" +
+	"2087 SUBSCRIPT SEVEN\n" +
+	"2088 SUBSCRIPT EIGHT\n" +
+	"2089 SUBSCRIPT NINE\n" +
+	"208A SUBSCRIPT PLUS SIGN\n" +
+	"208B SUBSCRIPT MINUS\n" +
+	"208C SUBSCRIPT EQUALS SIGN\n" +
+	"208D SUBSCRIPT LEFT PARENTHESIS\n" +
+	"208E SUBSCRIPT RIGHT PARENTHESIS\n" +
+	"2090 LATIN SUBSCRIPT SMALL LETTER A\n" +
+	"2091 LATIN SUBSCRIPT SMALL LETTER E\n" +
+	"2092 LATIN SUBSCRIPT SMALL LETTER O\n" +
+	"2093 LATIN SUBSCRIPT SMALL LETTER X\n" +
+	"2094 LATIN SUBSCRIPT SMALL LETTER SCHWA\n" +
+	"2095 LATIN SUBSCRIPT SMALL LETTER H\n" +
+	"2096 LATIN SUBSCRIPT SMALL LETTER K\n" +
+	"2097 LATIN SUBSCRIPT SMALL LETTER L\n" +
+	"2098 LATIN SUBSCRIPT SMALL LETTER M\n" +
+	"2099 LATIN SUBSCRIPT SMALL LETTER N\n" +
+	"209A LATIN SUBSCRIPT SMALL LETTER P\n" +
+	"209B LATIN SUBSCRIPT SMALL LETTER S\n" +
+	"209C LATIN SUBSCRIPT SMALL LETTER T\n" +
+	"20A0 EURO-CURRENCY SIGN\n" +
+	"20A1 COLON SIGN\n" +
+	"20A2 CRUZEIRO SIGN\n" +
+	"20A3 FRENCH FRANC SIGN\n" +
+	"20A4 LIRA SIGN\n" +
+	"20A5 MILL SIGN\n" +
+	"20A6 NAIRA SIGN\n" +
+	"20A7 PESETA SIGN\n" +
+	"20A8 RUPEE SIGN\n" +
+	"20A9 WON SIGN\n" +
+	"20AA NEW SHEQEL SIGN\n" +
+	"20AB DONG SIGN\n" +
+	"20AC EURO SIGN\n" +
+	"20AD KIP SIGN\n" +
+	"20AE TUGRIK SIGN\n" +
+	"20AF DRACHMA SIGN\n" +
+	"20B0 GERMAN PENNY SIGN\n" +
+	"20B1 PESO SIGN\n" +
+	"20B2 GUARANI SIGN\n" +
+	"20B3 AUSTRAL SIGN\n" +
+	"20B4 HRYVNIA SIGN\n" +
+	"20B5 CEDI SIGN\n" +
+	"20B6 LIVRE TOURNOIS SIGN\n" +
+	"20B7 SPESMILO SIGN\n" +
+	"20B8 TENGE SIGN\n" +
+	"20B9 INDIAN RUPEE SIGN\n" +
+	"20BA TURKISH LIRA SIGN\n" +
+	"20BB NORDIC MARK SIGN\n" +
+	"20BC MANAT SIGN\n" +
+	"20BD RUBLE SIGN\n" +
+	"20BE LARI SIGN\n" +
+	"20BF BITCOIN SIGN\n" +
+	"20C0 SOM SIGN\n" +
+	"2100 ACCOUNT OF\n" +
+	"2101 ADDRESSED TO THE SUBJECT\n" +
+	"2102 DOUBLE-STRUCK CAPITAL C\n" +
+	"2103 DEGREE CELSIUS\n" +
+	"2104 CENTRE LINE SYMBOL\n" +
+	"2105 CARE OF\n" +
+	"2106 CADA UNA\n" +
+	"2107 EULER CONSTANT\n" +
+	"2108 SCRUPLE\n" +
+	"2109 DEGREE FAHRENHEIT\n" +
+	"210A SCRIPT SMALL G\n" +
+	"210B SCRIPT CAPITAL H\n" +
+	"210C BLACK-LETTER CAPITAL H\n" +
+	"210D DOUBLE-STRUCK CAPITAL H\n" +
+	"210E PLANCK CONSTANT\n" +
+	"210F PLANCK CONSTANT OVER TWO PI\n" +
+	"2110 SCRIPT CAPITAL I\n" +
+	"2111 BLACK-LETTER CAPITAL I\n" +
+	"2112 SCRIPT CAPITAL L\n" +
+	"2113 SCRIPT SMALL L\n" +
+	"2114 L B BAR SYMBOL\n" +
+	"2115 DOUBLE-STRUCK CAPITAL N\n" +
+	"2116 NUMERO SIGN\n" +
+	"2117 SOUND RECORDING COPYRIGHT\n" +
+	"2118 SCRIPT CAPITAL P\n" +
+	"2119 DOUBLE-STRUCK CAPITAL P\n" +
+	"211A DOUBLE-STRUCK CAPITAL Q\n" +
+	"211B SCRIPT CAPITAL R\n" +
+	"211C BLACK-LETTER CAPITAL R\n" +
+	"211D DOUBLE-STRUCK CAPITAL R\n" +
+	"211E PRESCRIPTION TAKE\n" +
+	"211F RESPONSE\n" +
+	"2120 SERVICE MARK\n" +
+	"2121 TELEPHONE SIGN\n" +
+	"2122 TRADE MARK SIGN\n" +
+	"2123 VERSICLE\n" +
+	"2124 DOUBLE-STRUCK CAPITAL Z\n" +
+	"2125 OUNCE SIGN\n" +
+	"2126 OHM SIGN\n" +
+	"2127 INVERTED OHM SIGN\n" +
+	"2128 BLACK-LETTER CAPITAL Z\n" +
+	"2129 TURNED GREEK SMALL LETTER IOTA\n" +
+	"212A KELVIN SIGN\n" +
+	"212B ANGSTROM SIGN\n" +
+	"212C SCRIPT CAPITAL B\n" +
+	"212D BLACK-LETTER CAPITAL C\n" +
+	"212E ESTIMATED SYMBOL\n" +
+	"212F SCRIPT SMALL E\n" +
+	"2130 SCRIPT CAPITAL E\n" +
+	"2131 SCRIPT CAPITAL F\n" +
+	"2132 TURNED CAPITAL F\n" +
+	"2133 SCRIPT CAPITAL M\n" +
+	"2134 SCRIPT SMALL O\n" +
+	"2135 ALEF SYMBOL\n" +
+	"2136 BET SYMBOL\n" +
+	"2137 GIMEL SYMBOL\n" +
+	"2138 DALET SYMBOL\n" +
+	"2139 INFORMATION SOURCE\n" +
+	"213A ROTATED CAPITAL Q\n" +
+	"213B FACSIMILE SIGN\n" +
+	"213C DOUBLE-STRUCK SMALL PI\n" +
+	"213D DOUBLE-STRUCK SMALL GAMMA\n" +
+	"213E DOUBLE-STRUCK CAPITAL GAMMA\n" +
+	"213F DOUBLE-STRUCK CAPITAL PI\n" +
+	"2140 DOUBLE-STRUCK N-ARY SUMMATION\n" +
+	"2141 TURNED SANS-SERIF CAPITAL G\n" +
+	"2142 TURNED SANS-SERIF CAPITAL L\n" +
+	"2143 REVERSED SANS-SERIF CAPITAL L\n" +
+	"2144 TURNED SANS-SERIF CAPITAL Y\n" +
+	"2145 DOUBLE-STRUCK ITALIC CAPITAL D\n" +
+	"2146 DOUBLE-STRUCK ITALIC SMALL D\n" +
+	"2147 DOUBLE-STRUCK ITALIC SMALL E\n" +
+	"2148 DOUBLE-STRUCK ITALIC SMALL I\n" +
+	"2149 DOUBLE-STRUCK ITALIC SMALL J\n" +
+	"214A PROPERTY LINE\n" +
+	"214B TURNED AMPERSAND\n" +
+	"214C PER SIGN\n" +
+	"214D AKTIESELSKAB\n" +
+	"214E TURNED SMALL F\n" +
+	"214F SYMBOL FOR SAMARITAN SOURCE\n" +
+	"2150 VULGAR FRACTION ONE SEVENTH\n" +
+	"2151 VULGAR FRACTION ONE NINTH\n" +
+	"2152 VULGAR FRACTION ONE TENTH\n" +
+	"2153 VULGAR FRACTION ONE THIRD\n" +
+	"2154 VULGAR FRACTION TWO THIRDS\n" +
+	"2155 VULGAR FRACTION ONE FIFTH\n" +
+	"2156 VULGAR FRACTION TWO FIFTHS\n" +
+	"2157 VULGAR FRACTION THREE FIFTHS\n" +
+	"2158 VULGAR FRACTION FOUR FIFTHS\n" +
+	"2159 VULGAR FRACTION ONE SIXTH\n" +
+	"215A VULGAR FRACTION FIVE SIXTHS\n" +
+	"215B VULGAR FRACTION ONE EIGHTH\n" +
+	"215C VULGAR FRACTION THREE EIGHTHS\n" +
+	"215D VULGAR FRACTION FIVE EIGHTHS\n" +
+	"215E VULGAR FRACTION SEVEN EIGHTHS\n" +
+	"215F FRACTION NUMERATOR ONE\n" +
+	"2160 ROMAN NUMERAL ONE\n" +
+	"2161 ROMAN NUMERAL TWO\n" +
+	"2162 ROMAN NUMERAL THREE\n" +
+	"2163 ROMAN NUMERAL FOUR\n" +
+	"2164 ROMAN NUMERAL FIVE\n" +
+	"2165 ROMAN NUMERAL SIX\n" +
+	"2166 ROMAN NUMERAL SEVEN\n" +
+	"2167 ROMAN NUMERAL EIGHT\n" +
+	"2168 ROMAN NUMERAL NINE\n" +
+	"2169 ROMAN NUMERAL TEN\n" +
+	"216A ROMAN NUMERAL ELEVEN\n" +
+	"216B ROMAN NUMERAL TWELVE\n" +
+	"216C ROMAN NUMERAL FIFTY\n" +
+	"216D ROMAN NUMERAL ONE HUNDRED\n" +
+	"216E ROMAN NUMERAL FIVE HUNDRED\n" +
+	"216F ROMAN NUMERAL ONE THOUSAND\n" +
+	"2170 SMALL ROMAN NUMERAL ONE\n" +
+	"2171 SMALL ROMAN NUMERAL TWO\n" +
+	"2172 SMALL ROMAN NUMERAL THREE\n" +
+	"2173 SMALL ROMAN NUMERAL FOUR\n" +
+	"2174 SMALL ROMAN NUMERAL FIVE\n" +
+	"2175 SMALL ROMAN NUMERAL SIX\n" +
+	"2176 SMALL ROMAN NUMERAL SEVEN\n" +
+	"2177 SMALL ROMAN NUMERAL EIGHT\n" +
+	"2178 SMALL ROMAN NUMERAL NINE\n" +
+	"2179 SMALL ROMAN NUMERAL TEN\n" +
+	"217A SMALL ROMAN NUMERAL ELEVEN\n" +
+	"217B SMALL ROMAN NUMERAL TWELVE\n" +
+	"217C SMALL ROMAN NUMERAL FIFTY\n" +
+	"217D SMALL ROMAN NUMERAL ONE HUNDRED\n" +
+	"217E SMALL ROMAN NUMERAL FIVE HUNDRED\n" +
+	"217F SMALL ROMAN NUMERAL ONE THOUSAND\n" +
+	"2180 ROMAN NUMERAL ONE THOUSAND C D\n" +
+	"2181 ROMAN NUMERAL FIVE THOUSAND\n" +
+	"2182 ROMAN NUMERAL TEN THOUSAND\n" +
+	"2183 ROMAN NUMERAL REVERSED ONE HUNDRED\n" +
+	"2184 LATIN SMALL LETTER REVERSED C\n" +
+	"2185 ROMAN NUMERAL SIX LATE FORM\n" +
+	"2186 ROMAN NUMERAL FIFTY EARLY FORM\n" +
+	"2187 ROMAN NUMERAL FIFTY THOUSAND\n" +
+	"2188 ROMAN NUMERAL ONE HUNDRED THOUSAND\n" +
+	"2189 VULGAR FRACTION ZERO THIRDS\n" +
+	"218A TURNED DIGIT TWO\n" +
+	"218B TURNED DIGIT THREE\n" +
+	"2190 LEFTWARDS ARROW\n" +
+	"2191 UPWARDS ARROW\n" +
+	"2192 RIGHTWARDS ARROW\n" +
+	"2193 DOWNWARDS ARROW\n" +
+	"2194 LEFT RIGHT ARROW\n" +
+	"2195 UP DOWN ARROW\n" +
+	"2196 NORTH WEST ARROW\n" +
+	"2197 NORTH EAST ARROW\n" +
+	"2198 SOUTH EAST ARROW\n" +
+	"2199 SOUTH WEST ARROW\n" +
+	"219A LEFTWARDS ARROW WITH STROKE\n" +
+	"219B RIGHTWARDS ARROW WITH STROKE\n" +
+	"219C LEFTWARDS WAVE ARROW\n" +
+	"219D RIGHTWARDS WAVE ARROW\n" +
+	"219E LEFTWARDS TWO HEADED ARROW\n" +
+	"219F UPWARDS TWO HEADED ARROW\n" +
+	"21A0 RIGHTWARDS TWO HEADED ARROW\n" +
+	"21A1 DOWNWARDS TWO HEADED ARROW\n" +
+	"21A2 LEFTWARDS ARROW WITH TAIL\n" +
+	"21A3 RIGHTWARDS ARROW WITH TAIL\n" +
+	"21A4 LEFTWARDS ARROW FROM BAR\n" +
+	"21A5 UPWARDS ARROW FROM BAR\n" +
+	"21A6 RIGHTWARDS ARROW FROM BAR\n" +
+	"21A7 DOWNWARDS ARROW FROM BAR\n" +
+	"21A8 UP DOWN ARROW WITH BASE\n" +
+	"21A9 LEFTWARDS ARROW WITH HOOK\n" +
+	"21AA RIGHTWARDS ARROW WITH HOOK\n" +
+	"21AB LEFTWARDS ARROW WITH LOOP\n" +
+	"21AC RIGHTWARDS ARROW WITH LOOP\n" +
+	"21AD LEFT RIGHT WAVE ARROW\n" +
+	"21AE LEFT RIGHT ARROW WITH STROKE\n" +
+	"21AF DOWNWARDS ZIGZAG ARROW\n" +
+	"21B0 UPWARDS ARROW WITH TIP LEFTWARDS\n" +
+	"21B1 UPWARDS ARROW WITH TIP RIGHTWARDS\n" +
+	"21B2 DOWNWARDS ARROW WITH TIP LEFTWARDS\n" +
+	"21B3 DOWNWARDS ARROW WITH TIP RIGHTWARDS\n" +
+	"21B4 RIGHTWARDS ARROW WITH CORNER DOWNWARDS\n" +
+	"21B5 DOWNWARDS ARROW WITH CORNER LEFTWARDS\n" +
+	"21B6 ANTICLOCKWISE TOP SEMICIRCLE ARROW\n" +
+	"21B7 CLOCKWISE TOP SEMICIRCLE ARROW\n" +
+	"21B8 NORTH WEST ARROW TO LONG BAR\n" +
+	"21B9 LEFTWARDS ARROW TO BAR OVER RIGHTWARDS ARROW TO BAR\n" +
+	"21BA ANTICLOCKWISE OPEN CIRCLE ARROW\n" +
+	"21BB CLOCKWISE OPEN CIRCLE ARROW\n" +
+	"21BC LEFTWARDS HARPOON WITH BARB UPWARDS\n" +
+	"21BD LEFTWARDS HARPOON WITH BARB DOWNWARDS\n" +
+	"21BE UPWARDS HARPOON WITH BARB RIGHTWARDS\n" +
+	"21BF UPWARDS HARPOON WITH BARB LEFTWARDS\n" +
+	"21C0 RIGHTWARDS HARPOON WITH BARB UPWARDS\n" +
+	"21C1 RIGHTWARDS HARPOON WITH BARB DOWNWARDS\n" +
+	"21C2 DOWNWARDS HARPOON WITH BARB RIGHTWARDS\n" +
+	"21C3 DOWNWARDS HARPOON WITH BARB LEFTWARDS\n" +
+	"21C4 RIGHTWARDS ARROW OVER LEFTWARDS ARROW\n" +
+	"21C5 UPWARDS ARROW LEFTWARDS OF DOWNWARDS ARROW\n" +
+	"21C6 LEFTWARDS ARROW OVER RIGHTWARDS ARROW\n" +
+	"21C7 LEFTWARDS PAIRED ARROWS\n" +
+	"21C8 UPWARDS PAIRED ARROWS\n" +
+	"21C9 RIGHTWARDS PAIRED ARROWS\n" +
+	"21CA DOWNWARDS PAIRED ARROWS\n" +
+	"21CB LEFTWARDS HARPOON OVER RIGHTWARDS HARPOON\n" +
+	"21CC RIGHTWARDS HARPOON OVER LEFTWARDS HARPOON\n" +
+	"21CD LEFTWARDS DOUBLE ARROW WITH STROKE\n" +
+	"21CE LEFT RIGHT DOUBLE ARROW WITH STROKE\n" +
+	"21CF RIGHTWARDS DOUBLE ARROW WITH STROKE\n" +
+	"21D0 LEFTWARDS DOUBLE ARROW\n" +
+	"21D1 UPWARDS DOUBLE ARROW\n" +
+	"21D2 RIGHTWARDS DOUBLE ARROW\n" +
+	"21D3 DOWNWARDS DOUBLE ARROW\n" +
+	"21D4 LEFT RIGHT DOUBLE ARROW\n" +
+	"21D5 UP DOWN DOUBLE ARROW\n" +
+	"21D6 NORTH WEST DOUBLE ARROW\n" +
+	"21D7 NORTH EAST DOUBLE ARROW\n" +
+	"21D8 SOUTH EAST DOUBLE ARROW\n" +
+	"21D9 SOUTH WEST DOUBLE ARROW\n" +
+	"21DA LEFTWARDS TRIPLE ARROW\n" +
+	"21DB RIGHTWARDS TRIPLE ARROW\n" +
+	"21DC LEFTWARDS SQUIGGLE ARROW\n" +
+	"21DD RIGHTWARDS SQUIGGLE ARROW\n" +
+	"21DE UPWARDS ARROW WITH DOUBLE STROKE\n" +
+	"21DF DOWNWARDS ARROW WITH DOUBLE STROKE\n" +
+	"21E0 LEFTWARDS DASHED ARROW\n" +
+	"21E1 UPWARDS DASHED ARROW\n" +
+	"21E2 RIGHTWARDS DASHED ARROW\n" +
+	"21E3 DOWNWARDS DASHED ARROW\n" +
+	"21E4 LEFTWARDS ARROW TO BAR\n" +
+	"21E5 RIGHTWARDS ARROW TO BAR\n" +
+	"21E6 LEFTWARDS WHITE ARROW\n" +
+	"21E7 UPWARDS WHITE ARROW\n" +
+	"21E8 RIGHTWARDS WHITE ARROW\n" +
+	"21E9 DOWNWARDS WHITE ARROW\n" +
+	"21EA UPWARDS WHITE ARROW FROM BAR\n" +
+	"21EB UPWARDS WHITE ARROW ON PEDESTAL\n" +
+	"21EC UPWARDS WHITE ARROW ON PEDESTAL WITH HORIZONTAL BAR\n" +
+	"21ED UPWARDS WHITE ARROW ON PEDESTAL WITH VERTICAL BAR\n" +
+	"21EE UPWARDS WHITE DOUBLE ARROW\n" +
+	"21EF UPWARDS WHITE DOUBLE ARROW ON PEDESTAL\n" +
+	"21F0 RIGHTWARDS WHITE ARROW FROM WALL\n" +
+	"21F1 NORTH WEST ARROW TO CORNER\n" +
+	"21F2 SOUTH EAST ARROW TO CORNER\n" +
+	"21F3 UP DOWN WHITE ARROW\n" +
+	"21F4 RIGHT ARROW WITH SMALL CIRCLE\n" +
+	"21F5 DOWNWARDS ARROW LEFTWARDS OF UPWARDS ARROW\n" +
+	"21F6 THREE RIGHTWARDS ARROWS\n" +
+	"21F7 LEFTWARDS ARROW WITH VERTICAL STROKE\n" +
+	"21F8 RIGHTWARDS ARROW WITH VERTICAL STROKE\n" +
+	"21F9 LEFT RIGHT ARROW WITH VERTICAL STROKE\n" +
+	"21FA LEFTWARDS ARROW WITH DOUBLE VERTICAL STROKE\n" +
+	"21FB RIGHTWARDS ARROW WITH DOUBLE VERTICAL STROKE\n" +
+	"21FC LEFT RIGHT ARROW WITH DOUBLE VERTICAL STROKE\n" +
+	"21FD LEFTWARDS OPEN-HEADED ARROW\n" +
+	"21FE RIGHTWARDS OPEN-HEADED ARROW\n" +
+	"21FF LEFT RIGHT OPEN-HEADED ARROW\n" +
+	"2200 FOR ALL\n" +
+	"2201 COMPLEMENT\n" +
+	"2202 PARTIAL DIFFERENTIAL\n" +
+	"2203 THERE EXISTS\n" +
+	"2204 THERE DOES NOT EXIST\n" +
+	"2205 EMPTY SET\n" +
+	"2206 INCREMENT\n" +
+	"2207 NABLA\n" +
+	"2208 ELEMENT OF\n" +
+	"2209 NOT AN ELEMENT OF\n" +
+	"220A SMALL ELEMENT OF\n" +
+	"220B CONTAINS AS MEMBER\n" +
+	"220C DOES NOT CONTAIN AS MEMBER\n" +
+	"220D SMALL CONTAINS AS MEMBER\n" +
+	"220E END OF PROOF\n" +
+	"220F N-ARY PRODUCT\n" +
+	"2210 N-ARY COPRODUCT\n" +
+	"2211 N-ARY SUMMATION\n" +
+	"2212 MINUS SIGN\n" +
+	"2213 MINUS-OR-PLUS SIGN\n" +
+	"2214 DOT PLUS\n" +
+	"2215 DIVISION SLASH\n" +
+	"2216 SET MINUS\n" +
+	"2217 ASTERISK OPERATOR\n" +
+	"2218 RING OPERATOR\n" +
+	"2219 BULLET OPERATOR\n" +
+	"221A SQUARE ROOT\n" +
+	"221B CUBE ROOT\n" +
+	"221C FOURTH ROOT\n" +
+	"221D PROPORTIONAL TO\n" +
+	"221E INFINITY\n" +
+	"221F RIGHT ANGLE\n" +
+	"2220 ANGLE\n" +
+	"2221 MEASURED ANGLE\n" +
+	"2222 SPHERICAL ANGLE\n" +
+	"2223 DIVIDES\n" +
+	"2224 DOES NOT DIVIDE\n" +
+	"2225 PARALLEL TO\n" +
+	"2226 NOT PARALLEL TO\n" +
+	"2227 LOGICAL AND\n" +
+	"2228 LOGICAL OR\n" +
+	"2229 INTERSECTION\n" +
+	"222A UNION\n" +
+	"222B INTEGRAL\n" +
+	"222C DOUBLE INTEGRAL\n" +
+	"222D TRIPLE INTEGRAL\n" +
+	"222E CONTOUR INTEGRAL\n" +
+	"222F SURFACE INTEGRAL\n" +
+	"2230 VOLUME INTEGRAL\n" +
+	"2231 CLOCKWISE INTEGRAL\n" +
+	"2232 CLOCKWISE CONTOUR INTEGRAL\n" +
+	"2233 ANTICLOCKWISE CONTOUR INTEGRAL\n" +
+	"2234 THEREFORE\n" +
+	"2235 BECAUSE\n" +
+	"2236 RATIO\n" +
+	"2237 PROPORTION\n" +
+	"2238 DOT MINUS\n" +
+	"2239 EXCESS\n" +
+	"223A GEOMETRIC PROPORTION\n" +
+	"223B HOMOTHETIC\n" +
+	"223C TILDE OPERATOR\n" +
+	"223D REVERSED TILDE\n" +
+	"223E INVERTED LAZY S\n" +
+	"223F SINE WAVE\n" +
+	"2240 WREATH PRODUCT\n" +
+	"2241 NOT TILDE\n" +
+	"2242 MINUS TILDE\n" +
+	"2243 ASYMPTOTICALLY EQUAL TO\n" +
+	"2244 NOT ASYMPTOTICALLY EQUAL TO\n" +
+	"2245 APPROXIMATELY EQUAL TO\n" +
+	"2246 APPROXIMATELY BUT NOT ACTUALLY EQUAL TO\n" +
+	"2247 NEITHER APPROXIMATELY NOR ACTUALLY EQUAL TO\n" +
+	"2248 ALMOST EQUAL TO\n" +
+	"2249 NOT ALMOST EQUAL TO\n" +
+	"224A ALMOST EQUAL OR EQUAL TO\n" +
+	"224B TRIPLE TILDE\n" +
+	"224C ALL EQUAL TO\n" +
+	"224D EQUIVALENT TO\n" +
+	"224E GEOMETRICALLY EQUIVALENT TO\n" +
+	"224F DIFFERENCE BETWEEN\n" +
+	"2250 APPROACHES THE LIMIT\n" +
+	"2251 GEOMETRICALLY EQUAL TO\n" +
+	"2252 APPROXIMATELY EQUAL TO OR THE IMAGE OF\n" +
+	"2253 IMAGE OF OR APPROXIMATELY EQUAL TO\n" +
+	"2254 COLON EQUALS\n" +
+	"2255 EQUALS COLON\n" +
+	"2256 RING IN EQUAL TO\n" +
+	"2257 RING EQUAL TO\n" +
+	"2258 CORRESPONDS TO\n" +
+	"2259 ESTIMATES\n" +
+	"225A EQUIANGULAR TO\n" +
+	"225B STAR EQUALS\n" +
+	"225C DELTA EQUAL TO\n" +
+	"225D EQUAL TO BY DEFINITION\n" +
+	"225E MEASURED BY\n" +
+	"225F QUESTIONED EQUAL TO\n" +
+	"2260 NOT EQUAL TO\n" +
+	"2261 IDENTICAL TO\n" +
+	"2262 NOT IDENTICAL TO\n" +
+	"2263 STRICTLY EQUIVALENT TO\n" +
+	"2264 LESS-THAN OR EQUAL TO\n" +
+	"2265 GREATER-THAN OR EQUAL TO\n" +
+	"2266 LESS-THAN OVER EQUAL TO\n" +
+	"2267 GREATER-THAN OVER EQUAL TO\n" +
+	"2268 LESS-THAN BUT NOT EQUAL TO\n" +
+	"2269 GREATER-THAN BUT NOT EQUAL TO\n" +
+	"226A MUCH LESS-THAN\n" +
+	"226B MUCH GREATER-THAN\n" +
+	"226C BETWEEN\n" +
+	"226D NOT EQUIVALENT TO\n" +
+	"226E NOT LESS-THAN\n" +
+	"226F NOT GREATER-THAN\n" +
+	"2270 NEITHER LESS-THAN NOR EQUAL TO\n" +
+	"2271 NEITHER GREATER-THAN NOR EQUAL TO\n" +
+	"2272 LESS-THAN OR EQUIVALENT TO\n" +
+	"2273 GREATER-THAN OR EQUIVALENT TO\n" +
+	"2274 NEITHER LESS-THAN NOR EQUIVALENT TO\n" +
+	"2275 NEITHER GREATER-THAN NOR EQUIVALENT TO\n" +
+	"2276 LESS-THAN OR GREATER-THAN\n" +
+	"2277 GREATER-THAN OR LESS-THAN\n" +
+	"2278 NEITHER LESS-THAN NOR GREATER-THAN\n" +
+	"2279 NEITHER GREATER-THAN NOR LESS-THAN\n" +
+	"227A PRECEDES\n" +
+	"227B SUCCEEDS\n" +
+	"227C PRECEDES OR EQUAL TO\n" +
+	"227D SUCCEEDS OR EQUAL TO\n" +
+	"227E PRECEDES OR EQUIVALENT TO\n" +
+	"227F SUCCEEDS OR EQUIVALENT TO\n" +
+	"2280 DOES NOT PRECEDE\n" +
+	"2281 DOES NOT SUCCEED\n" +
+	"2282 SUBSET OF\n" +
+	"2283 SUPERSET OF\n" +
+	"2284 NOT A SUBSET OF\n" +
+	"2285 NOT A SUPERSET OF\n" +
+	"2286 SUBSET OF OR EQUAL TO\n" +
+	"2287 SUPERSET OF OR EQUAL TO\n" +
+	"2288 NEITHER A SUBSET OF NOR EQUAL TO\n" +
+	"2289 NEITHER A SUPERSET OF NOR EQUAL TO\n" +
+	"228A SUBSET OF WITH NOT EQUAL TO\n" +
+	"228B SUPERSET OF WITH NOT EQUAL TO\n" +
+	"228C MULTISET\n" +
+	"228D MULTISET MULTIPLICATION\n" +
+	"228E MULTISET UNION\n" +
+	"228F SQUARE IMAGE OF\n" +
+	"2290 SQUARE ORIGINAL OF\n" +
+	"2291 SQUARE IMAGE OF OR EQUAL TO\n" +
+	"2292 SQUARE ORIGINAL OF OR EQUAL TO\n" +
+	"2293 SQUARE CAP\n" +
+	"2294 SQUARE CUP\n" +
+	"2295 CIRCLED PLUS\n" +
+	"2296 CIRCLED MINUS\n" +
+	"2297 CIRCLED TIMES\n" +
+	"2298 CIRCLED DIVISION SLASH\n" +
+	"2299 CIRCLED DOT OPERATOR\n" +
+	"229A CIRCLED RING OPERATOR\n" +
+	"229B CIRCLED ASTERISK OPERATOR\n" +
+	"229C CIRCLED EQUALS\n" +
+	"229D CIRCLED DASH\n" +
+	"229E SQUARED PLUS\n" +
+	"229F SQUARED MINUS\n" +
+	"22A0 SQUARED TIMES\n" +
+	"22A1 SQUARED DOT OPERATOR\n" +
+	"22A2 RIGHT TACK\n" +
+	"22A3 LEFT TACK\n" +
+	"22A4 DOWN TACK\n" +
+	"22A5 UP TACK\n" +
+	"22A6 ASSERTION\n" +
+	"22A7 MODELS\n" +
+	"22A8 TRUE\n" +
+	"22A9 FORCES\n" +
+	"22AA TRIPLE VERTICAL BAR RIGHT TURNSTILE\n" +
+	"22AB DOUBLE VERTICAL BAR DOUBLE RIGHT TURNSTILE\n" +
+	"22AC DOES NOT PROVE\n" +
+	"22AD NOT TRUE\n" +
+	"22AE DOES NOT FORCE\n" +
+	"22AF NEGATED DOUBLE VERTICAL BAR DOUBLE RIGHT TURNSTILE\n" +
+	"22B0 PRECEDES UNDER RELATION\n" +
+	"22B1 SUCCEEDS UNDER RELATION\n" +
+	"22B2 NORMAL SUBGROUP OF\n" +
+	"22B3 CONTAINS AS NORMAL SUBGROUP\n" +
+	"22B4 NORMAL SUBGROUP OF OR EQUAL TO\n" +
+	"22B5 CONTAINS AS NORMAL SUBGROUP OR EQUAL TO\n" +
+	"22B6 ORIGINAL OF\n" +
+	"22B7 IMAGE OF\n" +
+	"22B8 MULTIMAP\n" +
+	"22B9 HERMITIAN CONJUGATE MATRIX\n" +
+	"22BA INTERCALATE\n" +
+	"22BB XOR\n" +
+	"22BC NAND\n" +
+	"22BD NOR\n" +
+	"22BE RIGHT ANGLE WITH ARC\n" +
+	"22BF RIGHT TRIANGLE\n" +
+	"22C0 N-ARY LOGICAL AND\n" +
+	"22C1 N-ARY LOGICAL OR\n" +
+	"22C2 N-ARY INTERSECTION\n" +
+	"22C3 N-ARY UNION\n" +
+	"22C4 DIAMOND OPERATOR\n" +
+	"22C5 DOT OPERATOR\n" +
+	"22C6 STAR OPERATOR\n" +
+	"22C7 DIVISION TIMES\n" +
+	"22C8 BOWTIE\n" +
+	"22C9 LEFT NORMAL FACTOR SEMIDIRECT PRODUCT\n" +
+	"22CA RIGHT NORMAL FACTOR SEMIDIRECT PRODUCT\n" +
+	"22CB LEFT SEMIDIRECT PRODUCT\n" +
+	"22CC RIGHT SEMIDIRECT PRODUCT\n" +
+	"22CD REVERSED TILDE EQUALS\n" +
+	"22CE CURLY LOGICAL OR\n" +
+	"22CF CURLY LOGICAL AND\n" +
+	"22D0 DOUBLE SUBSET\n" +
+	"22D1 DOUBLE SUPERSET\n" +
+	"22D2 DOUBLE INTERSECTION\n" +
+	"22D3 DOUBLE UNION\n" +
+	"22D4 PITCHFORK\n" +
+	"22D5 EQUAL AND PARALLEL TO\n" +
+	"22D6 LESS-THAN WITH DOT\n" +
+	"22D7 GREATER-THAN WITH DOT\n" +
+	"22D8 VERY MUCH LESS-THAN\n" +
+	"22D9 VERY MUCH GREATER-THAN\n" +
+	"22DA LESS-THAN EQUAL TO OR GREATER-THAN\n" +
+	"22DB GREATER-THAN EQUAL TO OR LESS-THAN\n" +
+	"22DC EQUAL TO OR LESS-THAN\n" +
+	"22DD EQUAL TO OR GREATER-THAN\n" +
+	"22DE EQUAL TO OR PRECEDES\n" +
+	"22DF EQUAL TO OR SUCCEEDS\n" +
+	"22E0 DOES NOT PRECEDE OR EQUAL\n" +
+	"22E1 DOES NOT SUCCEED OR EQUAL\n" +
+	"22E2 NOT SQUARE IMAGE OF OR EQUAL TO\n" +
+	"22E3 NOT SQUARE ORIGINAL OF OR EQUAL TO\n" +
+	"22E4 SQUARE IMAGE OF OR NOT EQUAL TO\n" +
+	"22E5 SQUARE ORIGINAL OF OR NOT EQUAL TO\n" +
+	"22E6 LESS-THAN BUT NOT EQUIVALENT TO\n" +
+	"22E7 GREATER-THAN BUT NOT EQUIVALENT TO\n" +
+	"22E8 PRECEDES BUT NOT EQUIVALENT TO\n" +
+	"22E9 SUCCEEDS BUT NOT EQUIVALENT TO\n" +
+	"22EA NOT NORMAL SUBGROUP OF\n" +
+	"22EB DOES NOT CONTAIN AS NORMAL SUBGROUP\n" +
+	"22EC NOT NORMAL SUBGROUP OF OR EQUAL TO\n" +
+	"22ED DOES NOT CONTAIN AS NORMAL SUBGROUP OR EQUAL\n" +
+	"22EE VERTICAL ELLIPSIS\n" +
+	"22EF MIDLINE HORIZONTAL ELLIPSIS\n" +
+	"22F0 UP RIGHT DIAGONAL ELLIPSIS\n" +
+	"22F1 DOWN RIGHT DIAGONAL ELLIPSIS\n" +
+	"22F2 ELEMENT OF WITH LONG HORIZONTAL STROKE\n" +
+	"22F3 ELEMENT OF WITH VERTICAL BAR AT END OF HORIZONTAL STROKE\n" +
+	"22F4 SMALL ELEMENT OF WITH VERTICAL BAR AT END OF HORIZONTAL STROKE\n" +
+	"22F5 ELEMENT OF WITH DOT ABOVE\n" +
+	"22F6 ELEMENT OF WITH OVERBAR\n" +
+	"22F7 SMALL ELEMENT OF WITH OVERBAR\n" +
+	"22F8 ELEMENT OF WITH UNDERBAR\n" +
+	"22F9 ELEMENT OF WITH TWO HORIZONTAL STROKES\n" +
+	"22FA CONTAINS WITH LONG HORIZONTAL STROKE\n" +
+	"22FB CONTAINS WITH VERTICAL BAR AT END OF HORIZONTAL STROKE\n" +
+	"22FC SMALL CONTAINS WITH VERTICAL BAR AT END OF HORIZONTAL STROKE\n" +
+	"22FD CONTAINS WITH OVERBAR\n" +
+	"22FE SMALL CONTAINS WITH OVERBAR\n" +
+	"22FF Z NOTATION BAG MEMBERSHIP\n" +
+	"2300 DIAMETER SIGN\n" +
+	"2301 ELECTRIC ARROW\n" +
+	"2302 HOUSE\n" +
+	"2303 UP ARROWHEAD\n" +
+	"2304 DOWN ARROWHEAD\n" +
+	"2305 PROJECTIVE\n" +
+	"2306 PERSPECTIVE\n" +
+	"2307 WAVY LINE\n" +
+	"2308 LEFT CEILING\n" +
+	"2309 RIGHT CEILING\n" +
+	"230A LEFT FLOOR\n" +
+	"230B RIGHT FLOOR\n" +
+	"230C BOTTOM RIGHT CROP\n" +
+	"230D BOTTOM LEFT CROP\n" +
+	"230E TOP RIGHT CROP\n" +
+	"230F TOP LEFT CROP\n" +
+	"2310 REVERSED NOT SIGN\n" +
+	"2311 SQUARE LOZENGE\n" +
+	"2312 ARC\n" +
+	"2313 SEGMENT\n" +
+	"2314 SECTOR\n" +
+	"2315 TELEPHONE RECORDER\n" +
+	"2316 POSITION INDICATOR\n" +
+	"2317 VIEWDATA SQUARE\n" +
+	"2318 PLACE OF INTEREST SIGN\n" +
+	"2319 TURNED NOT SIGN\n" +
+	"231A WATCH\n" +
+	"231B HOURGLASS\n" +
+	"231C TOP LEFT CORNER\n" +
+	"231D TOP RIGHT CORNER\n" +
+	"231E BOTTOM LEFT CORNER\n" +
+	"231F BOTTOM RIGHT CORNER\n" +
+	"2320 TOP HALF INTEGRAL\n" +
+	"2321 BOTTOM HALF INTEGRAL\n" +
+	"2322 FROWN\n" +
+	"2323 SMILE\n" +
+	"2324 UP ARROWHEAD BETWEEN TWO HORIZONTAL BARS\n" +
+	"2325 OPTION KEY\n" +
+	"2326 ERASE TO THE RIGHT\n" +
+	"2327 X IN A RECTANGLE BOX\n" +
+	"2328 KEYBOARD\n" +
+	"2329 LEFT-POINTING ANGLE BRACKET\n" +
+	"232A RIGHT-POINTING ANGLE BRACKET\n" +
+	"232B ERASE TO THE LEFT\n" +
+	"232C BENZENE RING\n" +
+	"232D CYLINDRICITY\n" +
+	"232E ALL AROUND-PROFILE\n" +
+	"232F SYMMETRY\n" +
+	"2330 TOTAL RUNOUT\n" +
+	"2331 DIMENSION ORIGIN\n" +
+	"2332 CONICAL TAPER\n" +
+	"2333 SLOPE\n" +
+	"2334 COUNTERBORE\n" +
+	"2335 COUNTERSINK\n" +
+	"2336 APL FUNCTIONAL SYMBOL I-BEAM\n" +
+	"2337 APL FUNCTIONAL SYMBOL SQUISH QUAD\n" +
+	"2338 APL FUNCTIONAL SYMBOL QUAD EQUAL\n" +
+	"2339 APL FUNCTIONAL SYMBOL QUAD DIVIDE\n" +
+	"233A APL FUNCTIONAL SYMBOL QUAD DIAMOND\n" +
+	"233B APL FUNCTIONAL SYMBOL QUAD JOT\n" +
+	"233C APL FUNCTIONAL SYMBOL QUAD CIRCLE\n" +
+	"233D APL FUNCTIONAL SYMBOL CIRCLE STILE\n" +
+	"233E APL FUNCTIONAL SYMBOL CIRCLE JOT\n" +
+	"233F APL FUNCTIONAL SYMBOL SLASH BAR\n" +
+	"2340 APL FUNCTIONAL SYMBOL BACKSLASH BAR\n" +
+	"2341 APL FUNCTIONAL SYMBOL QUAD SLASH\n" +
+	"2342 APL FUNCTIONAL SYMBOL QUAD BACKSLASH\n" +
+	"2343 APL FUNCTIONAL SYMBOL QUAD LESS-THAN\n" +
+	"2344 APL FUNCTIONAL SYMBOL QUAD GREATER-THAN\n" +
+	"2345 APL FUNCTIONAL SYMBOL LEFTWARDS VANE\n" +
+	"2346 APL FUNCTIONAL SYMBOL RIGHTWARDS VANE\n" +
+	"2347 APL FUNCTIONAL SYMBOL QUAD LEFTWARDS ARROW\n" +
+	"2348 APL FUNCTIONAL SYMBOL QUAD RIGHTWARDS ARROW\n" +
+	"2349 APL FUNCTIONAL SYMBOL CIRCLE BACKSLASH\n" +
+	"234A APL FUNCTIONAL SYMBOL DOWN TACK UNDERBAR\n" +
+	"234B APL FUNCTIONAL SYMBOL DELTA STILE\n" +
+	"234C APL FUNCTIONAL SYMBOL QUAD DOWN CARET\n" +
+	"234D APL FUNCTIONAL SYMBOL QUAD DELTA\n" +
+	"234E APL FUNCTIONAL SYMBOL DOWN TACK JOT\n" +
+	"234F APL FUNCTIONAL SYMBOL UPWARDS VANE\n" +
+	"2350 APL FUNCTIONAL SYMBOL QUAD UPWARDS ARROW\n" +
+	"2351 APL FUNCTIONAL SYMBOL UP TACK OVERBAR\n" +
+	"2352 APL FUNCTIONAL SYMBOL DEL STILE\n" +
+	"2353 APL FUNCTIONAL SYMBOL QUAD UP CARET\n" +
+	"2354 APL FUNCTIONAL SYMBOL QUAD DEL\n" +
+	"2355 APL FUNCTIONAL SYMBOL UP TACK JOT\n" +
+	"2356 APL FUNCTIONAL SYMBOL DOWNWARDS VANE\n" +
+	"2357 APL FUNCTIONAL SYMBOL QUAD DOWNWARDS ARROW\n" +
+	"2358 APL FUNCTIONAL SYMBOL QUOTE UNDERBAR\n" +
+	"2359 APL FUNCTIONAL SYMBOL DELTA UNDERBAR\n" +
+	"235A APL FUNCTIONAL SYMBOL DIAMOND UNDERBAR\n" +
+	"235B APL FUNCTIONAL SYMBOL JOT UNDERBAR\n" +
+	"235C APL FUNCTIONAL SYMBOL CIRCLE UNDERBAR\n" +
+	"235D APL FUNCTIONAL SYMBOL UP SHOE JOT\n" +
+	"235E APL FUNCTIONAL SYMBOL QUOTE QUAD\n" +
+	"235F APL FUNCTIONAL SYMBOL CIRCLE STAR\n" +
+	"2360 APL FUNCTIONAL SYMBOL QUAD COLON\n" +
+	"2361 APL FUNCTIONAL SYMBOL UP TACK DIAERESIS\n" +
+	"2362 APL FUNCTIONAL SYMBOL DEL DIAERESIS\n" +
+	"2363 APL FUNCTIONAL SYMBOL STAR DIAERESIS\n" +
+	"2364 APL FUNCTIONAL SYMBOL JOT DIAERESIS\n" +
+	"2365 APL FUNCTIONAL SYMBOL CIRCLE DIAERESIS\n" +
+	"2366 APL FUNCTIONAL SYMBOL DOWN SHOE STILE\n" +
+	"2367 APL FUNCTIONAL SYMBOL LEFT SHOE STILE\n" +
+	"2368 APL FUNCTIONAL SYMBOL TILDE DIAERESIS\n" +
+	"2369 APL FUNCTIONAL SYMBOL GREATER-THAN DIAERESIS\n" +
+	"236A APL FUNCTIONAL SYMBOL COMMA BAR\n" +
+	"236B APL FUNCTIONAL SYMBOL DEL TILDE\n" +
+	"236C APL FUNCTIONAL SYMBOL ZILDE\n" +
+	"236D APL FUNCTIONAL SYMBOL STILE TILDE\n" +
+	"236E APL FUNCTIONAL SYMBOL SEMICOLON UNDERBAR\n" +
+	"236F APL FUNCTIONAL SYMBOL QUAD NOT EQUAL\n" +
+	"2370 APL FUNCTIONAL SYMBOL QUAD QUESTION\n" +
+	"2371 APL FUNCTIONAL SYMBOL DOWN CARET TILDE\n" +
+	"2372 APL FUNCTIONAL SYMBOL UP CARET TILDE\n" +
+	"2373 APL FUNCTIONAL SYMBOL IOTA\n" +
+	"2374 APL FUNCTIONAL SYMBOL RHO\n" +
+	"2375 APL FUNCTIONAL SYMBOL OMEGA\n" +
+	"2376 APL FUNCTIONAL SYMBOL ALPHA UNDERBAR\n" +
+	"2377 APL FUNCTIONAL SYMBOL EPSILON UNDERBAR\n" +
+	"2378 APL FUNCTIONAL SYMBOL IOTA UNDERBAR\n" +
+	"2379 APL FUNCTIONAL SYMBOL OMEGA UNDERBAR\n" +
+	"237A APL FUNCTIONAL SYMBOL ALPHA\n" +
+	"237B NOT CHECK MARK\n" +
+	"237C RIGHT ANGLE WITH DOWNWARDS ZIGZAG ARROW\n" +
+	"237D SHOULDERED OPEN BOX\n" +
+	"237E BELL SYMBOL\n" +
+	"237F VERTICAL LINE WITH MIDDLE DOT\n" +
+	"2380 INSERTION SYMBOL\n" +
+	"2381 CONTINUOUS UNDERLINE SYMBOL\n" +
+	"2382 DISCONTINUOUS UNDERLINE SYMBOL\n" +
+	"2383 EMPHASIS SYMBOL\n" +
+	"2384 COMPOSITION SYMBOL\n" +
+	"2385 WHITE SQUARE WITH CENTRE VERTICAL LINE\n" +
+	"2386 ENTER SYMBOL\n" +
+	"2387 ALTERNATIVE KEY SYMBOL\n" +
+	"2388 HELM SYMBOL\n" +
+	"2389 CIRCLED HORIZONTAL BAR WITH NOTCH\n" +
+	"238A CIRCLED TRIANGLE DOWN\n" +
+	"238B BROKEN CIRCLE WITH NORTHWEST ARROW\n" +
+	"238C UNDO SYMBOL\n" +
+	"238D MONOSTABLE SYMBOL\n" +
+	"238E HYSTERESIS SYMBOL\n" +
+	"238F OPEN-CIRCUIT-OUTPUT H-TYPE SYMBOL\n" +
+	"2390 OPEN-CIRCUIT-OUTPUT L-TYPE SYMBOL\n" +
+	"2391 PASSIVE-PULL-DOWN-OUTPUT SYMBOL\n" +
+	"2392 PASSIVE-PULL-UP-OUTPUT SYMBOL\n" +
+	"2393 DIRECT CURRENT SYMBOL FORM TWO\n" +
+	"2394 SOFTWARE-FUNCTION SYMBOL\n" +
+	"2395 APL FUNCTIONAL SYMBOL QUAD\n" +
+	"2396 DECIMAL SEPARATOR KEY SYMBOL\n" +
+	"2397 PREVIOUS PAGE\n" +
+	"2398 NEXT PAGE\n" +
+	"2399 PRINT SCREEN SYMBOL\n" +
+	"239A CLEAR SCREEN SYMBOL\n" +
+	"239B LEFT PARENTHESIS UPPER HOOK\n" +
+	"239C LEFT PARENTHESIS EXTENSION\n" +
+	"239D LEFT PARENTHESIS LOWER HOOK\n" +
+	"239E RIGHT PARENTHESIS UPPER HOOK\n" +
+	"239F RIGHT PARENTHESIS EXTENSION\n" +
+	"23A0 RIGHT PARENTHESIS LOWER HOOK\n" +
+	"23A1 LEFT SQUARE BRACKET UPPER CORNER\n" +
+	"23A2 LEFT SQUARE BRACKET EXTENSION\n" +
+	"23A3 LEFT SQUARE BRACKET LOWER CORNER\n" +
+	"23A4 RIGHT SQUARE BRACKET UPPER CORNER\n" +
+	"23A5 RIGHT SQUARE BRACKET EXTENSION\n" +
+	"23A6 RIGHT SQUARE BRACKET LOWER CORNER\n" +
+	"23A7 LEFT CURLY BRACKET UPPER HOOK\n" +
+	"23A8 LEFT CURLY BRACKET MIDDLE PIECE\n" +
+	"23A9 LEFT CURLY BRACKET LOWER HOOK\n" +
+	"23AA CURLY BRACKET EXTENSION\n" +
+	"23AB RIGHT CURLY BRACKET UPPER HOOK\n" +
+	"23AC RIGHT CURLY BRACKET MIDDLE PIECE\n" +
+	"23AD RIGHT CURLY BRACKET LOWER HOOK\n" +
+	"23AE INTEGRAL EXTENSION\n" +
+	"23AF HORIZONTAL LINE EXTENSION\n" +
+	"23B0 UPPER LEFT OR LOWER RIGHT CURLY BRACKET SECTION\n" +
+	"23B1 UPPER RIGHT OR LOWER LEFT CURLY BRACKET SECTION\n" +
+	"23B2 SUMMATION TOP\n" +
+	"23B3 SUMMATION BOTTOM\n" +
+	"23B4 TOP SQUARE BRACKET\n" +
+	"23B5 BOTTOM SQUARE BRACKET\n" +
+	"23B6 BOTTOM SQUARE BRACKET OVER TOP SQUARE BRACKET\n" +
+	"23B7 RADICAL SYMBOL BOTTOM\n" +
+	"23B8 LEFT VERTICAL BOX LINE\n" +
+	"23B9 RIGHT VERTICAL BOX LINE\n" +
+	"23BA HORIZONTAL SCAN LINE-1\n" +
+	"23BB HORIZONTAL SCAN LINE-3\n" +
+	"23BC HORIZONTAL SCAN LINE-7\n" +
+	"23BD HORIZONTAL SCAN LINE-9\n" +
+	"23BE DENTISTRY SYMBOL LIGHT VERTICAL AND TOP RIGHT\n" +
+	"23BF DENTISTRY SYMBOL LIGHT VERTICAL AND BOTTOM RIGHT\n" +
+	"23C0 DENTISTRY SYMBOL LIGHT VERTICAL WITH CIRCLE\n" +
+	"23C1 DENTISTRY SYMBOL LIGHT DOWN AND HORIZONTAL WITH CIRCLE\n" +
+	"23C2 DENTISTRY SYMBOL LIGHT UP AND HORIZONTAL WITH CIRCLE\n" +
+	"23C3 DENTISTRY SYMBOL LIGHT VERTICAL WITH TRIANGLE\n" +
+	"23C4 DENTISTRY SYMBOL LIGHT DOWN AND HORIZONTAL WITH TRIANGLE\n" +
+	"23C5 DENTISTRY SYMBOL LIGHT UP AND HORIZONTAL WITH TRIANGLE\n" +
+	"23C6 DENTISTRY SYMBOL LIGHT VERTICAL AND WAVE\n" +
+	"23C7 DENTISTRY SYMBOL LIGHT DOWN AND HORIZONTAL WITH WAVE\n" +
+	"23C8 DENTISTRY SYMBOL LIGHT UP AND HORIZONTAL WITH WAVE\n" +
+	"23C9 DENTISTRY SYMBOL LIGHT DOWN AND HORIZONTAL\n" +
+	"23CA DENTISTRY SYMBOL LIGHT UP AND HORIZONTAL\n" +
+	"23CB DENTISTRY SYMBOL LIGHT VERTICAL AND TOP LEFT\n" +
+	"23CC DENTISTRY SYMBOL LIGHT VERTICAL AND BOTTOM LEFT\n" +
+	"23CD SQUARE FOOT\n" +
+	"23CE RETURN SYMBOL\n" +
+	"23CF EJECT SYMBOL\n" +
+	"23D0 VERTICAL LINE EXTENSION\n" +
+	"23D1 METRICAL BREVE\n" +
+	"23D2 METRICAL LONG OVER SHORT\n" +
+	"23D3 METRICAL SHORT OVER LONG\n" +
+	"23D4 METRICAL LONG OVER TWO SHORTS\n" +
+	"23D5 METRICAL TWO SHORTS OVER LONG\n" +
+	"23D6 METRICAL TWO SHORTS JOINED\n" +
+	"23D7 METRICAL TRISEME\n" +
+	"23D8 METRICAL TETRASEME\n" +
+	"23D9 METRICAL PENTASEME\n" +
+	"23DA EARTH GROUND\n" +
+	"23DB FUSE\n" +
+	"23DC TOP PARENTHESIS\n" +
+	"23DD BOTTOM PARENTHESIS\n" +
+	"23DE TOP CURLY BRACKET\n" +
+	"23DF BOTTOM CURLY BRACKET\n" +
+	"23E0 TOP TORTOISE SHELL BRACKET\n" +
+	"23E1 BOTTOM TORTOISE SHELL BRACKET\n" +
+	"23E2 WHITE TRAPEZIUM\n" +
+	"23E3 BENZENE RING WITH CIRCLE\n" +
+	"23E4 STRAIGHTNESS\n" +
+	"23E5 FLATNESS\n" +
+	"23E6 AC CURRENT\n" +
+	"23E7 ELECTRICAL INTERSECTION\n" +
+	"23E8 DECIMAL EXPONENT SYMBOL\n" +
+	"23E9 BLACK RIGHT-POINTING DOUBLE TRIANGLE\n" +
+	"23EA BLACK LEFT-POINTING DOUBLE TRIANGLE\n" +
+	"23EB BLACK UP-POINTING DOUBLE TRIANGLE\n" +
+	"23EC BLACK DOWN-POINTING DOUBLE TRIANGLE\n" +
+	"23ED BLACK RIGHT-POINTING DOUBLE TRIANGLE WITH VERTICAL BAR\n" +
+	"23EE BLACK LEFT-POINTING DOUBLE TRIANGLE WITH VERTICAL BAR\n" +
+	"23EF BLACK RIGHT-POINTING TRIANGLE WITH DOUBLE VERTICAL BAR\n" +
+	"23F0 ALARM CLOCK\n" +
+	"23F1 STOPWATCH\n" +
+	"23F2 TIMER CLOCK\n" +
+	"23F3 HOURGLASS WITH FLOWING SAND\n" +
+	"23F4 BLACK MEDIUM LEFT-POINTING TRIANGLE\n" +
+	"23F5 BLACK MEDIUM RIGHT-POINTING TRIANGLE\n" +
+	"23F6 BLACK MEDIUM UP-POINTING TRIANGLE\n" +
+	"23F7 BLACK MEDIUM DOWN-POINTING TRIANGLE\n" +
+	"23F8 DOUBLE VERTICAL BAR\n" +
+	"23F9 BLACK SQUARE FOR STOP\n" +
+	"23FA BLACK CIRCLE FOR RECORD\n" +
+	"23FB POWER SYMBOL\n" +
+	"23FC POWER ON-OFF SYMBOL\n" +
+	"23FD POWER ON SYMBOL\n" +
+	"23FE POWER SLEEP SYMBOL\n" +
+	"23FF OBSERVER EYE SYMBOL\n" +
+	"2460 CIRCLED DIGIT ONE\n" +
+	"2461 CIRCLED DIGIT TWO\n" +
+	"2462 CIRCLED DIGIT THREE\n" +
+	"2463 CIRCLED DIGIT FOUR\n" +
+	"2464 CIRCLED DIGIT FIVE\n" +
+	"2465 CIRCLED DIGIT SIX\n" +
+	"2466 CIRCLED DIGIT SEVEN\n" +
+	"2467 CIRCLED DIGIT EIGHT\n" +
+	"2468 CIRCLED DIGIT NINE\n" +
+	"2469 CIRCLED NUMBER TEN\n" +
+	"246A CIRCLED NUMBER ELEVEN\n" +
+	"246B CIRCLED NUMBER TWELVE\n" +
+	"246C CIRCLED NUMBER THIRTEEN\n" +
+	"246D CIRCLED NUMBER FOURTEEN\n" +
+	"246E CIRCLED NUMBER FIFTEEN\n" +
+	"246F CIRCLED NUMBER SIXTEEN\n" +
+	"2470 CIRCLED NUMBER SEVENTEEN\n" +
+	"2471 CIRCLED NUMBER EIGHTEEN\n" +
+	"2472 CIRCLED NUMBER NINETEEN\n" +
+	"2473 CIRCLED NUMBER TWENTY\n" +
+	"2474 PARENTHESIZED DIGIT ONE\n" +
+	"2475 PARENTHESIZED DIGIT TWO\n" +
+	"2476 PARENTHESIZED DIGIT THREE\n" +
+	"2477 PARENTHESIZED DIGIT FOUR\n" +
+	"2478 PARENTHESIZED DIGIT FIVE\n" +
+	"2479 PARENTHESIZED DIGIT SIX\n" +
+	"247A PARENTHESIZED DIGIT SEVEN\n" +
+	"247B PARENTHESIZED DIGIT EIGHT\n" +
+	"247C PARENTHESIZED DIGIT NINE\n" +
+	"247D PARENTHESIZED NUMBER TEN\n" +
+	"247E PARENTHESIZED NUMBER ELEVEN\n" +
+	"247F PARENTHESIZED NUMBER TWELVE\n" +
+	"2480 PARENTHESIZED NUMBER THIRTEEN\n" +
+	"2481 PARENTHESIZED NUMBER FOURTEEN\n" +
+	"2482 PARENTHESIZED NUMBER FIFTEEN\n" +
+	"2483 PARENTHESIZED NUMBER SIXTEEN\n" +
+	"2484 PARENTHESIZED NUMBER SEVENTEEN\n" +
+	"2485 PARENTHESIZED NUMBER EIGHTEEN\n" +
+	"2486 PARENTHESIZED NUMBER NINETEEN\n" +
+	"2487 PARENTHESIZED NUMBER TWENTY\n" +
+	"2488 DIGIT ONE FULL STOP\n" +
+	"2489 DIGIT TWO FULL STOP\n" +
+	"248A DIGIT THREE FULL STOP\n" +
+	"248B DIGIT FOUR FULL STOP\n" +
+	"248C DIGIT FIVE FULL STOP\n" +
+	"248D DIGIT SIX FULL STOP\n" +
+	"248E DIGIT SEVEN FULL STOP\n" +
+	"248F DIGIT EIGHT FULL STOP\n" +
+	"2490 DIGIT NINE FULL STOP\n" +
+	"2491 NUMBER TEN FULL STOP\n" +
+	"2492 NUMBER ELEVEN FULL STOP\n" +
+	"2493 NUMBER TWELVE FULL STOP\n" +
+	"2494 NUMBER THIRTEEN FULL STOP\n" +
+	"2495 NUMBER FOURTEEN FULL STOP\n" +
+	"2496 NUMBER FIFTEEN FULL STOP\n" +
+	"2497 NUMBER SIXTEEN FULL STOP\n" +
+	"2498 NUMBER SEVENTEEN FULL STOP\n" +
+	"2499 NUMBER EIGHTEEN FULL STOP\n" +
+	"249A NUMBER NINETEEN FULL STOP\n" +
+	"249B NUMBER TWENTY FULL STOP\n" +
+	"249C PARENTHESIZED LATIN SMALL LETTER A\n" +
+	"249D PARENTHESIZED LATIN SMALL LETTER B\n" +
+	"249E PARENTHESIZED LATIN SMALL LETTER C\n" +
+	"249F PARENTHESIZED LATIN SMALL LETTER D\n" +
+	"24A0 PARENTHESIZED LATIN SMALL LETTER E\n" +
+	"24A1 PARENTHESIZED LATIN SMALL LETTER F\n" +
+	"24A2 PARENTHESIZED LATIN SMALL LETTER G\n" +
+	"24A3 PARENTHESIZED LATIN SMALL LETTER H\n" +
+	"24A4 PARENTHESIZED LATIN SMALL LETTER I\n" +
+	"24A5 PARENTHESIZED LATIN SMALL LETTER J\n" +
+	"24A6 PARENTHESIZED LATIN SMALL LETTER K\n" +
+	"24A7 PARENTHESIZED LATIN SMALL LETTER L\n" +
+	"24A8 PARENTHESIZED LATIN SMALL LETTER M\n" +
+	"24A9 PARENTHESIZED LATIN SMALL LETTER N\n" +
+	"24AA PARENTHESIZED LATIN SMALL LETTER O\n" +
+	"24AB PARENTHESIZED LATIN SMALL LETTER P\n" +
+	"24AC PARENTHESIZED LATIN SMALL LETTER Q\n" +
+	"24AD PARENTHESIZED LATIN SMALL LETTER R\n" +
+	"24AE PARENTHESIZED LATIN SMALL LETTER S\n" +
+	"24AF PARENTHESIZED LATIN SMALL LETTER T\n" +
+	"24B0 PARENTHESIZED LATIN SMALL LETTER U\n" +
+	"24B1 PARENTHESIZED LATIN SMALL LETTER V\n" +
+	"24B2 PARENTHESIZED LATIN SMALL LETTER W\n" +
+	"24B3 PARENTHESIZED LATIN SMALL LETTER X\n" +
+	"24B4 PARENTHESIZED LATIN SMALL LETTER Y\n" +
+	"24B5 PARENTHESIZED LATIN SMALL LETTER Z\n" +
+	"24B6 CIRCLED LATIN CAPITAL LETTER A\n" +
+	"24B7 CIRCLED LATIN CAPITAL LETTER B\n" +
+	"24B8 CIRCLED LATIN CAPITAL LETTER C\n" +
+	"24B9 CIRCLED LATIN CAPITAL LETTER D\n" +
+	"24BA CIRCLED LATIN CAPITAL LETTER E\n" +
+	"24BB CIRCLED LATIN CAPITAL LETTER F\n" +
+	"24BC CIRCLED LATIN CAPITAL LETTER G\n" +
+	"24BD CIRCLED LATIN CAPITAL LETTER H\n" +
+	"24BE CIRCLED LATIN CAPITAL LETTER I\n" +
+	"24BF CIRCLED LATIN CAPITAL LETTER J\n" +
+	"24C0 CIRCLED LATIN CAPITAL LETTER K\n" +
+	"24C1 CIRCLED LATIN CAPITAL LETTER L\n" +
+	"24C2 CIRCLED LATIN CAPITAL LETTER M\n" +
+	"24C3 CIRCLED LATIN CAPITAL LETTER N\n" +
+	"24C4 CIRCLED LATIN CAPITAL LETTER O\n" +
+	"24C5 CIRCLED LATIN CAPITAL LETTER P\n" +
+	"24C6 CIRCLED LATIN CAPITAL LETTER Q\n" +
+	"24C7 CIRCLED LATIN CAPITAL LETTER R\n" +
+	"24C8 CIRCLED LATIN CAPITAL LETTER S\n" +
+	"24C9 CIRCLED LATIN CAPITAL LETTER T\n" +
+	"24CA CIRCLED LATIN CAPITAL LETTER U\n" +
+	"24CB CIRCLED LATIN CAPITAL LETTER V\n" +
+	"24CC CIRCLED LATIN CAPITAL LETTER W\n" +
+	"24CD CIRCLED LATIN CAPITAL LETTER X\n" +
+	"24CE CIRCLED LATIN CAPITAL LETTER Y\n" +
+	"24CF CIRCLED LATIN CAPITAL LETTER Z\n" +
+	"24D0 CIRCLED LATIN SMALL LETTER A\n" +
+	"24D1 CIRCLED LATIN SMALL LETTER B\n" +
+	"24D2 CIRCLED LATIN SMALL LETTER C\n" +
+	"24D3 CIRCLED LATIN SMALL LETTER D\n" +
+	"24D4 CIRCLED LATIN SMALL LETTER E\n" +
+	"24D5 CIRCLED LATIN SMALL LETTER F\n" +
+	"24D6 CIRCLED LATIN SMALL LETTER G\n" +
+	"24D7 CIRCLED LATIN SMALL LETTER H\n" +
+	"24D8 CIRCLED LATIN SMALL LETTER I\n" +
+	"24D9 CIRCLED LATIN SMALL LETTER J\n" +
+	"24DA CIRCLED LATIN SMALL LETTER K\n" +
+	"24DB CIRCLED LATIN SMALL LETTER L\n" +
+	"24DC CIRCLED LATIN SMALL LETTER M\n" +
+	"24DD CIRCLED LATIN SMALL LETTER N\n" +
+	"24DE CIRCLED LATIN SMALL LETTER O\n" +
+	"24DF CIRCLED LATIN SMALL LETTER P\n" +
+	"24E0 CIRCLED LATIN SMALL LETTER Q\n" +
+	"24E1 CIRCLED LATIN SMALL LETTER R\n" +
+	"24E2 CIRCLED LATIN SMALL LETTER S\n" +
+	"24E3 CIRCLED LATIN SMALL LETTER T\n" +
+	"24E4 CIRCLED LATIN SMALL LETTER U\n" +
+	"24E5 CIRCLED LATIN SMALL LETTER V\n" +
+	"24E6 CIRCLED LATIN SMALL LETTER W\n" +
+	"24E7 CIRCLED LATIN SMALL LETTER X\n" +
+	"24E8 CIRCLED LATIN SMALL LETTER Y\n" +
+	"24E9 CIRCLED LATIN SMALL LETTER Z\n" +
+	"24EA CIRCLED DIGIT ZERO\n" +
+	"24EB NEGATIVE CIRCLED NUMBER ELEVEN\n" +
+	"24EC NEGATIVE CIRCLED NUMBER TWELVE\n" +
+	"24ED NEGATIVE CIRCLED NUMBER THIRTEEN\n" +
+	"24EE NEGATIVE CIRCLED NUMBER FOURTEEN\n" +
+	"24EF NEGATIVE CIRCLED NUMBER FIFTEEN\n" +
+	"24F0 NEGATIVE CIRCLED NUMBER SIXTEEN\n" +
+	"24F1 NEGATIVE CIRCLED NUMBER SEVENTEEN\n" +
+	"24F2 NEGATIVE CIRCLED NUMBER EIGHTEEN\n" +
+	"24F3 NEGATIVE CIRCLED NUMBER NINETEEN\n" +
+	"24F4 NEGATIVE CIRCLED NUMBER TWENTY\n" +
+	"24F5 DOUBLE CIRCLED DIGIT ONE\n" +
+	"24F6 DOUBLE CIRCLED DIGIT TWO\n" +
+	"24F7 DOUBLE CIRCLED DIGIT THREE\n" +
+	"24F8 DOUBLE CIRCLED DIGIT FOUR\n" +
+	"24F9 DOUBLE CIRCLED DIGIT FIVE\n" +
+	"24FA DOUBLE CIRCLED DIGIT SIX\n" +
+	"24FB DOUBLE CIRCLED DIGIT SEVEN\n" +
+	"24FC DOUBLE CIRCLED DIGIT EIGHT\n" +
+	"24FD DOUBLE CIRCLED DIGIT NINE\n" +
+	"24FE DOUBLE CIRCLED NUMBER TEN\n" +
+	"24FF NEGATIVE CIRCLED DIGIT ZERO\n" +
+	"2500 BOX DRAWINGS LIGHT HORIZONTAL\n" +
+	"2501 BOX DRAWINGS HEAVY HORIZONTAL\n" +
+	"2502 BOX DRAWINGS LIGHT VERTICAL\n" +
+	"2503 BOX DRAWINGS HEAVY VERTICAL\n" +
+	"2504 BOX DRAWINGS LIGHT TRIPLE DASH HORIZONTAL\n" +
+	"2505 BOX DRAWINGS HEAVY TRIPLE DASH HORIZONTAL\n" +
+	"2506 BOX DRAWINGS LIGHT TRIPLE DASH VERTICAL\n" +
+	"2507 BOX DRAWINGS HEAVY TRIPLE DASH VERTICAL\n" +
+	"2508 BOX DRAWINGS LIGHT QUADRUPLE DASH HORIZONTAL\n" +
+	"2509 BOX DRAWINGS HEAVY QUADRUPLE DASH HORIZONTAL\n" +
+	"250A BOX DRAWINGS LIGHT QUADRUPLE DASH VERTICAL\n" +
+	"250B BOX DRAWINGS HEAVY QUADRUPLE DASH VERTICAL\n" +
+	"250C BOX DRAWINGS LIGHT DOWN AND RIGHT\n" +
+	"250D BOX DRAWINGS DOWN LIGHT AND RIGHT HEAVY\n" +
+	"250E BOX DRAWINGS DOWN HEAVY AND RIGHT LIGHT\n" +
+	"250F BOX DRAWINGS HEAVY DOWN AND RIGHT\n" +
+	"2510 BOX DRAWINGS LIGHT DOWN AND LEFT\n" +
+	"2511 BOX DRAWINGS DOWN LIGHT AND LEFT HEAVY\n" +
+	"2512 BOX DRAWINGS DOWN HEAVY AND LEFT LIGHT\n" +
+	"2513 BOX DRAWINGS HEAVY DOWN AND LEFT\n" +
+	"2514 BOX DRAWINGS LIGHT UP AND RIGHT\n" +
+	"2515 BOX DRAWINGS UP LIGHT AND RIGHT HEAVY\n" +
+	"2516 BOX DRAWINGS UP HEAVY AND RIGHT LIGHT\n" +
+	"2517 BOX DRAWINGS HEAVY UP AND RIGHT\n" +
+	"2518 BOX DRAWINGS LIGHT UP AND LEFT\n" +
+	"2519 BOX DRAWINGS UP LIGHT AND LEFT HEAVY\n" +
+	"251A BOX DRAWINGS UP HEAVY AND LEFT LIGHT\n" +
+	"251B BOX DRAWINGS HEAVY UP AND LEFT\n" +
+	"251C BOX DRAWINGS LIGHT VERTICAL AND RIGHT\n" +
+	"251D BOX DRAWINGS VERTICAL LIGHT AND RIGHT HEAVY\n" +
+	"251E BOX DRAWINGS UP HEAVY AND RIGHT DOWN LIGHT\n" +
+	"251F BOX DRAWINGS DOWN HEAVY AND RIGHT UP LIGHT\n" +
+	"2520 BOX DRAWINGS VERTICAL HEAVY AND RIGHT LIGHT\n" +
+	"2521 BOX DRAWINGS DOWN LIGHT AND RIGHT UP HEAVY\n" +
+	"2522 BOX DRAWINGS UP LIGHT AND RIGHT DOWN HEAVY\n" +
+	"2523 BOX DRAWINGS HEAVY VERTICAL AND RIGHT\n" +
+	"2524 BOX DRAWINGS LIGHT VERTICAL AND LEFT\n" +
+	"2525 BOX DRAWINGS VERTICAL LIGHT AND LEFT HEAVY\n" +
+	"2526 BOX DRAWINGS UP HEAVY AND LEFT DOWN LIGHT\n" +
+	"2527 BOX DRAWINGS DOWN HEAVY AND LEFT UP LIGHT\n" +
+	"2528 BOX DRAWINGS VERTICAL HEAVY AND LEFT LIGHT\n" +
+	"2529 BOX DRAWINGS DOWN LIGHT AND LEFT UP HEAVY\n" +
+	"252A BOX DRAWINGS UP LIGHT AND LEFT DOWN HEAVY\n" +
+	"252B BOX DRAWINGS HEAVY VERTICAL AND LEFT\n" +
+	"252C BOX DRAWINGS LIGHT DOWN AND HORIZONTAL\n" +
+	"252D BOX DRAWINGS LEFT HEAVY AND RIGHT DOWN LIGHT\n" +
+	"252E BOX DRAWINGS RIGHT HEAVY AND LEFT DOWN LIGHT\n" +
+	"252F BOX DRAWINGS DOWN LIGHT AND HORIZONTAL HEAVY\n" +
+	"2530 BOX DRAWINGS DOWN HEAVY AND HORIZONTAL LIGHT\n" +
+	"2531 BOX DRAWINGS RIGHT LIGHT AND LEFT DOWN HEAVY\n" +
+	"2532 BOX DRAWINGS LEFT LIGHT AND RIGHT DOWN HEAVY\n" +
+	"2533 BOX DRAWINGS HEAVY DOWN AND HORIZONTAL\n" +
+	"2534 BOX DRAWINGS LIGHT UP AND HORIZONTAL\n" +
+	"2535 BOX DRAWINGS LEFT HEAVY AND RIGHT UP LIGHT\n" +
+	"2536 BOX DRAWINGS RIGHT HEAVY AND LEFT UP LIGHT\n" +
+	"2537 BOX DRAWINGS UP LIGHT AND HORIZONTAL HEAVY\n" +
+	"2538 BOX DRAWINGS UP HEAVY AND HORIZONTAL LIGHT\n" +
+	"2539 BOX DRAWINGS RIGHT LIGHT AND LEFT UP HEAVY\n" +
+	"253A BOX DRAWINGS LEFT LIGHT AND RIGHT UP HEAVY\n" +
+	"253B BOX DRAWINGS HEAVY UP AND HORIZONTAL\n" +
+	"253C BOX DRAWINGS LIGHT VERTICAL AND HORIZONTAL\n" +
+	"253D BOX DRAWINGS LEFT HEAVY AND RIGHT VERTICAL LIGHT\n" +
+	"253E BOX DRAWINGS RIGHT HEAVY AND LEFT VERTICAL LIGHT\n" +
+	"253F BOX DRAWINGS VERTICAL LIGHT AND HORIZONTAL HEAVY\n" +
+	"2540 BOX DRAWINGS UP HEAVY AND DOWN HORIZONTAL LIGHT\n" +
+	"2541 BOX DRAWINGS DOWN HEAVY AND UP HORIZONTAL LIGHT\n" +
+	"2542 BOX DRAWINGS VERTICAL HEAVY AND HORIZONTAL LIGHT\n" +
+	"2543 BOX DRAWINGS LEFT UP HEAVY AND RIGHT DOWN LIGHT\n" +
+	"2544 BOX DRAWINGS RIGHT UP HEAVY AND LEFT DOWN LIGHT\n" +
+	"2545 BOX DRAWINGS LEFT DOWN HEAVY AND RIGHT UP LIGHT\n" +
+	"2546 BOX DRAWINGS RIGHT DOWN HEAVY AND LEFT UP LIGHT\n" +
+	"2547 BOX DRAWINGS DOWN LIGHT AND UP HORIZONTAL HEAVY\n" +
+	"2548 BOX DRAWINGS UP LIGHT AND DOWN HORIZONTAL HEAVY\n" +
+	"2549 BOX DRAWINGS RIGHT LIGHT AND LEFT VERTICAL HEAVY\n" +
+	"254A BOX DRAWINGS LEFT LIGHT AND RIGHT VERTICAL HEAVY\n" +
+	"254B BOX DRAWINGS HEAVY VERTICAL AND HORIZONTAL\n" +
+	"254C BOX DRAWINGS LIGHT DOUBLE DASH HORIZONTAL\n" +
+	"254D BOX DRAWINGS HEAVY DOUBLE DASH HORIZONTAL\n" +
+	"254E BOX DRAWINGS LIGHT DOUBLE DASH VERTICAL\n" +
+	"254F BOX DRAWINGS HEAVY DOUBLE DASH VERTICAL\n" +
+	"2550 BOX DRAWINGS DOUBLE HORIZONTAL\n" +
+	"2551 BOX DRAWINGS DOUBLE VERTICAL\n" +
+	"2552 BOX DRAWINGS DOWN SINGLE AND RIGHT DOUBLE\n" +
+	"2553 BOX DRAWINGS DOWN DOUBLE AND RIGHT SINGLE\n" +
+	"2554 BOX DRAWINGS DOUBLE DOWN AND RIGHT\n" +
+	"2555 BOX DRAWINGS DOWN SINGLE AND LEFT DOUBLE\n" +
+	"2556 BOX DRAWINGS DOWN DOUBLE AND LEFT SINGLE\n" +
+	"2557 BOX DRAWINGS DOUBLE DOWN AND LEFT\n" +
+	"2558 BOX DRAWINGS UP SINGLE AND RIGHT DOUBLE\n" +
+	"2559 BOX DRAWINGS UP DOUBLE AND RIGHT SINGLE\n" +
+	"255A BOX DRAWINGS DOUBLE UP AND RIGHT\n" +
+	"255B BOX DRAWINGS UP SINGLE AND LEFT DOUBLE\n" +
+	"255C BOX DRAWINGS UP DOUBLE AND LEFT SINGLE\n" +
+	"255D BOX DRAWINGS DOUBLE UP AND LEFT\n" +
+	"255E BOX DRAWINGS VERTICAL SINGLE AND RIGHT DOUBLE\n" +
+	"255F BOX DRAWINGS VERTICAL DOUBLE AND RIGHT SINGLE\n" +
+	"2560 BOX DRAWINGS DOUBLE VERTICAL AND RIGHT\n" +
+	"2561 BOX DRAWINGS VERTICAL SINGLE AND LEFT DOUBLE\n" +
+	"2562 BOX DRAWINGS VERTICAL DOUBLE AND LEFT SINGLE\n" +
+	"2563 BOX DRAWINGS DOUBLE VERTICAL AND LEFT\n" +
+	"2564 BOX DRAWINGS DOWN SINGLE AND HORIZONTAL DOUBLE\n" +
+	"2565 BOX DRAWINGS DOWN DOUBLE AND HORIZONTAL SINGLE\n" +
+	"2566 BOX DRAWINGS DOUBLE DOWN AND HORIZONTAL\n" +
+	"2567 BOX DRAWINGS UP SINGLE AND HORIZONTAL DOUBLE\n" +
+	"2568 BOX DRAWINGS UP DOUBLE AND HORIZONTAL SINGLE\n" +
+	"2569 BOX DRAWINGS DOUBLE UP AND HORIZONTAL\n" +
+	"256A BOX DRAWINGS VERTICAL SINGLE AND HORIZONTAL DOUBLE\n" +
+	"256B BOX DRAWINGS VERTICAL DOUBLE AND HORIZONTAL SINGLE\n" +
+	"256C BOX DRAWINGS DOUBLE VERTICAL AND HORIZONTAL\n" +
+	"256D BOX DRAWINGS LIGHT ARC DOWN AND RIGHT\n" +
+	"256E BOX DRAWINGS LIGHT ARC DOWN AND LEFT\n" +
+	"256F BOX DRAWINGS LIGHT ARC UP AND LEFT\n" +
+	"2570 BOX DRAWINGS LIGHT ARC UP AND RIGHT\n" +
+	"2571 BOX DRAWINGS LIGHT DIAGONAL UPPER RIGHT TO LOWER LEFT\n" +
+	"2572 BOX DRAWINGS LIGHT DIAGONAL UPPER LEFT TO LOWER RIGHT\n" +
+	"2573 BOX DRAWINGS LIGHT DIAGONAL CROSS\n" +
+	"2574 BOX DRAWINGS LIGHT LEFT\n" +
+	"2575 BOX DRAWINGS LIGHT UP\n" +
+	"2576 BOX DRAWINGS LIGHT RIGHT\n" +
+	"2577 BOX DRAWINGS LIGHT DOWN\n" +
+	"2578 BOX DRAWINGS HEAVY LEFT\n" +
+	"2579 BOX DRAWINGS HEAVY UP\n" +
+	"257A BOX DRAWINGS HEAVY RIGHT\n" +
+	"257B BOX DRAWINGS HEAVY DOWN\n" +
+	"257C BOX DRAWINGS LIGHT LEFT AND HEAVY RIGHT\n" +
+	"257D BOX DRAWINGS LIGHT UP AND HEAVY DOWN\n" +
+	"257E BOX DRAWINGS HEAVY LEFT AND LIGHT RIGHT\n" +
+	"257F BOX DRAWINGS HEAVY UP AND LIGHT DOWN\n" +
+	"2580 UPPER HALF BLOCK\n" +
+	"2581 LOWER ONE EIGHTH BLOCK\n" +
+	"2582 LOWER ONE QUARTER BLOCK\n" +
+	"2583 LOWER THREE EIGHTHS BLOCK\n" +
+	"2584 LOWER HALF BLOCK\n" +
+	"2585 LOWER FIVE EIGHTHS BLOCK\n" +
+	"2586 LOWER THREE QUARTERS BLOCK\n" +
+	"2587 LOWER SEVEN EIGHTHS BLOCK\n" +
+	"2588 FULL BLOCK\n" +
+	"2589 LEFT SEVEN EIGHTHS BLOCK\n" +
+	"258A LEFT THREE QUARTERS BLOCK\n" +
+	"258B LEFT FIVE EIGHTHS BLOCK\n" +
+	"258C LEFT HALF BLOCK\n" +
+	"258D LEFT THREE EIGHTHS BLOCK\n" +
+	"258E LEFT ONE QUARTER BLOCK\n" +
+	"258F LEFT ONE EIGHTH BLOCK\n" +
+	"2590 RIGHT HALF BLOCK\n" +
+	"2591 LIGHT SHADE\n" +
+	"2592 MEDIUM SHADE\n" +
+	"2593 DARK SHADE\n" +
+	"2594 UPPER ONE EIGHTH BLOCK\n" +
+	"2595 RIGHT ONE EIGHTH BLOCK\n" +
+	"2596 QUADRANT LOWER LEFT\n" +
+	"2597 QUADRANT LOWER RIGHT\n" +
+	"2598 QUADRANT UPPER LEFT\n" +
+	"2599 QUADRANT UPPER LEFT AND LOWER LEFT AND LOWER RIGHT\n" +
+	"259A QUADRANT UPPER LEFT AND LOWER RIGHT\n" +
+	"259B QUADRANT UPPER LEFT AND UPPER RIGHT AND LOWER LEFT\n" +
+	"259C QUADRANT UPPER LEFT AND UPPER RIGHT AND LOWER RIGHT\n" +
+	"259D QUADRANT UPPER RIGHT\n" +
+	"259E QUADRANT UPPER RIGHT AND LOWER LEFT\n" +
+	"259F QUADRANT UPPER RIGHT AND LOWER LEFT AND LOWER RIGHT\n" +
+	"25A0 BLACK SQUARE\n" +
+	"25A1 WHITE SQUARE\n" +
+	"25A2 WHITE SQUARE WITH ROUNDED CORNERS\n" +
+	"25A3 WHITE SQUARE CONTAINING BLACK SMALL SQUARE\n" +
+	"25A4 SQUARE WITH HORIZONTAL FILL\n" +
+	"25A5 SQUARE WITH VERTICAL FILL\n" +
+	"25A6 SQUARE WITH ORTHOGONAL CROSSHATCH FILL\n" +
+	"25A7 SQUARE WITH UPPER LEFT TO LOWER RIGHT FILL\n" +
+	"25A8 SQUARE WITH UPPER RIGHT TO LOWER LEFT FILL\n" +
+	"25A9 SQUARE WITH DIAGONAL CROSSHATCH FILL\n" +
+	"25AA BLACK SMALL SQUARE\n" +
+	"25AB WHITE SMALL SQUARE\n" +
+	"25AC BLACK RECTANGLE\n" +
+	"25AD WHITE RECTANGLE\n" +
+	"25AE BLACK VERTICAL RECTANGLE\n" +
+	"25AF WHITE VERTICAL RECTANGLE\n" +
+	"25B0 BLACK PARALLELOGRAM\n" +
+	"25B1 WHITE PARALLELOGRAM\n" +
+	"25B2 BLACK UP-POINTING TRIANGLE\n" +
+	"25B3 WHITE UP-POINTING TRIANGLE\n" +
+	"25B4 BLACK UP-POINTING SMALL TRIANGLE\n" +
+	"25B5 WHITE UP-POINTING SMALL TRIANGLE\n" +
+	"25B6 BLACK RIGHT-POINTING TRIANGLE\n" +
+	"25B7 WHITE RIGHT-POINTING TRIANGLE\n" +
+	"25B8 BLACK RIGHT-POINTING SMALL TRIANGLE\n" +
+	"25B9 WHITE RIGHT-POINTING SMALL TRIANGLE\n" +
+	"25BA BLACK RIGHT-POINTING POINTER\n" +
+	"25BB WHITE RIGHT-POINTING POINTER\n" +
+	"25BC BLACK DOWN-POINTING TRIANGLE\n" +
+	"25BD WHITE DOWN-POINTING TRIANGLE\n" +
+	"25BE BLACK DOWN-POINTING SMALL TRIANGLE\n" +
+	"25BF WHITE DOWN-POINTING SMALL TRIANGLE\n" +
+	"25C0 BLACK LEFT-POINTING TRIANGLE\n" +
+	"25C1 WHITE LEFT-POINTING TRIANGLE\n" +
+	"25C2 BLACK LEFT-POINTING SMALL TRIANGLE\n" +
+	"25C3 WHITE LEFT-POINTING SMALL TRIANGLE\n" +
+	"25C4 BLACK LEFT-POINTING POINTER\n" +
+	"25C5 WHITE LEFT-POINTING POINTER\n" +
+	"25C6 BLACK DIAMOND\n" +
+	"25C7 WHITE DIAMOND\n" +
+	"25C8 WHITE DIAMOND CONTAINING BLACK SMALL DIAMOND\n" +
+	"25C9 FISHEYE\n" +
+	"25CA LOZENGE\n" +
+	"25CB WHITE CIRCLE\n" +
+	"25CC DOTTED CIRCLE\n" +
+	"25CD CIRCLE WITH VERTICAL FILL\n" +
+	"25CE BULLSEYE\n" +
+	"25CF BLACK CIRCLE\n" +
+	"25D0 CIRCLE WITH LEFT HALF BLACK\n" +
+	"25D1 CIRCLE WITH RIGHT HALF BLACK\n" +
+	"25D2 CIRCLE WITH LOWER HALF BLACK\n" +
+	"25D3 CIRCLE WITH UPPER HALF BLACK\n" +
+	"25D4 CIRCLE WITH UPPER RIGHT QUADRANT BLACK\n" +
+	"25D5 CIRCLE WITH ALL BUT UPPER LEFT QUADRANT BLACK\n" +
+	"25D6 LEFT HALF BLACK CIRCLE\n" +
+	"25D7 RIGHT HALF BLACK CIRCLE\n" +
+	"25D8 INVERSE BULLET\n" +
+	"25D9 INVERSE WHITE CIRCLE\n" +
+	"25DA UPPER HALF INVERSE WHITE CIRCLE\n" +
+	"25DB LOWER HALF INVERSE WHITE CIRCLE\n" +
+	"25DC UPPER LEFT QUADRANT CIRCULAR ARC\n" +
+	"25DD UPPER RIGHT QUADRANT CIRCULAR ARC\n" +
+	"25DE LOWER RIGHT QUADRANT CIRCULAR ARC\n" +
+	"25DF LOWER LEFT QUADRANT CIRCULAR ARC\n" +
+	"25E0 UPPER HALF CIRCLE\n" +
+	"25E1 LOWER HALF CIRCLE\n" +
+	"25E2 BLACK LOWER RIGHT TRIANGLE\n" +
+	"25E3 BLACK LOWER LEFT TRIANGLE\n" +
+	"25E4 BLACK UPPER LEFT TRIANGLE\n" +
+	"25E5 BLACK UPPER RIGHT TRIANGLE\n" +
+	"25E6 WHITE BULLET\n" +
+	"25E7 SQUARE WITH LEFT HALF BLACK\n" +
+	"25E8 SQUARE WITH RIGHT HALF BLACK\n" +
+	"25E9 SQUARE WITH UPPER LEFT DIAGONAL HALF BLACK\n" +
+	"25EA SQUARE WITH LOWER RIGHT DIAGONAL HALF BLACK\n" +
+	"25EB WHITE SQUARE WITH VERTICAL BISECTING LINE\n" +
+	"25EC WHITE UP-POINTING TRIANGLE WITH DOT\n" +
+	"25ED UP-POINTING TRIANGLE WITH LEFT HALF BLACK\n" +
+	"25EE UP-POINTING TRIANGLE WITH RIGHT HALF BLACK\n" +
+	"25EF LARGE CIRCLE\n" +
+	"25F0 WHITE SQUARE WITH UPPER LEFT QUADRANT\n" +
+	"25F1 WHITE SQUARE WITH LOWER LEFT QUADRANT\n" +
+	"25F2 WHITE SQUARE WITH LOWER RIGHT QUADRANT\n" +
+	"25F3 WHITE SQUARE WITH UPPER RIGHT QUADRANT\n" +
+	"25F4 WHITE CIRCLE WITH UPPER LEFT QUADRANT\n" +
+	"25F5 WHITE CIRCLE WITH LOWER LEFT QUADRANT\n" +
+	"25F6 WHITE CIRCLE WITH LOWER RIGHT QUADRANT\n" +
+	"25F7 WHITE CIRCLE WITH UPPER RIGHT QUADRANT\n" +
+	"25F8 UPPER LEFT TRIANGLE\n" +
+	"25F9 UPPER RIGHT TRIANGLE\n" +
+	"25FA LOWER LEFT TRIANGLE\n" +
+	"25FB WHITE MEDIUM SQUARE\n" +
+	"25FC BLACK MEDIUM SQUARE\n" +
+	"25FD WHITE MEDIUM SMALL SQUARE\n" +
+	"25FE BLACK MEDIUM SMALL SQUARE\n" +
+	"25FF LOWER RIGHT TRIANGLE\n" +
+	"2600 BLACK SUN WITH RAYS\n" +
+	"2601 CLOUD\n" +
+	"2602 UMBRELLA\n" +
+	"2603 SNOWMAN\n" +
+	"2604 COMET\n" +
+	"2605 BLACK STAR\n" +
+	"2606 WHITE STAR\n" +
+	"2607 LIGHTNING\n" +
+	"2608 THUNDERSTORM\n" +
+	"2609 SUN\n" +
+	"260A ASCENDING NODE\n" +
+	"260B DESCENDING NODE\n" +
+	"260C CONJUNCTION\n" +
+	"260D OPPOSITION\n" +
+	"260E BLACK TELEPHONE\n" +
+	"260F WHITE TELEPHONE\n" +
+	"2610 BALLOT BOX\n" +
+	"2611 BALLOT BOX WITH CHECK\n" +
+	"2612 BALLOT BOX WITH X\n" +
+	"2613 SALTIRE\n" +
+	"2614 UMBRELLA WITH RAIN DROPS\n" +
+	"2615 HOT BEVERAGE\n" +
+	"2616 WHITE SHOGI PIECE\n" +
+	"2617 BLACK SHOGI PIECE\n" +
+	"2618 SHAMROCK\n" +
+	"2619 REVERSED ROTATED FLORAL HEART BULLET\n" +
+	"261A BLACK LEFT POINTING INDEX\n" +
+	"261B BLACK RIGHT POINTING INDEX\n" +
+	"261C WHITE LEFT POINTING INDEX\n" +
+	"261D WHITE UP POINTING INDEX\n" +
+	"261E WHITE RIGHT POINTING INDEX\n" +
+	"261F WHITE DOWN POINTING INDEX\n" +
+	"2620 SKULL AND CROSSBONES\n" +
+	"2621 CAUTION SIGN\n" +
+	"2622 RADIOACTIVE SIGN\n" +
+	"2623 BIOHAZARD SIGN\n" +
+	"2624 CADUCEUS\n" +
+	"2625 ANKH\n" +
+	"2626 ORTHODOX CROSS\n" +
+	"2627 CHI RHO\n" +
+	"2628 CROSS OF LORRAINE\n" +
+	"2629 CROSS OF JERUSALEM\n" +
+	"262A STAR AND CRESCENT\n" +
+	"262B FARSI SYMBOL\n" +
+	"262C ADI SHAKTI\n" +
+	"262D HAMMER AND SICKLE\n" +
+	"262E PEACE SYMBOL\n" +
+	"262F YIN YANG\n" +
+	"2630 TRIGRAM FOR HEAVEN\n" +
+	"2631 TRIGRAM FOR LAKE\n" +
+	"2632 TRIGRAM FOR FIRE\n" +
+	"2633 TRIGRAM FOR THUNDER\n" +
+	"2634 TRIGRAM FOR WIND\n" +
+	"2635 TRIGRAM FOR WATER\n" +
+	"2636 TRIGRAM FOR MOUNTAIN\n" +
+	"2637 TRIGRAM FOR EARTH\n" +
+	"2638 WHEEL OF DHARMA\n" +
+	"2639 WHITE FROWNING FACE\n" +
+	"263A WHITE SMILING FACE\n" +
+	"263B BLACK SMILING FACE\n" +
+	"263C WHITE SUN WITH RAYS\n" +
+	"263D FIRST QUARTER MOON\n" +
+	"263E LAST QUARTER MOON\n" +
+	"263F MERCURY\n" +
+	"2640 FEMALE SIGN\n" +
+	"2641 EARTH\n" +
+	"2642 MALE SIGN\n" +
+	"2643 JUPITER\n" +
+	"2644 SATURN\n" +
+	"2645 URANUS\n" +
+	"2646 NEPTUNE\n" +
+	"2647 PLUTO\n" +
+	"2648 ARIES\n" +
+	"2649 TAURUS\n" +
+	"264A GEMINI\n" +
+	"264B CANCER\n" +
+	"264C LEO\n" +
+	"264D VIRGO\n" +
+	"264E LIBRA\n" +
+	"264F SCORPIUS\n" +
+	"2650 SAGITTARIUS\n" +
+	"2651 CAPRICORN\n" +
+	"2652 AQUARIUS\n" +
+	"2653 PISCES\n" +
+	"2654 WHITE CHESS KING\n" +
+	"2655 WHITE CHESS QUEEN\n" +
+	"2656 WHITE CHESS ROOK\n" +
+	"2657 WHITE CHESS BISHOP\n" +
+	"2658 WHITE CHESS KNIGHT\n" +
+	"2659 WHITE CHESS PAWN\n" +
+	"265A BLACK CHESS KING\n" +
+	"265B BLACK CHESS QUEEN\n" +
+	"265C BLACK CHESS ROOK\n" +
+	"265D BLACK CHESS BISHOP\n" +
+	"265E BLACK CHESS KNIGHT\n" +
+	"265F BLACK CHESS PAWN\n" +
+	"2660 BLACK SPADE SUIT\n" +
+	"2661 WHITE HEART SUIT\n" +
+	"2662 WHITE DIAMOND SUIT\n" +
+	"2663 BLACK CLUB SUIT\n" +
+	"2664 WHITE SPADE SUIT\n" +
+	"2665 BLACK HEART SUIT\n" +
+	"2666 BLACK DIAMOND SUIT\n" +
+	"2667 WHITE CLUB SUIT\n" +
+	"2668 HOT SPRINGS\n" +
+	"2669 QUARTER NOTE\n" +
+	"266A EIGHTH NOTE\n" +
+	"266B BEAMED EIGHTH NOTES\n" +
+	"266C BEAMED SIXTEENTH NOTES\n" +
+	"266D MUSIC FLAT SIGN\n" +
+	"266E MUSIC NATURAL SIGN\n" +
+	"266F MUSIC SHARP SIGN\n" +
+	"2670 WEST SYRIAC CROSS\n" +
+	"2671 EAST SYRIAC CROSS\n" +
+	"2672 UNIVERSAL RECYCLING SYMBOL\n" +
+	"2673 RECYCLING SYMBOL FOR TYPE-1 PLASTICS\n" +
+	"2674 RECYCLING SYMBOL FOR TYPE-2 PLASTICS\n" +
+	"2675 RECYCLING SYMBOL FOR TYPE-3 PLASTICS\n" +
+	"2676 RECYCLING SYMBOL FOR TYPE-4 PLASTICS\n" +
+	"2677 RECYCLING SYMBOL FOR TYPE-5 PLASTICS\n" +
+	"2678 RECYCLING SYMBOL FOR TYPE-6 PLASTICS\n" +
+	"2679 RECYCLING SYMBOL FOR TYPE-7 PLASTICS\n" +
+	"267A RECYCLING SYMBOL FOR GENERIC MATERIALS\n" +
+	"267B BLACK UNIVERSAL RECYCLING SYMBOL\n" +
+	"267C RECYCLED PAPER SYMBOL\n" +
+	"267D PARTIALLY-RECYCLED PAPER SYMBOL\n" +
+	"267E PERMANENT PAPER SIGN\n" +
+	"267F WHEELCHAIR SYMBOL\n" +
+	"2680 DIE FACE-1\n" +
+	"2681 DIE FACE-2\n" +
+	"2682 DIE FACE-3\n" +
+	"2683 DIE FACE-4\n" +
+	"2684 DIE FACE-5\n" +
+	"2685 DIE FACE-6\n" +
+	"2686 WHITE CIRCLE WITH DOT RIGHT\n" +
+	"2687 WHITE CIRCLE WITH TWO DOTS\n" +
+	"2688 BLACK CIRCLE WITH WHITE DOT RIGHT\n" +
+	"2689 BLACK CIRCLE WITH TWO WHITE DOTS\n" +
+	"268A MONOGRAM FOR YANG\n" +
+	"268B MONOGRAM FOR YIN\n" +
+	"268C DIGRAM FOR GREATER YANG\n" +
+	"268D DIGRAM FOR LESSER YIN\n" +
+	"268E DIGRAM FOR LESSER YANG\n" +
+	"268F DIGRAM FOR GREATER YIN\n" +
+	"2690 WHITE FLAG\n" +
+	"2691 BLACK FLAG\n" +
+	"2692 HAMMER AND PICK\n" +
+	"2693 ANCHOR\n" +
+	"2694 CROSSED SWORDS\n" +
+	"2695 STAFF OF AESCULAPIUS\n" +
+	"2696 SCALES\n" +
+	"2697 ALEMBIC\n" +
+	"2698 FLOWER\n" +
+	"2699 GEAR\n" +
+	"269A STAFF OF HERMES\n" +
+	"269B ATOM SYMBOL\n" +
+	"269C FLEUR-DE-LIS\n" +
+	"269D OUTLINED WHITE STAR\n" +
+	"269E THREE LINES CONVERGING RIGHT\n" +
+	"269F THREE LINES CONVERGING LEFT\n" +
+	"26A0 WARNING SIGN\n" +
+	"26A1 HIGH VOLTAGE SIGN\n" +
+	"26A2 DOUBLED FEMALE SIGN\n" +
+	"26A3 DOUBLED MALE SIGN\n" +
+	"26A4 INTERLOCKED FEMALE AND MALE SIGN\n" +
+	"26A5 MALE AND FEMALE SIGN\n" +
+	"26A6 MALE WITH STROKE SIGN\n" +
+	"26A7 MALE WITH STROKE AND MALE AND FEMALE SIGN\n" +
+	"26A8 VERTICAL MALE WITH STROKE SIGN\n" +
+	"26A9 HORIZONTAL MALE WITH STROKE SIGN\n" +
+	"26AA MEDIUM WHITE CIRCLE\n" +
+	"26AB MEDIUM BLACK CIRCLE\n" +
+	"26AC MEDIUM SMALL WHITE CIRCLE\n" +
+	"26AD MARRIAGE SYMBOL\n" +
+	"26AE DIVORCE SYMBOL\n" +
+	"26AF UNMARRIED PARTNERSHIP SYMBOL\n" +
+	"26B0 COFFIN\n" +
+	"26B1 FUNERAL URN\n" +
+	"26B2 NEUTER\n" +
+	"26B3 CERES\n" +
+	"26B4 PALLAS\n" +
+	"26B5 JUNO\n" +
+	"26B6 VESTA\n" +
+	"26B7 CHIRON\n" +
+	"26B8 BLACK MOON LILITH\n" +
+	"26B9 SEXTILE\n" +
+	"26BA SEMISEXTILE\n" +
+	"26BB QUINCUNX\n" +
+	"26BC SESQUIQUADRATE\n" +
+	"26BD SOCCER BALL\n" +
+	"26BE BASEBALL\n" +
+	"26BF SQUARED KEY\n" +
+	"26C0 WHITE DRAUGHTS MAN\n" +
+	"26C1 WHITE DRAUGHTS KING\n" +
+	"26C2 BLACK DRAUGHTS MAN\n" +
+	"26C3 BLACK DRAUGHTS KING\n" +
+	"26C4 SNOWMAN WITHOUT SNOW\n" +
+	"26C5 SUN BEHIND CLOUD\n" +
+	"26C6 RAIN\n" +
+	"26C7 BLACK SNOWMAN\n" +
+	"26C8 THUNDER CLOUD AND RAIN\n" +
+	"26C9 TURNED WHITE SHOGI PIECE\n" +
+	"26CA TURNED BLACK SHOGI PIECE\n" +
+	"26CB WHITE DIAMOND IN SQUARE\n" +
+	"26CC CROSSING LANES\n" +
+	"26CD DISABLED CAR\n" +
+	"26CE OPHIUCHUS\n" +
+	"26CF PICK\n" +
+	"26D0 CAR SLIDING\n" +
+	"26D1 HELMET WITH WHITE CROSS\n" +
+	"26D2 CIRCLED CROSSING LANES\n" +
+	"26D3 CHAINS\n" +
+	"26D4 NO ENTRY\n" +
+	"26D5 ALTERNATE ONE-WAY LEFT WAY TRAFFIC\n" +
+	"26D6 BLACK TWO-WAY LEFT WAY TRAFFIC\n" +
+	"26D7 WHITE TWO-WAY LEFT WAY TRAFFIC\n" +
+	"26D8 BLACK LEFT LANE MERGE\n" +
+	"26D9 WHITE LEFT LANE MERGE\n" +
+	"26DA DRIVE SLOW SIGN\n" +
+	"26DB HEAVY WHITE DOWN-POINTING TRIANGLE\n" +
+	"26DC LEFT CLOSED ENTRY\n" +
+	"26DD SQUARED SALTIRE\n" +
+	"26DE FALLING DIAGONAL IN WHITE CIRCLE IN BLACK SQUARE\n" +
+	"26DF BLACK TRUCK\n" +
+	"26E0 RESTRICTED LEFT ENTRY-1\n" +
+	"26E1 RESTRICTED LEFT ENTRY-2\n" +
+	"26E2 ASTRONOMICAL SYMBOL FOR URANUS\n" +
+	"26E3 HEAVY CIRCLE WITH STROKE AND TWO DOTS ABOVE\n" +
+	"26E4 PENTAGRAM\n" +
+	"26E5 RIGHT-HANDED INTERLACED PENTAGRAM\n" +
+	"26E6 LEFT-HANDED INTERLACED PENTAGRAM\n" +
+	"26E7 INVERTED PENTAGRAM\n" +
+	"26E8 BLACK CROSS ON SHIELD\n" +
+	"26E9 SHINTO SHRINE\n" +
+	"26EA CHURCH\n" +
+	"26EB CASTLE\n" +
+	"26EC HISTORIC SITE\n" +
+	"26ED GEAR WITHOUT HUB\n" +
+	"26EE GEAR WITH HANDLES\n" +
+	"26EF MAP SYMBOL FOR LIGHTHOUSE\n" +
+	"26F0 MOUNTAIN\n" +
+	"26F1 UMBRELLA ON GROUND\n" +
+	"26F2 FOUNTAIN\n" +
+	"26F3 FLAG IN HOLE\n" +
+	"26F4 FERRY\n" +
+	"26F5 SAILBOAT\n" +
+	"26F6 SQUARE FOUR CORNERS\n" +
+	"26F7 SKIER\n" +
+	"26F8 ICE SKATE\n" +
+	"26F9 PERSON WITH BALL\n" +
+	"26FA TENT\n" +
+	"26FB JAPANESE BANK SYMBOL\n" +
+	"26FC HEADSTONE GRAVEYARD SYMBOL\n" +
+	"26FD FUEL PUMP\n" +
+	"26FE CUP ON BLACK SQUARE\n" +
+	"26FF WHITE FLAG WITH HORIZONTAL MIDDLE BLACK STRIPE\n" +
+	"2700 BLACK SAFETY SCISSORS\n" +
+	"2701 UPPER BLADE SCISSORS\n" +
+	"2702 BLACK SCISSORS\n" +
+	"2703 LOWER BLADE SCISSORS\n" +
+	"2704 WHITE SCISSORS\n" +
+	"2705 WHITE HEAVY CHECK MARK\n" +
+	"2706 TELEPHONE LOCATION SIGN\n" +
+	"2707 TAPE DRIVE\n" +
+	"2708 AIRPLANE\n" +
+	"2709 ENVELOPE\n" +
+	"270A RAISED FIST\n" +
+	"270B RAISED HAND\n" +
+	"270C VICTORY HAND\n" +
+	"270D WRITING HAND\n" +
+	"270E LOWER RIGHT PENCIL\n" +
+	"270F PENCIL\n" +
+	"2710 UPPER RIGHT PENCIL\n" +
+	"2711 WHITE NIB\n" +
+	"2712 BLACK NIB\n" +
+	"2713 CHECK MARK\n" +
+	"2714 HEAVY CHECK MARK\n" +
+	"2715 MULTIPLICATION X\n" +
+	"2716 HEAVY MULTIPLICATION X\n" +
+	"2717 BALLOT X\n" +
+	"2718 HEAVY BALLOT X\n" +
+	"2719 OUTLINED GREEK CROSS\n" +
+	"271A HEAVY GREEK CROSS\n" +
+	"271B OPEN CENTRE CROSS\n" +
+	"271C HEAVY OPEN CENTRE CROSS\n" +
+	"271D LATIN CROSS\n" +
+	"271E SHADOWED WHITE LATIN CROSS\n" +
+	"271F OUTLINED LATIN CROSS\n" +
+	"2720 MALTESE CROSS\n" +
+	"2721 STAR OF DAVID\n" +
+	"2722 FOUR TEARDROP-SPOKED ASTERISK\n" +
+	"2723 FOUR BALLOON-SPOKED ASTERISK\n" +
+	"2724 HEAVY FOUR BALLOON-SPOKED ASTERISK\n" +
+	"2725 FOUR CLUB-SPOKED ASTERISK\n" +
+	"2726 BLACK FOUR POINTED STAR\n" +
+	"2727 WHITE FOUR POINTED STAR\n" +
+	"2728 SPARKLES\n" +
+	"2729 STRESS OUTLINED WHITE STAR\n" +
+	"272A CIRCLED WHITE STAR\n" +
+	"272B OPEN CENTRE BLACK STAR\n" +
+	"272C BLACK CENTRE WHITE STAR\n" +
+	"272D OUTLINED BLACK STAR\n" +
+	"272E HEAVY OUTLINED BLACK STAR\n" +
+	"272F PINWHEEL STAR\n" +
+	"2730 SHADOWED WHITE STAR\n" +
+	"2731 HEAVY ASTERISK\n" +
+	"2732 OPEN CENTRE ASTERISK\n" +
+	"2733 EIGHT SPOKED ASTERISK\n" +
+	"2734 EIGHT POINTED BLACK STAR\n" +
+	"2735 EIGHT POINTED PINWHEEL STAR\n" +
+	"2736 SIX POINTED BLACK STAR\n" +
+	"2737 EIGHT POINTED RECTILINEAR BLACK STAR\n" +
+	"2738 HEAVY EIGHT POINTED RECTILINEAR BLACK STAR\n" +
+	"2739 TWELVE POINTED BLACK STAR\n" +
+	"273A SIXTEEN POINTED ASTERISK\n" +
+	"273B TEARDROP-SPOKED ASTERISK\n" +
+	"273C OPEN CENTRE TEARDROP-SPOKED ASTERISK\n" +
+	"273D HEAVY TEARDROP-SPOKED ASTERISK\n" +
+	"273E SIX PETALLED BLACK AND WHITE FLORETTE\n" +
+	"273F BLACK FLORETTE\n" +
+	"2740 WHITE FLORETTE\n" +
+	"2741 EIGHT PETALLED OUTLINED BLACK FLORETTE\n" +
+	"2742 CIRCLED OPEN CENTRE EIGHT POINTED STAR\n" +
+	"2743 HEAVY TEARDROP-SPOKED PINWHEEL ASTERISK\n" +
+	"2744 SNOWFLAKE\n" +
+	"2745 TIGHT TRIFOLIATE SNOWFLAKE\n" +
+	"2746 HEAVY CHEVRON SNOWFLAKE\n" +
+	"2747 SPARKLE\n" +
+	"2748 HEAVY SPARKLE\n" +
+	"2749 BALLOON-SPOKED ASTERISK\n" +
+	"274A EIGHT TEARDROP-SPOKED PROPELLER ASTERISK\n" +
+	"274B HEAVY EIGHT TEARDROP-SPOKED PROPELLER ASTERISK\n" +
+	"274C CROSS MARK\n" +
+	"274D SHADOWED WHITE CIRCLE\n" +
+	"274E NEGATIVE SQUARED CROSS MARK\n" +
+	"274F LOWER RIGHT DROP-SHADOWED WHITE SQUARE\n" +
+	"2750 UPPER RIGHT DROP-SHADOWED WHITE SQUARE\n" +
+	"2751 LOWER RIGHT SHADOWED WHITE SQUARE\n" +
+	"2752 UPPER RIGHT SHADOWED WHITE SQUARE\n" +
+	"2753 BLACK QUESTION MARK ORNAMENT\n" +
+	"2754 WHITE QUESTION MARK ORNAMENT\n" +
+	"2755 WHITE EXCLAMATION MARK ORNAMENT\n" +
+	"2756 BLACK DIAMOND MINUS WHITE X\n" +
+	"2757 HEAVY EXCLAMATION MARK SYMBOL\n" +
+	"2758 LIGHT VERTICAL BAR\n" +
+	"2759 MEDIUM VERTICAL BAR\n" +
+	"275A HEAVY VERTICAL BAR\n" +
+	"275B HEAVY SINGLE TURNED COMMA QUOTATION MARK ORNAMENT\n" +
+	"275C HEAVY SINGLE COMMA QUOTATION MARK ORNAMENT\n" +
+	"275D HEAVY DOUBLE TURNED COMMA QUOTATION MARK ORNAMENT\n" +
+	"275E HEAVY DOUBLE COMMA QUOTATION MARK ORNAMENT\n" +
+	"275F HEAVY LOW SINGLE COMMA QUOTATION MARK ORNAMENT\n" +
+	"2760 HEAVY LOW DOUBLE COMMA QUOTATION MARK ORNAMENT\n" +
+	"2761 CURVED STEM PARAGRAPH SIGN ORNAMENT\n" +
+	"2762 HEAVY EXCLAMATION MARK ORNAMENT\n" +
+	"2763 HEAVY HEART EXCLAMATION MARK ORNAMENT\n" +
+	"2764 HEAVY BLACK HEART\n" +
+	"2765 ROTATED HEAVY BLACK HEART BULLET\n" +
+	"2766 FLORAL HEART\n" +
+	"2767 ROTATED FLORAL HEART BULLET\n" +
+	"2768 MEDIUM LEFT PARENTHESIS ORNAMENT\n" +
+	"2769 MEDIUM RIGHT PARENTHESIS ORNAMENT\n" +
+	"276A MEDIUM FLATTENED LEFT PARENTHESIS ORNAMENT\n" +
+	"276B MEDIUM FLATTENED RIGHT PARENTHESIS ORNAMENT\n" +
+	"276C MEDIUM LEFT-POINTING ANGLE BRACKET ORNAMENT\n" +
+	"276D MEDIUM RIGHT-POINTING ANGLE BRACKET ORNAMENT\n" +
+	"276E HEAVY LEFT-POINTING ANGLE QUOTATION MARK ORNAMENT\n" +
+	"276F HEAVY RIGHT-POINTING ANGLE QUOTATION MARK ORNAMENT\n" +
+	"2770 HEAVY LEFT-POINTING ANGLE BRACKET ORNAMENT\n" +
+	"2771 HEAVY RIGHT-POINTING ANGLE BRACKET ORNAMENT\n" +
+	"2772 LIGHT LEFT TORTOISE SHELL BRACKET ORNAMENT\n" +
+	"2773 LIGHT RIGHT TORTOISE SHELL BRACKET ORNAMENT\n" +
+	"2774 MEDIUM LEFT CURLY BRACKET ORNAMENT\n" +
+	"2775 MEDIUM RIGHT CURLY BRACKET ORNAMENT\n" +
+	"2776 DINGBAT NEGATIVE CIRCLED DIGIT ONE\n" +
+	"2777 DINGBAT NEGATIVE CIRCLED DIGIT TWO\n" +
+	"2778 DINGBAT NEGATIVE CIRCLED DIGIT THREE\n" +
+	"2779 DINGBAT NEGATIVE CIRCLED DIGIT FOUR\n" +
+	"277A DINGBAT NEGATIVE CIRCLED DIGIT FIVE\n" +
+	"277B DINGBAT NEGATIVE CIRCLED DIGIT SIX\n" +
+	"277C DINGBAT NEGATIVE CIRCLED DIGIT SEVEN\n" +
+	"277D DINGBAT NEGATIVE CIRCLED DIGIT EIGHT\n" +
+	"277E DINGBAT NEGATIVE CIRCLED DIGIT NINE\n" +
+	"277F DINGBAT NEGATIVE CIRCLED NUMBER TEN\n" +
+	"2780 DINGBAT CIRCLED SANS-SERIF DIGIT ONE\n" +
+	"2781 DINGBAT CIRCLED SANS-SERIF DIGIT TWO\n" +
+	"2782 DINGBAT CIRCLED SANS-SERIF DIGIT THREE\n" +
+	"2783 DINGBAT CIRCLED SANS-SERIF DIGIT FOUR\n" +
+	"2784 DINGBAT CIRCLED SANS-SERIF DIGIT FIVE\n" +
+	"2785 DINGBAT CIRCLED SANS-SERIF DIGIT SIX\n" +
+	"2786 DINGBAT CIRCLED SANS-SERIF DIGIT SEVEN\n" +
+	"2787 DINGBAT CIRCLED SANS-SERIF DIGIT EIGHT\n" +
+	"2788 DINGBAT CIRCLED SANS-SERIF DIGIT NINE\n" +
+	"2789 DINGBAT CIRCLED SANS-SERIF NUMBER TEN\n" +
+	"278A DINGBAT NEGATIVE CIRCLED SANS-SERIF DIGIT ONE\n" +
+	"278B DINGBAT NEGATIVE CIRCLED SANS-SERIF DIGIT TWO\n" +
+	"278C DINGBAT NEGATIVE CIRCLED SANS-SERIF DIGIT THREE\n" +
+	"278D DINGBAT NEGATIVE CIRCLED SANS-SERIF DIGIT FOUR\n" +
+	"278E DINGBAT NEGATIVE CIRCLED SANS-SERIF DIGIT FIVE\n" +
+	"278F DINGBAT NEGATIVE CIRCLED SANS-SERIF DIGIT SIX\n" +
+	"2790 DINGBAT NEGATIVE CIRCLED SANS-SERIF DIGIT SEVEN\n" +
+	"2791 DINGBAT NEGATIVE CIRCLED SANS-SERIF DIGIT EIGHT\n" +
+	"2792 DINGBAT NEGATIVE CIRCLED SANS-SERIF DIGIT NINE\n" +
+	"2793 DINGBAT NEGATIVE CIRCLED SANS-SERIF NUMBER TEN\n" +
+	"2794 HEAVY WIDE-HEADED RIGHTWARDS ARROW\n" +
+	"2795 HEAVY PLUS SIGN\n" +
+	"2796 HEAVY MINUS SIGN\n" +
+	"2797 HEAVY DIVISION SIGN\n" +
+	"2798 HEAVY SOUTH EAST ARROW\n" +
+	"2799 HEAVY RIGHTWARDS ARROW\n" +
+	"279A HEAVY NORTH EAST ARROW\n" +
+	"279B DRAFTING POINT RIGHTWARDS ARROW\n" +
+	"279C HEAVY ROUND-TIPPED RIGHTWARDS ARROW\n" +
+	"279D TRIANGLE-HEADED RIGHTWARDS ARROW\n" +
+	"279E HEAVY TRIANGLE-HEADED RIGHTWARDS ARROW\n" +
+	"279F DASHED TRIANGLE-HEADED RIGHTWARDS ARROW\n" +
+	"27A0 HEAVY DASHED TRIANGLE-HEADED RIGHTWARDS ARROW\n" +
+	"27A1 BLACK RIGHTWARDS ARROW\n" +
+	"27A2 THREE-D TOP-LIGHTED RIGHTWARDS ARROWHEAD\n" +
+	"27A3 THREE-D BOTTOM-LIGHTED RIGHTWARDS ARROWHEAD\n" +
+	"27A4 BLACK RIGHTWARDS ARROWHEAD\n" +
+	"27A5 HEAVY BLACK CURVED DOWNWARDS AND RIGHTWARDS ARROW\n" +
+	"27A6 HEAVY BLACK CURVED UPWARDS AND RIGHTWARDS ARROW\n" +
+	"27A7 SQUAT BLACK RIGHTWARDS ARROW\n" +
+	"27A8 HEAVY CONCAVE-POINTED BLACK RIGHTWARDS ARROW\n" +
+	"27A9 RIGHT-SHADED WHITE RIGHTWARDS ARROW\n" +
+	"27AA LEFT-SHADED WHITE RIGHTWARDS ARROW\n" +
+	"27AB BACK-TILTED SHADOWED WHITE RIGHTWARDS ARROW\n" +
+	"27AC FRONT-TILTED SHADOWED WHITE RIGHTWARDS ARROW\n" +
+	"27AD HEAVY LOWER RIGHT-SHADOWED WHITE RIGHTWARDS ARROW\n" +
+	"27AE HEAVY UPPER RIGHT-SHADOWED WHITE RIGHTWARDS ARROW\n" +
+	"27AF NOTCHED LOWER RIGHT-SHADOWED WHITE RIGHTWARDS ARROW\n" +
+	"27B0 CURLY LOOP\n" +
+	"27B1 NOTCHED UPPER RIGHT-SHADOWED WHITE RIGHTWARDS ARROW\n" +
+	"27B2 CIRCLED HEAVY WHITE RIGHTWARDS ARROW\n" +
+	"27B3 WHITE-FEATHERED RIGHTWARDS ARROW\n" +
+	"27B4 BLACK-FEATHERED SOUTH EAST ARROW\n" +
+	"27B5 BLACK-FEATHERED RIGHTWARDS ARROW\n" +
+	"27B6 BLACK-FEATHERED NORTH EAST ARROW\n" +
+	"27B7 HEAVY BLACK-FEATHERED SOUTH EAST ARROW\n" +
+	"27B8 HEAVY BLACK-FEATHERED RIGHTWARDS ARROW\n" +
+	"27B9 HEAVY BLACK-FEATHERED NORTH EAST ARROW\n" +
+	"27BA TEARDROP-BARBED RIGHTWARDS ARROW\n" +
+	"27BB HEAVY TEARDROP-SHANKED RIGHTWARDS ARROW\n" +
+	"27BC WEDGE-TAILED RIGHTWARDS ARROW\n" +
+	"27BD HEAVY WEDGE-TAILED RIGHTWARDS ARROW\n" +
+	"27BE OPEN-OUTLINED RIGHTWARDS ARROW\n" +
+	"27BF DOUBLE CURLY LOOP\n" +
+	"27C0 THREE DIMENSIONAL ANGLE\n" +
+	"27C1 WHITE TRIANGLE CONTAINING SMALL WHITE TRIANGLE\n" +
+	"27C2 PERPENDICULAR\n" +
+	"27C3 OPEN SUBSET\n" +
+	"27C4 OPEN SUPERSET\n" +
+	"27C5 LEFT S-SHAPED BAG DELIMITER\n" +
+	"27C6 RIGHT S-SHAPED BAG DELIMITER\n" +
+	"27C7 OR WITH DOT INSIDE\n" +
+	"27C8 REVERSE SOLIDUS PRECEDING SUBSET\n" +
+	"27C9 SUPERSET PRECEDING SOLIDUS\n" +
+	"27CA VERTICAL BAR WITH HORIZONTAL STROKE\n" +
+	"27CB MATHEMATICAL RISING DIAGONAL\n" +
+	"27CC LONG DIVISION\n" +
+	"27CD MATHEMATICAL FALLING DIAGONAL\n" +
+	"27CE SQUARED LOGICAL AND\n" +
+	"27CF SQUARED LOGICAL OR\n" +
+	"27D0 WHITE DIAMOND WITH CENTRED DOT\n" +
+	"27D1 AND WITH DOT\n" +
+	"27D2 ELEMENT OF OPENING UPWARDS\n" +
+	"27D3 LOWER RIGHT CORNER WITH DOT\n" +
+	"27D4 UPPER LEFT CORNER WITH DOT\n" +
+	"27D5 LEFT OUTER JOIN\n" +
+	"27D6 RIGHT OUTER JOIN\n" +
+	"27D7 FULL OUTER JOIN\n" +
+	"27D8 LARGE UP TACK\n" +
+	"27D9 LARGE DOWN TACK\n" +
+	"27DA LEFT AND RIGHT DOUBLE TURNSTILE\n" +
+	"27DB LEFT AND RIGHT TACK\n" +
+	"27DC LEFT MULTIMAP\n" +
+	"27DD LONG RIGHT TACK\n" +
+	"27DE LONG LEFT TACK\n" +
+	"27DF UP TACK WITH CIRCLE ABOVE\n" +
+	"27E0 LOZENGE DIVIDED BY HORIZONTAL RULE\n" +
+	"27E1 WHITE CONCAVE-SIDED DIAMOND\n" +
+	"27E2 WHITE CONCAVE-SIDED DIAMOND WITH LEFTWARDS TICK\n" +
+	"27E3 WHITE CONCAVE-SIDED DIAMOND WITH RIGHTWARDS TICK\n" +
+	"27E4 WHITE SQUARE WITH LEFTWARDS TICK\n" +
+	"27E5 WHITE SQUARE WITH RIGHTWARDS TICK\n" +
+	"27E6 MATHEMATICAL LEFT WHITE SQUARE BRACKET\n" +
+	"27E7 MATHEMATICAL RIGHT WHITE SQUARE BRACKET\n" +
+	"27E8 MATHEMATICAL LEFT ANGLE BRACKET\n" +
+	"27E9 MATHEMATICAL RIGHT ANGLE BRACKET\n" +
+	"27EA MATHEMATICAL LEFT DOUBLE ANGLE BRACKET\n" +
+	"27EB MATHEMATICAL RIGHT DOUBLE ANGLE BRACKET\n" +
+	"27EC MATHEMATICAL LEFT WHITE TORTOISE SHELL BRACKET\n" +
+	"27ED MATHEMATICAL RIGHT WHITE TORTOISE SHELL BRACKET\n" +
+	"27EE MATHEMATICAL LEFT FLATTENED PARENTHESIS\n" +
+	"27EF MATHEMATICAL RIGHT FLATTENED PARENTHESIS\n" +
+	"27F0 UPWARDS QUADRUPLE ARROW\n" +
+	"27F1 DOWNWARDS QUADRUPLE ARROW\n" +
+	"27F2 ANTICLOCKWISE GAPPED CIRCLE ARROW\n" +
+	"27F3 CLOCKWISE GAPPED CIRCLE ARROW\n" +
+	"27F4 RIGHT ARROW WITH CIRCLED PLUS\n" +
+	"27F5 LONG LEFTWARDS ARROW\n" +
+	"27F6 LONG RIGHTWARDS ARROW\n" +
+	"27F7 LONG LEFT RIGHT ARROW\n" +
+	"27F8 LONG LEFTWARDS DOUBLE ARROW\n" +
+	"27F9 LONG RIGHTWARDS DOUBLE ARROW\n" +
+	"27FA LONG LEFT RIGHT DOUBLE ARROW\n" +
+	"27FB LONG LEFTWARDS ARROW FROM BAR\n" +
+	"27FC LONG RIGHTWARDS ARROW FROM BAR\n" +
+	"27FD LONG LEFTWARDS DOUBLE ARROW FROM BAR\n" +
+	"27FE LONG RIGHTWARDS DOUBLE ARROW FROM BAR\n" +
+	"27FF LONG RIGHTWARDS SQUIGGLE ARROW\n" +
+	"2900 RIGHTWARDS TWO-HEADED ARROW WITH VERTICAL STROKE\n" +
+	"2901 RIGHTWARDS TWO-HEADED ARROW WITH DOUBLE VERTICAL STROKE\n" +
+	"2902 LEFTWARDS DOUBLE ARROW WITH VERTICAL STROKE\n" +
+	"2903 RIGHTWARDS DOUBLE ARROW WITH VERTICAL STROKE\n" +
+	"2904 LEFT RIGHT DOUBLE ARROW WITH VERTICAL STROKE\n" +
+	"2905 RIGHTWARDS TWO-HEADED ARROW FROM BAR\n" +
+	"2906 LEFTWARDS DOUBLE ARROW FROM BAR\n" +
+	"2907 RIGHTWARDS DOUBLE ARROW FROM BAR\n" +
+	"2908 DOWNWARDS ARROW WITH HORIZONTAL STROKE\n" +
+	"2909 UPWARDS ARROW WITH HORIZONTAL STROKE\n" +
+	"290A UPWARDS TRIPLE ARROW\n" +
+	"290B DOWNWARDS TRIPLE ARROW\n" +
+	"290C LEFTWARDS DOUBLE DASH ARROW\n" +
+	"290D RIGHTWARDS DOUBLE DASH ARROW\n" +
+	"290E LEFTWARDS TRIPLE DASH ARROW\n" +
+	"290F RIGHTWARDS TRIPLE DASH ARROW\n" +
+	"2910 RIGHTWARDS TWO-HEADED TRIPLE DASH ARROW\n" +
+	"2911 RIGHTWARDS ARROW WITH DOTTED STEM\n" +
+	"2912 UPWARDS ARROW TO BAR\n" +
+	"2913 DOWNWARDS ARROW TO BAR\n" +
+	"2914 RIGHTWARDS ARROW WITH TAIL WITH VERTICAL STROKE\n" +
+	"2915 RIGHTWARDS ARROW WITH TAIL WITH DOUBLE VERTICAL STROKE\n" +
+	"2916 RIGHTWARDS TWO-HEADED ARROW WITH TAIL\n" +
+	"2917 RIGHTWARDS TWO-HEADED ARROW WITH TAIL WITH VERTICAL STROKE\n" +
+	"2918 RIGHTWARDS TWO-HEADED ARROW WITH TAIL WITH DOUBLE VERTICAL STROKE\n" +
+	"2919 LEFTWARDS ARROW-TAIL\n" +
+	"291A RIGHTWARDS ARROW-TAIL\n" +
+	"291B LEFTWARDS DOUBLE ARROW-TAIL\n" +
+	"291C RIGHTWARDS DOUBLE ARROW-TAIL\n" +
+	"291D LEFTWARDS ARROW TO BLACK DIAMOND\n" +
+	"291E RIGHTWARDS ARROW TO BLACK DIAMOND\n" +
+	"291F LEFTWARDS ARROW FROM BAR TO BLACK DIAMOND\n" +
+	"2920 RIGHTWARDS ARROW FROM BAR TO BLACK DIAMOND\n" +
+	"2921 NORTH WEST AND SOUTH EAST ARROW\n" +
+	"2922 NORTH EAST AND SOUTH WEST ARROW\n" +
+	"2923 NORTH WEST ARROW WITH HOOK\n" +
+	"2924 NORTH EAST ARROW WITH HOOK\n" +
+	"2925 SOUTH EAST ARROW WITH HOOK\n" +
+	"2926 SOUTH WEST ARROW WITH HOOK\n" +
+	"2927 NORTH WEST ARROW AND NORTH EAST ARROW\n" +
+	"2928 NORTH EAST ARROW AND SOUTH EAST ARROW\n" +
+	"2929 SOUTH EAST ARROW AND SOUTH WEST ARROW\n" +
+	"292A SOUTH WEST ARROW AND NORTH WEST ARROW\n" +
+	"292B RISING DIAGONAL CROSSING FALLING DIAGONAL\n" +
+	"292C FALLING DIAGONAL CROSSING RISING DIAGONAL\n" +
+	"292D SOUTH EAST ARROW CROSSING NORTH EAST ARROW\n" +
+	"292E NORTH EAST ARROW CROSSING SOUTH EAST ARROW\n" +
+	"292F FALLING DIAGONAL CROSSING NORTH EAST ARROW\n" +
+	"2930 RISING DIAGONAL CROSSING SOUTH EAST ARROW\n" +
+	"2931 NORTH EAST ARROW CROSSING NORTH WEST ARROW\n" +
+	"2932 NORTH WEST ARROW CROSSING NORTH EAST ARROW\n" +
+	"2933 WAVE ARROW POINTING DIRECTLY RIGHT\n" +
+	"2934 ARROW POINTING RIGHTWARDS THEN CURVING UPWARDS\n" +
+	"2935 ARROW POINTING RIGHTWARDS THEN CURVING DOWNWARDS\n" +
+	"2936 ARROW POINTING DOWNWARDS THEN CURVING LEFTWARDS\n" +
+	"2937 ARROW POINTING DOWNWARDS THEN CURVING RIGHTWARDS\n" +
+	"2938 RIGHT-SIDE ARC CLOCKWISE ARROW\n" +
+	"2939 LEFT-SIDE ARC ANTICLOCKWISE ARROW\n" +
+	"293A TOP ARC ANTICLOCKWISE ARROW\n" +
+	"293B BOTTOM ARC ANTICLOCKWISE ARROW\n" +
+	"293C TOP ARC CLOCKWISE ARROW WITH MINUS\n" +
+	"293D TOP ARC ANTICLOCKWISE ARROW WITH PLUS\n" +
+	"293E LOWER RIGHT SEMICIRCULAR CLOCKWISE ARROW\n" +
+	"293F LOWER LEFT SEMICIRCULAR ANTICLOCKWISE ARROW\n" +
+	"2940 ANTICLOCKWISE CLOSED CIRCLE ARROW\n" +
+	"2941 CLOCKWISE CLOSED CIRCLE ARROW\n" +
+	"2942 RIGHTWARDS ARROW ABOVE SHORT LEFTWARDS ARROW\n" +
+	"2943 LEFTWARDS ARROW ABOVE SHORT RIGHTWARDS ARROW\n" +
+	"2944 SHORT RIGHTWARDS ARROW ABOVE LEFTWARDS ARROW\n" +
+	"2945 RIGHTWARDS ARROW WITH PLUS BELOW\n" +
+	"2946 LEFTWARDS ARROW WITH PLUS BELOW\n" +
+	"2947 RIGHTWARDS ARROW THROUGH X\n" +
+	"2948 LEFT RIGHT ARROW THROUGH SMALL CIRCLE\n" +
+	"2949 UPWARDS TWO-HEADED ARROW FROM SMALL CIRCLE\n" +
+	"294A LEFT BARB UP RIGHT BARB DOWN HARPOON\n" +
+	"294B LEFT BARB DOWN RIGHT BARB UP HARPOON\n" +
+	"294C UP BARB RIGHT DOWN BARB LEFT HARPOON\n" +
+	"294D UP BARB LEFT DOWN BARB RIGHT HARPOON\n" +
+	"294E LEFT BARB UP RIGHT BARB UP HARPOON\n" +
+	"294F UP BARB RIGHT DOWN BARB RIGHT HARPOON\n" +
+	"2950 LEFT BARB DOWN RIGHT BARB DOWN HARPOON\n" +
+	"2951 UP BARB LEFT DOWN BARB LEFT HARPOON\n" +
+	"2952 LEFTWARDS HARPOON WITH BARB UP TO BAR\n" +
+	"2953 RIGHTWARDS HARPOON WITH BARB UP TO BAR\n" +
+	"2954 UPWARDS HARPOON WITH BARB RIGHT TO BAR\n" +
+	"2955 DOWNWARDS HARPOON WITH BARB RIGHT TO BAR\n" +
+	"2956 LEFTWARDS HARPOON WITH BARB DOWN TO BAR\n" +
+	"2957 RIGHTWARDS HARPOON WITH BARB DOWN TO BAR\n" +
+	"2958 UPWARDS HARPOON WITH BARB LEFT TO BAR\n" +
+	"2959 DOWNWARDS HARPOON WITH BARB LEFT TO BAR\n" +
+	"295A LEFTWARDS HARPOON WITH BARB UP FROM BAR\n" +
+	"295B RIGHTWARDS HARPOON WITH BARB UP FROM BAR\n" +
+	"295C UPWARDS HARPOON WITH BARB RIGHT FROM BAR\n" +
+	"295D DOWNWARDS HARPOON WITH BARB RIGHT FROM BAR\n" +
+	"295E LEFTWARDS HARPOON WITH BARB DOWN FROM BAR\n" +
+	"295F RIGHTWARDS HARPOON WITH BARB DOWN FROM BAR\n" +
+	"2960 UPWARDS HARPOON WITH BARB LEFT FROM BAR\n" +
+	"2961 DOWNWARDS HARPOON WITH BARB LEFT FROM BAR\n" +
+	"2962 LEFTWARDS HARPOON WITH BARB UP ABOVE LEFTWARDS HARPOON WITH BARB DOWN\n" +
+	"2963 UPWARDS HARPOON WITH BARB LEFT BESIDE UPWARDS HARPOON WITH BARB RIGHT\n" +
+	"2964 RIGHTWARDS HARPOON WITH BARB UP ABOVE RIGHTWARDS HARPOON WITH BARB DOWN\n" +
+	"2965 DOWNWARDS HARPOON WITH BARB LEFT BESIDE DOWNWARDS HARPOON WITH BARB RIGHT\n" +
+	"2966 LEFTWARDS HARPOON WITH BARB UP ABOVE RIGHTWARDS HARPOON WITH BARB UP\n" +
+	"2967 LEFTWARDS HARPOON WITH BARB DOWN ABOVE RIGHTWARDS HARPOON WITH BARB DOWN\n" +
+	"2968 RIGHTWARDS HARPOON WITH BARB UP ABOVE LEFTWARDS HARPOON WITH BARB UP\n" +
+	"2969 RIGHTWARDS HARPOON WITH BARB DOWN ABOVE LEFTWARDS HARPOON WITH BARB DOWN\n" +
+	"296A LEFTWARDS HARPOON WITH BARB UP ABOVE LONG DASH\n" +
+	"296B LEFTWARDS HARPOON WITH BARB DOWN BELOW LONG DASH\n" +
+	"296C RIGHTWARDS HARPOON WITH BARB UP ABOVE LONG DASH\n" +
+	"296D RIGHTWARDS HARPOON WITH BARB DOWN BELOW LONG DASH\n" +
+	"296E UPWARDS HARPOON WITH BARB LEFT BESIDE DOWNWARDS HARPOON WITH BARB RIGHT\n" +
+	"296F DOWNWARDS HARPOON WITH BARB LEFT BESIDE UPWARDS HARPOON WITH BARB RIGHT\n" +
+	"2970 RIGHT DOUBLE ARROW WITH ROUNDED HEAD\n" +
+	"2971 EQUALS SIGN ABOVE RIGHTWARDS ARROW\n" +
+	"2972 TILDE OPERATOR ABOVE RIGHTWARDS ARROW\n" +
+	"2973 LEFTWARDS ARROW ABOVE TILDE OPERATOR\n" +
+	"2974 RIGHTWARDS ARROW ABOVE TILDE OPERATOR\n" +
+	"2975 RIGHTWARDS ARROW ABOVE ALMOST EQUAL TO\n" +
+	"2976 LESS-THAN ABOVE LEFTWARDS ARROW\n" +
+	"2977 LEFTWARDS ARROW THROUGH LESS-THAN\n" +
+	"2978 GREATER-THAN ABOVE RIGHTWARDS ARROW\n" +
+	"2979 SUBSET ABOVE RIGHTWARDS ARROW\n" +
+	"297A LEFTWARDS ARROW THROUGH SUBSET\n" +
+	"297B SUPERSET ABOVE LEFTWARDS ARROW\n" +
+	"297C LEFT FISH TAIL\n" +
+	"297D RIGHT FISH TAIL\n" +
+	"297E UP FISH TAIL\n" +
+	"297F DOWN FISH TAIL\n" +
+	"2980 TRIPLE VERTICAL BAR DELIMITER\n" +
+	"2981 Z NOTATION SPOT\n" +
+	"2982 Z NOTATION TYPE COLON\n" +
+	"2983 LEFT WHITE CURLY BRACKET\n" +
+	"2984 RIGHT WHITE CURLY BRACKET\n" +
+	"2985 LEFT WHITE PARENTHESIS\n" +
+	"2986 RIGHT WHITE PARENTHESIS\n" +
+	"2987 Z NOTATION LEFT IMAGE BRACKET\n" +
+	"2988 Z NOTATION RIGHT IMAGE BRACKET\n" +
+	"2989 Z NOTATION LEFT BINDING BRACKET\n" +
+	"298A Z NOTATION RIGHT BINDING BRACKET\n" +
+	"298B LEFT SQUARE BRACKET WITH UNDERBAR\n" +
+	"298C RIGHT SQUARE BRACKET WITH UNDERBAR\n" +
+	"298D LEFT SQUARE BRACKET WITH TICK IN TOP CORNER\n" +
+	"298E RIGHT SQUARE BRACKET WITH TICK IN BOTTOM CORNER\n" +
+	"298F LEFT SQUARE BRACKET WITH TICK IN BOTTOM CORNER\n" +
+	"2990 RIGHT SQUARE BRACKET WITH TICK IN TOP CORNER\n" +
+	"2991 LEFT ANGLE BRACKET WITH DOT\n" +
+	"2992 RIGHT ANGLE BRACKET WITH DOT\n" +
+	"2993 LEFT ARC LESS-THAN BRACKET\n" +
+	"2994 RIGHT ARC GREATER-THAN BRACKET\n" +
+	"2995 DOUBLE LEFT ARC GREATER-THAN BRACKET\n" +
+	"2996 DOUBLE RIGHT ARC LESS-THAN BRACKET\n" +
+	"2997 LEFT BLACK TORTOISE SHELL BRACKET\n" +
+	"2998 RIGHT BLACK TORTOISE SHELL BRACKET\n" +
+	"2999 DOTTED FENCE\n" +
+	"299A VERTICAL ZIGZAG LINE\n" +
+	"299B MEASURED ANGLE OPENING LEFT\n" +
+	"299C RIGHT ANGLE VARIANT WITH SQUARE\n" +
+	"299D MEASURED RIGHT ANGLE WITH DOT\n" +
+	"299E ANGLE WITH S INSIDE\n" +
+	"299F ACUTE ANGLE\n" +
+	"29A0 SPHERICAL ANGLE OPENING LEFT\n" +
+	"29A1 SPHERICAL ANGLE OPENING UP\n" +
+	"29A2 TURNED ANGLE\n" +
+	"29A3 REVERSED ANGLE\n" +
+	"29A4 ANGLE WITH UNDERBAR\n" +
+	"29A5 REVERSED ANGLE WITH UNDERBAR\n" +
+	"29A6 OBLIQUE ANGLE OPENING UP\n" +
+	"29A7 OBLIQUE ANGLE OPENING DOWN\n" +
+	"29A8 MEASURED ANGLE WITH OPEN ARM ENDING IN ARROW POINTING UP AND RIGHT\n" +
+	"29A9 MEASURED ANGLE WITH OPEN ARM ENDING IN ARROW POINTING UP AND LEFT\n" +
+	"29AA MEASURED ANGLE WITH OPEN ARM ENDING IN ARROW POINTING DOWN AND RIGHT\n" +
+	"29AB MEASURED ANGLE WITH OPEN ARM ENDING IN ARROW POINTING DOWN AND LEFT\n" +
+	"29AC MEASURED ANGLE WITH OPEN ARM ENDING IN ARROW POINTING RIGHT AND UP\n" +
+	"29AD MEASURED ANGLE WITH OPEN ARM ENDING IN ARROW POINTING LEFT AND UP\n" +
+	"29AE MEASURED ANGLE WITH OPEN ARM ENDING IN ARROW POINTING RIGHT AND DOWN\n" +
+	"29AF MEASURED ANGLE WITH OPEN ARM ENDING IN ARROW POINTING LEFT AND DOWN\n" +
+	"29B0 REVERSED EMPTY SET\n" +
+	"29B1 EMPTY SET WITH OVERBAR\n" +
+	"29B2 EMPTY SET WITH SMALL CIRCLE ABOVE\n" +
+	"29B3 EMPTY SET WITH RIGHT ARROW ABOVE\n" +
+	"29B4 EMPTY SET WITH LEFT ARROW ABOVE\n" +
+	"29B5 CIRCLE WITH HORIZONTAL BAR\n" +
+	"29B6 CIRCLED VERTICAL BAR\n" +
+	"29B7 CIRCLED PARALLEL\n" +
+	"29B8 CIRCLED REVERSE SOLIDUS\n" +
+	"29B9 CIRCLED PERPENDICULAR\n" +
+	"29BA CIRCLE DIVIDED BY HORIZONTAL BAR AND TOP HALF DIVIDED BY VERTICAL BAR\n" +
+	"29BB CIRCLE WITH SUPERIMPOSED X\n" +
+	"29BC CIRCLED ANTICLOCKWISE-ROTATED DIVISION SIGN\n" +
+	"29BD UP ARROW THROUGH CIRCLE\n" +
+	"29BE CIRCLED WHITE BULLET\n" +
+	"29BF CIRCLED BULLET\n" +
+	"29C0 CIRCLED LESS-THAN\n" +
+	"29C1 CIRCLED GREATER-THAN\n" +
+	"29C2 CIRCLE WITH SMALL CIRCLE TO THE RIGHT\n" +
+	"29C3 CIRCLE WITH TWO HORIZONTAL STROKES TO THE RIGHT\n" +
+	"29C4 SQUARED RISING DIAGONAL SLASH\n" +
+	"29C5 SQUARED FALLING DIAGONAL SLASH\n" +
+	"29C6 SQUARED ASTERISK\n" +
+	"29C7 SQUARED SMALL CIRCLE\n" +
+	"29C8 SQUARED SQUARE\n" +
+	"29C9 TWO JOINED SQUARES\n" +
+	"29CA TRIANGLE WITH DOT ABOVE\n" +
+	"29CB TRIANGLE WITH UNDERBAR\n" +
+	"29CC S IN TRIANGLE\n" +
+	"29CD TRIANGLE WITH SERIFS AT BOTTOM\n" +
+	"29CE RIGHT TRIANGLE ABOVE LEFT TRIANGLE\n" +
+	"29CF LEFT TRIANGLE BESIDE VERTICAL BAR\n" +
+	"29D0 VERTICAL BAR BESIDE RIGHT TRIANGLE\n" +
+	"29D1 BOWTIE WITH LEFT HALF BLACK\n" +
+	"29D2 BOWTIE WITH RIGHT HALF BLACK\n" +
+	"29D3 BLACK BOWTIE\n" +
+	"29D4 TIMES WITH LEFT HALF BLACK\n" +
+	"29D5 TIMES WITH RIGHT HALF BLACK\n" +
+	"29D6 WHITE HOURGLASS\n" +
+	"29D7 BLACK HOURGLASS\n" +
+	"29D8 LEFT WIGGLY FENCE\n" +
+	"29D9 RIGHT WIGGLY FENCE\n" +
+	"29DA LEFT DOUBLE WIGGLY FENCE\n" +
+	"29DB RIGHT DOUBLE WIGGLY FENCE\n" +
+	"29DC INCOMPLETE INFINITY\n" +
+	"29DD TIE OVER INFINITY\n" +
+	"29DE INFINITY NEGATED WITH VERTICAL BAR\n" +
+	"29DF DOUBLE-ENDED MULTIMAP\n" +
+	"29E0 SQUARE WITH CONTOURED OUTLINE\n" +
+	"29E1 INCREASES AS\n" +
+	"29E2 SHUFFLE PRODUCT\n" +
+	"29E3 EQUALS SIGN AND SLANTED PARALLEL\n" +
+	"29E4 EQUALS SIGN AND SLANTED PARALLEL WITH TILDE ABOVE\n" +
+	"29E5 IDENTICAL TO AND SLANTED PARALLEL\n" +
+	"29E6 GLEICH STARK\n" +
+	"29E7 THERMODYNAMIC\n" +
+	"29E8 DOWN-POINTING TRIANGLE WITH LEFT HALF BLACK\n" +
+	"29E9 DOWN-POINTING TRIANGLE WITH RIGHT HALF BLACK\n" +
+	"29EA BLACK DIAMOND WITH DOWN ARROW\n" +
+	"29EB BLACK LOZENGE\n" +
+	"29EC WHITE CIRCLE WITH DOWN ARROW\n" +
+	"29ED BLACK CIRCLE WITH DOWN ARROW\n" +
+	"29EE ERROR-BARRED WHITE SQUARE\n" +
+	"29EF ERROR-BARRED BLACK SQUARE\n" +
+	"29F0 ERROR-BARRED WHITE DIAMOND\n" +
+	"29F1 ERROR-BARRED BLACK DIAMOND\n" +
+	"29F2 ERROR-BARRED WHITE CIRCLE\n" +
+	"29F3 ERROR-BARRED BLACK CIRCLE\n" +
+	"29F4 RULE-DELAYED\n" +
+	"29F5 REVERSE SOLIDUS OPERATOR\n" +
+	"29F6 SOLIDUS WITH OVERBAR\n" +
+	"29F7 REVERSE SOLIDUS WITH HORIZONTAL STROKE\n" +
+	"29F8 BIG SOLIDUS\n" +
+	"29F9 BIG REVERSE SOLIDUS\n" +
+	"29FA DOUBLE PLUS\n" +
+	"29FB TRIPLE PLUS\n" +
+	"29FC LEFT-POINTING CURVED ANGLE BRACKET\n" +
+	"29FD RIGHT-POINTING CURVED ANGLE BRACKET\n" +
+	"29FE TINY\n" +
+	"29FF MINY\n" +
+	"2A00 N-ARY CIRCLED DOT OPERATOR\n" +
+	"2A01 N-ARY CIRCLED PLUS OPERATOR\n" +
+	"2A02 N-ARY CIRCLED TIMES OPERATOR\n" +
+	"2A03 N-ARY UNION OPERATOR WITH DOT\n" +
+	"2A04 N-ARY UNION OPERATOR WITH PLUS\n" +
+	"2A05 N-ARY SQUARE INTERSECTION OPERATOR\n" +
+	"2A06 N-ARY SQUARE UNION OPERATOR\n" +
+	"2A07 TWO LOGICAL AND OPERATOR\n" +
+	"2A08 TWO LOGICAL OR OPERATOR\n" +
+	"2A09 N-ARY TIMES OPERATOR\n" +
+	"2A0A MODULO TWO SUM\n" +
+	"2A0B SUMMATION WITH INTEGRAL\n" +
+	"2A0C QUADRUPLE INTEGRAL OPERATOR\n" +
+	"2A0D FINITE PART INTEGRAL\n" +
+	"2A0E INTEGRAL WITH DOUBLE STROKE\n" +
+	"2A0F INTEGRAL AVERAGE WITH SLASH\n" +
+	"2A10 CIRCULATION FUNCTION\n" +
+	"2A11 ANTICLOCKWISE INTEGRATION\n" +
+	"2A12 LINE INTEGRATION WITH RECTANGULAR PATH AROUND POLE\n" +
+	"2A13 LINE INTEGRATION WITH SEMICIRCULAR PATH AROUND POLE\n" +
+	"2A14 LINE INTEGRATION NOT INCLUDING THE POLE\n" +
+	"2A15 INTEGRAL AROUND A POINT OPERATOR\n" +
+	"2A16 QUATERNION INTEGRAL OPERATOR\n" +
+	"2A17 INTEGRAL WITH LEFTWARDS ARROW WITH HOOK\n" +
+	"2A18 INTEGRAL WITH TIMES SIGN\n" +
+	"2A19 INTEGRAL WITH INTERSECTION\n" +
+	"2A1A INTEGRAL WITH UNION\n" +
+	"2A1B INTEGRAL WITH OVERBAR\n" +
+	"2A1C INTEGRAL WITH UNDERBAR\n" +
+	"2A1D JOIN\n" +
+	"2A1E LARGE LEFT TRIANGLE OPERATOR\n" +
+	"2A1F Z NOTATION SCHEMA COMPOSITION\n" +
+	"2A20 Z NOTATION SCHEMA PIPING\n" +
+	"2A21 Z NOTATION SCHEMA PROJECTION\n" +
+	"2A22 PLUS SIGN WITH SMALL CIRCLE ABOVE\n" +
+	"2A23 PLUS SIGN WITH CIRCUMFLEX ACCENT ABOVE\n" +
+	"2A24 PLUS SIGN WITH TILDE ABOVE\n" +
+	"2A25 PLUS SIGN WITH DOT BELOW\n" +
+	"2A26 PLUS SIGN WITH TILDE BELOW\n" +
+	"2A27 PLUS SIGN WITH SUBSCRIPT TWO\n" +
+	"2A28 PLUS SIGN WITH BLACK TRIANGLE\n" +
+	"2A29 MINUS SIGN WITH COMMA ABOVE\n" +
+	"2A2A MINUS SIGN WITH DOT BELOW\n" +
+	"2A2B MINUS SIGN WITH FALLING DOTS\n" +
+	"2A2C MINUS SIGN WITH RISING DOTS\n" +
+	"2A2D PLUS SIGN IN LEFT HALF CIRCLE\n" +
+	"2A2E PLUS SIGN IN RIGHT HALF CIRCLE\n" +
+	"2A2F VECTOR OR CROSS PRODUCT\n" +
+	"2A30 MULTIPLICATION SIGN WITH DOT ABOVE\n" +
+	"2A31 MULTIPLICATION SIGN WITH UNDERBAR\n" +
+	"2A32 SEMIDIRECT PRODUCT WITH BOTTOM CLOSED\n" +
+	"2A33 SMASH PRODUCT\n" +
+	"2A34 MULTIPLICATION SIGN IN LEFT HALF CIRCLE\n" +
+	"2A35 MULTIPLICATION SIGN IN RIGHT HALF CIRCLE\n" +
+	"2A36 CIRCLED MULTIPLICATION SIGN WITH CIRCUMFLEX ACCENT\n" +
+	"2A37 MULTIPLICATION SIGN IN DOUBLE CIRCLE\n" +
+	"2A38 CIRCLED DIVISION SIGN\n" +
+	"2A39 PLUS SIGN IN TRIANGLE\n" +
+	"2A3A MINUS SIGN IN TRIANGLE\n" +
+	"2A3B MULTIPLICATION SIGN IN TRIANGLE\n" +
+	"2A3C INTERIOR PRODUCT\n" +
+	"2A3D RIGHTHAND INTERIOR PRODUCT\n" +
+	"2A3E Z NOTATION RELATIONAL COMPOSITION\n" +
+	"2A3F AMALGAMATION OR COPRODUCT\n" +
+	"2A40 INTERSECTION WITH DOT\n" +
+	"2A41 UNION WITH MINUS SIGN\n" +
+	"2A42 UNION WITH OVERBAR\n" +
+	"2A43 INTERSECTION WITH OVERBAR\n" +
+	"2A44 INTERSECTION WITH LOGICAL AND\n" +
+	"2A45 UNION WITH LOGICAL OR\n" +
+	"2A46 UNION ABOVE INTERSECTION\n" +
+	"2A47 INTERSECTION ABOVE UNION\n" +
+	"2A48 UNION ABOVE BAR ABOVE INTERSECTION\n" +
+	"2A49 INTERSECTION ABOVE BAR ABOVE UNION\n" +
+	"2A4A UNION BESIDE AND JOINED WITH UNION\n" +
+	"2A4B INTERSECTION BESIDE AND JOINED WITH INTERSECTION\n" +
+	"2A4C CLOSED UNION WITH SERIFS\n" +
+	"2A4D CLOSED INTERSECTION WITH SERIFS\n" +
+	"2A4E DOUBLE SQUARE INTERSECTION\n" +
+	"2A4F DOUBLE SQUARE UNION\n" +
+	"2A50 CLOSED UNION WITH SERIFS AND SMASH PRODUCT\n" +
+	"2A51 LOGICAL AND WITH DOT ABOVE\n" +
+	"2A52 LOGICAL OR WITH DOT ABOVE\n" +
+	"2A53 DOUBLE LOGICAL AND\n" +
+	"2A54 DOUBLE LOGICAL OR\n" +
+	"2A55 TWO INTERSECTING LOGICAL AND\n" +
+	"2A56 TWO INTERSECTING LOGICAL OR\n" +
+	"2A57 SLOPING LARGE OR\n" +
+	"2A58 SLOPING LARGE AND\n" +
+	"2A59 LOGICAL OR OVERLAPPING LOGICAL AND\n" +
+	"2A5A LOGICAL AND WITH MIDDLE STEM\n" +
+	"2A5B LOGICAL OR WITH MIDDLE STEM\n" +
+	"2A5C LOGICAL AND WITH HORIZONTAL DASH\n" +
+	"2A5D LOGICAL OR WITH HORIZONTAL DASH\n" +
+	"2A5E LOGICAL AND WITH DOUBLE OVERBAR\n" +
+	"2A5F LOGICAL AND WITH UNDERBAR\n" +
+	"2A60 LOGICAL AND WITH DOUBLE UNDERBAR\n" +
+	"2A61 SMALL VEE WITH UNDERBAR\n" +
+	"2A62 LOGICAL OR WITH DOUBLE OVERBAR\n" +
+	"2A63 LOGICAL OR WITH DOUBLE UNDERBAR\n" +
+	"2A64 Z NOTATION DOMAIN ANTIRESTRICTION\n" +
+	"2A65 Z NOTATION RANGE ANTIRESTRICTION\n" +
+	"2A66 EQUALS SIGN WITH DOT BELOW\n" +
+	"2A67 IDENTICAL WITH DOT ABOVE\n" +
+	"2A68 TRIPLE HORIZONTAL BAR WITH DOUBLE VERTICAL STROKE\n" +
+	"2A69 TRIPLE HORIZONTAL BAR WITH TRIPLE VERTICAL STROKE\n" +
+	"2A6A TILDE OPERATOR WITH DOT ABOVE\n" +
+	"2A6B TILDE OPERATOR WITH RISING DOTS\n" +
+	"2A6C SIMILAR MINUS SIMILAR\n" +
+	"2A6D CONGRUENT WITH DOT ABOVE\n" +
+	"2A6E EQUALS WITH ASTERISK\n" +
+	"2A6F ALMOST EQUAL TO WITH CIRCUMFLEX ACCENT\n" +
+	"2A70 APPROXIMATELY EQUAL OR EQUAL TO\n" +
+	"2A71 EQUALS SIGN ABOVE PLUS SIGN\n" +
+	"2A72 PLUS SIGN ABOVE EQUALS SIGN\n" +
+	"2A73 EQUALS SIGN ABOVE TILDE OPERATOR\n" +
+	"2A74 DOUBLE COLON EQUAL\n" +
+	"2A75 TWO CONSECUTIVE EQUALS SIGNS\n" +
+	"2A76 THREE CONSECUTIVE EQUALS SIGNS\n" +
+	"2A77 EQUALS SIGN WITH TWO DOTS ABOVE AND TWO DOTS BELOW\n" +
+	"2A78 EQUIVALENT WITH FOUR DOTS ABOVE\n" +
+	"2A79 LESS-THAN WITH CIRCLE INSIDE\n" +
+	"2A7A GREATER-THAN WITH CIRCLE INSIDE\n" +
+	"2A7B LESS-THAN WITH QUESTION MARK ABOVE\n" +
+	"2A7C GREATER-THAN WITH QUESTION MARK ABOVE\n" +
+	"2A7D LESS-THAN OR SLANTED EQUAL TO\n" +
+	"2A7E GREATER-THAN OR SLANTED EQUAL TO\n" +
+	"2A7F LESS-THAN OR SLANTED EQUAL TO WITH DOT INSIDE\n" +
+	"2A80 GREATER-THAN OR SLANTED EQUAL TO WITH DOT INSIDE\n" +
+	"2A81 LESS-THAN OR SLANTED EQUAL TO WITH DOT ABOVE\n" +
+	"2A82 GREATER-THAN OR SLANTED EQUAL TO WITH DOT ABOVE\n" +
+	"2A83 LESS-THAN OR SLANTED EQUAL TO WITH DOT ABOVE RIGHT\n" +
+	"2A84 GREATER-THAN OR SLANTED EQUAL TO WITH DOT ABOVE LEFT\n" +
+	"2A85 LESS-THAN OR APPROXIMATE\n" +
+	"2A86 GREATER-THAN OR APPROXIMATE\n" +
+	"2A87 LESS-THAN AND SINGLE-LINE NOT EQUAL TO\n" +
+	"2A88 GREATER-THAN AND SINGLE-LINE NOT EQUAL TO\n" +
+	"2A89 LESS-THAN AND NOT APPROXIMATE\n" +
+	"2A8A GREATER-THAN AND NOT APPROXIMATE\n" +
+	"2A8B LESS-THAN ABOVE DOUBLE-LINE EQUAL ABOVE GREATER-THAN\n" +
+	"2A8C GREATER-THAN ABOVE DOUBLE-LINE EQUAL ABOVE LESS-THAN\n" +
+	"2A8D LESS-THAN ABOVE SIMILAR OR EQUAL\n" +
+	"2A8E GREATER-THAN ABOVE SIMILAR OR EQUAL\n" +
+	"2A8F LESS-THAN ABOVE SIMILAR ABOVE GREATER-THAN\n" +
+	"2A90 GREATER-THAN ABOVE SIMILAR ABOVE LESS-THAN\n" +
+	"2A91 LESS-THAN ABOVE GREATER-THAN ABOVE DOUBLE-LINE EQUAL\n" +
+	"2A92 GREATER-THAN ABOVE LESS-THAN ABOVE DOUBLE-LINE EQUAL\n" +
+	"2A93 LESS-THAN ABOVE SLANTED EQUAL ABOVE GREATER-THAN ABOVE SLANTED EQUAL\n" +
+	"2A94 GREATER-THAN ABOVE SLANTED EQUAL ABOVE LESS-THAN ABOVE SLANTED EQUAL\n" +
+	"2A95 SLANTED EQUAL TO OR LESS-THAN\n" +
+	"2A96 SLANTED EQUAL TO OR GREATER-THAN\n" +
+	"2A97 SLANTED EQUAL TO OR LESS-THAN WITH DOT INSIDE\n" +
+	"2A98 SLANTED EQUAL TO OR GREATER-THAN WITH DOT INSIDE\n" +
+	"2A99 DOUBLE-LINE EQUAL TO OR LESS-THAN\n" +
+	"2A9A DOUBLE-LINE EQUAL TO OR GREATER-THAN\n" +
+	"2A9B DOUBLE-LINE SLANTED EQUAL TO OR LESS-THAN\n" +
+	"2A9C DOUBLE-LINE SLANTED EQUAL TO OR GREATER-THAN\n" +
+	"2A9D SIMILAR OR LESS-THAN\n" +
+	"2A9E SIMILAR OR GREATER-THAN\n" +
+	"2A9F SIMILAR ABOVE LESS-THAN ABOVE EQUALS SIGN\n" +
+	"2AA0 SIMILAR ABOVE GREATER-THAN ABOVE EQUALS SIGN\n" +
+	"2AA1 DOUBLE NESTED LESS-THAN\n" +
+	"2AA2 DOUBLE NESTED GREATER-THAN\n" +
+	"2AA3 DOUBLE NESTED LESS-THAN WITH UNDERBAR\n" +
+	"2AA4 GREATER-THAN OVERLAPPING LESS-THAN\n" +
+	"2AA5 GREATER-THAN BESIDE LESS-THAN\n" +
+	"2AA6 LESS-THAN CLOSED BY CURVE\n" +
+	"2AA7 GREATER-THAN CLOSED BY CURVE\n" +
+	"2AA8 LESS-THAN CLOSED BY CURVE ABOVE SLANTED EQUAL\n" +
+	"2AA9 GREATER-THAN CLOSED BY CURVE ABOVE SLANTED EQUAL\n" +
+	"2AAA SMALLER THAN\n" +
+	"2AAB LARGER THAN\n" +
+	"2AAC SMALLER THAN OR EQUAL TO\n" +
+	"2AAD LARGER THAN OR EQUAL TO\n" +
+	"2AAE EQUALS SIGN WITH BUMPY ABOVE\n" +
+	"2AAF PRECEDES ABOVE SINGLE-LINE EQUALS SIGN\n" +
+	"2AB0 SUCCEEDS ABOVE SINGLE-LINE EQUALS SIGN\n" +
+	"2AB1 PRECEDES ABOVE SINGLE-LINE NOT EQUAL TO\n" +
+	"2AB2 SUCCEEDS ABOVE SINGLE-LINE NOT EQUAL TO\n" +
+	"2AB3 PRECEDES ABOVE EQUALS SIGN\n" +
+	"2AB4 SUCCEEDS ABOVE EQUALS SIGN\n" +
+	"2AB5 PRECEDES ABOVE NOT EQUAL TO\n" +
+	"2AB6 SUCCEEDS ABOVE NOT EQUAL TO\n" +
+	"2AB7 PRECEDES ABOVE ALMOST EQUAL TO\n" +
+	"2AB8 SUCCEEDS ABOVE ALMOST EQUAL TO\n" +
+	"2AB9 PRECEDES ABOVE NOT ALMOST EQUAL TO\n" +
+	"2ABA SUCCEEDS ABOVE NOT ALMOST EQUAL TO\n" +
+	"2ABB DOUBLE PRECEDES\n" +
+	"2ABC DOUBLE SUCCEEDS\n" +
+	"2ABD SUBSET WITH DOT\n" +
+	"2ABE SUPERSET WITH DOT\n" +
+	"2ABF SUBSET WITH PLUS SIGN BELOW\n" +
+	"2AC0 SUPERSET WITH PLUS SIGN BELOW\n" +
+	"2AC1 SUBSET WITH MULTIPLICATION SIGN BELOW\n" +
+	"2AC2 SUPERSET WITH MULTIPLICATION SIGN BELOW\n" +
+	"2AC3 SUBSET OF OR EQUAL TO WITH DOT ABOVE\n" +
+	"2AC4 SUPERSET OF OR EQUAL TO WITH DOT ABOVE\n" +
+	"2AC5 SUBSET OF ABOVE EQUALS SIGN\n" +
+	"2AC6 SUPERSET OF ABOVE EQUALS SIGN\n" +
+	"2AC7 SUBSET OF ABOVE TILDE OPERATOR\n" +
+	"2AC8 SUPERSET OF ABOVE TILDE OPERATOR\n" +
+	"2AC9 SUBSET OF ABOVE ALMOST EQUAL TO\n" +
+	"2ACA SUPERSET OF ABOVE ALMOST EQUAL TO\n" +
+	"2ACB SUBSET OF ABOVE NOT EQUAL TO\n" +
+	"2ACC SUPERSET OF ABOVE NOT EQUAL TO\n" +
+	"2ACD SQUARE LEFT OPEN BOX OPERATOR\n" +
+	"2ACE SQUARE RIGHT OPEN BOX OPERATOR\n" +
+	"2ACF CLOSED SUBSET\n" +
+	"2AD0 CLOSED SUPERSET\n" +
+	"2AD1 CLOSED SUBSET OR EQUAL TO\n" +
+	"2AD2 CLOSED SUPERSET OR EQUAL TO\n" +
+	"2AD3 SUBSET ABOVE SUPERSET\n" +
+	"2AD4 SUPERSET ABOVE SUBSET\n" +
+	"2AD5 SUBSET ABOVE SUBSET\n" +
+	"2AD6 SUPERSET ABOVE SUPERSET\n" +
+	"2AD7 SUPERSET BESIDE SUBSET\n" +
+	"2AD8 SUPERSET BESIDE AND JOINED BY DASH WITH SUBSET\n" +
+	"2AD9 ELEMENT OF OPENING DOWNWARDS\n" +
+	"2ADA PITCHFORK WITH TEE TOP\n" +
+	"2ADB TRANSVERSAL INTERSECTION\n" +
+	"2ADC FORKING\n" +
+	"2ADD NONFORKING\n" +
+	"2ADE SHORT LEFT TACK\n" +
+	"2ADF SHORT DOWN TACK\n" +
+	"2AE0 SHORT UP TACK\n" +
+	"2AE1 PERPENDICULAR WITH S\n" +
+	"2AE2 VERTICAL BAR TRIPLE RIGHT TURNSTILE\n" +
+	"2AE3 DOUBLE VERTICAL BAR LEFT TURNSTILE\n" +
+	"2AE4 VERTICAL BAR DOUBLE LEFT TURNSTILE\n" +
+	"2AE5 DOUBLE VERTICAL BAR DOUBLE LEFT TURNSTILE\n" +
+	"2AE6 LONG DASH FROM LEFT MEMBER OF DOUBLE VERTICAL\n" +
+	"2AE7 SHORT DOWN TACK WITH OVERBAR\n" +
+	"2AE8 SHORT UP TACK WITH UNDERBAR\n" +
+	"2AE9 SHORT UP TACK ABOVE SHORT DOWN TACK\n" +
+	"2AEA DOUBLE DOWN TACK\n" +
+	"2AEB DOUBLE UP TACK\n" +
+	"2AEC DOUBLE STROKE NOT SIGN\n" +
+	"2AED REVERSED DOUBLE STROKE NOT SIGN\n" +
+	"2AEE DOES NOT DIVIDE WITH REVERSED NEGATION SLASH\n" +
+	"2AEF VERTICAL LINE WITH CIRCLE ABOVE\n" +
+	"2AF0 VERTICAL LINE WITH CIRCLE BELOW\n" +
+	"2AF1 DOWN TACK WITH CIRCLE BELOW\n" +
+	"2AF2 PARALLEL WITH HORIZONTAL STROKE\n" +
+	"2AF3 PARALLEL WITH TILDE OPERATOR\n" +
+	"2AF4 TRIPLE VERTICAL BAR BINARY RELATION\n" +
+	"2AF5 TRIPLE VERTICAL BAR WITH HORIZONTAL STROKE\n" +
+	"2AF6 TRIPLE COLON OPERATOR\n" +
+	"2AF7 TRIPLE NESTED LESS-THAN\n" +
+	"2AF8 TRIPLE NESTED GREATER-THAN\n" +
+	"2AF9 DOUBLE-LINE SLANTED LESS-THAN OR EQUAL TO\n" +
+	"2AFA DOUBLE-LINE SLANTED GREATER-THAN OR EQUAL TO\n" +
+	"2AFB TRIPLE SOLIDUS BINARY RELATION\n" +
+	"2AFC LARGE TRIPLE VERTICAL BAR OPERATOR\n" +
+	"2AFD DOUBLE SOLIDUS OPERATOR\n" +
+	"2AFE WHITE VERTICAL BAR\n" +
+	"2AFF N-ARY WHITE VERTICAL BAR\n" +
+	"2B00 NORTH EAST WHITE ARROW\n" +
+	"2B01 NORTH WEST WHITE ARROW\n" +
+	"2B02 SOUTH EAST WHITE ARROW\n" +
+	"2B03 SOUTH WEST WHITE ARROW\n" +
+	"2B04 LEFT RIGHT WHITE ARROW\n" +
+	"2B05 LEFTWARDS BLACK ARROW\n" +
+	"2B06 UPWARDS BLACK ARROW\n" +
+	"2B07 DOWNWARDS BLACK ARROW\n" +
+	"2B08 NORTH EAST BLACK ARROW\n" +
+	"2B09 NORTH WEST BLACK ARROW\n" +
+	"2B0A SOUTH EAST BLACK ARROW\n" +
+	"2B0B SOUTH WEST BLACK ARROW\n" +
+	"2B0C LEFT RIGHT BLACK ARROW\n" +
+	"2B0D UP DOWN BLACK ARROW\n" +
+	"2B0E RIGHTWARDS ARROW WITH TIP DOWNWARDS\n" +
+	"2B0F RIGHTWARDS ARROW WITH TIP UPWARDS\n" +
+	"2B10 LEFTWARDS ARROW WITH TIP DOWNWARDS\n" +
+	"2B11 LEFTWARDS ARROW WITH TIP UPWARDS\n" +
+	"2B12 SQUARE WITH TOP HALF BLACK\n" +
+	"2B13 SQUARE WITH BOTTOM HALF BLACK\n" +
+	"2B14 SQUARE WITH UPPER RIGHT DIAGONAL HALF BLACK\n" +
+	"2B15 SQUARE WITH LOWER LEFT DIAGONAL HALF BLACK\n" +
+	"2B16 DIAMOND WITH LEFT HALF BLACK\n" +
+	"2B17 DIAMOND WITH RIGHT HALF BLACK\n" +
+	"2B18 DIAMOND WITH TOP HALF BLACK\n" +
+	"2B19 DIAMOND WITH BOTTOM HALF BLACK\n" +
+	"2B1A DOTTED SQUARE\n" +
+	"2B1B BLACK LARGE SQUARE\n" +
+	"2B1C WHITE LARGE SQUARE\n" +
+	"2B1D BLACK VERY SMALL SQUARE\n" +
+	"2B1E WHITE VERY SMALL SQUARE\n" +
+	"2B1F BLACK PENTAGON\n" +
+	"2B20 WHITE PENTAGON\n" +
+	"2B21 WHITE HEXAGON\n" +
+	"2B22 BLACK HEXAGON\n" +
+	"2B23 HORIZONTAL BLACK HEXAGON\n" +
+	"2B24 BLACK LARGE CIRCLE\n" +
+	"2B25 BLACK MEDIUM DIAMOND\n" +
+	"2B26 WHITE MEDIUM DIAMOND\n" +
+	"2B27 BLACK MEDIUM LOZENGE\n" +
+	"2B28 WHITE MEDIUM LOZENGE\n" +
+	"2B29 BLACK SMALL DIAMOND\n" +
+	"2B2A BLACK SMALL LOZENGE\n" +
+	"2B2B WHITE SMALL LOZENGE\n" +
+	"2B2C BLACK HORIZONTAL ELLIPSE\n" +
+	"2B2D WHITE HORIZONTAL ELLIPSE\n" +
+	"2B2E BLACK VERTICAL ELLIPSE\n" +
+	"2B2F WHITE VERTICAL ELLIPSE\n" +
+	"2B30 LEFT ARROW WITH SMALL CIRCLE\n" +
+	"2B31 THREE LEFTWARDS ARROWS\n" +
+	"2B32 LEFT ARROW WITH CIRCLED PLUS\n" +
+	"2B33 LONG LEFTWARDS SQUIGGLE ARROW\n" +
+	"2B34 LEFTWARDS TWO-HEADED ARROW WITH VERTICAL STROKE\n" +
+	"2B35 LEFTWARDS TWO-HEADED ARROW WITH DOUBLE VERTICAL STROKE\n" +
+	"2B36 LEFTWARDS TWO-HEADED ARROW FROM BAR\n" +
+	"2B37 LEFTWARDS TWO-HEADED TRIPLE DASH ARROW\n" +
+	"2B38 LEFTWARDS ARROW WITH DOTTED STEM\n" +
+	"2B39 LEFTWARDS ARROW WITH TAIL WITH VERTICAL STROKE\n" +
+	"2B3A LEFTWARDS ARROW WITH TAIL WITH DOUBLE VERTICAL STROKE\n" +
+	"2B3B LEFTWARDS TWO-HEADED ARROW WITH TAIL\n" +
+	"2B3C LEFTWARDS TWO-HEADED ARROW WITH TAIL WITH VERTICAL STROKE\n" +
+	"2B3D LEFTWARDS TWO-HEADED ARROW WITH TAIL WITH DOUBLE VERTICAL STROKE\n" +
+	"2B3E LEFTWARDS ARROW THROUGH X\n" +
+	"2B3F WAVE ARROW POINTING DIRECTLY LEFT\n" +
+	"2B40 EQUALS SIGN ABOVE LEFTWARDS ARROW\n" +
+	"2B41 REVERSE TILDE OPERATOR ABOVE LEFTWARDS ARROW\n" +
+	"2B42 LEFTWARDS ARROW ABOVE REVERSE ALMOST EQUAL TO\n" +
+	"2B43 RIGHTWARDS ARROW THROUGH GREATER-THAN\n" +
+	"2B44 RIGHTWARDS ARROW THROUGH SUPERSET\n" +
+	"2B45 LEFTWARDS QUADRUPLE ARROW\n" +
+	"2B46 RIGHTWARDS QUADRUPLE ARROW\n" +
+	"2B47 REVERSE TILDE OPERATOR ABOVE RIGHTWARDS ARROW\n" +
+	"2B48 RIGHTWARDS ARROW ABOVE REVERSE ALMOST EQUAL TO\n" +
+	"2B49 TILDE OPERATOR ABOVE LEFTWARDS ARROW\n" +
+	"2B4A LEFTWARDS ARROW ABOVE ALMOST EQUAL TO\n" +
+	"2B4B LEFTWARDS ARROW ABOVE REVERSE TILDE OPERATOR\n" +
+	"2B4C RIGHTWARDS ARROW ABOVE REVERSE TILDE OPERATOR\n" +
+	"2B4D DOWNWARDS TRIANGLE-HEADED ZIGZAG ARROW\n" +
+	"2B4E SHORT SLANTED NORTH ARROW\n" +
+	"2B4F SHORT BACKSLANTED SOUTH ARROW\n" +
+	"2B50 WHITE MEDIUM STAR\n" +
+	"2B51 BLACK SMALL STAR\n" +
+	"2B52 WHITE SMALL STAR\n" +
+	"2B53 BLACK RIGHT-POINTING PENTAGON\n" +
+	"2B54 WHITE RIGHT-POINTING PENTAGON\n" +
+	"2B55 HEAVY LARGE CIRCLE\n" +
+	"2B56 HEAVY OVAL WITH OVAL INSIDE\n" +
+	"2B57 HEAVY CIRCLE WITH CIRCLE INSIDE\n" +
+	"2B58 HEAVY CIRCLE\n" +
+	"2B59 HEAVY CIRCLED SALTIRE\n" +
+	"2B5A SLANTED NORTH ARROW WITH HOOKED HEAD\n" +
+	"2B5B BACKSLANTED SOUTH ARROW WITH HOOKED TAIL\n" +
+	"2B5C SLANTED NORTH ARROW WITH HORIZONTAL TAIL\n" +
+	"2B5D BACKSLANTED SOUTH ARROW WITH HORIZONTAL TAIL\n" +
+	"2B5E BENT ARROW POINTING DOWNWARDS THEN NORTH EAST\n" +
+	"2B5F SHORT BENT ARROW POINTING DOWNWARDS THEN NORTH EAST\n" +
+	"2B60 LEFTWARDS TRIANGLE-HEADED ARROW\n" +
+	"2B61 UPWARDS TRIANGLE-HEADED ARROW\n" +
+	"2B62 RIGHTWARDS TRIANGLE-HEADED ARROW\n" +
+	"2B63 DOWNWARDS TRIANGLE-HEADED ARROW\n" +
+	"2B64 LEFT RIGHT TRIANGLE-HEADED ARROW\n" +
+	"2B65 UP DOWN TRIANGLE-HEADED ARROW\n" +
+	"2B66 NORTH WEST TRIANGLE-HEADED ARROW\n" +
+	"2B67 NORTH EAST TRIANGLE-HEADED ARROW\n" +
+	"2B68 SOUTH EAST TRIANGLE-HEADED ARROW\n" +
+	"2B69 SOUTH WEST TRIANGLE-HEADED ARROW\n" +
+	"2B6A LEFTWARDS TRIANGLE-HEADED DASHED ARROW\n" +
+	"2B6B UPWARDS TRIANGLE-HEADED DASHED ARROW\n" +
+	"2B6C RIGHTWARDS TRIANGLE-HEADED DASHED ARROW\n" +
+	"2B6D DOWNWARDS TRIANGLE-HEADED DASHED ARROW\n" +
+	"2B6E CLOCKWISE TRIANGLE-HEADED OPEN CIRCLE ARROW\n" +
+	"2B6F ANTICLOCKWISE TRIANGLE-HEADED OPEN CIRCLE ARROW\n" +
+	"2B70 LEFTWARDS TRIANGLE-HEADED ARROW TO BAR\n" +
+	"2B71 UPWARDS TRIANGLE-HEADED ARROW TO BAR\n" +
+	"2B72 RIGHTWARDS TRIANGLE-HEADED ARROW TO BAR\n" +
+	"2B73 DOWNWARDS TRIANGLE-HEADED ARROW TO BAR\n" +
+	"2B76 NORTH WEST TRIANGLE-HEADED ARROW TO BAR\n" +
+	"2B77 NORTH EAST TRIANGLE-HEADED ARROW TO BAR\n" +
+	"2B78 SOUTH EAST TRIANGLE-HEADED ARROW TO BAR\n" +
+	"2B79 SOUTH WEST TRIANGLE-HEADED ARROW TO BAR\n" +
+	"2B7A LEFTWARDS TRIANGLE-HEADED ARROW WITH DOUBLE HORIZONTAL STROKE\n" +
+	"2B7B UPWARDS TRIANGLE-HEADED ARROW WITH DOUBLE HORIZONTAL STROKE\n" +
+	"2B7C RIGHTWARDS TRIANGLE-HEADED ARROW WITH DOUBLE HORIZONTAL STROKE\n" +
+	"2B7D DOWNWARDS TRIANGLE-HEADED ARROW WITH DOUBLE HORIZONTAL STROKE\n" +
+	"2B7E HORIZONTAL TAB KEY\n" +
+	"2B7F VERTICAL TAB KEY\n" +
+	"2B80 LEFTWARDS TRIANGLE-HEADED ARROW OVER RIGHTWARDS TRIANGLE-HEADED ARROW\n" +
+	"2B81 UPWARDS TRIANGLE-HEADED ARROW LEFTWARDS OF DOWNWARDS TRIANGLE-HEADED ARROW\n" +
+	"2B82 RIGHTWARDS TRIANGLE-HEADED ARROW OVER LEFTWARDS TRIANGLE-HEADED ARROW\n" +
+	"2B83 DOWNWARDS TRIANGLE-HEADED ARROW LEFTWARDS OF UPWARDS TRIANGLE-HEADED ARROW\n" +
+	"2B84 LEFTWARDS TRIANGLE-HEADED PAIRED ARROWS\n" +
+	"2B85 UPWARDS TRIANGLE-HEADED PAIRED ARROWS\n" +
+	"2B86 RIGHTWARDS TRIANGLE-HEADED PAIRED ARROWS\n" +
+	"2B87 DOWNWARDS TRIANGLE-HEADED PAIRED ARROWS\n" +
+	"2B88 LEFTWARDS BLACK CIRCLED WHITE ARROW\n" +
+	"2B89 UPWARDS BLACK CIRCLED WHITE ARROW\n" +
+	"2B8A RIGHTWARDS BLACK CIRCLED WHITE ARROW\n" +
+	"2B8B DOWNWARDS BLACK CIRCLED WHITE ARROW\n" +
+	"2B8C ANTICLOCKWISE TRIANGLE-HEADED RIGHT U-SHAPED ARROW\n" +
+	"2B8D ANTICLOCKWISE TRIANGLE-HEADED BOTTOM U-SHAPED ARROW\n" +
+	"2B8E ANTICLOCKWISE TRIANGLE-HEADED LEFT U-SHAPED ARROW\n" +
+	"2B8F ANTICLOCKWISE TRIANGLE-HEADED TOP U-SHAPED ARROW\n" +
+	"2B90 RETURN LEFT\n" +
+	"2B91 RETURN RIGHT\n" +
+	"2B92 NEWLINE LEFT\n" +
+	"2B93 NEWLINE RIGHT\n" +
+	"2B94 FOUR CORNER ARROWS CIRCLING ANTICLOCKWISE\n" +
+	"2B95 RIGHTWARDS BLACK ARROW\n" +
+	"2B97 SYMBOL FOR TYPE A ELECTRONICS\n" +
+	"2B98 THREE-D TOP-LIGHTED LEFTWARDS EQUILATERAL ARROWHEAD\n" +
+	"2B99 THREE-D RIGHT-LIGHTED UPWARDS EQUILATERAL ARROWHEAD\n" +
+	"2B9A THREE-D TOP-LIGHTED RIGHTWARDS EQUILATERAL ARROWHEAD\n" +
+	"2B9B THREE-D LEFT-LIGHTED DOWNWARDS EQUILATERAL ARROWHEAD\n" +
+	"2B9C BLACK LEFTWARDS EQUILATERAL ARROWHEAD\n" +
+	"2B9D BLACK UPWARDS EQUILATERAL ARROWHEAD\n" +
+	"2B9E BLACK RIGHTWARDS EQUILATERAL ARROWHEAD\n" +
+	"2B9F BLACK DOWNWARDS EQUILATERAL ARROWHEAD\n" +
+	"2BA0 DOWNWARDS TRIANGLE-HEADED ARROW WITH LONG TIP LEFTWARDS\n" +
+	"2BA1 DOWNWARDS TRIANGLE-HEADED ARROW WITH LONG TIP RIGHTWARDS\n" +
+	"2BA2 UPWARDS TRIANGLE-HEADED ARROW WITH LONG TIP LEFTWARDS\n" +
+	"2BA3 UPWARDS TRIANGLE-HEADED ARROW WITH LONG TIP RIGHTWARDS\n" +
+	"2BA4 LEFTWARDS TRIANGLE-HEADED ARROW WITH LONG TIP UPWARDS\n" +
+	"2BA5 RIGHTWARDS TRIANGLE-HEADED ARROW WITH LONG TIP UPWARDS\n" +
+	"2BA6 LEFTWARDS TRIANGLE-HEADED ARROW WITH LONG TIP DOWNWARDS\n" +
+	"2BA7 RIGHTWARDS TRIANGLE-HEADED ARROW WITH LONG TIP DOWNWARDS\n" +
+	"2BA8 BLACK CURVED DOWNWARDS AND LEFTWARDS ARROW\n" +
+	"2BA9 BLACK CURVED DOWNWARDS AND RIGHTWARDS ARROW\n" +
+	"2BAA BLACK CURVED UPWARDS AND LEFTWARDS ARROW\n" +
+	"2BAB BLACK CURVED UPWARDS AND RIGHTWARDS ARROW\n" +
+	"2BAC BLACK CURVED LEFTWARDS AND UPWARDS ARROW\n" +
+	"2BAD BLACK CURVED RIGHTWARDS AND UPWARDS ARROW\n" +
+	"2BAE BLACK CURVED LEFTWARDS AND DOWNWARDS ARROW\n" +
+	"2BAF BLACK CURVED RIGHTWARDS AND DOWNWARDS ARROW\n" +
+	"2BB0 RIBBON ARROW DOWN LEFT\n" +
+	"2BB1 RIBBON ARROW DOWN RIGHT\n" +
+	"2BB2 RIBBON ARROW UP LEFT\n" +
+	"2BB3 RIBBON ARROW UP RIGHT\n" +
+	"2BB4 RIBBON ARROW LEFT UP\n" +
+	"2BB5 RIBBON ARROW RIGHT UP\n" +
+	"2BB6 RIBBON ARROW LEFT DOWN\n" +
+	"2BB7 RIBBON ARROW RIGHT DOWN\n" +
+	"2BB8 UPWARDS WHITE ARROW FROM BAR WITH HORIZONTAL BAR\n" +
+	"2BB9 UP ARROWHEAD IN A RECTANGLE BOX\n" +
+	"2BBA OVERLAPPING WHITE SQUARES\n" +
+	"2BBB OVERLAPPING WHITE AND BLACK SQUARES\n" +
+	"2BBC OVERLAPPING BLACK SQUARES\n" +
+	"2BBD BALLOT BOX WITH LIGHT X\n" +
+	"2BBE CIRCLED X\n" +
+	"2BBF CIRCLED BOLD X\n" +
+	"2BC0 BLACK SQUARE CENTRED\n" +
+	"2BC1 BLACK DIAMOND CENTRED\n" +
+	"2BC2 TURNED BLACK PENTAGON\n" +
+	"2BC3 HORIZONTAL BLACK OCTAGON\n" +
+	"2BC4 BLACK OCTAGON\n" +
+	"2BC5 BLACK MEDIUM UP-POINTING TRIANGLE CENTRED\n" +
+	"2BC6 BLACK MEDIUM DOWN-POINTING TRIANGLE CENTRED\n" +
+	"2BC7 BLACK MEDIUM LEFT-POINTING TRIANGLE CENTRED\n" +
+	"2BC8 BLACK MEDIUM RIGHT-POINTING TRIANGLE CENTRED\n" +
+	"2BC9 NEPTUNE FORM TWO\n" +
+	"2BCA TOP HALF BLACK CIRCLE\n" +
+	"2BCB BOTTOM HALF BLACK CIRCLE\n" +
+	"2BCC LIGHT FOUR POINTED BLACK CUSP\n" +
+	"2BCD ROTATED LIGHT FOUR POINTED BLACK CUSP\n" +
+	"2BCE WHITE FOUR POINTED CUSP\n" +
+	"2BCF ROTATED WHITE FOUR POINTED CUSP\n" +
+	"2BD0 SQUARE POSITION INDICATOR\n" +
+	"2BD1 UNCERTAINTY SIGN\n" +
+	"2BD2 GROUP MARK\n" +
+	"2BD3 PLUTO FORM TWO\n" +
+	"2BD4 PLUTO FORM THREE\n" +
+	"2BD5 PLUTO FORM FOUR\n" +
+	"2BD6 PLUTO FORM FIVE\n" +
+	"2BD7 TRANSPLUTO\n" +
+	"2BD8 PROSERPINA\n" +
+	"2BD9 ASTRAEA\n" +
+	"2BDA HYGIEA\n" +
+	"2BDB PHOLUS\n" +
+	"2BDC NESSUS\n" +
+	"2BDD WHITE MOON SELENA\n" +
+	"2BDE BLACK DIAMOND ON CROSS\n" +
+	"2BDF TRUE LIGHT MOON ARTA\n" +
+	"2BE0 CUPIDO\n" +
+	"2BE1 HADES\n" +
+	"2BE2 ZEUS\n" +
+	"2BE3 KRONOS\n" +
+	"2BE4 APOLLON\n" +
+	"2BE5 ADMETOS\n" +
+	"2BE6 VULCANUS\n" +
+	"2BE7 POSEIDON\n" +
+	"2BE8 LEFT HALF BLACK STAR\n" +
+	"2BE9 RIGHT HALF BLACK STAR\n" +
+	"2BEA STAR WITH LEFT HALF BLACK\n" +
+	"2BEB STAR WITH RIGHT HALF BLACK\n" +
+	"2BEC LEFTWARDS TWO-HEADED ARROW WITH TRIANGLE ARROWHEADS\n" +
+	"2BED UPWARDS TWO-HEADED ARROW WITH TRIANGLE ARROWHEADS\n" +
+	"2BEE RIGHTWARDS TWO-HEADED ARROW WITH TRIANGLE ARROWHEADS\n" +
+	"2BEF DOWNWARDS TWO-HEADED ARROW WITH TRIANGLE ARROWHEADS\n" +
+	"2BF0 ERIS FORM ONE\n" +
+	"2BF1 ERIS FORM TWO\n" +
+	"2BF2 SEDNA\n" +
+	"2BF3 RUSSIAN ASTROLOGICAL SYMBOL VIGINTILE\n" +
+	"2BF4 RUSSIAN ASTROLOGICAL SYMBOL NOVILE\n" +
+	"2BF5 RUSSIAN ASTROLOGICAL SYMBOL QUINTILE\n" +
+	"2BF6 RUSSIAN ASTROLOGICAL SYMBOL BINOVILE\n" +
+	"2BF7 RUSSIAN ASTROLOGICAL SYMBOL SENTAGON\n" +
+	"2BF8 RUSSIAN ASTROLOGICAL SYMBOL TREDECILE\n" +
+	"2BF9 EQUALS SIGN WITH INFINITY BELOW\n" +
+	"2BFA UNITED SYMBOL\n" +
+	"2BFB SEPARATED SYMBOL\n" +
+	"2BFC DOUBLED SYMBOL\n" +
+	"2BFD PASSED SYMBOL\n" +
+	"2BFE REVERSED RIGHT ANGLE\n" +
+	"2BFF HELLSCHREIBER PAUSE SYMBOL\n" +
+	"3000 IDEOGRAPHIC SPACE\n" +
+	"3001 IDEOGRAPHIC COMMA\n" +
+	"3002 IDEOGRAPHIC FULL STOP\n" +
+	"3003 DITTO MARK\n" +
+	"3004 JAPANESE INDUSTRIAL STANDARD SYMBOL\n" +
+	"3005 IDEOGRAPHIC ITERATION MARK\n" +
+	"3006 IDEOGRAPHIC CLOSING MARK\n" +
+	"3007 IDEOGRAPHIC NUMBER ZERO\n" +
+	"3008 LEFT ANGLE BRACKET\n" +
+	"3009 RIGHT ANGLE BRACKET\n" +
+	"300A LEFT DOUBLE ANGLE BRACKET\n" +
+	"300B RIGHT DOUBLE ANGLE BRACKET\n" +
+	"300C LEFT CORNER BRACKET\n" +
+	"300D RIGHT CORNER BRACKET\n" +
+	"300E LEFT WHITE CORNER BRACKET\n" +
+	"300F RIGHT WHITE CORNER BRACKET\n" +
+	"3010 LEFT BLACK LENTICULAR BRACKET\n" +
+	"3011 RIGHT BLACK LENTICULAR BRACKET\n" +
+	"3012 POSTAL MARK\n" +
+	"3013 GETA MARK\n" +
+	"3014 LEFT TORTOISE SHELL BRACKET\n" +
+	"3015 RIGHT TORTOISE SHELL BRACKET\n" +
+	"3016 LEFT WHITE LENTICULAR BRACKET\n" +
+	"3017 RIGHT WHITE LENTICULAR BRACKET\n" +
+	"3018 LEFT WHITE TORTOISE SHELL BRACKET\n" +
+	"3019 RIGHT WHITE TORTOISE SHELL BRACKET\n" +
+	"301A LEFT WHITE SQUARE BRACKET\n" +
+	"301B RIGHT WHITE SQUARE BRACKET\n" +
+	"301C WAVE DASH\n" +
+	"301D REVERSED DOUBLE PRIME QUOTATION MARK\n" +
+	"301E DOUBLE PRIME QUOTATION MARK\n" +
+	"301F LOW DOUBLE PRIME QUOTATION MARK\n" +
+	"3020 POSTAL MARK FACE\n" +
+	"3021 HANGZHOU NUMERAL ONE\n" +
+	"3022 HANGZHOU NUMERAL TWO\n" +
+	"3023 HANGZHOU NUMERAL THREE\n" +
+	"3024 HANGZHOU NUMERAL FOUR\n" +
+	"3025 HANGZHOU NUMERAL FIVE\n" +
+	"3026 HANGZHOU NUMERAL SIX\n" +
+	"3027 HANGZHOU NUMERAL SEVEN\n" +
+	"3028 HANGZHOU NUMERAL EIGHT\n" +
+	"3029 HANGZHOU NUMERAL NINE\n" +
+	"302A IDEOGRAPHIC LEVEL TONE MARK\n" +
+	"302B IDEOGRAPHIC RISING TONE MARK\n" +
+	"302C IDEOGRAPHIC DEPARTING TONE MARK\n" +
+	"302D IDEOGRAPHIC ENTERING TONE MARK\n" +
+	"302E HANGUL SINGLE DOT TONE MARK\n" +
+	"302F HANGUL DOUBLE DOT TONE MARK\n" +
+	"3030 WAVY DASH\n" +
+	"3031 VERTICAL KANA REPEAT MARK\n" +
+	"3032 VERTICAL KANA REPEAT WITH VOICED SOUND MARK\n" +
+	"3033 VERTICAL KANA REPEAT MARK UPPER HALF\n" +
+	"3034 VERTICAL KANA REPEAT WITH VOICED SOUND MARK UPPER HALF\n" +
+	"3035 VERTICAL KANA REPEAT MARK LOWER HALF\n" +
+	"3036 CIRCLED POSTAL MARK\n" +
+	"3037 IDEOGRAPHIC TELEGRAPH LINE FEED SEPARATOR SYMBOL\n" +
+	"3038 HANGZHOU NUMERAL TEN\n" +
+	"3039 HANGZHOU NUMERAL TWENTY\n" +
+	"303A HANGZHOU NUMERAL THIRTY\n" +
+	"303B VERTICAL IDEOGRAPHIC ITERATION MARK\n" +
+	"303C MASU MARK\n" +
+	"303D PART ALTERNATION MARK\n" +
+	"303E IDEOGRAPHIC VARIATION INDICATOR\n" +
+	"303F IDEOGRAPHIC HALF FILL SPACE\n" +
+	"FE00 VARIATION SELECTOR-1\n" +
+	"FE01 VARIATION SELECTOR-2\n" +
+	"FE02 VARIATION SELECTOR-3\n" +
+	"FE03 VARIATION SELECTOR-4\n" +
+	"FE04 VARIATION SELECTOR-5\n" +
+	"FE05 VARIATION SELECTOR-6\n" +
+	"FE06 VARIATION SELECTOR-7\n" +
+	"FE07 VARIATION SELECTOR-8\n" +
+	"FE08 VARIATION SELECTOR-9\n" +
+	"FE09 VARIATION SELECTOR-10\n" +
+	"FE0A VARIATION SELECTOR-11\n" +
+	"FE0B VARIATION SELECTOR-12\n" +
+	"FE0C VARIATION SELECTOR-13\n" +
+	"FE0D VARIATION SELECTOR-14\n" +
+	"FE0E VARIATION SELECTOR-15\n" +
+	"FE0F VARIATION SELECTOR-16\n" +
+	"FEFF ZERO WIDTH NO-BREAK SPACE\n" +
+	"FFF9 INTERLINEAR ANNOTATION ANCHOR\n" +
+	"FFFA INTERLINEAR ANNOTATION SEPARATOR\n" +
+	"FFFB INTERLINEAR ANNOTATION TERMINATOR\n" +
+	"FFFC OBJECT REPLACEMENT CHARACTER\n" +
+	"FFFD REPLACEMENT CHARACTER\n" +
+	"1F300 CYCLONE\n" +
+	"1F301 FOGGY\n" +
+	"1F302 CLOSED UMBRELLA\n" +
+	"1F303 NIGHT WITH STARS\n" +
+	"1F304 SUNRISE OVER MOUNTAINS\n" +
+	"1F305 SUNRISE\n" +
+	"1F306 CITYSCAPE AT DUSK\n" +
+	"1F307 SUNSET OVER BUILDINGS\n" +
+	"1F308 RAINBOW\n" +
+	"1F309 BRIDGE AT NIGHT\n" +
+	"1F30A WATER WAVE\n" +
+	"1F30B VOLCANO\n" +
+	"1F30C MILKY WAY\n" +
+	"1F30D EARTH GLOBE EUROPE-AFRICA\n" +
+	"1F30E EARTH GLOBE AMERICAS\n" +
+	"1F30F EARTH GLOBE ASIA-AUSTRALIA\n" +
+	"1F310 GLOBE WITH MERIDIANS\n" +
+	"1F311 NEW MOON SYMBOL\n" +
+	"1F312 WAXING CRESCENT MOON SYMBOL\n" +
+	"1F313 FIRST QUARTER MOON SYMBOL\n" +
+	"1F314 WAXING GIBBOUS MOON SYMBOL\n" +
+	"1F315 FULL MOON SYMBOL\n" +
+	"1F316 WANING GIBBOUS MOON SYMBOL\n" +
+	"1F317 LAST QUARTER MOON SYMBOL\n" +
+	"1F318 WANING CRESCENT MOON SYMBOL\n" +
+	"1F319 CRESCENT MOON\n" +
+	"1F31A NEW MOON WITH FACE\n" +
+	"1F31B FIRST QUARTER MOON WITH FACE\n" +
+	"1F31C LAST QUARTER MOON WITH FACE\n" +
+	"1F31D FULL MOON WITH FACE\n" +
+	"1F31E SUN WITH FACE\n" +
+	"1F31F GLOWING STAR\n" +
+	"1F320 SHOOTING STAR\n" +
+	"1F321 THERMOMETER\n" +
+	"1F322 BLACK DROPLET\n" +
+	"1F323 WHITE SUN\n" +
+	"1F324 WHITE SUN WITH SMALL CLOUD\n" +
+	"1F325 WHITE SUN BEHIND CLOUD\n" +
+	"1F326 WHITE SUN BEHIND CLOUD WITH RAIN\n" +
+	"1F327 CLOUD WITH RAIN\n" +
+	"1F328 CLOUD WITH SNOW\n" +
+	"1F329 CLOUD WITH LIGHTNING\n" +
+	"1F32A CLOUD WITH TORNADO\n" +
+	"1F32B FOG\n" +
+	"1F32C WIND BLOWING FACE\n" +
+	"1F32D HOT DOG\n" +
+	"1F32E TACO\n" +
+	"1F32F BURRITO\n" +
+	"1F330 CHESTNUT\n" +
+	"1F331 SEEDLING\n" +
+	"1F332 EVERGREEN TREE\n" +
+	"1F333 DECIDUOUS TREE\n" +
+	"1F334 PALM TREE\n" +
+	"1F335 CACTUS\n" +
+	"1F336 HOT PEPPER\n" +
+	"1F337 TULIP\n" +
+	"1F338 CHERRY BLOSSOM\n" +
+	"1F339 ROSE\n" +
+	"1F33A HIBISCUS\n" +
+	"1F33B SUNFLOWER\n" +
+	"1F33C BLOSSOM\n" +
+	"1F33D EAR OF MAIZE\n" +
+	"1F33E EAR OF RICE\n" +
+	"1F33F HERB\n" +
+	"1F340 FOUR LEAF CLOVER\n" +
+	"1F341 MAPLE LEAF\n" +
+	"1F342 FALLEN LEAF\n" +
+	"1F343 LEAF FLUTTERING IN WIND\n" +
+	"1F344 MUSHROOM\n" +
+	"1F345 TOMATO\n" +
+	"1F346 AUBERGINE\n" +
+	"1F347 GRAPES\n" +
+	"1F348 MELON\n" +
+	"1F349 WATERMELON\n" +
+	"1F34A TANGERINE\n" +
+	"1F34B LEMON\n" +
+	"1F34C BANANA\n" +
+	"1F34D PINEAPPLE\n" +
+	"1F34E RED APPLE\n" +
+	"1F34F GREEN APPLE\n" +
+	"1F350 PEAR\n" +
+	"1F351 PEACH\n" +
+	"1F352 CHERRIES\n" +
+	"1F353 STRAWBERRY\n" +
+	"1F354 HAMBURGER\n" +
+	"1F355 SLICE OF PIZZA\n" +
+	"1F356 MEAT ON BONE\n" +
+	"1F357 POULTRY LEG\n" +
+	"1F358 RICE CRACKER\n" +
+	"1F359 RICE BALL\n" +
+	"1F35A COOKED RICE\n" +
+	"1F35B CURRY AND RICE\n" +
+	"1F35C STEAMING BOWL\n" +
+	"1F35D SPAGHETTI\n" +
+	"1F35E BREAD\n" +
+	"1F35F FRENCH FRIES\n" +
+	"1F360 ROASTED SWEET POTATO\n" +
+	"1F361 DANGO\n" +
+	"1F362 ODEN\n" +
+	"1F363 SUSHI\n" +
+	"1F364 FRIED SHRIMP\n" +
+	"1F365 FISH CAKE WITH SWIRL DESIGN\n" +
+	"1F366 SOFT ICE CREAM\n" +
+	"1F367 SHAVED ICE\n" +
+	"1F368 ICE CREAM\n" +
+	"1F369 DOUGHNUT\n" +
+	"1F36A COOKIE\n" +
+	"1F36B CHOCOLATE BAR\n" +
+	"1F36C CANDY\n" +
+	"1F36D LOLLIPOP\n" +
+	"1F36E CUSTARD\n" +
+	"1F36F HONEY POT\n" +
+	"1F370 SHORTCAKE\n" +
+	"1F371 BENTO BOX\n" +
+	"1F372 POT OF FOOD\n" +
+	"1F373 COOKING\n" +
+	"1F374 FORK AND KNIFE\n" +
+	"1F375 TEACUP WITHOUT HANDLE\n" +
+	"1F376 SAKE BOTTLE AND CUP\n" +
+	"1F377 WINE GLASS\n" +
+	"1F378 COCKTAIL GLASS\n" +
+	"1F379 TROPICAL DRINK\n" +
+	"1F37A BEER MUG\n" +
+	"1F37B CLINKING BEER MUGS\n" +
+	"1F37C BABY BOTTLE\n" +
+	"1F37D FORK AND KNIFE WITH PLATE\n" +
+	"1F37E BOTTLE WITH POPPING CORK\n" +
+	"1F37F POPCORN\n" +
+	"1F380 RIBBON\n" +
+	"1F381 WRAPPED PRESENT\n" +
+	"1F382 BIRTHDAY CAKE\n" +
+	"1F383 JACK-O-LANTERN\n" +
+	"1F384 CHRISTMAS TREE\n" +
+	"1F385 FATHER CHRISTMAS\n" +
+	"1F386 FIREWORKS\n" +
+	"1F387 FIREWORK SPARKLER\n" +
+	"1F388 BALLOON\n" +
+	"1F389 PARTY POPPER\n" +
+	"1F38A CONFETTI BALL\n" +
+	"1F38B TANABATA TREE\n" +
+	"1F38C CROSSED FLAGS\n" +
+	"1F38D PINE DECORATION\n" +
+	"1F38E JAPANESE DOLLS\n" +
+	"1F38F CARP STREAMER\n" +
+	"1F390 WIND CHIME\n" +
+	"1F391 MOON VIEWING CEREMONY\n" +
+	"1F392 SCHOOL SATCHEL\n" +
+	"1F393 GRADUATION CAP\n" +
+	"1F394 HEART WITH TIP ON THE LEFT\n" +
+	"1F395 BOUQUET OF FLOWERS\n" +
+	"1F396 MILITARY MEDAL\n" +
+	"1F397 REMINDER RIBBON\n" +
+	"1F398 MUSICAL KEYBOARD WITH JACKS\n" +
+	"1F399 STUDIO MICROPHONE\n" +
+	"1F39A LEVEL SLIDER\n" +
+	"1F39B CONTROL KNOBS\n" +
+	"1F39C BEAMED ASCENDING MUSICAL NOTES\n" +
+	"1F39D BEAMED DESCENDING MUSICAL NOTES\n" +
+	"1F39E FILM FRAMES\n" +
+	"1F39F ADMISSION TICKETS\n" +
+	"1F3A0 CAROUSEL HORSE\n" +
+	"1F3A1 FERRIS WHEEL\n" +
+	"1F3A2 ROLLER COASTER\n" +
+	"1F3A3 FISHING POLE AND FISH\n" +
+	"1F3A4 MICROPHONE\n" +
+	"1F3A5 MOVIE CAMERA\n" +
+	"1F3A6 CINEMA\n" +
+	"1F3A7 HEADPHONE\n" +
+	"1F3A8 ARTIST PALETTE\n" +
+	"1F3A9 TOP HAT\n" +
+	"1F3AA CIRCUS TENT\n" +
+	"1F3AB TICKET\n" +
+	"1F3AC CLAPPER BOARD\n" +
+	"1F3AD PERFORMING ARTS\n" +
+	"1F3AE VIDEO GAME\n" +
+	"1F3AF DIRECT HIT\n" +
+	"1F3B0 SLOT MACHINE\n" +
+	"1F3B1 BILLIARDS\n" +
+	"1F3B2 GAME DIE\n" +
+	"1F3B3 BOWLING\n" +
+	"1F3B4 FLOWER PLAYING CARDS\n" +
+	"1F3B5 MUSICAL NOTE\n" +
+	"1F3B6 MULTIPLE MUSICAL NOTES\n" +
+	"1F3B7 SAXOPHONE\n" +
+	"1F3B8 GUITAR\n" +
+	"1F3B9 MUSICAL KEYBOARD\n" +
+	"1F3BA TRUMPET\n" +
+	"1F3BB VIOLIN\n" +
+	"1F3BC MUSICAL SCORE\n" +
+	"1F3BD RUNNING SHIRT WITH SASH\n" +
+	"1F3BE TENNIS RACQUET AND BALL\n" +
+	"1F3BF SKI AND SKI BOOT\n" +
+	"1F3C0 BASKETBALL AND HOOP\n" +
+	"1F3C1 CHEQUERED FLAG\n" +
+	"1F3C2 SNOWBOARDER\n" +
+	"1F3C3 RUNNER\n" +
+	"1F3C4 SURFER\n" +
+	"1F3C5 SPORTS MEDAL\n" +
+	"1F3C6 TROPHY\n" +
+	"1F3C7 HORSE RACING\n" +
+	"1F3C8 AMERICAN FOOTBALL\n" +
+	"1F3C9 RUGBY FOOTBALL\n" +
+	"1F3CA SWIMMER\n" +
+	"1F3CB WEIGHT LIFTER\n" +
+	"1F3CC GOLFER\n" +
+	"1F3CD RACING MOTORCYCLE\n" +
+	"1F3CE RACING CAR\n" +
+	"1F3CF CRICKET BAT AND BALL\n" +
+	"1F3D0 VOLLEYBALL\n" +
+	"1F3D1 FIELD HOCKEY STICK AND BALL\n" +
+	"1F3D2 ICE HOCKEY STICK AND PUCK\n" +
+	"1F3D3 TABLE TENNIS PADDLE AND BALL\n" +
+	"1F3D4 SNOW CAPPED MOUNTAIN\n" +
+	"1F3D5 CAMPING\n" +
+	"1F3D6 BEACH WITH UMBRELLA\n" +
+	"1F3D7 BUILDING CONSTRUCTION\n" +
+	"1F3D8 HOUSE BUILDINGS\n" +
+	"1F3D9 CITYSCAPE\n" +
+	"1F3DA DERELICT HOUSE BUILDING\n" +
+	"1F3DB CLASSICAL BUILDING\n" +
+	"1F3DC DESERT\n" +
+	"1F3DD DESERT ISLAND\n" +
+	"1F3DE NATIONAL PARK\n" +
+	"1F3DF STADIUM\n" +
+	"1F3E0 HOUSE BUILDING\n" +
+	"1F3E1 HOUSE WITH GARDEN\n" +
+	"1F3E2 OFFICE BUILDING\n" +
+	"1F3E3 JAPANESE POST OFFICE\n" +
+	"1F3E4 EUROPEAN POST OFFICE\n" +
+	"1F3E5 HOSPITAL\n" +
+	"1F3E6 BANK\n" +
+	"1F3E7 AUTOMATED TELLER MACHINE\n" +
+	"1F3E8 HOTEL\n" +
+	"1F3E9 LOVE HOTEL\n" +
+	"1F3EA CONVENIENCE STORE\n" +
+	"1F3EB SCHOOL\n" +
+	"1F3EC DEPARTMENT STORE\n" +
+	"1F3ED FACTORY\n" +
+	"1F3EE IZAKAYA LANTERN\n" +
+	"1F3EF JAPANESE CASTLE\n" +
+	"1F3F0 EUROPEAN CASTLE\n" +
+	"1F3F1 WHITE PENNANT\n" +
+	"1F3F2 BLACK PENNANT\n" +
+	"1F3F3 WAVING WHITE FLAG\n" +
+	"1F3F4 WAVING BLACK FLAG\n" +
+	"1F3F5 ROSETTE\n" +
+	"1F3F6 BLACK ROSETTE\n" +
+	"1F3F7 LABEL\n" +
+	"1F3F8 BADMINTON RACQUET AND SHUTTLECOCK\n" +
+	"1F3F9 BOW AND ARROW\n" +
+	"1F3FA AMPHORA\n" +
+	"1F3FB EMOJI MODIFIER FITZPATRICK TYPE-1-2\n" +
+	"1F3FC EMOJI MODIFIER FITZPATRICK TYPE-3\n" +
+	"1F3FD EMOJI MODIFIER FITZPATRICK TYPE-4\n" +
+	"1F3FE EMOJI MODIFIER FITZPATRICK TYPE-5\n" +
+	"1F3FF EMOJI MODIFIER FITZPATRICK TYPE-6\n" +
+	"1F400 RAT\n" +
+	"1F401 MOUSE\n" +
+	"1F402 OX\n" +
+	"1F403 WATER BUFFALO\n" +
+	"1F404 COW\n" +
+	"1F405 TIGER\n" +
+	"1F406 LEOPARD\n" +
+	"1F407 RABBIT\n" +
+	"1F408 CAT\n" +
+	"1F409 DRAGON\n" +
+	"1F40A CROCODILE\n" +
+	"1F40B WHALE\n" +
+	"1F40C SNAIL\n" +
+	"1F40D SNAKE\n" +
+	"1F40E HORSE\n" +
+	"1F40F RAM\n" +
+	"1F410 GOAT\n" +
+	"1F411 SHEEP\n" +
+	"1F412 MONKEY\n" +
+	"1F413 ROOSTER\n" +
+	"1F414 CHICKEN\n" +
+	"1F415 DOG\n" +
+	"1F416 PIG\n" +
+	"1F417 BOAR\n" +
+	"1F418 ELEPHANT\n" +
+	"1F419 OCTOPUS\n" +
+	"1F41A SPIRAL SHELL\n" +
+	"1F41B BUG\n" +
+	"1F41C ANT\n" +
+	"1F41D HONEYBEE\n" +
+	"1F41E LADY BEETLE\n" +
+	"1F41F FISH\n" +
+	"1F420 TROPICAL FISH\n" +
+	"1F421 BLOWFISH\n" +
+	"1F422 TURTLE\n" +
+	"1F423 HATCHING CHICK\n" +
+	"1F424 BABY CHICK\n" +
+	"1F425 FRONT-FACING BABY CHICK\n" +
+	"1F426 BIRD\n" +
+	"1F427 PENGUIN\n" +
+	"1F428 KOALA\n" +
+	"1F429 POODLE\n" +
+	"1F42A DROMEDARY CAMEL\n" +
+	"1F42B BACTRIAN CAMEL\n" +
+	"1F42C DOLPHIN\n" +
+	"1F42D MOUSE FACE\n" +
+	"1F42E COW FACE\n" +
+	"1F42F TIGER FACE\n" +
+	"1F430 RABBIT FACE\n" +
+	"1F431 CAT FACE\n" +
+	"1F432 DRAGON FACE\n" +
+	"1F433 SPOUTING WHALE\n" +
+	"1F434 HORSE FACE\n" +
+	"1F435 MONKEY FACE\n" +
+	"1F436 DOG FACE\n" +
+	"1F437 PIG FACE\n" +
+	"1F438 FROG FACE\n" +
+	"1F439 HAMSTER FACE\n" +
+	"1F43A WOLF FACE\n" +
+	"1F43B BEAR FACE\n" +
+	"1F43C PANDA FACE\n" +
+	"1F43D PIG NOSE\n" +
+	"1F43E PAW PRINTS\n" +
+	"1F43F CHIPMUNK\n" +
+	"1F440 EYES\n" +
+	"1F441 EYE\n" +
+	"1F442 EAR\n" +
+	"1F443 NOSE\n" +
+	"1F444 MOUTH\n" +
+	"1F445 TONGUE\n" +
+	"1F446 WHITE UP POINTING BACKHAND INDEX\n" +
+	"1F447 WHITE DOWN POINTING BACKHAND INDEX\n" +
+	"1F448 WHITE LEFT POINTING BACKHAND INDEX\n" +
+	"1F449 WHITE RIGHT POINTING BACKHAND INDEX\n" +
+	"1F44A FISTED HAND SIGN\n" +
+	"1F44B WAVING HAND SIGN\n" +
+	"1F44C OK HAND SIGN\n" +
+	"1F44D THUMBS UP SIGN\n" +
+	"1F44E THUMBS DOWN SIGN\n" +
+	"1F44F CLAPPING HANDS SIGN\n" +
+	"1F450 OPEN HANDS SIGN\n" +
+	"1F451 CROWN\n" +
+	"1F452 WOMANS HAT\n" +
+	"1F453 EYEGLASSES\n" +
+	"1F454 NECKTIE\n" +
+	"1F455 T-SHIRT\n" +
+	"1F456 JEANS\n" +
+	"1F457 DRESS\n" +
+	"1F458 KIMONO\n" +
+	"1F459 BIKINI\n" +
+	"1F45A WOMANS CLOTHES\n" +
+	"1F45B PURSE\n" +
+	"1F45C HANDBAG\n" +
+	"1F45D POUCH\n" +
+	"1F45E MANS SHOE\n" +
+	"1F45F ATHLETIC SHOE\n" +
+	"1F460 HIGH-HEELED SHOE\n" +
+	"1F461 WOMANS SANDAL\n" +
+	"1F462 WOMANS BOOTS\n" +
+	"1F463 FOOTPRINTS\n" +
+	"1F464 BUST IN SILHOUETTE\n" +
+	"1F465 BUSTS IN SILHOUETTE\n" +
+	"1F466 BOY\n" +
+	"1F467 GIRL\n" +
+	"1F468 MAN\n" +
+	"1F469 WOMAN\n" +
+	"1F46A FAMILY\n" +
+	"1F46B MAN AND WOMAN HOLDING HANDS\n" +
+	"1F46C TWO MEN HOLDING HANDS\n" +
+	"1F46D TWO WOMEN HOLDING HANDS\n" +
+	"1F46E POLICE OFFICER\n" +
+	"1F46F WOMAN WITH BUNNY EARS\n" +
+	"1F470 BRIDE WITH VEIL\n" +
+	"1F471 PERSON WITH BLOND HAIR\n" +
+	"1F472 MAN WITH GUA PI MAO\n" +
+	"1F473 MAN WITH TURBAN\n" +
+	"1F474 OLDER MAN\n" +
+	"1F475 OLDER WOMAN\n" +
+	"1F476 BABY\n" +
+	"1F477 CONSTRUCTION WORKER\n" +
+	"1F478 PRINCESS\n" +
+	"1F479 JAPANESE OGRE\n" +
+	"1F47A JAPANESE GOBLIN\n" +
+	"1F47B GHOST\n" +
+	"1F47C BABY ANGEL\n" +
+	"1F47D EXTRATERRESTRIAL ALIEN\n" +
+	"1F47E ALIEN MONSTER\n" +
+	"1F47F IMP\n" +
+	"1F480 SKULL\n" +
+	"1F481 INFORMATION DESK PERSON\n" +
+	"1F482 GUARDSMAN\n" +
+	"1F483 DANCER\n" +
+	"1F484 LIPSTICK\n" +
+	"1F485 NAIL POLISH\n" +
+	"1F486 FACE MASSAGE\n" +
+	"1F487 HAIRCUT\n" +
+	"1F488 BARBER POLE\n" +
+	"1F489 SYRINGE\n" +
+	"1F48A PILL\n" +
+	"1F48B KISS MARK\n" +
+	"1F48C LOVE LETTER\n" +
+	"1F48D RING\n" +
+	"1F48E GEM STONE\n" +
+	"1F48F KISS\n" +
+	"1F490 BOUQUET\n" +
+	"1F491 COUPLE WITH HEART\n" +
+	"1F492 WEDDING\n" +
+	"1F493 BEATING HEART\n" +
+	"1F494 BROKEN HEART\n" +
+	"1F495 TWO HEARTS\n" +
+	"1F496 SPARKLING HEART\n" +
+	"1F497 GROWING HEART\n" +
+	"1F498 HEART WITH ARROW\n" +
+	"1F499 BLUE HEART\n" +
+	"1F49A GREEN HEART\n" +
+	"1F49B YELLOW HEART\n" +
+	"1F49C PURPLE HEART\n" +
+	"1F49D HEART WITH RIBBON\n" +
+	"1F49E REVOLVING HEARTS\n" +
+	"1F49F HEART DECORATION\n" +
+	"1F4A0 DIAMOND SHAPE WITH A DOT INSIDE\n" +
+	"1F4A1 ELECTRIC LIGHT BULB\n" +
+	"1F4A2 ANGER SYMBOL\n" +
+	"1F4A3 BOMB\n" +
+	"1F4A4 SLEEPING SYMBOL\n" +
+	"1F4A5 COLLISION SYMBOL\n" +
+	"1F4A6 SPLASHING SWEAT SYMBOL\n" +
+	"1F4A7 DROPLET\n" +
+	"1F4A8 DASH SYMBOL\n" +
+	"1F4A9 PILE OF POO\n" +
+	"1F4AA FLEXED BICEPS\n" +
+	"1F4AB DIZZY SYMBOL\n" +
+	"1F4AC SPEECH BALLOON\n" +
+	"1F4AD THOUGHT BALLOON\n" +
+	"1F4AE WHITE FLOWER\n" +
+	"1F4AF HUNDRED POINTS SYMBOL\n" +
+	"1F4B0 MONEY BAG\n" +
+	"1F4B1 CURRENCY EXCHANGE\n" +
+	"1F4B2 HEAVY DOLLAR SIGN\n" +
+	"1F4B3 CREDIT CARD\n" +
+	"1F4B4 BANKNOTE WITH YEN SIGN\n" +
+	"1F4B5 BANKNOTE WITH DOLLAR SIGN\n" +
+	"1F4B6 BANKNOTE WITH EURO SIGN\n" +
+	"1F4B7 BANKNOTE WITH POUND SIGN\n" +
+	"1F4B8 MONEY WITH WINGS\n" +
+	"1F4B9 CHART WITH UPWARDS TREND AND YEN SIGN\n" +
+	"1F4BA SEAT\n" +
+	"1F4BB PERSONAL COMPUTER\n" +
+	"1F4BC BRIEFCASE\n" +
+	"1F4BD MINIDISC\n" +
+	"1F4BE FLOPPY DISK\n" +
+	"1F4BF OPTICAL DISC\n" +
+	"1F4C0 DVD\n" +
+	"1F4C1 FILE FOLDER\n" +
+	"1F4C2 OPEN FILE FOLDER\n" +
+	"1F4C3 PAGE WITH CURL\n" +
+	"1F4C4 PAGE FACING UP\n" +
+	"1F4C5 CALENDAR\n" +
+	"1F4C6 TEAR-OFF CALENDAR\n" +
+	"1F4C7 CARD INDEX\n" +
+	"1F4C8 CHART WITH UPWARDS TREND\n" +
+	"1F4C9 CHART WITH DOWNWARDS TREND\n" +
+	"1F4CA BAR CHART\n" +
+	"1F4CB CLIPBOARD\n" +
+	"1F4CC PUSHPIN\n" +
+	"1F4CD ROUND PUSHPIN\n" +
+	"1F4CE PAPERCLIP\n" +
+	"1F4CF STRAIGHT RULER\n" +
+	"1F4D0 TRIANGULAR RULER\n" +
+	"1F4D1 BOOKMARK TABS\n" +
+	"1F4D2 LEDGER\n" +
+	"1F4D3 NOTEBOOK\n" +
+	"1F4D4 NOTEBOOK WITH DECORATIVE COVER\n" +
+	"1F4D5 CLOSED BOOK\n" +
+	"1F4D6 OPEN BOOK\n" +
+	"1F4D7 GREEN BOOK\n" +
+	"1F4D8 BLUE BOOK\n" +
+	"1F4D9 ORANGE BOOK\n" +
+	"1F4DA BOOKS\n" +
+	"1F4DB NAME BADGE\n" +
+	"1F4DC SCROLL\n" +
+	"1F4DD MEMO\n" +
+	"1F4DE TELEPHONE RECEIVER\n" +
+	"1F4DF PAGER\n" +
+	"1F4E0 FAX MACHINE\n" +
+	"1F4E1 SATELLITE ANTENNA\n" +
+	"1F4E2 PUBLIC ADDRESS LOUDSPEAKER\n" +
+	"1F4E3 CHEERING MEGAPHONE\n" +
+	"1F4E4 OUTBOX TRAY\n" +
+	"1F4E5 INBOX TRAY\n" +
+	"1F4E6 PACKAGE\n" +
+	"1F4E7 E-MAIL SYMBOL\n" +
+	"1F4E8 INCOMING ENVELOPE\n" +
+	"1F4E9 ENVELOPE WITH DOWNWARDS ARROW ABOVE\n" +
+	"1F4EA CLOSED MAILBOX WITH LOWERED FLAG\n" +
+	"1F4EB CLOSED MAILBOX WITH RAISED FLAG\n" +
+	"1F4EC OPEN MAILBOX WITH RAISED FLAG\n" +
+	"1F4ED OPEN MAILBOX WITH LOWERED FLAG\n" +
+	"1F4EE POSTBOX\n" +
+	"1F4EF POSTAL HORN\n" +
+	"1F4F0 NEWSPAPER\n" +
+	"1F4F1 MOBILE PHONE\n" +
+	"1F4F2 MOBILE PHONE WITH RIGHTWARDS ARROW AT LEFT\n" +
+	"1F4F3 VIBRATION MODE\n" +
+	"1F4F4 MOBILE PHONE OFF\n" +
+	"1F4F5 NO MOBILE PHONES\n" +
+	"1F4F6 ANTENNA WITH BARS\n" +
+	"1F4F7 CAMERA\n" +
+	"1F4F8 CAMERA WITH FLASH\n" +
+	"1F4F9 VIDEO CAMERA\n" +
+	"1F4FA TELEVISION\n" +
+	"1F4FB RADIO\n" +
+	"1F4FC VIDEOCASSETTE\n" +
+	"1F4FD FILM PROJECTOR\n" +
+	"1F4FE PORTABLE STEREO\n" +
+	"1F4FF PRAYER BEADS\n" +
+	"1F500 TWISTED RIGHTWARDS ARROWS\n" +
+	"1F501 CLOCKWISE RIGHTWARDS AND LEFTWARDS OPEN CIRCLE ARROWS\n" +
+	"1F502 CLOCKWISE RIGHTWARDS AND LEFTWARDS OPEN CIRCLE ARROWS WITH CIRCLED ONE OVERLAY\n" +
+	"1F503 CLOCKWISE DOWNWARDS AND UPWARDS OPEN CIRCLE ARROWS\n" +
+	"1F504 ANTICLOCKWISE DOWNWARDS AND UPWARDS OPEN CIRCLE ARROWS\n" +
+	"1F505 LOW BRIGHTNESS SYMBOL\n" +
+	"1F506 HIGH BRIGHTNESS SYMBOL\n" +
+	"1F507 SPEAKER WITH CANCELLATION STROKE\n" +
+	"1F508 SPEAKER\n" +
+	"1F509 SPEAKER WITH ONE SOUND WAVE\n" +
+	"1F50A SPEAKER WITH THREE SOUND WAVES\n" +
+	"1F50B BATTERY\n" +
+	"1F50C ELECTRIC PLUG\n" +
+	"1F50D LEFT-POINTING MAGNIFYING GLASS\n" +
+	"1F50E RIGHT-POINTING MAGNIFYING GLASS\n" +
+	"1F50F LOCK WITH INK PEN\n" +
+	"1F510 CLOSED LOCK WITH KEY\n" +
+	"1F511 KEY\n" +
+	"1F512 LOCK\n" +
+	"1F513 OPEN LOCK\n" +
+	"1F514 BELL\n" +
+	"1F515 BELL WITH CANCELLATION STROKE\n" +
+	"1F516 BOOKMARK\n" +
+	"1F517 LINK SYMBOL\n" +
+	"1F518 RADIO BUTTON\n" +
+	"1F519 BACK WITH LEFTWARDS ARROW ABOVE\n" +
+	"1F51A END WITH LEFTWARDS ARROW ABOVE\n" +
+	"1F51B ON WITH EXCLAMATION MARK WITH LEFT RIGHT ARROW ABOVE\n" +
+	"1F51C SOON WITH RIGHTWARDS ARROW ABOVE\n" +
+	"1F51D TOP WITH UPWARDS ARROW ABOVE\n" +
+	"1F51E NO ONE UNDER EIGHTEEN SYMBOL\n" +
+	"1F51F KEYCAP TEN\n" +
+	"1F520 INPUT SYMBOL FOR LATIN CAPITAL LETTERS\n" +
+	"1F521 INPUT SYMBOL FOR LATIN SMALL LETTERS\n" +
+	"1F522 INPUT SYMBOL FOR NUMBERS\n" +
+	"1F523 INPUT SYMBOL FOR SYMBOLS\n" +
+	"1F524 INPUT SYMBOL FOR LATIN LETTERS\n" +
+	"1F525 FIRE\n" +
+	"1F526 ELECTRIC TORCH\n" +
+	"1F527 WRENCH\n" +
+	"1F528 HAMMER\n" +
+	"1F529 NUT AND BOLT\n" +
+	"1F52A HOCHO\n" +
+	"1F52B PISTOL\n" +
+	"1F52C MICROSCOPE\n" +
+	"1F52D TELESCOPE\n" +
+	"1F52E CRYSTAL BALL\n" +
+	"1F52F SIX POINTED STAR WITH MIDDLE DOT\n" +
+	"1F530 JAPANESE SYMBOL FOR BEGINNER\n" +
+	"1F531 TRIDENT EMBLEM\n" +
+	"1F532 BLACK SQUARE BUTTON\n" +
+	"1F533 WHITE SQUARE BUTTON\n" +
+	"1F534 LARGE RED CIRCLE\n" +
+	"1F535 LARGE BLUE CIRCLE\n" +
+	"1F536 LARGE ORANGE DIAMOND\n" +
+	"1F537 LARGE BLUE DIAMOND\n" +
+	"1F538 SMALL ORANGE DIAMOND\n" +
+	"1F539 SMALL BLUE DIAMOND\n" +
+	"1F53A UP-POINTING RED TRIANGLE\n" +
+	"1F53B DOWN-POINTING RED TRIANGLE\n" +
+	"1F53C UP-POINTING SMALL RED TRIANGLE\n" +
+	"1F53D DOWN-POINTING SMALL RED TRIANGLE\n" +
+	"1F53E LOWER RIGHT SHADOWED WHITE CIRCLE\n" +
+	"1F53F UPPER RIGHT SHADOWED WHITE CIRCLE\n" +
+	"1F540 CIRCLED CROSS POMMEE\n" +
+	"1F541 CROSS POMMEE WITH HALF-CIRCLE BELOW\n" +
+	"1F542 CROSS POMMEE\n" +
+	"1F543 NOTCHED LEFT SEMICIRCLE WITH THREE DOTS\n" +
+	"1F544 NOTCHED RIGHT SEMICIRCLE WITH THREE DOTS\n" +
+	"1F545 SYMBOL FOR MARKS CHAPTER\n" +
+	"1F546 WHITE LATIN CROSS\n" +
+	"1F547 HEAVY LATIN CROSS\n" +
+	"1F548 CELTIC CROSS\n" +
+	"1F549 OM SYMBOL\n" +
+	"1F54A DOVE OF PEACE\n" +
+	"1F54B KAABA\n" +
+	"1F54C MOSQUE\n" +
+	"1F54D SYNAGOGUE\n" +
+	"1F54E MENORAH WITH NINE BRANCHES\n" +
+	"1F54F BOWL OF HYGIEIA\n" +
+	"1F550 CLOCK FACE ONE OCLOCK\n" +
+	"1F551 CLOCK FACE TWO OCLOCK\n" +
+	"1F552 CLOCK FACE THREE OCLOCK\n" +
+	"1F553 CLOCK FACE FOUR OCLOCK\n" +
+	"1F554 CLOCK FACE FIVE OCLOCK\n" +
+	"1F555 CLOCK FACE SIX OCLOCK\n" +
+	"1F556 CLOCK FACE SEVEN OCLOCK\n" +
+	"1F557 CLOCK FACE EIGHT OCLOCK\n" +
+	"1F558 CLOCK FACE NINE OCLOCK\n" +
+	"1F559 CLOCK FACE TEN OCLOCK\n" +
+	"1F55A CLOCK FACE ELEVEN OCLOCK\n" +
+	"1F55B CLOCK FACE TWELVE OCLOCK\n" +
+	"1F55C CLOCK FACE ONE-THIRTY\n" +
+	"1F55D CLOCK FACE TWO-THIRTY\n" +
+	"1F55E CLOCK FACE THREE-THIRTY\n" +
+	"1F55F CLOCK FACE FOUR-THIRTY\n" +
+	"1F560 CLOCK FACE FIVE-THIRTY\n" +
+	"1F561 CLOCK FACE SIX-THIRTY\n" +
+	"1F562 CLOCK FACE SEVEN-THIRTY\n" +
+	"1F563 CLOCK FACE EIGHT-THIRTY\n" +
+	"1F564 CLOCK FACE NINE-THIRTY\n" +
+	"1F565 CLOCK FACE TEN-THIRTY\n" +
+	"1F566 CLOCK FACE ELEVEN-THIRTY\n" +
+	"1F567 CLOCK FACE TWELVE-THIRTY\n" +
+	"1F568 RIGHT SPEAKER\n" +
+	"1F569 RIGHT SPEAKER WITH ONE SOUND WAVE\n" +
+	"1F56A RIGHT SPEAKER WITH THREE SOUND WAVES\n" +
+	"1F56B BULLHORN\n" +
+	"1F56C BULLHORN WITH SOUND WAVES\n" +
+	"1F56D RINGING BELL\n" +
+	"1F56E BOOK\n" +
+	"1F56F CANDLE\n" +
+	"1F570 MANTELPIECE CLOCK\n" +
+	"1F571 BLACK SKULL AND CROSSBONES\n" +
+	"1F572 NO PIRACY\n" +
+	"1F573 HOLE\n" +
+	"1F574 MAN IN BUSINESS SUIT LEVITATING\n" +
+	"1F575 SLEUTH OR SPY\n" +
+	"1F576 DARK SUNGLASSES\n" +
+	"1F577 SPIDER\n" +
+	"1F578 SPIDER WEB\n" +
+	"1F579 JOYSTICK\n" +
+	"1F57A MAN DANCING\n" +
+	"1F57B LEFT HAND TELEPHONE RECEIVER\n" +
+	"1F57C TELEPHONE RECEIVER WITH PAGE\n" +
+	"1F57D RIGHT HAND TELEPHONE RECEIVER\n" +
+	"1F57E WHITE TOUCHTONE TELEPHONE\n" +
+	"1F57F BLACK TOUCHTONE TELEPHONE\n" +
+	"1F580 TELEPHONE ON TOP OF MODEM\n" +
+	"1F581 CLAMSHELL MOBILE PHONE\n" +
+	"1F582 BACK OF ENVELOPE\n" +
+	"1F583 STAMPED ENVELOPE\n" +
+	"1F584 ENVELOPE WITH LIGHTNING\n" +
+	"1F585 FLYING ENVELOPE\n" +
+	"1F586 PEN OVER STAMPED ENVELOPE\n" +
+	"1F587 LINKED PAPERCLIPS\n" +
+	"1F588 BLACK PUSHPIN\n" +
+	"1F589 LOWER LEFT PENCIL\n" +
+	"1F58A LOWER LEFT BALLPOINT PEN\n" +
+	"1F58B LOWER LEFT FOUNTAIN PEN\n" +
+	"1F58C LOWER LEFT PAINTBRUSH\n" +
+	"1F58D LOWER LEFT CRAYON\n" +
+	"1F58E LEFT WRITING HAND\n" +
+	"1F58F TURNED OK HAND SIGN\n" +
+	"1F590 RAISED HAND WITH FINGERS SPLAYED\n" +
+	"1F591 REVERSED RAISED HAND WITH FINGERS SPLAYED\n" +
+	"1F592 REVERSED THUMBS UP SIGN\n" +
+	"1F593 REVERSED THUMBS DOWN SIGN\n" +
+	"1F594 REVERSED VICTORY HAND\n" +
+	"1F595 REVERSED HAND WITH MIDDLE FINGER EXTENDED\n" +
+	"1F596 RAISED HAND WITH PART BETWEEN MIDDLE AND RING FINGERS\n" +
+	"1F597 WHITE DOWN POINTING LEFT HAND INDEX\n" +
+	"1F598 SIDEWAYS WHITE LEFT POINTING INDEX\n" +
+	"1F599 SIDEWAYS WHITE RIGHT POINTING INDEX\n" +
+	"1F59A SIDEWAYS BLACK LEFT POINTING INDEX\n" +
+	"1F59B SIDEWAYS BLACK RIGHT POINTING INDEX\n" +
+	"1F59C BLACK LEFT POINTING BACKHAND INDEX\n" +
+	"1F59D BLACK RIGHT POINTING BACKHAND INDEX\n" +
+	"1F59E SIDEWAYS WHITE UP POINTING INDEX\n" +
+	"1F59F SIDEWAYS WHITE DOWN POINTING INDEX\n" +
+	"1F5A0 SIDEWAYS BLACK UP POINTING INDEX\n" +
+	"1F5A1 SIDEWAYS BLACK DOWN POINTING INDEX\n" +
+	"1F5A2 BLACK UP POINTING BACKHAND INDEX\n" +
+	"1F5A3 BLACK DOWN POINTING BACKHAND INDEX\n" +
+	"1F5A4 BLACK HEART\n" +
+	"1F5A5 DESKTOP COMPUTER\n" +
+	"1F5A6 KEYBOARD AND MOUSE\n" +
+	"1F5A7 THREE NETWORKED COMPUTERS\n" +
+	"1F5A8 PRINTER\n" +
+	"1F5A9 POCKET CALCULATOR\n" +
+	"1F5AA BLACK HARD SHELL FLOPPY DISK\n" +
+	"1F5AB WHITE HARD SHELL FLOPPY DISK\n" +
+	"1F5AC SOFT SHELL FLOPPY DISK\n" +
+	"1F5AD TAPE CARTRIDGE\n" +
+	"1F5AE WIRED KEYBOARD\n" +
+	"1F5AF ONE BUTTON MOUSE\n" +
+	"1F5B0 TWO BUTTON MOUSE\n" +
+	"1F5B1 THREE BUTTON MOUSE\n" +
+	"1F5B2 TRACKBALL\n" +
+	"1F5B3 OLD PERSONAL COMPUTER\n" +
+	"1F5B4 HARD DISK\n" +
+	"1F5B5 SCREEN\n" +
+	"1F5B6 PRINTER ICON\n" +
+	"1F5B7 FAX ICON\n" +
+	"1F5B8 OPTICAL DISC ICON\n" +
+	"1F5B9 DOCUMENT WITH TEXT\n" +
+	"1F5BA DOCUMENT WITH TEXT AND PICTURE\n" +
+	"1F5BB DOCUMENT WITH PICTURE\n" +
+	"1F5BC FRAME WITH PICTURE\n" +
+	"1F5BD FRAME WITH TILES\n" +
+	"1F5BE FRAME WITH AN X\n" +
+	"1F5BF BLACK FOLDER\n" +
+	"1F5C0 FOLDER\n" +
+	"1F5C1 OPEN FOLDER\n" +
+	"1F5C2 CARD INDEX DIVIDERS\n" +
+	"1F5C3 CARD FILE BOX\n" +
+	"1F5C4 FILE CABINET\n" +
+	"1F5C5 EMPTY NOTE\n" +
+	"1F5C6 EMPTY NOTE PAGE\n" +
+	"1F5C7 EMPTY NOTE PAD\n" +
+	"1F5C8 NOTE\n" +
+	"1F5C9 NOTE PAGE\n" +
+	"1F5CA NOTE PAD\n" +
+	"1F5CB EMPTY DOCUMENT\n" +
+	"1F5CC EMPTY PAGE\n" +
+	"1F5CD EMPTY PAGES\n" +
+	"1F5CE DOCUMENT\n" +
+	"1F5CF PAGE\n" +
+	"1F5D0 PAGES\n" +
+	"1F5D1 WASTEBASKET\n" +
+	"1F5D2 SPIRAL NOTE PAD\n" +
+	"1F5D3 SPIRAL CALENDAR PAD\n" +
+	"1F5D4 DESKTOP WINDOW\n" +
+	"1F5D5 MINIMIZE\n" +
+	"1F5D6 MAXIMIZE\n" +
+	"1F5D7 OVERLAP\n" +
+	"1F5D8 CLOCKWISE RIGHT AND LEFT SEMICIRCLE ARROWS\n" +
+	"1F5D9 CANCELLATION X\n" +
+	"1F5DA INCREASE FONT SIZE SYMBOL\n" +
+	"1F5DB DECREASE FONT SIZE SYMBOL\n" +
+	"1F5DC COMPRESSION\n" +
+	"1F5DD OLD KEY\n" +
+	"1F5DE ROLLED-UP NEWSPAPER\n" +
+	"1F5DF PAGE WITH CIRCLED TEXT\n" +
+	"1F5E0 STOCK CHART\n" +
+	"1F5E1 DAGGER KNIFE\n" +
+	"1F5E2 LIPS\n" +
+	"1F5E3 SPEAKING HEAD IN SILHOUETTE\n" +
+	"1F5E4 THREE RAYS ABOVE\n" +
+	"1F5E5 THREE RAYS BELOW\n" +
+	"1F5E6 THREE RAYS LEFT\n" +
+	"1F5E7 THREE RAYS RIGHT\n" +
+	"1F5E8 LEFT SPEECH BUBBLE\n" +
+	"1F5E9 RIGHT SPEECH BUBBLE\n" +
+	"1F5EA TWO SPEECH BUBBLES\n" +
+	"1F5EB THREE SPEECH BUBBLES\n" +
+	"1F5EC LEFT THOUGHT BUBBLE\n" +
+	"1F5ED RIGHT THOUGHT BUBBLE\n" +
+	"1F5EE LEFT ANGER BUBBLE\n" +
+	"1F5EF RIGHT ANGER BUBBLE\n" +
+	"1F5F0 MOOD BUBBLE\n" +
+	"1F5F1 LIGHTNING MOOD BUBBLE\n" +
+	"1F5F2 LIGHTNING MOOD\n" +
+	"1F5F3 BALLOT BOX WITH BALLOT\n" +
+	"1F5F4 BALLOT SCRIPT X\n" +
+	"1F5F5 BALLOT BOX WITH SCRIPT X\n" +
+	"1F5F6 BALLOT BOLD SCRIPT X\n" +
+	"1F5F7 BALLOT BOX WITH BOLD SCRIPT X\n" +
+	"1F5F8 LIGHT CHECK MARK\n" +
+	"1F5F9 BALLOT BOX WITH BOLD CHECK\n" +
+	"1F5FA WORLD MAP\n" +
+	"1F5FB MOUNT FUJI\n" +
+	"1F5FC TOKYO TOWER\n" +
+	"1F5FD STATUE OF LIBERTY\n" +
+	"1F5FE SILHOUETTE OF JAPAN\n" +
+	"1F5FF MOYAI\n" +
+	"1F600 GRINNING FACE\n" +
+	"1F601 GRINNING FACE WITH SMILING EYES\n" +
+	"1F602 FACE WITH TEARS OF JOY\n" +
+	"1F603 SMILING FACE WITH OPEN MOUTH\n" +
+	"1F604 SMILING FACE WITH OPEN MOUTH AND SMILING EYES\n" +
+	"1F605 SMILING FACE WITH OPEN MOUTH AND COLD SWEAT\n" +
+	"1F606 SMILING FACE WITH OPEN MOUTH AND TIGHTLY-CLOSED EYES\n" +
+	"1F607 SMILING FACE WITH HALO\n" +
+	"1F608 SMILING FACE WITH HORNS\n" +
+	"1F609 WINKING FACE\n" +
+	"1F60A SMILING FACE WITH SMILING EYES\n" +
+	"1F60B FACE SAVOURING DELICIOUS FOOD\n" +
+	"1F60C RELIEVED FACE\n" +
+	"1F60D SMILING FACE WITH HEART-SHAPED EYES\n" +
+	"1F60E SMILING FACE WITH SUNGLASSES\n" +
+	"1F60F SMIRKING FACE\n" +
+	"1F610 NEUTRAL FACE\n" +
+	"1F611 EXPRESSIONLESS FACE\n" +
+	"1F612 UNAMUSED FACE\n" +
+	"1F613 FACE WITH COLD SWEAT\n" +
+	"1F614 PENSIVE FACE\n" +
+	"1F615 CONFUSED FACE\n" +
+	"1F616 CONFOUNDED FACE\n" +
+	"1F617 KISSING FACE\n" +
+	"1F618 FACE THROWING A KISS\n" +
+	"1F619 KISSING FACE WITH SMILING EYES\n" +
+	"1F61A KISSING FACE WITH CLOSED EYES\n" +
+	"1F61B FACE WITH STUCK-OUT TONGUE\n" +
+	"1F61C FACE WITH STUCK-OUT TONGUE AND WINKING EYE\n" +
+	"1F61D FACE WITH STUCK-OUT TONGUE AND TIGHTLY-CLOSED EYES\n" +
+	"1F61E DISAPPOINTED FACE\n" +
+	"1F61F WORRIED FACE\n" +
+	"1F620 ANGRY FACE\n" +
+	"1F621 POUTING FACE\n" +
+	"1F622 CRYING FACE\n" +
+	"1F623 PERSEVERING FACE\n" +
+	"1F624 FACE WITH LOOK OF TRIUMPH\n" +
+	"1F625 DISAPPOINTED BUT RELIEVED FACE\n" +
+	"1F626 FROWNING FACE WITH OPEN MOUTH\n" +
+	"1F627 ANGUISHED FACE\n" +
+	"1F628 FEARFUL FACE\n" +
+	"1F629 WEARY FACE\n" +
+	"1F62A SLEEPY FACE\n" +
+	"1F62B TIRED FACE\n" +
+	"1F62C GRIMACING FACE\n" +
+	"1F62D LOUDLY CRYING FACE\n" +
+	"1F62E FACE WITH OPEN MOUTH\n" +
+	"1F62F HUSHED FACE\n" +
+	"1F630 FACE WITH OPEN MOUTH AND COLD SWEAT\n" +
+	"1F631 FACE SCREAMING IN FEAR\n" +
+	"1F632 ASTONISHED FACE\n" +
+	"1F633 FLUSHED FACE\n" +
+	"1F634 SLEEPING FACE\n" +
+	"1F635 DIZZY FACE\n" +
+	"1F636 FACE WITHOUT MOUTH\n" +
+	"1F637 FACE WITH MEDICAL MASK\n" +
+	"1F638 GRINNING CAT FACE WITH SMILING EYES\n" +
+	"1F639 CAT FACE WITH TEARS OF JOY\n" +
+	"1F63A SMILING CAT FACE WITH OPEN MOUTH\n" +
+	"1F63B SMILING CAT FACE WITH HEART-SHAPED EYES\n" +
+	"1F63C CAT FACE WITH WRY SMILE\n" +
+	"1F63D KISSING CAT FACE WITH CLOSED EYES\n" +
+	"1F63E POUTING CAT FACE\n" +
+	"1F63F CRYING CAT FACE\n" +
+	"1F640 WEARY CAT FACE\n" +
+	"1F641 SLIGHTLY FROWNING FACE\n" +
+	"1F642 SLIGHTLY SMILING FACE\n" +
+	"1F643 UPSIDE-DOWN FACE\n" +
+	"1F644 FACE WITH ROLLING EYES\n" +
+	"1F645 FACE WITH NO GOOD GESTURE\n" +
+	"1F646 FACE WITH OK GESTURE\n" +
+	"1F647 PERSON BOWING DEEPLY\n" +
+	"1F648 SEE-NO-EVIL MONKEY\n" +
+	"1F649 HEAR-NO-EVIL MONKEY\n" +
+	"1F64A SPEAK-NO-EVIL MONKEY\n" +
+	"1F64B HAPPY PERSON RAISING ONE HAND\n" +
+	"1F64C PERSON RAISING BOTH HANDS IN CELEBRATION\n" +
+	"1F64D PERSON FROWNING\n" +
+	"1F64E PERSON WITH POUTING FACE\n" +
+	"1F64F PERSON WITH FOLDED HANDS\n" +
+	"1F680 ROCKET\n" +
+	"1F681 HELICOPTER\n" +
+	"1F682 STEAM LOCOMOTIVE\n" +
+	"1F683 RAILWAY CAR\n" +
+	"1F684 HIGH-SPEED TRAIN\n" +
+	"1F685 HIGH-SPEED TRAIN WITH BULLET NOSE\n" +
+	"1F686 TRAIN\n" +
+	"1F687 METRO\n" +
+	"1F688 LIGHT RAIL\n" +
+	"1F689 STATION\n" +
+	"1F68A TRAM\n" +
+	"1F68B TRAM CAR\n" +
+	"1F68C BUS\n" +
+	"1F68D ONCOMING BUS\n" +
+	"1F68E TROLLEYBUS\n" +
+	"1F68F BUS STOP\n" +
+	"1F690 MINIBUS\n" +
+	"1F691 AMBULANCE\n" +
+	"1F692 FIRE ENGINE\n" +
+	"1F693 POLICE CAR\n" +
+	"1F694 ONCOMING POLICE CAR\n" +
+	"1F695 TAXI\n" +
+	"1F696 ONCOMING TAXI\n" +
+	"1F697 AUTOMOBILE\n" +
+	"1F698 ONCOMING AUTOMOBILE\n" +
+	"1F699 RECREATIONAL VEHICLE\n" +
+	"1F69A DELIVERY TRUCK\n" +
+	"1F69B ARTICULATED LORRY\n" +
+	"1F69C TRACTOR\n" +
+	"1F69D MONORAIL\n" +
+	"1F69E MOUNTAIN RAILWAY\n" +
+	"1F69F SUSPENSION RAILWAY\n" +
+	"1F6A0 MOUNTAIN CABLEWAY\n" +
+	"1F6A1 AERIAL TRAMWAY\n" +
+	"1F6A2 SHIP\n" +
+	"1F6A3 ROWBOAT\n" +
+	"1F6A4 SPEEDBOAT\n" +
+	"1F6A5 HORIZONTAL TRAFFIC LIGHT\n" +
+	"1F6A6 VERTICAL TRAFFIC LIGHT\n" +
+	"1F6A7 CONSTRUCTION SIGN\n" +
+	"1F6A8 POLICE CARS REVOLVING LIGHT\n" +
+	"1F6A9 TRIANGULAR FLAG ON POST\n" +
+	"1F6AA DOOR\n" +
+	"1F6AB NO ENTRY SIGN\n" +
+	"1F6AC SMOKING SYMBOL\n" +
+	"1F6AD NO SMOKING SYMBOL\n" +
+	"1F6AE PUT LITTER IN ITS PLACE SYMBOL\n" +
+	"1F6AF DO NOT LITTER SYMBOL\n" +
+	"1F6B0 POTABLE WATER SYMBOL\n" +
+	"1F6B1 NON-POTABLE WATER SYMBOL\n" +
+	"1F6B2 BICYCLE\n" +
+	"1F6B3 NO BICYCLES\n" +
+	"1F6B4 BICYCLIST\n" +
+	"1F6B5 MOUNTAIN BICYCLIST\n" +
+	"1F6B6 PEDESTRIAN\n" +
+	"1F6B7 NO PEDESTRIANS\n" +
+	"1F6B8 CHILDREN CROSSING\n" +
+	"1F6B9 MENS SYMBOL\n" +
+	"1F6BA WOMENS SYMBOL\n" +
+	"1F6BB RESTROOM\n" +
+	"1F6BC BABY SYMBOL\n" +
+	"1F6BD TOILET\n" +
+	"1F6BE WATER CLOSET\n" +
+	"1F6BF SHOWER\n" +
+	"1F6C0 BATH\n" +
+	"1F6C1 BATHTUB\n" +
+	"1F6C2 PASSPORT CONTROL\n" +
+	"1F6C3 CUSTOMS\n" +
+	"1F6C4 BAGGAGE CLAIM\n" +
+	"1F6C5 LEFT LUGGAGE\n" +
+	"1F6C6 TRIANGLE WITH ROUNDED CORNERS\n" +
+	"1F6C7 PROHIBITED SIGN\n" +
+	"1F6C8 CIRCLED INFORMATION SOURCE\n" +
+	"1F6C9 BOYS SYMBOL\n" +
+	"1F6CA GIRLS SYMBOL\n" +
+	"1F6CB COUCH AND LAMP\n" +
+	"1F6CC SLEEPING ACCOMMODATION\n" +
+	"1F6CD SHOPPING BAGS\n" +
+	"1F6CE BELLHOP BELL\n" +
+	"1F6CF BED\n" +
+	"1F6D0 PLACE OF WORSHIP\n" +
+	"1F6D1 OCTAGONAL SIGN\n" +
+	"1F6D2 SHOPPING TROLLEY\n" +
+	"1F6D3 STUPA\n" +
+	"1F6D4 PAGODA\n" +
+	"1F6D5 HINDU TEMPLE\n" +
+	"1F6D6 HUT\n" +
+	"1F6D7 ELEVATOR\n" +
+	"1F6DD PLAYGROUND SLIDE\n" +
+	"1F6DE WHEEL\n" +
+	"1F6DF RING BUOY\n" +
+	"1F6E0 HAMMER AND WRENCH\n" +
+	"1F6E1 SHIELD\n" +
+	"1F6E2 OIL DRUM\n" +
+	"1F6E3 MOTORWAY\n" +
+	"1F6E4 RAILWAY TRACK\n" +
+	"1F6E5 MOTOR BOAT\n" +
+	"1F6E6 UP-POINTING MILITARY AIRPLANE\n" +
+	"1F6E7 UP-POINTING AIRPLANE\n" +
+	"1F6E8 UP-POINTING SMALL AIRPLANE\n" +
+	"1F6E9 SMALL AIRPLANE\n" +
+	"1F6EA NORTHEAST-POINTING AIRPLANE\n" +
+	"1F6EB AIRPLANE DEPARTURE\n" +
+	"1F6EC AIRPLANE ARRIVING\n" +
+	"1F6F0 SATELLITE\n" +
+	"1F6F1 ONCOMING FIRE ENGINE\n" +
+	"1F6F2 DIESEL LOCOMOTIVE\n" +
+	"1F6F3 PASSENGER SHIP\n" +
+	"1F6F4 SCOOTER\n" +
+	"1F6F5 MOTOR SCOOTER\n" +
+	"1F6F6 CANOE\n" +
+	"1F6F7 SLED\n" +
+	"1F6F8 FLYING SAUCER\n" +
+	"1F6F9 SKATEBOARD\n" +
+	"1F6FA AUTO RICKSHAW\n" +
+	"1F6FB PICKUP TRUCK\n" +
+	"1F6FC ROLLER SKATE\n" +
+	"1F900 CIRCLED CROSS FORMEE WITH FOUR DOTS\n" +
+	"1F901 CIRCLED CROSS FORMEE WITH TWO DOTS\n" +
+	"1F902 CIRCLED CROSS FORMEE\n" +
+	"1F903 LEFT HALF CIRCLE WITH FOUR DOTS\n" +
+	"1F904 LEFT HALF CIRCLE WITH THREE DOTS\n" +
+	"1F905 LEFT HALF CIRCLE WITH TWO DOTS\n" +
+	"1F906 LEFT HALF CIRCLE WITH DOT\n" +
+	"1F907 LEFT HALF CIRCLE\n" +
+	"1F908 DOWNWARD FACING HOOK\n" +
+	"1F909 DOWNWARD FACING NOTCHED HOOK\n" +
+	"1F90A DOWNWARD FACING HOOK WITH DOT\n" +
+	"1F90B DOWNWARD FACING NOTCHED HOOK WITH DOT\n" +
+	"1F90C PINCHED FINGERS\n" +
+	"1F90D WHITE HEART\n" +
+	"1F90E BROWN HEART\n" +
+	"1F90F PINCHING HAND\n" +
+	"1F910 ZIPPER-MOUTH FACE\n" +
+	"1F911 MONEY-MOUTH FACE\n" +
+	"1F912 FACE WITH THERMOMETER\n" +
+	"1F913 NERD FACE\n" +
+	"1F914 THINKING FACE\n" +
+	"1F915 FACE WITH HEAD-BANDAGE\n" +
+	"1F916 ROBOT FACE\n" +
+	"1F917 HUGGING FACE\n" +
+	"1F918 SIGN OF THE HORNS\n" +
+	"1F919 CALL ME HAND\n" +
+	"1F91A RAISED BACK OF HAND\n" +
+	"1F91B LEFT-FACING FIST\n" +
+	"1F91C RIGHT-FACING FIST\n" +
+	"1F91D HANDSHAKE\n" +
+	"1F91E HAND WITH INDEX AND MIDDLE FINGERS CROSSED\n" +
+	"1F91F I LOVE YOU HAND SIGN\n" +
+	"1F920 FACE WITH COWBOY HAT\n" +
+	"1F921 CLOWN FACE\n" +
+	"1F922 NAUSEATED FACE\n" +
+	"1F923 ROLLING ON THE FLOOR LAUGHING\n" +
+	"1F924 DROOLING FACE\n" +
+	"1F925 LYING FACE\n" +
+	"1F926 FACE PALM\n" +
+	"1F927 SNEEZING FACE\n" +
+	"1F928 FACE WITH ONE EYEBROW RAISED\n" +
+	"1F929 GRINNING FACE WITH STAR EYES\n" +
+	"1F92A GRINNING FACE WITH ONE LARGE AND ONE SMALL EYE\n" +
+	"1F92B FACE WITH FINGER COVERING CLOSED LIPS\n" +
+	"1F92C SERIOUS FACE WITH SYMBOLS COVERING MOUTH\n" +
+	"1F92D SMILING FACE WITH SMILING EYES AND HAND COVERING MOUTH\n" +
+	"1F92E FACE WITH OPEN MOUTH VOMITING\n" +
+	"1F92F SHOCKED FACE WITH EXPLODING HEAD\n" +
+	"1F930 PREGNANT WOMAN\n" +
+	"1F931 BREAST-FEEDING\n" +
+	"1F932 PALMS UP TOGETHER\n" +
+	"1F933 SELFIE\n" +
+	"1F934 PRINCE\n" +
+	"1F935 MAN IN TUXEDO\n" +
+	"1F936 MOTHER CHRISTMAS\n" +
+	"1F937 SHRUG\n" +
+	"1F938 PERSON DOING CARTWHEEL\n" +
+	"1F939 JUGGLING\n" +
+	"1F93A FENCER\n" +
+	"1F93B MODERN PENTATHLON\n" +
+	"1F93C WRESTLERS\n" +
+	"1F93D WATER POLO\n" +
+	"1F93E HANDBALL\n" +
+	"1F93F DIVING MASK\n" +
+	"1F940 WILTED FLOWER\n" +
+	"1F941 DRUM WITH DRUMSTICKS\n" +
+	"1F942 CLINKING GLASSES\n" +
+	"1F943 TUMBLER GLASS\n" +
+	"1F944 SPOON\n" +
+	"1F945 GOAL NET\n" +
+	"1F946 RIFLE\n" +
+	"1F947 FIRST PLACE MEDAL\n" +
+	"1F948 SECOND PLACE MEDAL\n" +
+	"1F949 THIRD PLACE MEDAL\n" +
+	"1F94A BOXING GLOVE\n" +
+	"1F94B MARTIAL ARTS UNIFORM\n" +
+	"1F94C CURLING STONE\n" +
+	"1F94D LACROSSE STICK AND BALL\n" +
+	"1F94E SOFTBALL\n" +
+	"1F94F FLYING DISC\n" +
+	"1F950 CROISSANT\n" +
+	"1F951 AVOCADO\n" +
+	"1F952 CUCUMBER\n" +
+	"1F953 BACON\n" +
+	"1F954 POTATO\n" +
+	"1F955 CARROT\n" +
+	"1F956 BAGUETTE BREAD\n" +
+	"1F957 GREEN SALAD\n" +
+	"1F958 SHALLOW PAN OF FOOD\n" +
+	"1F959 STUFFED FLATBREAD\n" +
+	"1F95A EGG\n" +
+	"1F95B GLASS OF MILK\n" +
+	"1F95C PEANUTS\n" +
+	"1F95D KIWIFRUIT\n" +
+	"1F95E PANCAKES\n" +
+	"1F95F DUMPLING\n" +
+	"1F960 FORTUNE COOKIE\n" +
+	"1F961 TAKEOUT BOX\n" +
+	"1F962 CHOPSTICKS\n" +
+	"1F963 BOWL WITH SPOON\n" +
+	"1F964 CUP WITH STRAW\n" +
+	"1F965 COCONUT\n" +
+	"1F966 BROCCOLI\n" +
+	"1F967 PIE\n" +
+	"1F968 PRETZEL\n" +
+	"1F969 CUT OF MEAT\n" +
+	"1F96A SANDWICH\n" +
+	"1F96B CANNED FOOD\n" +
+	"1F96C LEAFY GREEN\n" +
+	"1F96D MANGO\n" +
+	"1F96E MOON CAKE\n" +
+	"1F96F BAGEL\n" +
+	"1F970 SMILING FACE WITH SMILING EYES AND THREE HEARTS\n" +
+	"1F971 YAWNING FACE\n" +
+	"1F972 SMILING FACE WITH TEAR\n" +
+	"1F973 FACE WITH PARTY HORN AND PARTY HAT\n" +
+	"1F974 FACE WITH UNEVEN EYES AND WAVY MOUTH\n" +
+	"1F975 OVERHEATED FACE\n" +
+	"1F976 FREEZING FACE\n" +
+	"1F977 NINJA\n" +
+	"1F978 DISGUISED FACE\n" +
+	"1F979 FACE HOLDING BACK TEARS\n" +
+	"1F97A FACE WITH PLEADING EYES\n" +
+	"1F97B SARI\n" +
+	"1F97C LAB COAT\n" +
+	"1F97D GOGGLES\n" +
+	"1F97E HIKING BOOT\n" +
+	"1F97F FLAT SHOE\n" +
+	"1F980 CRAB\n" +
+	"1F981 LION FACE\n" +
+	"1F982 SCORPION\n" +
+	"1F983 TURKEY\n" +
+	"1F984 UNICORN FACE\n" +
+	"1F985 EAGLE\n" +
+	"1F986 DUCK\n" +
+	"1F987 BAT\n" +
+	"1F988 SHARK\n" +
+	"1F989 OWL\n" +
+	"1F98A FOX FACE\n" +
+	"1F98B BUTTERFLY\n" +
+	"1F98C DEER\n" +
+	"1F98D GORILLA\n" +
+	"1F98E LIZARD\n" +
+	"1F98F RHINOCEROS\n" +
+	"1F990 SHRIMP\n" +
+	"1F991 SQUID\n" +
+	"1F992 GIRAFFE FACE\n" +
+	"1F993 ZEBRA FACE\n" +
+	"1F994 HEDGEHOG\n" +
+	"1F995 SAUROPOD\n" +
+	"1F996 T-REX\n" +
+	"1F997 CRICKET\n" +
+	"1F998 KANGAROO\n" +
+	"1F999 LLAMA\n" +
+	"1F99A PEACOCK\n" +
+	"1F99B HIPPOPOTAMUS\n" +
+	"1F99C PARROT\n" +
+	"1F99D RACCOON\n" +
+	"1F99E LOBSTER\n" +
+	"1F99F MOSQUITO\n" +
+	"1F9A0 MICROBE\n" +
+	"1F9A1 BADGER\n" +
+	"1F9A2 SWAN\n" +
+	"1F9A3 MAMMOTH\n" +
+	"1F9A4 DODO\n" +
+	"1F9A5 SLOTH\n" +
+	"1F9A6 OTTER\n" +
+	"1F9A7 ORANGUTAN\n" +
+	"1F9A8 SKUNK\n" +
+	"1F9A9 FLAMINGO\n" +
+	"1F9AA OYSTER\n" +
+	"1F9AB BEAVER\n" +
+	"1F9AC BISON\n" +
+	"1F9AD SEAL\n" +
+	"1F9AE GUIDE DOG\n" +
+	"1F9AF PROBING CANE\n" +
+	"1F9B0 EMOJI COMPONENT RED HAIR\n" +
+	"1F9B1 EMOJI COMPONENT CURLY HAIR\n" +
+	"1F9B2 EMOJI COMPONENT BALD\n" +
+	"1F9B3 EMOJI COMPONENT WHITE HAIR\n" +
+	"1F9B4 BONE\n" +
+	"1F9B5 LEG\n" +
+	"1F9B6 FOOT\n" +
+	"1F9B7 TOOTH\n" +
+	"1F9B8 SUPERHERO\n" +
+	"1F9B9 SUPERVILLAIN\n" +
+	"1F9BA SAFETY VEST\n" +
+	"1F9BB EAR WITH HEARING AID\n" +
+	"1F9BC MOTORIZED WHEELCHAIR\n" +
+	"1F9BD MANUAL WHEELCHAIR\n" +
+	"1F9BE MECHANICAL ARM\n" +
+	"1F9BF MECHANICAL LEG\n" +
+	"1F9C0 CHEESE WEDGE\n" +
+	"1F9C1 CUPCAKE\n" +
+	"1F9C2 SALT SHAKER\n" +
+	"1F9C3 BEVERAGE BOX\n" +
+	"1F9C4 GARLIC\n" +
+	"1F9C5 ONION\n" +
+	"1F9C6 FALAFEL\n" +
+	"1F9C7 WAFFLE\n" +
+	"1F9C8 BUTTER\n" +
+	"1F9C9 MATE DRINK\n" +
+	"1F9CA ICE CUBE\n" +
+	"1F9CB BUBBLE TEA\n" +
+	"1F9CC TROLL\n" +
+	"1F9CD STANDING PERSON\n" +
+	"1F9CE KNEELING PERSON\n" +
+	"1F9CF DEAF PERSON\n" +
+	"1F9D0 FACE WITH MONOCLE\n" +
+	"1F9D1 ADULT\n" +
+	"1F9D2 CHILD\n" +
+	"1F9D3 OLDER ADULT\n" +
+	"1F9D4 BEARDED PERSON\n" +
+	"1F9D5 PERSON WITH HEADSCARF\n" +
+	"1F9D6 PERSON IN STEAMY ROOM\n" +
+	"1F9D7 PERSON CLIMBING\n" +
+	"1F9D8 PERSON IN LOTUS POSITION\n" +
+	"1F9D9 MAGE\n" +
+	"1F9DA FAIRY\n" +
+	"1F9DB VAMPIRE\n" +
+	"1F9DC MERPERSON\n" +
+	"1F9DD ELF\n" +
+	"1F9DE GENIE\n" +
+	"1F9DF ZOMBIE\n" +
+	"1F9E0 BRAIN\n" +
+	"1F9E1 ORANGE HEART\n" +
+	"1F9E2 BILLED CAP\n" +
+	"1F9E3 SCARF\n" +
+	"1F9E4 GLOVES\n" +
+	"1F9E5 COAT\n" +
+	"1F9E6 SOCKS\n" +
+	"1F9E7 RED GIFT ENVELOPE\n" +
+	"1F9E8 FIRECRACKER\n" +
+	"1F9E9 JIGSAW PUZZLE PIECE\n" +
+	"1F9EA TEST TUBE\n" +
+	"1F9EB PETRI DISH\n" +
+	"1F9EC DNA DOUBLE HELIX\n" +
+	"1F9ED COMPASS\n" +
+	"1F9EE ABACUS\n" +
+	"1F9EF FIRE EXTINGUISHER\n" +
+	"1F9F0 TOOLBOX\n" +
+	"1F9F1 BRICK\n" +
+	"1F9F2 MAGNET\n" +
+	"1F9F3 LUGGAGE\n" +
+	"1F9F4 LOTION BOTTLE\n" +
+	"1F9F5 SPOOL OF THREAD\n" +
+	"1F9F6 BALL OF YARN\n" +
+	"1F9F7 SAFETY PIN\n" +
+	"1F9F8 TEDDY BEAR\n" +
+	"1F9F9 BROOM\n" +
+	"1F9FA BASKET\n" +
+	"1F9FB ROLL OF PAPER\n" +
+	"1F9FC BAR OF SOAP\n" +
+	"1F9FD SPONGE\n" +
+	"1F9FE RECEIPT\n" +
+	"1F9FF NAZAR AMULET\n"
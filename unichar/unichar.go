@@ -0,0 +1,222 @@
+// Package unichar looks up characters by code point, name and RFC 1345 digraph, for inserting
+// characters that are not on the keyboard.
+//
+// Character names are only known for the commonly used blocks: Latin, Greek, Cyrillic,
+// punctuation, symbols, arrows, mathematical operators, box drawing and emoticons.
+package unichar
+
+import (
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Entry describes a character that can be inserted.
+type Entry struct {
+	Rune    rune
+	Name    string
+	Digraph string
+}
+
+var (
+	loadNames sync.Once
+	names     map[rune]string
+	entries   []Entry
+)
+
+func load() {
+	names = map[rune]string{}
+	reverse := map[rune]string{}
+	for keys, r := range digraphs {
+		if old, ok := reverse[r]; !ok || keys < old {
+			reverse[r] = keys
+		}
+	}
+
+	for line := range strings.SplitSeq(nameData, "\n") {
+		hex, name, ok := strings.Cut(line, " ")
+		if !ok {
+			continue
+		}
+		n, err := strconv.ParseUint(hex, 16, 32)
+		if err != nil {
+			continue
+		}
+		r := rune(n)
+		names[r] = name
+		entries = append(entries, Entry{Rune: r, Name: name, Digraph: reverse[r]})
+	}
+}
+
+// Name returns the Unicode name of r, or "" if it is not known.
+func Name(r rune) string {
+	loadNames.Do(load)
+	return names[r]
+}
+
+// ParseCodepoint parses "U+00E9", "u+e9" or "0xE9" into the character it names.
+func ParseCodepoint(s string) (rune, bool) {
+	hex := s
+	switch {
+	case len(s) > 2 && (s[:2] == "U+" || s[:2] == "u+" || s[:2] == "0x" || s[:2] == "0X"):
+		hex = s[2:]
+	default:
+		return 0, false
+	}
+
+	n, err := strconv.ParseUint(hex, 16, 32)
+	if err != nil || n > 0x10FFFF || n >= 0xD800 && n <= 0xDFFF {
+		return 0, false
+	}
+	return rune(n), true
+}
+
+// Digraph returns the character for the two key mnemonic a, b. As in vim, the keys are also
+// tried in the opposite order.
+func Digraph(a, b rune) (rune, bool) {
+	if r, ok := digraphs[string([]rune{a, b})]; ok {
+		return r, true
+	}
+	r, ok := digraphs[string([]rune{b, a})]
+	return r, ok
+}
+
+// Search returns up to limit characters whose name contains every word of query, ignoring case.
+// Characters whose name starts with the query sort first, then shorter names before longer ones. A query that is a code point or a
+// digraph also matches that character.
+func Search(query string, limit int) []Entry {
+	loadNames.Do(load)
+
+	words := strings.Fields(strings.ToUpper(query))
+	var exact, prefix, other []Entry
+	if r, ok := ParseCodepoint(strings.TrimSpace(query)); ok {
+		exact = append(exact, Entry{Rune: r, Name: names[r]})
+	} else if q := []rune(query); len(q) == 2 {
+		if r, ok := Digraph(q[0], q[1]); ok {
+			exact = append(exact, Entry{Rune: r, Name: names[r], Digraph: query})
+		}
+	}
+
+	for _, e := range entries {
+		if len(words) == 0 || !containsAll(e.Name, words) {
+			continue
+		}
+		if strings.HasPrefix(e.Name, strings.Join(words, " ")) {
+			prefix = append(prefix, e)
+		} else {
+			other = append(other, e)
+		}
+	}
+
+	byLength := func(a, b Entry) int {
+		return len(a.Name) - len(b.Name)
+	}
+	slices.SortStableFunc(prefix, byLength)
+	slices.SortStableFunc(other, byLength)
+
+	found := slices.Concat(exact, prefix, other)
+	if limit > 0 && len(found) > limit {
+		found = found[:limit]
+	}
+	return found
+}
+
+func containsAll(name string, words []string) bool {
+	for _, w := range words {
+		if !strings.Contains(name, w) {
+			return false
+		}
+	}
+	return true
+}
+
+// Composer turns the keys typed after the insert-character prefix into a character: either
+// "U+" followed by hex digits, ended by any other key, or a two key digraph.
+type Composer struct {
+	keys []rune
+}
+
+// Feed adds a typed key. done reports whether the sequence is complete, in which case ok reports
+// whether it produced the character r. The key ending a code point is consumed.
+func (c *Composer) Feed(key rune) (r rune, done, ok bool) {
+	c.keys = append(c.keys, key)
+	keys := c.keys
+
+	if len(keys) >= 2 && (keys[0] == 'u' || keys[0] == 'U') && keys[1] == '+' {
+		isHex := strings.ContainsRune("0123456789abcdefABCDEF", key)
+		if len(keys) == 2 || isHex && len(keys) < 8 {
+			return 0, false, false
+		}
+
+		digits := keys[2:]
+		if !isHex {
+			digits = digits[:len(digits)-1]
+		}
+		c.keys = nil
+		r, ok = ParseCodepoint("U+" + string(digits))
+		return r, true, ok
+	}
+
+	if len(keys) < 2 {
+		return 0, false, false
+	}
+	c.keys = nil
+	r, ok = Digraph(keys[0], keys[1])
+	return r, true, ok
+}
+
+// Picker is a browsable, filterable list of characters.
+type Picker struct {
+	query    string
+	items    []Entry
+	selected int
+}
+
+// NewPicker returns a picker listing the digraph characters.
+func NewPicker() *Picker {
+	p := &Picker{}
+	p.SetQuery("")
+	return p
+}
+
+// SetQuery filters the list by name, code point or digraph. An empty query lists every
+// character with a digraph.
+func (p *Picker) SetQuery(query string) {
+	p.query, p.selected = query, 0
+	if strings.TrimSpace(query) != "" {
+		p.items = Search(query, 0)
+		return
+	}
+
+	loadNames.Do(load)
+	p.items = p.items[:0]
+	for _, e := range entries {
+		if e.Digraph != "" {
+			p.items = append(p.items, e)
+		}
+	}
+}
+
+// Items returns the characters matching the query.
+func (p *Picker) Items() []Entry {
+	return p.items
+}
+
+// Move moves the selection by delta items, clamped to the list.
+func (p *Picker) Move(delta int) {
+	p.selected = min(max(p.selected+delta, 0), max(len(p.items)-1, 0))
+}
+
+// Selected returns the index of the selected item.
+func (p *Picker) Selected() int {
+	return p.selected
+}
+
+// Choice returns the selected character. Returns false if nothing matches the query.
+func (p *Picker) Choice() (rune, bool) {
+	if len(p.items) == 0 {
+		return 0, false
+	}
+	return p.items[p.selected].Rune, true
+}
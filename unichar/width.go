@@ -0,0 +1,50 @@
+package unichar
+
+import (
+	"sort"
+	"unicode"
+)
+
+// Width returns how many terminal columns r takes on its own: 2 for East Asian wide and
+// fullwidth characters, 0 for combining marks and other zero width characters, 1 otherwise.
+// Control characters are reported as 1 although renderers usually show them as escapes.
+func Width(r rune) int {
+	switch {
+	case r == 0:
+		return 0
+	case r < 0x300:
+		return 1
+	case Extends(r) || r == zwj || unicode.Is(unicode.Cf, r):
+		return 0
+	}
+
+	i := sort.Search(len(wide), func(i int) bool {
+		return wide[i][1] >= r
+	})
+	if i < len(wide) && wide[i][0] <= r {
+		return 2
+	}
+	return 1
+}
+
+// ClusterWidth returns how many columns the grapheme cluster c takes. It is the width of its
+// first character, widened to 2 by an emoji presentation selector or for regional indicator
+// pairs.
+func ClusterWidth(c []rune) int {
+	if len(c) == 0 {
+		return 0
+	}
+
+	w := Width(c[0])
+	for _, r := range c[1:] {
+		if r == 0xFE0F || isRegional(c[0]) && isRegional(r) {
+			w = 2
+		}
+	}
+	return w
+}
+
+// IsRTL reports whether r belongs to a right to left script.
+func IsRTL(r rune) bool {
+	return unicode.In(r, unicode.Hebrew, unicode.Arabic, unicode.Syriac, unicode.Thaana, unicode.Nko)
+}
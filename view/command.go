@@ -0,0 +1,26 @@
+package view
+
+import (
+	"github.com/avalonbits/goted/command"
+	"github.com/avalonbits/goted/text"
+)
+
+// Register adds the scrolling commands to r. viewport returns the viewport showing a buffer.
+func Register(r *command.Registry, viewport func(*text.Buffer) *Viewport) {
+	scroll := func(fn func(*Viewport, *text.Buffer)) command.Func {
+		return func(b *text.Buffer, _ []string) error {
+			if v := viewport(b); v != nil {
+				fn(v, b)
+			}
+			return nil
+		}
+	}
+
+	r.Register("scroll-half-down", scroll(func(v *Viewport, b *text.Buffer) { v.HalfPage(b, 1) }))
+	r.Register("scroll-half-up", scroll(func(v *Viewport, b *text.Buffer) { v.HalfPage(b, -1) }))
+	r.Register("scroll-page-down", scroll(func(v *Viewport, b *text.Buffer) { v.FullPage(b, 1) }))
+	r.Register("scroll-page-up", scroll(func(v *Viewport, b *text.Buffer) { v.FullPage(b, -1) }))
+	r.Register("cursor-top", scroll(func(v *Viewport, b *text.Buffer) { v.CursorTop(b.Line(), b.Lines()) }))
+	r.Register("cursor-center", scroll(func(v *Viewport, b *text.Buffer) { v.CursorCenter(b.Line(), b.Lines()) }))
+	r.Register("cursor-bottom", scroll(func(v *Viewport, b *text.Buffer) { v.CursorBottom(b.Line(), b.Lines()) }))
+}
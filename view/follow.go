@@ -0,0 +1,85 @@
+package view
+
+// FollowMode is how a linked viewport tracks the one it follows.
+type FollowMode int
+
+const (
+	// FollowLine keeps the line distance between the two viewports that they had when linked.
+	FollowLine FollowMode = iota
+
+	// FollowPercent keeps both viewports at the same relative position in their documents,
+	// for documents of different lengths such as a file and its diff.
+	FollowPercent
+)
+
+// link ties two viewports so that scrolling one scrolls the other.
+type link struct {
+	a, b   *Viewport
+	mode   FollowMode
+	offset int
+}
+
+// Links is the set of viewport pairs that scroll in lockstep.
+type Links struct {
+	links []*link
+}
+
+// Toggle links a and b with mode, or unlinks them if they already are. Returns whether they are
+// linked afterwards.
+func (ls *Links) Toggle(a, b *Viewport, mode FollowMode) bool {
+	for i, l := range ls.links {
+		if l.a == a && l.b == b || l.a == b && l.b == a {
+			ls.links = append(ls.links[:i], ls.links[i+1:]...)
+			return false
+		}
+	}
+
+	ls.links = append(ls.links, &link{a: a, b: b, mode: mode, offset: b.Top - a.Top})
+	return true
+}
+
+// Linked returns whether v is linked to another viewport.
+func (ls *Links) Linked(v *Viewport) bool {
+	for _, l := range ls.links {
+		if l.a == v || l.b == v {
+			return true
+		}
+	}
+	return false
+}
+
+// Unlink removes every link involving v, for when its window closes.
+func (ls *Links) Unlink(v *Viewport) {
+	kept := ls.links[:0]
+	for _, l := range ls.links {
+		if l.a != v && l.b != v {
+			kept = append(kept, l)
+		}
+	}
+	ls.links = kept
+}
+
+// Scrolled moves the viewports linked to v after v scrolled. lines returns the length of the
+// document shown in a viewport.
+func (ls *Links) Scrolled(v *Viewport, lines func(*Viewport) int) {
+	for _, l := range ls.links {
+		switch v {
+		case l.a:
+			l.follow(l.a, l.b, l.offset, lines)
+		case l.b:
+			l.follow(l.b, l.a, -l.offset, lines)
+		}
+	}
+}
+
+func (l *link) follow(from, to *Viewport, offset int, lines func(*Viewport) int) {
+	switch l.mode {
+	case FollowLine:
+		to.Top = from.Top + offset
+	case FollowPercent:
+		fromRange := max(lines(from)-from.Height, 1)
+		toRange := max(lines(to)-to.Height, 0)
+		to.Top = from.Top * toRange / fromRange
+	}
+	to.clamp(lines(to))
+}
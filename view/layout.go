@@ -0,0 +1,104 @@
+package view
+
+import (
+	"github.com/avalonbits/goted/screen"
+	"github.com/avalonbits/goted/text"
+)
+
+// Pane is a node of the window layout. A leaf shows Buffer through Viewport; any other pane is
+// split into Children, side by side if Vertical is set and stacked otherwise.
+type Pane struct {
+	Buffer   *text.Buffer
+	Viewport *Viewport
+
+	Vertical bool
+	Children []*Pane
+
+	// Rect is where the pane was last laid out.
+	Rect screen.Rect
+}
+
+// Leaf reports whether p shows a buffer rather than being split.
+func (p *Pane) Leaf() bool {
+	return len(p.Children) == 0
+}
+
+// Leaves returns the panes showing buffers, in layout order.
+func (p *Pane) Leaves() []*Pane {
+	if p.Leaf() {
+		return []*Pane{p}
+	}
+	var leaves []*Pane
+	for _, c := range p.Children {
+		leaves = append(leaves, c.Leaves()...)
+	}
+	return leaves
+}
+
+// Split splits the leaf p in two, the new half showing b from the same line, and returns the new
+// pane. Split panes share the space equally.
+func (p *Pane) Split(b *text.Buffer, vertical bool) *Pane {
+	old := &Pane{Buffer: p.Buffer, Viewport: p.Viewport, Rect: p.Rect}
+	v := *p.Viewport
+	added := &Pane{Buffer: b, Viewport: &v}
+
+	p.Buffer, p.Viewport = nil, nil
+	p.Vertical, p.Children = vertical, []*Pane{old, added}
+	half := p.Rect
+	if vertical {
+		half.Width /= 2
+	} else {
+		half.Height /= 2
+	}
+	old.Rect, added.Rect = half, half
+	p.Layout(p.Rect)
+	return added
+}
+
+// Layout fits p into r. Children are resized in proportion to the space they had before, so
+// a terminal resize keeps the split ratios, and leaves keep their cursor line on the same row.
+func (p *Pane) Layout(r screen.Rect) {
+	p.Rect = r
+	if p.Leaf() {
+		if p.Viewport != nil && p.Buffer != nil {
+			p.Viewport.Resize(r.Height, p.Buffer.Line(), p.Buffer.Lines())
+		}
+		return
+	}
+
+	total, old := r.Height, 0
+	if p.Vertical {
+		total = r.Width
+	}
+	for _, c := range p.Children {
+		old += c.size(p.Vertical)
+	}
+
+	pos, used := 0, 0
+	for i, c := range p.Children {
+		size := total - used
+		if i < len(p.Children)-1 {
+			if old > 0 {
+				size = (c.size(p.Vertical) * total) / old
+			} else {
+				size = total / len(p.Children)
+			}
+			size = max(size, 1)
+		}
+
+		cr := screen.Rect{X: r.X, Y: r.Y + pos, Width: r.Width, Height: size}
+		if p.Vertical {
+			cr = screen.Rect{X: r.X + pos, Y: r.Y, Width: size, Height: r.Height}
+		}
+		c.Layout(cr)
+		pos, used = pos+size, used+size
+	}
+}
+
+// size returns the extent of p along the split direction.
+func (p *Pane) size(vertical bool) int {
+	if vertical {
+		return p.Rect.Width
+	}
+	return p.Rect.Height
+}
@@ -0,0 +1,130 @@
+package view
+
+import (
+	"strings"
+
+	"github.com/avalonbits/goted/render"
+	"github.com/avalonbits/goted/screen"
+	"github.com/avalonbits/goted/syntax"
+)
+
+// popupTab is how many columns a tab takes in popup text.
+const popupTab = 4
+
+// Popup is a box of text floating over a buffer next to Anchor, a rune offset, as used for
+// hover documentation, completion details and diagnostics. Its text is word wrapped to the box
+// and scrolls when it has more rows than fit; Top is the first row shown.
+type Popup struct {
+	Anchor int
+	Text   string
+	Top    int
+
+	// Spans style parts of Text, in rune offsets, such as the active parameter of a signature.
+	Spans []syntax.Span
+
+	// MaxWidth and MaxHeight bound the size of the text in the box, 0 leaving it to the screen.
+	MaxWidth, MaxHeight int
+
+	// height is how many rows were shown when the popup was last laid out.
+	height int
+}
+
+// Layout places the popup for its anchor at screen cell x, y within area, and returns its box
+// and all the rows of its text, of which those from Top on are shown. The box goes below the
+// anchor row if the text fits there or there is no more room above it, and above otherwise,
+// shifted left if needed to fit. The text has a column of padding on each side. Top is clamped
+// so the last row does not scroll past the bottom of the box.
+func (p *Popup) Layout(x, y int, area screen.Rect) (screen.Rect, []render.PopupRow) {
+	width := area.Width - 2
+	if p.MaxWidth > 0 {
+		width = min(width, p.MaxWidth)
+	}
+	rows, textWidth := p.rows(max(width, 1))
+
+	below := area.Y + area.Height - y - 1
+	above := y - area.Y
+	height := len(rows)
+	if p.MaxHeight > 0 {
+		height = min(height, p.MaxHeight)
+	}
+	r := screen.Rect{Width: min(textWidth+2, area.Width)}
+	if height <= below || below >= above {
+		r.Height = min(height, below)
+		r.Y = y + 1
+	} else {
+		r.Height = min(height, above)
+		r.Y = y - r.Height
+	}
+	r.X = max(min(x, area.X+area.Width-r.Width), area.X)
+
+	p.height = r.Height
+	p.Top = max(min(p.Top, len(rows)-r.Height), 0)
+	return r, rows
+}
+
+// ScrollPage scrolls the text a box height down, or up if dir is negative, keeping a row of
+// overlap.
+func (p *Popup) ScrollPage(dir int) {
+	step := max(p.height-1, 1)
+	if dir < 0 {
+		step = -step
+	}
+	p.Top = max(p.Top+step, 0)
+}
+
+// rows returns the text wrapped to width columns and the width of its widest row.
+func (p *Popup) rows(width int) ([]render.PopupRow, int) {
+	o := render.Options{TabWidth: popupTab}
+	var rows []render.PopupRow
+	widest, offset := 0, 0
+	for _, line := range strings.Split(strings.TrimRight(p.Text, "\n"), "\n") {
+		runes, cols := expandTabs([]rune(line))
+		var spans []syntax.Span
+		for _, s := range p.Spans {
+			start, end := s.Start-offset, s.End-offset
+			if end > 0 && start < len(cols)-1 {
+				start, end = max(start, 0), min(end, len(cols)-1)
+				spans = append(spans, syntax.Span{Start: cols[start], End: cols[end], Scope: s.Scope})
+			}
+		}
+		offset += len(cols)
+
+		starts := render.Wrap(runes, width, o)
+		for i, start := range starts {
+			end := len(runes)
+			if i+1 < len(starts) {
+				end = starts[i+1]
+			}
+			row := []rune(strings.TrimRight(string(runes[start:end]), " "))
+			r := render.PopupRow{Text: string(row)}
+			for _, s := range spans {
+				if s.End > start && s.Start < start+len(row) {
+					r.Spans = append(r.Spans, syntax.Span{
+						Start: max(s.Start-start, 0),
+						End:   min(s.End-start, len(row)),
+						Scope: s.Scope,
+					})
+				}
+			}
+			rows = append(rows, r)
+			widest = max(widest, render.ScreenColumn(row, len(row), o))
+		}
+	}
+	return rows, widest
+}
+
+// expandTabs replaces the tabs of line with spaces, and returns the column of the expanded line
+// where each rune of line, and the end of it, went.
+func expandTabs(line []rune) ([]rune, []int) {
+	var out []rune
+	cols := make([]int, 0, len(line)+1)
+	for _, r := range line {
+		cols = append(cols, len(out))
+		if r == '\t' {
+			out = append(out, []rune(strings.Repeat(" ", popupTab))...)
+		} else {
+			out = append(out, r)
+		}
+	}
+	return out, append(cols, len(out))
+}
@@ -0,0 +1,76 @@
+package view
+
+import (
+	"regexp"
+
+	"github.com/avalonbits/goted/text"
+)
+
+// MarkerKind is what a scrollbar marker points at. Higher kinds win when several markers share
+// a scrollbar row.
+type MarkerKind int
+
+const (
+	NoMarker MarkerKind = iota
+	SearchMarker
+	HunkMarker
+	DiagnosticMarker
+)
+
+// Marker flags a line of interest on the scrollbar.
+type Marker struct {
+	Line int
+	Kind MarkerKind
+}
+
+// ScrollbarRow is one row of the scrollbar: whether it is part of the thumb showing the visible
+// part of the document, and the most important marker in the lines it covers.
+type ScrollbarRow struct {
+	Thumb  bool
+	Marker MarkerKind
+}
+
+// Scrollbar lays out a scrollbar as tall as the viewport for a document of lines lines.
+func (v *Viewport) Scrollbar(lines int, markers []Marker) []ScrollbarRow {
+	rows := make([]ScrollbarRow, max(v.Height, 0))
+	if len(rows) == 0 {
+		return rows
+	}
+	lines = max(lines, 1)
+
+	first := v.Top * len(rows) / lines
+	last := max((v.Top+v.Height)*len(rows)/lines, first+1)
+	for i := first; i < min(last, len(rows)); i++ {
+		rows[i].Thumb = true
+	}
+
+	for _, m := range markers {
+		row := min(m.Line*len(rows)/lines, len(rows)-1)
+		if row >= 0 && m.Kind > rows[row].Marker {
+			rows[row].Marker = m.Kind
+		}
+	}
+	return rows
+}
+
+// ScrollbarLine returns the first document line represented by scrollbar row, for jumping
+// there on a click.
+func (v *Viewport) ScrollbarLine(row, lines int) int {
+	if v.Height <= 0 {
+		return 0
+	}
+	return min(max(row, 0)*lines/v.Height, max(lines-1, 0))
+}
+
+// SearchMarkers returns a marker for every line of b holding a match of re.
+func SearchMarkers(b *text.Buffer, re *regexp.Regexp) []Marker {
+	var markers []Marker
+	last := -1
+	for _, m := range b.FindAll(re) {
+		if line := b.LineOf(m[0]); line != last {
+			markers = append(markers, Marker{Line: line, Kind: SearchMarker})
+			last = line
+		}
+	}
+	return markers
+}
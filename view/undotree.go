@@ -0,0 +1,55 @@
+package view
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/avalonbits/goted/text"
+)
+
+// UndoTree draws the undo tree of a buffer, one state per line, for display in a side pane.
+// Branches are drawn with box characters and the current state is marked with "@". It also
+// returns the state shown on each line so a selection can be mapped back with Buffer.UndoTo.
+func UndoTree(states []text.UndoState) (lines []string, seqs []int) {
+	children := make(map[int][]int)
+	for _, s := range states {
+		if s.Parent >= 0 {
+			children[s.Parent] = append(children[s.Parent], s.Seq)
+		}
+	}
+
+	var walk func(seq int, prefix, branch string)
+	walk = func(seq int, prefix, branch string) {
+		s := states[seq]
+		mark := "o"
+		if s.Current {
+			mark = "@"
+		}
+		lines = append(lines, fmt.Sprintf("%s%s%s %d  %s", prefix, branch, mark, s.Seq, s.Time.Format("15:04:05")))
+		seqs = append(seqs, seq)
+
+		switch branch {
+		case "├─":
+			prefix += "│ "
+		case "└─":
+			prefix += "  "
+		}
+
+		kids := children[seq]
+		for i, kid := range kids {
+			if i == len(kids)-1 {
+				walk(kid, prefix, "└─")
+			} else {
+				walk(kid, prefix, "├─")
+			}
+		}
+	}
+
+	if len(states) > 0 {
+		walk(0, "", "")
+	}
+	for i := range lines {
+		lines[i] = strings.TrimRight(lines[i], " ")
+	}
+	return lines, seqs
+}
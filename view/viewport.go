@@ -0,0 +1,92 @@
+// Package view tracks which part of a buffer is shown on screen.
+package view
+
+import "github.com/avalonbits/goted/text"
+
+// Viewport is a window of Height lines starting at line Top. ScrollOff is how many lines of
+// context are kept above and below the cursor when scrolling to follow it.
+type Viewport struct {
+	Top       int
+	Height    int
+	ScrollOff int
+}
+
+// margin returns the scroll off in effect: it cannot exceed half of the viewport.
+func (v *Viewport) margin() int {
+	return max(min(v.ScrollOff, (v.Height-1)/2), 0)
+}
+
+// clamp keeps Top within a document of lines lines.
+func (v *Viewport) clamp(lines int) {
+	v.Top = max(min(v.Top, lines-1), 0)
+}
+
+// Follow scrolls the least needed to show line with the scroll off margin around it, in a
+// document of lines lines.
+func (v *Viewport) Follow(line, lines int) {
+	m := v.margin()
+	if line-m < v.Top {
+		v.Top = line - m
+	}
+	if line+m >= v.Top+v.Height {
+		v.Top = line + m - v.Height + 1
+	}
+	v.Top = min(v.Top, max(lines-v.Height, 0))
+	v.clamp(lines)
+}
+
+// Resize changes the height of the viewport, keeping line, the cursor line, on the same row
+// when it still fits, so the text around the cursor does not jump when the terminal is resized.
+func (v *Viewport) Resize(height, line, lines int) {
+	row := line - v.Top
+	v.Height = max(height, 1)
+	v.Top = line - min(max(row, 0), v.Height-1)
+	v.Follow(line, lines)
+}
+
+// ScrollBy moves the viewport delta lines down (up if negative) without moving the cursor.
+func (v *Viewport) ScrollBy(delta, lines int) {
+	v.Top += delta
+	v.clamp(lines)
+}
+
+// CursorTop scrolls so that line is at the top of the viewport, as vim's zt does.
+func (v *Viewport) CursorTop(line, lines int) {
+	v.Top = line - v.margin()
+	v.clamp(lines)
+}
+
+// CursorCenter scrolls so that line is in the middle of the viewport, as vim's zz does.
+func (v *Viewport) CursorCenter(line, lines int) {
+	v.Top = line - (v.Height-1)/2
+	v.clamp(lines)
+}
+
+// CursorBottom scrolls so that line is at the bottom of the viewport, as vim's zb does.
+func (v *Viewport) CursorBottom(line, lines int) {
+	v.Top = line - v.Height + 1 + v.margin()
+	v.clamp(lines)
+}
+
+// HalfPage scrolls half a viewport down (up if dir is negative) and moves the cursor of b by the
+// same number of lines, as vim's Ctrl-D and Ctrl-U do.
+func (v *Viewport) HalfPage(b *text.Buffer, dir int) {
+	v.page(b, dir, max(v.Height/2, 1))
+}
+
+// FullPage scrolls a viewport down (up if dir is negative), keeping two lines of overlap, and
+// moves the cursor of b by the same number of lines, as vim's Ctrl-F and Ctrl-B do.
+func (v *Viewport) FullPage(b *text.Buffer, dir int) {
+	v.page(b, dir, max(v.Height-2, 1))
+}
+
+func (v *Viewport) page(b *text.Buffer, dir, size int) {
+	if dir < 0 {
+		b.MoveUp(size)
+		v.ScrollBy(-size, b.Lines())
+	} else {
+		b.MoveDown(size)
+		v.ScrollBy(size, b.Lines())
+	}
+	v.Follow(b.Line(), b.Lines())
+}
@@ -0,0 +1,59 @@
+package view
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/avalonbits/goted/command"
+)
+
+// WhichKey lays out the continuations of a prefix key for a popup at most width columns wide,
+// in as many columns as fit, filled top to bottom. Keys starting longer bindings are listed as
+// "+N bindings".
+func WhichKey(conts []command.Continuation, width int) []string {
+	if len(conts) == 0 {
+		return nil
+	}
+
+	entries := make([]string, len(conts))
+	keyWidth, entryWidth := 0, 0
+	for _, c := range conts {
+		keyWidth = max(keyWidth, utf8.RuneCountInString(c.Key))
+	}
+	for i, c := range conts {
+		what := c.Command
+		if c.Prefix {
+			if what != "" {
+				what += ", "
+			}
+			what += fmt.Sprintf("+%d binding", c.Bindings)
+			if c.Bindings > 1 {
+				what += "s"
+			}
+		}
+		entries[i] = fmt.Sprintf("%-*s  %s", keyWidth, c.Key, what)
+		entryWidth = max(entryWidth, utf8.RuneCountInString(entries[i]))
+	}
+
+	const gap = 3
+	cols := max((width+gap)/(entryWidth+gap), 1)
+	rows := (len(entries) + cols - 1) / cols
+
+	lines := make([]string, rows)
+	for r := range rows {
+		var line strings.Builder
+		for c := range cols {
+			i := c*rows + r
+			if i >= len(entries) {
+				break
+			}
+			if c > 0 {
+				line.WriteString(strings.Repeat(" ", gap))
+			}
+			fmt.Fprintf(&line, "%-*s", entryWidth, entries[i])
+		}
+		lines[r] = strings.TrimRight(line.String(), " ")
+	}
+	return lines
+}